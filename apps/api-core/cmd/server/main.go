@@ -65,13 +65,24 @@ func main() {
 	jwtService := auth.NewJWTService(cfg.JWT.Secret, cfg.JWT.AccessTokenExpiry, cfg.JWT.RefreshTokenExpiry)
 
 	userRepo := repositories.NewUserRepository(db)
-	roomRepo := repositories.NewRoomRepository(db)
+	roomHoldRepo := repositories.NewRoomHoldRepository(redis)
+	roomRepo := repositories.NewRoomRepository(db, roomHoldRepo)
 	roomGroupRepo := repositories.NewRoomGroupRepository(db)
+	amenityRepo := repositories.NewAmenityRepository(db)
+	guestRepo := repositories.NewGuestRepository(db)
 	reservationRepo := repositories.NewReservationRepository(db)
 	dateBlockRepo := repositories.NewDateBlockRepository(db)
+	seasonRepo := repositories.NewSeasonRepository(db)
+	pricingRuleRepo := repositories.NewPricingRuleRepository(db)
+	reservationPaymentRepo := repositories.NewReservationPaymentRepository(db)
+	brokerFeeSettlementRepo := repositories.NewBrokerFeeSettlementRepository(db)
+	rentChargeRepo := repositories.NewRentChargeRepository(db)
 	paymentMethodRepo := repositories.NewPaymentMethodRepository(db)
 	loginAttemptRepo := repositories.NewLoginAttemptRepository(db)
-	// reservationRoomRepo := repositories.NewReservationRoomRepository(db) // Not used
+	reservationRoomRepo := repositories.NewReservationRoomRepository(db)
+	housekeepingTaskRepo := repositories.NewHousekeepingTaskRepository(db)
+	maintenanceTicketRepo := repositories.NewMaintenanceTicketRepository(db)
+	roomStatusScheduleRepo := repositories.NewRoomStatusScheduleRepository(db)
 
 	// Initialize audit service first
 	auditService := audit.NewService(db)
@@ -80,10 +91,26 @@ func main() {
 
 	authService := services.NewAuthService(userRepo, loginAttemptRepo, jwtService, cfg)
 	userService := services.NewUserService(userRepo)
-	roomService := services.NewRoomService(roomRepo, roomGroupRepo, auditService)
-	roomGroupService := services.NewRoomGroupService(roomGroupRepo)
-	reservationService := services.NewReservationService(reservationRepo, roomRepo, paymentMethodRepo, auditService, dateBlockRepo)
-	dateBlockService := services.NewDateBlockService(dateBlockRepo, auditService)
+	roomService := services.NewRoomService(roomRepo, roomGroupRepo, auditService, amenityRepo)
+	roomGroupService := services.NewRoomGroupService(roomGroupRepo, amenityRepo)
+	amenityService := services.NewAmenityService(amenityRepo, auditService)
+	guestService := services.NewGuestService(guestRepo)
+	reservationService := services.NewReservationService(reservationRepo, roomRepo, paymentMethodRepo, guestRepo, auditService, dateBlockRepo)
+	roomHoldService := services.NewRoomHoldService(roomHoldRepo, reservationRepo, roomRepo, dateBlockRepo, reservationService)
+	dateBlockService := services.NewDateBlockService(dateBlockRepo, roomGroupRepo, roomRepo, auditService)
+	seasonService := services.NewSeasonService(seasonRepo, roomGroupRepo, auditService)
+	pricingRuleService := services.NewPricingRuleService(pricingRuleRepo, roomGroupRepo, auditService)
+	quoteService := services.NewQuoteService(roomRepo, seasonRepo, pricingRuleRepo)
+	occupancyService := services.NewOccupancyService(roomRepo, reservationRoomRepo, dateBlockRepo, roomStatusScheduleRepo)
+	availabilityService := services.NewAvailabilityService(roomRepo, roomGroupRepo, reservationRoomRepo, dateBlockRepo, roomHoldRepo, seasonRepo, roomStatusScheduleRepo)
+	roomAssignmentService := services.NewRoomAssignmentService(reservationRepo, roomRepo, dateBlockRepo, roomHoldRepo, roomStatusScheduleRepo)
+	housekeepingService := services.NewHousekeepingService(housekeepingTaskRepo, roomRepo, reservationRoomRepo, userRepo)
+	maintenanceTicketService := services.NewMaintenanceTicketService(maintenanceTicketRepo, roomRepo, userRepo, roomStatusScheduleRepo)
+	roomStatusScheduleService := services.NewRoomStatusScheduleService(roomStatusScheduleRepo, roomRepo)
+	reservationPaymentService := services.NewReservationPaymentService(reservationPaymentRepo, reservationRepo, paymentMethodRepo, brokerFeeSettlementRepo, auditService)
+	rentScheduleService := services.NewRentScheduleService(rentChargeRepo, reservationRepo, reservationPaymentService)
+	stayChangeService := services.NewStayChangeService(reservationRepo, roomRepo, dateBlockRepo, quoteService)
+	brokerFeeSettlementService := services.NewBrokerFeeSettlementService(brokerFeeSettlementRepo, reservationRepo, paymentMethodRepo, auditService)
 	paymentMethodService := services.NewPaymentMethodService(paymentMethodRepo)
 	configService := services.NewConfigService(cfg)
 	developmentService := services.NewDevelopmentServiceV2(db)
@@ -93,9 +120,25 @@ func main() {
 	mainHandler := handlers.NewMainHandler(configService, userRepo)
 	userHandler := handlers.NewUserHandler(userService)
 	roomHandler := handlers.NewRoomHandler(roomService, userService, historyService)
+	roomStatusScheduleHandler := handlers.NewRoomStatusScheduleHandler(roomStatusScheduleService)
 	roomGroupHandler := handlers.NewRoomGroupHandler(roomGroupService, reservationService, userService)
-	reservationHandler := handlers.NewReservationHandler(reservationService, userService, historyService)
+	reservationHandler := handlers.NewReservationHandler(reservationService, userService, historyService, quoteService)
+	roomHoldHandler := handlers.NewRoomHoldHandler(roomHoldService, reservationService, quoteService, userService)
 	dateBlockHandler := handlers.NewDateBlockHandler(dateBlockService, historyService)
+	seasonHandler := handlers.NewSeasonHandler(seasonService, historyService)
+	pricingRuleHandler := handlers.NewPricingRuleHandler(pricingRuleService)
+	amenityHandler := handlers.NewAmenityHandler(amenityService)
+	guestHandler := handlers.NewGuestHandler(guestService)
+	quoteHandler := handlers.NewQuoteHandler(quoteService)
+	occupancyHandler := handlers.NewOccupancyHandler(occupancyService)
+	availabilityHandler := handlers.NewAvailabilityHandler(availabilityService)
+	roomAssignmentHandler := handlers.NewRoomAssignmentHandler(roomAssignmentService)
+	housekeepingHandler := handlers.NewHousekeepingHandler(housekeepingService, historyService)
+	maintenanceTicketHandler := handlers.NewMaintenanceTicketHandler(maintenanceTicketService, historyService)
+	reservationPaymentHandler := handlers.NewReservationPaymentHandler(reservationPaymentService)
+	brokerFeeSettlementHandler := handlers.NewBrokerFeeSettlementHandler(brokerFeeSettlementService)
+	rentScheduleHandler := handlers.NewRentScheduleHandler(rentScheduleService)
+	stayChangeHandler := handlers.NewStayChangeHandler(stayChangeService)
 	paymentMethodHandler := handlers.NewPaymentMethodHandler(paymentMethodService)
 	developmentHandler := handlers.NewDevelopmentHandler(developmentService)
 	healthHandler := handlers.NewHealthHandler(db, redis)
@@ -121,7 +164,7 @@ func main() {
 		c.File("./public/index.html")
 	})
 
-	setupRoutes(router, authHandler, mainHandler, userHandler, roomHandler, roomGroupHandler, reservationHandler, dateBlockHandler, paymentMethodHandler, developmentHandler, healthHandler, docsHandler, auditHandler, jwtService, cfg)
+	setupRoutes(router, authHandler, mainHandler, userHandler, roomHandler, roomStatusScheduleHandler, roomGroupHandler, reservationHandler, roomHoldHandler, dateBlockHandler, seasonHandler, pricingRuleHandler, amenityHandler, guestHandler, quoteHandler, occupancyHandler, availabilityHandler, roomAssignmentHandler, housekeepingHandler, maintenanceTicketHandler, reservationPaymentHandler, brokerFeeSettlementHandler, rentScheduleHandler, stayChangeHandler, paymentMethodHandler, developmentHandler, healthHandler, docsHandler, auditHandler, jwtService, cfg)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
@@ -136,10 +179,14 @@ func main() {
 
 	log.Printf("Server started on port %d", cfg.Server.Port)
 
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	go runRoomStatusScheduler(schedulerCtx, roomStatusScheduleService)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopScheduler()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -150,10 +197,43 @@ func main() {
 	log.Println("Server exiting")
 }
 
+// runRoomStatusScheduler는 서버를 시작할 때와 이후 매시간 날짜가 된 객실 상태 예약을 객실에 적용하고,
+// 기간이 끝난 예약의 객실 상태를 되돌립니다.
+func runRoomStatusScheduler(ctx context.Context, service services.RoomStatusScheduleService) {
+	applyDue := func() {
+		applied, reverted, err := service.ApplyDue(ctx, time.Now())
+		if err != nil {
+			log.Printf("Failed to apply room status schedules: %v", err)
+			return
+		}
+		if applied > 0 || reverted > 0 {
+			log.Printf("Room status schedules applied: %d, reverted: %d", applied, reverted)
+		}
+	}
+
+	applyDue()
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			applyDue()
+		}
+	}
+}
+
 func setupRoutes(r *gin.Engine, authHandler *handlers.AuthHandler, mainHandler *handlers.MainHandler,
-	userHandler *handlers.UserHandler, roomHandler *handlers.RoomHandler,
+	userHandler *handlers.UserHandler, roomHandler *handlers.RoomHandler, roomStatusScheduleHandler *handlers.RoomStatusScheduleHandler,
 	roomGroupHandler *handlers.RoomGroupHandler, reservationHandler *handlers.ReservationHandler,
-	dateBlockHandler *handlers.DateBlockHandler,
+	roomHoldHandler *handlers.RoomHoldHandler, dateBlockHandler *handlers.DateBlockHandler, seasonHandler *handlers.SeasonHandler,
+	pricingRuleHandler *handlers.PricingRuleHandler, amenityHandler *handlers.AmenityHandler, guestHandler *handlers.GuestHandler,
+	quoteHandler *handlers.QuoteHandler, occupancyHandler *handlers.OccupancyHandler,
+	availabilityHandler *handlers.AvailabilityHandler, roomAssignmentHandler *handlers.RoomAssignmentHandler,
+	housekeepingHandler *handlers.HousekeepingHandler, maintenanceTicketHandler *handlers.MaintenanceTicketHandler,
+	reservationPaymentHandler *handlers.ReservationPaymentHandler, brokerFeeSettlementHandler *handlers.BrokerFeeSettlementHandler,
+	rentScheduleHandler *handlers.RentScheduleHandler, stayChangeHandler *handlers.StayChangeHandler,
 	paymentMethodHandler *handlers.PaymentMethodHandler, developmentHandler *handlers.DevelopmentHandler,
 	healthHandler *handlers.HealthHandler, docsHandler *handlers.DocsHandler, auditHandler *handlers.AuditHandler,
 	jwtService *auth.JWTService, cfg *config.Config) {
@@ -218,6 +298,10 @@ func setupRoutes(r *gin.Engine, authHandler *handlers.AuthHandler, mainHandler *
 				roomRoutes.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomHandler.UpdateRoom)
 				roomRoutes.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomHandler.DeleteRoom)
 				roomRoutes.GET("/:id/histories", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomHandler.GetRoomHistories)
+				roomRoutes.GET("/:id/status-timeline", roomStatusScheduleHandler.GetTimeline)
+				roomRoutes.GET("/:id/status-schedules", roomStatusScheduleHandler.ListSchedules)
+				roomRoutes.POST("/:id/status-schedules", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomStatusScheduleHandler.CreateSchedule)
+				roomRoutes.DELETE("/:id/status-schedules/:scheduleId", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomStatusScheduleHandler.DeleteSchedule)
 			}
 
 			roomGroupRoutes := authenticated.Group("/room-groups")
@@ -237,6 +321,27 @@ func setupRoutes(r *gin.Engine, authHandler *handlers.AuthHandler, mainHandler *
 				reservationRoutes.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.UpdateReservation)
 				reservationRoutes.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.DeleteReservation)
 				reservationRoutes.GET("/:id/histories", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.GetReservationHistories)
+				reservationRoutes.POST("/:id/check-in", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.CheckInReservation)
+				reservationRoutes.POST("/:id/check-out", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.CheckOutReservation)
+				reservationRoutes.POST("/:id/move-room", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.MoveReservationRoom)
+				reservationRoutes.POST("/:id/extend", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), stayChangeHandler.ExtendStay)
+				reservationRoutes.POST("/:id/shorten", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), stayChangeHandler.ShortenStay)
+				reservationRoutes.GET("/:id/payments", reservationPaymentHandler.ListPayments)
+				reservationRoutes.POST("/:id/payments", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationPaymentHandler.CreatePayment)
+				reservationRoutes.PATCH("/:id/payments/:paymentId", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationPaymentHandler.UpdatePayment)
+				reservationRoutes.DELETE("/:id/payments/:paymentId", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationPaymentHandler.DeletePayment)
+				reservationRoutes.GET("/:id/rent-schedule", rentScheduleHandler.GetRentSchedule)
+				reservationRoutes.POST("/:id/rent-schedule/:chargeId/payment", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), rentScheduleHandler.PayRentCharge)
+				reservationRoutes.DELETE("/:id/rent-schedule/:chargeId/payment", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), rentScheduleHandler.CancelRentChargePayment)
+			}
+
+			roomHoldRoutes := authenticated.Group("/room-holds")
+			{
+				roomHoldRoutes.GET("", roomHoldHandler.ListRoomHolds)
+				roomHoldRoutes.GET("/:id", roomHoldHandler.GetRoomHold)
+				roomHoldRoutes.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomHoldHandler.CreateRoomHold)
+				roomHoldRoutes.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomHoldHandler.ReleaseRoomHold)
+				roomHoldRoutes.POST("/:id/reservation", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomHoldHandler.ConvertRoomHold)
 			}
 
 			dateBlocks := authenticated.Group("/date-blocks")
@@ -247,6 +352,74 @@ func setupRoutes(r *gin.Engine, authHandler *handlers.AuthHandler, mainHandler *
 				dateBlocks.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), dateBlockHandler.UpdateDateBlock)
 				dateBlocks.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), dateBlockHandler.DeleteDateBlock)
 				dateBlocks.GET("/:id/histories", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), dateBlockHandler.GetDateBlockHistories)
+				dateBlocks.GET("/:id/conflicts", dateBlockHandler.GetDateBlockConflicts)
+			}
+
+			seasons := authenticated.Group("/seasons")
+			{
+				seasons.GET("", seasonHandler.ListSeasons)
+				seasons.GET("/:id", seasonHandler.GetSeason)
+				seasons.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), seasonHandler.CreateSeason)
+				seasons.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), seasonHandler.UpdateSeason)
+				seasons.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), seasonHandler.DeleteSeason)
+				seasons.GET("/:id/histories", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), seasonHandler.GetSeasonHistories)
+			}
+
+			pricingRules := authenticated.Group("/pricing-rules")
+			{
+				pricingRules.GET("", pricingRuleHandler.ListPricingRules)
+				pricingRules.GET("/:id", pricingRuleHandler.GetPricingRule)
+				pricingRules.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), pricingRuleHandler.CreatePricingRule)
+				pricingRules.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), pricingRuleHandler.UpdatePricingRule)
+				pricingRules.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), pricingRuleHandler.DeletePricingRule)
+			}
+
+			amenities := authenticated.Group("/amenities")
+			{
+				amenities.GET("", amenityHandler.ListAmenities)
+				amenities.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), amenityHandler.CreateAmenity)
+				amenities.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), amenityHandler.UpdateAmenity)
+				amenities.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), amenityHandler.DeleteAmenity)
+			}
+
+			// Guest routes
+			guests := authenticated.Group("/guests")
+			{
+				guests.GET("", guestHandler.ListGuests)
+				guests.GET("/:id", guestHandler.GetGuest)
+				guests.GET("/:id/history", guestHandler.GetGuestHistory)
+				guests.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), guestHandler.CreateGuest)
+				guests.PATCH("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), guestHandler.UpdateGuest)
+			}
+
+			authenticated.GET("/quotes", quoteHandler.GetQuote)
+			authenticated.GET("/occupancy", occupancyHandler.GetOccupancy)
+			authenticated.GET("/availability", availabilityHandler.SearchAvailability)
+
+			roomAssignments := authenticated.Group("/room-assignments")
+			{
+				roomAssignments.GET("/proposal", roomAssignmentHandler.GetRoomAssignmentProposal)
+				roomAssignments.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), roomAssignmentHandler.ApplyRoomAssignments)
+			}
+
+			housekeeping := authenticated.Group("/housekeeping")
+			{
+				housekeeping.GET("/rooms", housekeepingHandler.ListRooms)
+				housekeeping.PATCH("/rooms/:id", housekeepingHandler.UpdateRoom)
+				housekeeping.GET("/tasks", housekeepingHandler.ListTasks)
+				housekeeping.POST("/tasks/generate", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), housekeepingHandler.GenerateTasks)
+				housekeeping.PATCH("/tasks/:id", housekeepingHandler.UpdateTask)
+				housekeeping.GET("/tasks/:id/histories", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), housekeepingHandler.GetTaskHistories)
+			}
+
+			maintenanceTickets := authenticated.Group("/maintenance-tickets")
+			{
+				maintenanceTickets.GET("", maintenanceTicketHandler.ListTickets)
+				maintenanceTickets.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), maintenanceTicketHandler.CreateTicket)
+				maintenanceTickets.GET("/:id", maintenanceTicketHandler.GetTicket)
+				maintenanceTickets.PATCH("/:id", maintenanceTicketHandler.UpdateTicket)
+				maintenanceTickets.POST("/:id/resolve", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), maintenanceTicketHandler.ResolveTicket)
+				maintenanceTickets.GET("/:id/histories", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), maintenanceTicketHandler.GetTicketHistories)
 			}
 
 			reservationStatsRoutes := authenticated.Group("/reservation-statistics")
@@ -254,6 +427,30 @@ func setupRoutes(r *gin.Engine, authHandler *handlers.AuthHandler, mainHandler *
 				reservationStatsRoutes.GET("", reservationHandler.GetReservationStatistics)
 			}
 
+			reservationDuplicateRoutes := authenticated.Group("/reservation-duplicates")
+			{
+				reservationDuplicateRoutes.GET("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), reservationHandler.GetDuplicateReport)
+			}
+
+			reservationReceivableRoutes := authenticated.Group("/reservation-receivables")
+			{
+				reservationReceivableRoutes.GET("", reservationHandler.GetReceivables)
+			}
+
+			rentCharges := authenticated.Group("/rent-charges")
+			{
+				rentCharges.GET("/overdue", rentScheduleHandler.ListOverdueRentCharges)
+			}
+
+			brokerFeeSettlements := authenticated.Group("/broker-fee-settlements")
+			{
+				brokerFeeSettlements.GET("", brokerFeeSettlementHandler.ListSettlements)
+				brokerFeeSettlements.GET("/report", brokerFeeSettlementHandler.GetReport)
+				brokerFeeSettlements.GET("/:id", brokerFeeSettlementHandler.GetSettlement)
+				brokerFeeSettlements.POST("", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), brokerFeeSettlementHandler.CreateSettlement)
+				brokerFeeSettlements.DELETE("/:id", middleware.RoleMiddleware("ADMIN", "SUPER_ADMIN"), brokerFeeSettlementHandler.DeleteSettlement)
+			}
+
 			paymentMethodRoutes := authenticated.Group("/payment-methods")
 			{
 				paymentMethodRoutes.GET("", paymentMethodHandler.ListPaymentMethods)
@@ -7,7 +7,10 @@ import (
 // contextKey is a private type for context keys to avoid collisions
 type contextKey string
 
-const userContextKey contextKey = "audit_user_context"
+const (
+	userContextKey   contextKey = "audit_user_context"
+	actionContextKey contextKey = "audit_action"
+)
 
 // SetUserContext sets user context in the request context for audit logging
 func SetUserContext(ctx context.Context, userID *uint, username string) context.Context {
@@ -34,3 +37,15 @@ func HasUserContext(ctx context.Context) bool {
 	_, ok := ctx.Value(userContextKey).(*UserContext)
 	return ok
 }
+
+// WithAction sets the action to record for updates made with this context
+// (e.g. CHECK_IN instead of a generic UPDATE)
+func WithAction(ctx context.Context, action Action) context.Context {
+	return context.WithValue(ctx, actionContextKey, action)
+}
+
+// GetAction retrieves the action set by WithAction
+func GetAction(ctx context.Context) (Action, bool) {
+	action, ok := ctx.Value(actionContextKey).(Action)
+	return action, ok
+}
@@ -93,6 +93,12 @@ func RegisterHooks(db *gorm.DB, auditService AuditService) {
 			if _, isReservation := auditable.(*models.Reservation); isReservation {
 				freshDB = freshDB.Preload("PaymentMethod").Preload("Rooms.Room")
 			}
+			if _, isSeason := auditable.(*models.Season); isSeason {
+				freshDB = freshDB.Preload("RoomGroups")
+			}
+			if _, isDateBlock := auditable.(*models.DateBlock); isDateBlock {
+				freshDB = freshDB.Preload("RoomGroups").Preload("Rooms").Preload("Exceptions")
+			}
 
 			if err := freshDB.Where("id = ?", auditable.GetAuditEntityID()).First(oldEntity).Error; err == nil {
 				if oldAuditable, ok := oldEntity.(Auditable); ok {
@@ -13,6 +13,14 @@ const (
 	ActionCreate Action = "CREATE"
 	ActionUpdate Action = "UPDATE"
 	ActionDelete Action = "DELETE"
+
+	// 일반 수정과 구분해서 이력을 남겨야 하는 업무 동작
+	ActionCheckIn        Action = "CHECK_IN"
+	ActionCheckOut       Action = "CHECK_OUT"
+	ActionRoomAssignment Action = "ROOM_ASSIGNMENT"
+	ActionRoomMove       Action = "ROOM_MOVE"
+	ActionStayExtend     Action = "STAY_EXTEND"
+	ActionStayShorten    Action = "STAY_SHORTEN"
 )
 
 // Auditable interface should be implemented by models that need audit logging
@@ -94,10 +94,15 @@ func (s *service) LogUpdate(ctx context.Context, entity Auditable, oldValues map
 		return fmt.Errorf("failed to marshal changed fields: %w", err)
 	}
 
+	action := ActionUpdate
+	if contextAction, ok := GetAction(ctx); ok {
+		action = contextAction
+	}
+
 	auditLog := AuditLog{
 		EntityType:    entity.GetAuditEntityType(),
 		EntityID:      entity.GetAuditEntityID(),
-		Action:        ActionUpdate,
+		Action:        action,
 		OldValues:     oldValuesJSON,
 		NewValues:     newValuesJSON,
 		ChangedFields: changedFieldsJSON,
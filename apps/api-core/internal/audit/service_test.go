@@ -118,6 +118,25 @@ func TestAuditService_LogUpdate(t *testing.T) {
 	assert.Contains(t, changedFields, "age")
 }
 
+func TestAuditService_LogUpdate_WithAction(t *testing.T) {
+	db := setupTestDB(t)
+	service := NewService(db)
+
+	entity := &testEntity{ID: 1, Name: "Entity", Age: 30}
+	oldValues := map[string]interface{}{"id": uint(1), "name": "Entity", "age": 25}
+
+	// 컨텍스트에 지정한 업무 동작으로 기록되어야 함
+	ctx := WithAction(context.Background(), ActionCheckIn)
+
+	err := service.LogUpdate(ctx, entity, oldValues)
+	assert.NoError(t, err)
+
+	var auditLog AuditLog
+	err = db.Where("entity_type = ? AND entity_id = ?", "test_entity", uint(1)).First(&auditLog).Error
+	assert.NoError(t, err)
+	assert.Equal(t, ActionCheckIn, auditLog.Action)
+}
+
 func TestAuditService_LogUpdate_NoChanges(t *testing.T) {
 	db := setupTestDB(t)
 	service := NewService(db)
@@ -0,0 +1,28 @@
+package dto
+
+type AmenityResponse struct {
+	ID        uint       `json:"id"`
+	Code      string     `json:"code"`
+	Name      string     `json:"name"`
+	Category  string     `json:"category"`
+	CreatedAt CustomTime `json:"createdAt"`
+	UpdatedAt CustomTime `json:"updatedAt"`
+}
+
+// AmenityFilter는 GET /amenities 쿼리 파라미터입니다.
+type AmenityFilter struct {
+	Category *string `form:"category" binding:"omitempty,oneof=VIEW FACILITY POLICY BED"`
+}
+
+// CreateAmenityRequest의 Code는 편의시설을 구분하는 고유 코드입니다 (예: OCEAN_VIEW, PET_FRIENDLY, KITCHEN, QUEEN_BED_1).
+type CreateAmenityRequest struct {
+	Code     string `json:"code" binding:"required,min=1,max=30"`
+	Name     string `json:"name" binding:"required,min=1,max=50"`
+	Category string `json:"category" binding:"required,oneof=VIEW FACILITY POLICY BED"`
+}
+
+type UpdateAmenityRequest struct {
+	Code     *string `json:"code" binding:"omitempty,min=1,max=30"`
+	Name     *string `json:"name" binding:"omitempty,min=1,max=50"`
+	Category *string `json:"category" binding:"omitempty,oneof=VIEW FACILITY POLICY BED"`
+}
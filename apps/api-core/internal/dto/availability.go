@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+// AvailabilityQuery는 GET /availability 쿼리 파라미터입니다. stayEndAt(퇴실일)은 숙박일에 포함하지 않습니다.
+// roomCount를 지정하면 숙박 기간 내내 그만큼의 객실이 비어 있는 객실 그룹만 반환합니다.
+type AvailabilityQuery struct {
+	StayStartAt string `form:"stayStartAt" binding:"required"`
+	StayEndAt   string `form:"stayEndAt" binding:"required"`
+	RoomGroupID *uint  `form:"roomGroupId"`
+	RoomCount   int    `form:"roomCount" binding:"min=0"`
+	PeopleCount int    `form:"peopleCount" binding:"min=0"`
+	AmenityIDs  []uint `form:"amenityIds"`
+}
+
+// ToAvailabilityRequest는 쿼리 파라미터를 빈 객실 조회 요청으로 변환합니다.
+func (q *AvailabilityQuery) ToAvailabilityRequest() (AvailabilityRequest, error) {
+	stayStartAt, err := time.Parse("2006-01-02", q.StayStartAt)
+	if err != nil {
+		return AvailabilityRequest{}, fmt.Errorf("invalid stayStartAt format, expected YYYY-MM-DD")
+	}
+	stayEndAt, err := time.Parse("2006-01-02", q.StayEndAt)
+	if err != nil {
+		return AvailabilityRequest{}, fmt.Errorf("invalid stayEndAt format, expected YYYY-MM-DD")
+	}
+
+	return AvailabilityRequest{
+		StayStartAt: stayStartAt,
+		StayEndAt:   stayEndAt,
+		RoomGroupID: q.RoomGroupID,
+		RoomCount:   q.RoomCount,
+		PeopleCount: q.PeopleCount,
+		AmenityIDs:  q.AmenityIDs,
+	}, nil
+}
+
+// AvailabilityRequest는 객실 그룹별 빈 객실 수 조회 입력입니다.
+// PeopleCount는 객실 1개에 묵을 인원이며, 최대 인원이 그보다 적은 객실은 빈 객실로 세지 않습니다.
+// AmenityIDs를 지정하면 객실 또는 객실 그룹에 모든 편의시설이 있는 객실만 셉니다.
+type AvailabilityRequest struct {
+	StayStartAt time.Time
+	StayEndAt   time.Time
+	RoomGroupID *uint
+	RoomCount   int
+	PeopleCount int
+	AmenityIDs  []uint
+}
+
+// AvailabilityResponse는 숙박 기간의 객실 그룹별 빈 객실 수입니다.
+type AvailabilityResponse struct {
+	StayStartAt JSONDate                        `json:"stayStartAt"`
+	StayEndAt   JSONDate                        `json:"stayEndAt"`
+	Nights      int                             `json:"nights"`
+	RoomGroups  []RoomGroupAvailabilityResponse `json:"roomGroups"`
+}
+
+// RoomGroupAvailabilityResponse는 객실 그룹 하나의 빈 객실 수입니다.
+// AvailableCount는 숙박 기간 내내 비어 있는 객실 수이며, 박별 빈 객실 수의 최솟값(MinAvailableCount)보다 작을 수 있습니다.
+// Price는 객실 1개의 박별 기준 요금(성수기 PeekPrice, 비성수기 OffPeekPrice) 합계로, 요금 규칙은 반영하지 않습니다.
+type RoomGroupAvailabilityResponse struct {
+	RoomGroupID       uint                          `json:"roomGroupId"`
+	RoomGroupName     string                        `json:"roomGroupName"`
+	StandardOccupancy int                           `json:"standardOccupancy"`
+	MaxOccupancy      int                           `json:"maxOccupancy"`
+	TotalCount        int                           `json:"totalCount"`
+	AvailableCount    int                           `json:"availableCount"`
+	MinAvailableCount int                           `json:"minAvailableCount"`
+	Price             int                           `json:"price"`
+	Nights            []NightlyAvailabilityResponse `json:"nights"`
+}
+
+type NightlyAvailabilityResponse struct {
+	Date           JSONDate `json:"date"`
+	AvailableCount int      `json:"availableCount"`
+	Peak           bool     `json:"peak"`
+	Price          int      `json:"price"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+// BrokerFeeSettlementReportQuery는 결제 수단별 정산 현황 조회 조건입니다. 결제 내역의 입금일 기준으로 기간을 나눕니다.
+type BrokerFeeSettlementReportQuery struct {
+	StartDate       time.Time `form:"startDate" binding:"required" time_format:"2006-01-02"`
+	EndDate         time.Time `form:"endDate" binding:"required" time_format:"2006-01-02"`
+	PeriodType      string    `form:"periodType" binding:"omitempty,oneof=DAILY MONTHLY YEARLY"`
+	PaymentMethodID *uint     `form:"paymentMethodId"`
+}
+
+// BrokerFeeSettlementReportResponse는 결제 수단 하나의 한 기간 정산 합계입니다.
+// SettledCount는 정산된 결제 내역 수이며, Settled는 기간의 결제 내역이 모두 정산 완료된 경우에만 true입니다.
+type BrokerFeeSettlementReportResponse struct {
+	Period            string `json:"period"`
+	PaymentMethodID   uint   `json:"paymentMethodId"`
+	PaymentMethodName string `json:"paymentMethodName"`
+	ReservationCount  int    `json:"reservationCount"`
+	PaymentCount      int    `json:"paymentCount"`
+	SettledCount      int    `json:"settledCount"`
+	Settled           bool   `json:"settled"`
+	GrossPrice        int    `json:"grossPrice"`
+	PaidAmount        int    `json:"paidAmount"`
+	RefundAmount      int    `json:"refundAmount"`
+	BrokerFee         int    `json:"brokerFee"`
+	NetPayout         int    `json:"netPayout"`
+}
+
+type BrokerFeeSettlementResponse struct {
+	ID               uint                   `json:"id"`
+	PaymentMethodID  uint                   `json:"paymentMethodId"`
+	PaymentMethod    *PaymentMethodResponse `json:"paymentMethod,omitempty"`
+	PeriodStart      JSONDate               `json:"periodStart"`
+	PeriodEnd        JSONDate               `json:"periodEnd"`
+	ReservationCount int                    `json:"reservationCount"`
+	PaymentCount     int                    `json:"paymentCount"`
+	GrossPrice       int                    `json:"grossPrice"`
+	PaidAmount       int                    `json:"paidAmount"`
+	RefundAmount     int                    `json:"refundAmount"`
+	BrokerFee        int                    `json:"brokerFee"`
+	NetPayout        int                    `json:"netPayout"`
+	Note             string                 `json:"note"`
+	CreatedBy        *UserSummaryResponse   `json:"createdBy"`
+	CreatedAt        CustomTime             `json:"createdAt"`
+}
+
+// CreateBrokerFeeSettlementRequest는 결제 수단의 [periodStart, periodEnd] 기간(입금일 기준)을 정산 완료 처리합니다.
+type CreateBrokerFeeSettlementRequest struct {
+	PaymentMethodID uint     `json:"paymentMethodId" binding:"required"`
+	PeriodStart     JSONDate `json:"periodStart"`
+	PeriodEnd       JSONDate `json:"periodEnd"`
+	Note            string   `json:"note" binding:"max=200"`
+}
+
+type BrokerFeeSettlementFilter struct {
+	PaymentMethodID *uint `form:"paymentMethodId"`
+}
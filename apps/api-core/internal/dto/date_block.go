@@ -6,24 +6,58 @@ import (
 )
 
 type DateBlockResponse struct {
-	ID        uint                 `json:"id"`
-	StartDate JSONDate             `json:"startDate"`
-	EndDate   JSONDate             `json:"endDate"`
-	Reason    string               `json:"reason"`
-	CreatedBy *UserSummaryResponse `json:"createdBy"`
-	CreatedAt CustomTime           `json:"createdAt"`
+	ID           uint                 `json:"id"`
+	StartDate    JSONDate             `json:"startDate"`
+	EndDate      JSONDate             `json:"endDate"`
+	Reason       string               `json:"reason"`
+	RoomGroupIDs []uint               `json:"roomGroupIds"`
+	RoomIDs      []uint               `json:"roomIds"`
+	CreatedBy    *UserSummaryResponse `json:"createdBy"`
+	CreatedAt    CustomTime           `json:"createdAt"`
+
+	Recurrence      string    `json:"recurrence"`
+	RecurrenceUntil *JSONDate `json:"recurrenceUntil"`
+	ExceptionDates  []string  `json:"exceptionDates"`
+	// Occurrences는 목록 조회 시 startDate ~ endDate 기간 안의 회차입니다. 기간을 모두 지정한 경우에만 채웁니다.
+	Occurrences []DateBlockOccurrenceResponse `json:"occurrences,omitempty"`
+}
+
+type DateBlockOccurrenceResponse struct {
+	StartDate JSONDate `json:"startDate"`
+	EndDate   JSONDate `json:"endDate"`
 }
 
+// CreateDateBlockRequest의 RoomGroupIDs와 RoomIDs를 모두 비우면 모든 객실을 차단합니다.
 type CreateDateBlockRequest struct {
-	StartDate string `json:"startDate" binding:"required"`
-	EndDate   string `json:"endDate" binding:"required"`
-	Reason    string `json:"reason" binding:"required,min=1,max=200"`
+	StartDate    string `json:"startDate" binding:"required"`
+	EndDate      string `json:"endDate" binding:"required"`
+	Reason       string `json:"reason" binding:"required,min=1,max=200"`
+	RoomGroupIDs []uint `json:"roomGroupIds"`
+	RoomIDs      []uint `json:"roomIds"`
+
+	// Recurrence를 지정하지 않으면 NONE(반복하지 않음)입니다.
+	Recurrence      string   `json:"recurrence" binding:"omitempty,oneof=NONE WEEKLY MONTHLY YEARLY"`
+	RecurrenceUntil *string  `json:"recurrenceUntil"`
+	ExceptionDates  []string `json:"exceptionDates"`
+
+	// Force가 true면 겹치는 예약이 있어도 날짜 차단을 생성합니다.
+	Force bool `json:"force"`
 }
 
 type UpdateDateBlockRequest struct {
-	StartDate *string `json:"startDate"`
-	EndDate   *string `json:"endDate"`
-	Reason    *string `json:"reason" binding:"omitempty,min=1,max=200"`
+	StartDate    *string `json:"startDate"`
+	EndDate      *string `json:"endDate"`
+	Reason       *string `json:"reason" binding:"omitempty,min=1,max=200"`
+	RoomGroupIDs *[]uint `json:"roomGroupIds"`
+	RoomIDs      *[]uint `json:"roomIds"`
+
+	Recurrence *string `json:"recurrence" binding:"omitempty,oneof=NONE WEEKLY MONTHLY YEARLY"`
+	// RecurrenceUntil을 빈 문자열로 보내면 반복 종료일을 없앱니다.
+	RecurrenceUntil *string   `json:"recurrenceUntil"`
+	ExceptionDates  *[]string `json:"exceptionDates"`
+
+	// Force가 true면 수정으로 새로 겹치는 예약이 있어도 날짜 차단을 수정합니다.
+	Force bool `json:"force"`
 }
 
 func (r *CreateDateBlockRequest) Validate() error {
@@ -41,9 +75,80 @@ func (r *CreateDateBlockRequest) Validate() error {
 		return fmt.Errorf("startDate must be before or equal to endDate")
 	}
 
+	var recurrenceUntil *time.Time
+	if r.RecurrenceUntil != nil {
+		until, err := time.Parse("2006-01-02", *r.RecurrenceUntil)
+		if err != nil {
+			return fmt.Errorf("invalid recurrenceUntil format, expected YYYY-MM-DD")
+		}
+		recurrenceUntil = &until
+	}
+
+	for _, exceptionDate := range r.ExceptionDates {
+		if _, err := time.Parse("2006-01-02", exceptionDate); err != nil {
+			return fmt.Errorf("invalid exceptionDates format, expected YYYY-MM-DD")
+		}
+	}
+
+	recurrence := r.Recurrence
+	if recurrence == "" {
+		recurrence = "NONE"
+	}
+	return ValidateDateBlockRecurrence(startDate, endDate, recurrence, recurrenceUntil, len(r.ExceptionDates) > 0)
+}
+
+// ValidateDateBlockRecurrence는 반복 규칙을 검증합니다. 회차끼리 겹치지 않도록 한 회차 기간은 반복 주기보다 짧아야 합니다.
+func ValidateDateBlockRecurrence(startDate, endDate time.Time, recurrence string, recurrenceUntil *time.Time, hasExceptions bool) error {
+	var nextStart time.Time
+	switch recurrence {
+	case "NONE":
+		if recurrenceUntil != nil || hasExceptions {
+			return fmt.Errorf("recurrenceUntil and exceptionDates require recurrence")
+		}
+		return nil
+	case "WEEKLY":
+		nextStart = startDate.AddDate(0, 0, 7)
+	case "MONTHLY":
+		nextStart = startDate.AddDate(0, 0, 28)
+	case "YEARLY":
+		nextStart = startDate.AddDate(1, 0, 0)
+	default:
+		return fmt.Errorf("unknown recurrence %s", recurrence)
+	}
+
+	if !endDate.Before(nextStart) {
+		return fmt.Errorf("recurring date block must be shorter than its recurrence period")
+	}
+	if recurrenceUntil != nil && recurrenceUntil.Before(startDate) {
+		return fmt.Errorf("recurrenceUntil must be on or after startDate")
+	}
 	return nil
 }
 
+// DateBlockConflictResponse는 날짜 차단과 겹치는 정상/대기 예약입니다. Rooms는 예약 객실 중 차단 대상 객실입니다.
+type DateBlockConflictResponse struct {
+	ReservationID uint                            `json:"reservationId"`
+	Name          string                          `json:"name"`
+	Phone         string                          `json:"phone"`
+	Status        string                          `json:"status"`
+	StayStartAt   JSONDate                        `json:"stayStartAt"`
+	StayEndAt     JSONDate                        `json:"stayEndAt"`
+	Rooms         []DateBlockConflictRoomResponse `json:"rooms"`
+}
+
+type DateBlockConflictRoomResponse struct {
+	ID     uint   `json:"id"`
+	Number string `json:"number"`
+}
+
+// DateBlockConflictErrorResponse는 겹치는 예약 때문에 날짜 차단을 저장하지 못했을 때의 409 응답입니다.
+type DateBlockConflictErrorResponse struct {
+	Message     string                      `json:"message"`
+	Errors      []string                    `json:"errors"`
+	FieldErrors []string                    `json:"fieldErrors"`
+	Conflicts   []DateBlockConflictResponse `json:"conflicts"`
+}
+
 type DateBlockFilter struct {
 	StartDate string `form:"startDate"`
 	EndDate   string `form:"endDate"`
@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// DuplicateCandidateFilter는 중복 의심 예약 후보 조회 조건입니다.
+// Name과 Phone을 모두 비우면 기간이 겹치는 정상/대기 예약을 모두 조회합니다.
+type DuplicateCandidateFilter struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// Name은 공백을 뺀 이름입니다.
+	Name string
+	// Phone은 숫자만 남긴 전화번호입니다.
+	Phone string
+}
+
+// DuplicateReservationResponse는 같은 예약을 두 번 입력한 것으로 의심되는 기존 예약입니다.
+// MatchedBy는 의심 근거로 PHONE(전화번호), NAME(이름)을 담으며, 보고서에서는 예약 쌍에만 채웁니다.
+type DuplicateReservationResponse struct {
+	ReservationID uint     `json:"reservationId"`
+	Name          string   `json:"name"`
+	Phone         string   `json:"phone"`
+	Status        string   `json:"status"`
+	StayStartAt   JSONDate `json:"stayStartAt"`
+	StayEndAt     JSONDate `json:"stayEndAt"`
+	RoomNumbers   []string `json:"roomNumbers"`
+	MatchedBy     []string `json:"matchedBy,omitempty"`
+}
+
+// DuplicateReservationErrorResponse는 중복 의심 예약이 있어 예약을 만들지 않았을 때의 409 응답입니다.
+// confirmDuplicate를 true로 다시 요청하면 예약을 만듭니다.
+type DuplicateReservationErrorResponse struct {
+	Message     string                         `json:"message"`
+	Errors      []string                       `json:"errors"`
+	FieldErrors []string                       `json:"fieldErrors"`
+	Duplicates  []DuplicateReservationResponse `json:"duplicates"`
+}
+
+// DuplicateReservationReportQuery는 중복 의심 예약 보고서의 조회 기간입니다. EndDate 당일 숙박까지 포함합니다.
+type DuplicateReservationReportQuery struct {
+	StartDate time.Time `form:"startDate" binding:"required" time_format:"2006-01-02"`
+	EndDate   time.Time `form:"endDate" binding:"required" time_format:"2006-01-02"`
+}
+
+// DuplicateReservationPairResponse는 보고서에서 서로 중복으로 의심되는 두 예약입니다. Reservation이 먼저 등록된 예약입니다.
+type DuplicateReservationPairResponse struct {
+	Reservation DuplicateReservationResponse `json:"reservation"`
+	Duplicate   DuplicateReservationResponse `json:"duplicate"`
+	MatchedBy   []string                     `json:"matchedBy"`
+}
@@ -0,0 +1,72 @@
+package dto
+
+// GuestResponse는 고객 정보입니다.
+type GuestResponse struct {
+	ID                 uint        `json:"id"`
+	Name               string      `json:"name"`
+	Phone              string      `json:"phone"`
+	Email              *string     `json:"email,omitempty"`
+	Note               string      `json:"note"`
+	IsVIP              bool        `json:"isVip"`
+	IsBlacklisted      bool        `json:"isBlacklisted"`
+	MarketingConsent   bool        `json:"marketingConsent"`
+	MarketingConsentAt *CustomTime `json:"marketingConsentAt,omitempty"`
+	CreatedAt          CustomTime  `json:"createdAt"`
+	UpdatedAt          CustomTime  `json:"updatedAt"`
+}
+
+// GuestFilter는 GET /guests 쿼리 파라미터입니다. search는 이름 일부나 전화번호 일부(형식 무관)로 찾습니다.
+type GuestFilter struct {
+	Search        string `form:"search"`
+	IsVIP         *bool  `form:"isVip"`
+	IsBlacklisted *bool  `form:"isBlacklisted"`
+}
+
+// GuestRepositoryFilter는 고객 조회 조건입니다. PhoneSearch는 숫자만 남긴 전화번호 검색어이며, 하이픈을 뺀 저장 번호와 비교합니다.
+type GuestRepositoryFilter struct {
+	NameSearch    string
+	PhoneSearch   string
+	IsVIP         *bool
+	IsBlacklisted *bool
+}
+
+type CreateGuestRequest struct {
+	Name             string  `json:"name" binding:"required,min=2,max=30"`
+	Phone            string  `json:"phone" binding:"required,max=20"`
+	Email            *string `json:"email" binding:"omitempty,email,max=100"`
+	Note             string  `json:"note" binding:"max=500"`
+	IsVIP            bool    `json:"isVip"`
+	IsBlacklisted    bool    `json:"isBlacklisted"`
+	MarketingConsent bool    `json:"marketingConsent"`
+}
+
+type UpdateGuestRequest struct {
+	Name             *string `json:"name" binding:"omitempty,min=2,max=30"`
+	Phone            *string `json:"phone" binding:"omitempty,max=20"`
+	Email            *string `json:"email" binding:"omitempty,email,max=100"`
+	Note             *string `json:"note" binding:"omitempty,max=500"`
+	IsVIP            *bool   `json:"isVip"`
+	IsBlacklisted    *bool   `json:"isBlacklisted"`
+	MarketingConsent *bool   `json:"marketingConsent"`
+}
+
+// GuestStayResponse는 고객 이력의 예약 한 건입니다.
+type GuestStayResponse struct {
+	ReservationID uint     `json:"reservationId"`
+	StayStartAt   JSONDate `json:"stayStartAt"`
+	StayEndAt     JSONDate `json:"stayEndAt"`
+	RoomNumbers   []string `json:"roomNumbers"`
+	Status        string   `json:"status"`
+	Price         int      `json:"price"`
+}
+
+// GuestHistoryResponse는 고객의 지난 숙박과 예정된 숙박, 누적 결제 금액과 노쇼 횟수입니다.
+// LifetimeSpend는 취소/노쇼를 포함한 모든 예약의 예약금과 결제 금액 합계에서 환불 금액을 뺀 값입니다.
+type GuestHistoryResponse struct {
+	Guest         GuestResponse       `json:"guest"`
+	PastStays     []GuestStayResponse `json:"pastStays"`
+	UpcomingStays []GuestStayResponse `json:"upcomingStays"`
+	StayCount     int                 `json:"stayCount"`
+	LifetimeSpend int                 `json:"lifetimeSpend"`
+	NoShowCount   int                 `json:"noShowCount"`
+}
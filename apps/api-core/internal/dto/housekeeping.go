@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+// HousekeepingRoomResponse는 청소 현황판의 객실 한 칸입니다.
+type HousekeepingRoomResponse struct {
+	RoomID             uint   `json:"roomId"`
+	RoomNumber         string `json:"roomNumber"`
+	RoomGroupID        uint   `json:"roomGroupId"`
+	Status             string `json:"status"`
+	HousekeepingStatus string `json:"housekeepingStatus"`
+}
+
+type HousekeepingRoomFilter struct {
+	RoomGroupID        *uint  `form:"roomGroupId"`
+	HousekeepingStatus string `form:"housekeepingStatus" binding:"omitempty,oneof=DIRTY CLEANING CLEAN INSPECTED"`
+}
+
+type UpdateHousekeepingRoomRequest struct {
+	HousekeepingStatus string `json:"housekeepingStatus" binding:"required,oneof=DIRTY CLEANING CLEAN INSPECTED"`
+}
+
+type HousekeepingTaskResponse struct {
+	ID            uint                 `json:"id"`
+	RoomID        uint                 `json:"roomId"`
+	RoomNumber    string               `json:"roomNumber"`
+	ReservationID *uint                `json:"reservationId"`
+	TaskDate      JSONDate             `json:"taskDate"`
+	Type          string               `json:"type"`
+	Status        string               `json:"status"`
+	Assignee      *UserSummaryResponse `json:"assignee"`
+	Note          string               `json:"note"`
+	CompletedAt   *CustomTime          `json:"completedAt"`
+	CreatedAt     CustomTime           `json:"createdAt"`
+	UpdatedAt     CustomTime           `json:"updatedAt"`
+}
+
+// HousekeepingTaskQuery는 GET /housekeeping/tasks 쿼리 파라미터입니다. date를 생략하면 오늘 작업을 조회합니다.
+type HousekeepingTaskQuery struct {
+	Date       string `form:"date"`
+	AssigneeID *uint  `form:"assigneeId"`
+	Status     string `form:"status" binding:"omitempty,oneof=PENDING IN_PROGRESS DONE"`
+}
+
+// ToHousekeepingTaskFilter는 쿼리 파라미터를 청소 작업 조회 조건으로 변환합니다.
+func (q *HousekeepingTaskQuery) ToHousekeepingTaskFilter(today time.Time) (HousekeepingTaskRepositoryFilter, error) {
+	filter := HousekeepingTaskRepositoryFilter{TaskDate: today, AssigneeID: q.AssigneeID}
+	if q.Date != "" {
+		date, err := time.Parse("2006-01-02", q.Date)
+		if err != nil {
+			return HousekeepingTaskRepositoryFilter{}, fmt.Errorf("invalid date format, expected YYYY-MM-DD")
+		}
+		filter.TaskDate = date
+	}
+	if q.Status != "" {
+		status, _ := models.ParseHousekeepingTaskStatus(q.Status)
+		filter.Status = &status
+	}
+	return filter, nil
+}
+
+type HousekeepingTaskRepositoryFilter struct {
+	TaskDate   time.Time
+	AssigneeID *uint
+	Status     *models.HousekeepingTaskStatus
+}
+
+// GenerateHousekeepingTasksRequest의 Date를 생략하면 오늘 작업을 생성합니다.
+type GenerateHousekeepingTasksRequest struct {
+	Date string `json:"date"`
+}
+
+// UpdateHousekeepingTaskRequest의 AssigneeID를 0으로 보내면 담당자를 해제합니다.
+type UpdateHousekeepingTaskRequest struct {
+	AssigneeID *uint   `json:"assigneeId"`
+	Status     *string `json:"status" binding:"omitempty,oneof=PENDING IN_PROGRESS DONE"`
+	Note       *string `json:"note" binding:"omitempty,max=200"`
+}
@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+type MaintenanceTicketResponse struct {
+	ID                 uint                 `json:"id"`
+	RoomID             uint                 `json:"roomId"`
+	RoomNumber         string               `json:"roomNumber"`
+	RoomStatus         string               `json:"roomStatus"`
+	Description        string               `json:"description"`
+	Priority           string               `json:"priority"`
+	Status             string               `json:"status"`
+	Assignee           *UserSummaryResponse `json:"assignee"`
+	ExpectedReturnDate *JSONDate            `json:"expectedReturnDate"`
+	Resolution         string               `json:"resolution"`
+	ResolvedAt         *CustomTime          `json:"resolvedAt"`
+	CreatedAt          CustomTime           `json:"createdAt"`
+	UpdatedAt          CustomTime           `json:"updatedAt"`
+
+	// AffectedReservations는 열린 티켓의 예상 고장 기간(오늘부터 예상 복구일 전날까지)에 이 객실을 쓰는 정상/대기 예약입니다.
+	// 단건 조회, 등록, 수정 응답에만 담기며, 예상 복구일이 없으면 오늘 이후 모든 예약을 담습니다.
+	AffectedReservations []MaintenanceAffectedReservationResponse `json:"affectedReservations,omitempty"`
+}
+
+type MaintenanceAffectedReservationResponse struct {
+	ReservationID uint     `json:"reservationId"`
+	Name          string   `json:"name"`
+	Phone         string   `json:"phone"`
+	Status        string   `json:"status"`
+	StayStartAt   JSONDate `json:"stayStartAt"`
+	StayEndAt     JSONDate `json:"stayEndAt"`
+}
+
+type MaintenanceTicketFilter struct {
+	RoomID   *uint  `form:"roomId"`
+	Status   string `form:"status" binding:"omitempty,oneof=OPEN RESOLVED"`
+	Priority string `form:"priority" binding:"omitempty,oneof=LOW NORMAL HIGH URGENT"`
+}
+
+// CreateMaintenanceTicketRequest의 RoomStatus를 지정하면 티켓을 열면서 객실 상태를 함께 바꿉니다.
+type CreateMaintenanceTicketRequest struct {
+	RoomID             uint   `json:"roomId" binding:"required"`
+	Description        string `json:"description" binding:"required,max=500"`
+	Priority           string `json:"priority" binding:"omitempty,oneof=LOW NORMAL HIGH URGENT"`
+	AssigneeID         *uint  `json:"assigneeId"`
+	ExpectedReturnDate string `json:"expectedReturnDate"`
+	RoomStatus         string `json:"roomStatus" binding:"omitempty,oneof=DAMAGED CONSTRUCTION"`
+}
+
+// ToMaintenanceTicket은 요청을 티켓 모델과 바꿀 객실 상태로 변환합니다. 객실 상태를 바꾸지 않으면 nil을 반환합니다.
+func (r *CreateMaintenanceTicketRequest) ToMaintenanceTicket() (*models.MaintenanceTicket, *models.RoomStatus, error) {
+	expectedReturnDate, err := ParseExpectedReturnDate(r.ExpectedReturnDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ticket := &models.MaintenanceTicket{
+		RoomID:             r.RoomID,
+		Description:        r.Description,
+		Priority:           models.MaintenanceTicketPriorityNormal,
+		Status:             models.MaintenanceTicketStatusOpen,
+		AssigneeID:         r.AssigneeID,
+		ExpectedReturnDate: expectedReturnDate,
+	}
+	if r.Priority != "" {
+		ticket.Priority, _ = models.ParseMaintenanceTicketPriority(r.Priority)
+	}
+
+	var roomStatus *models.RoomStatus
+	switch r.RoomStatus {
+	case "DAMAGED":
+		status := models.RoomStatusDamaged
+		roomStatus = &status
+	case "CONSTRUCTION":
+		status := models.RoomStatusConstruction
+		roomStatus = &status
+	}
+
+	return ticket, roomStatus, nil
+}
+
+// UpdateMaintenanceTicketRequest는 열린 티켓의 내용을 수정합니다. AssigneeID를 0으로 보내면 담당자를,
+// ExpectedReturnDate를 빈 문자열로 보내면 예상 복구일을 지웁니다.
+type UpdateMaintenanceTicketRequest struct {
+	Description        *string `json:"description" binding:"omitempty,min=1,max=500"`
+	Priority           *string `json:"priority" binding:"omitempty,oneof=LOW NORMAL HIGH URGENT"`
+	AssigneeID         *uint   `json:"assigneeId"`
+	ExpectedReturnDate *string `json:"expectedReturnDate"`
+}
+
+type ResolveMaintenanceTicketRequest struct {
+	Resolution string `json:"resolution" binding:"required,max=500"`
+}
+
+type MaintenanceTicketRepositoryFilter struct {
+	RoomID   *uint
+	Status   *models.MaintenanceTicketStatus
+	Priority *models.MaintenanceTicketPriority
+}
+
+// ToRepositoryFilter는 쿼리 파라미터를 유지보수 티켓 조회 조건으로 변환합니다.
+func (f *MaintenanceTicketFilter) ToRepositoryFilter() MaintenanceTicketRepositoryFilter {
+	filter := MaintenanceTicketRepositoryFilter{RoomID: f.RoomID}
+	if status, ok := models.ParseMaintenanceTicketStatus(f.Status); ok {
+		filter.Status = &status
+	}
+	if priority, ok := models.ParseMaintenanceTicketPriority(f.Priority); ok {
+		filter.Priority = &priority
+	}
+	return filter
+}
+
+// ParseExpectedReturnDate는 예상 복구일 문자열을 날짜로 변환합니다. 빈 문자열이면 nil을 반환합니다.
+func ParseExpectedReturnDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expectedReturnDate format, expected YYYY-MM-DD")
+	}
+	return &date, nil
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+// OccupancyQuery는 GET /occupancy 쿼리 파라미터입니다. endDate는 포함하지 않습니다.
+type OccupancyQuery struct {
+	StartDate   string `form:"startDate" binding:"required"`
+	EndDate     string `form:"endDate" binding:"required"`
+	RoomGroupID *uint  `form:"roomGroupId"`
+}
+
+// ToOccupancyRequest는 쿼리 파라미터를 점유 현황 조회 요청으로 변환합니다.
+func (q *OccupancyQuery) ToOccupancyRequest() (OccupancyRequest, error) {
+	startDate, err := time.Parse("2006-01-02", q.StartDate)
+	if err != nil {
+		return OccupancyRequest{}, fmt.Errorf("invalid startDate format, expected YYYY-MM-DD")
+	}
+	endDate, err := time.Parse("2006-01-02", q.EndDate)
+	if err != nil {
+		return OccupancyRequest{}, fmt.Errorf("invalid endDate format, expected YYYY-MM-DD")
+	}
+
+	return OccupancyRequest{StartDate: startDate, EndDate: endDate, RoomGroupID: q.RoomGroupID}, nil
+}
+
+// OccupancyRequest는 [StartDate, EndDate) 기간의 객실 × 날짜 점유 현황 조회 입력입니다.
+type OccupancyRequest struct {
+	StartDate   time.Time
+	EndDate     time.Time
+	RoomGroupID *uint
+}
+
+// OccupancyGridResponse는 객실 × 날짜 점유 현황(테이프 차트)입니다. 각 객실의 Cells는 Dates와 같은 순서입니다.
+type OccupancyGridResponse struct {
+	StartDate JSONDate                `json:"startDate"`
+	EndDate   JSONDate                `json:"endDate"`
+	Dates     []JSONDate              `json:"dates"`
+	Rooms     []OccupancyRoomResponse `json:"rooms"`
+}
+
+type OccupancyRoomResponse struct {
+	RoomID        uint                    `json:"roomId"`
+	RoomNumber    string                  `json:"roomNumber"`
+	RoomGroupID   uint                    `json:"roomGroupId"`
+	RoomGroupName string                  `json:"roomGroupName"`
+	Status        string                  `json:"status"`
+	Cells         []OccupancyCellResponse `json:"cells"`
+}
+
+// OccupancyCellResponse는 객실의 하루(숙박 1박) 상태입니다.
+// State는 OCCUPIED, OUT_OF_SERVICE, BLOCKED, FREE 순으로 우선하며, 예약과 날짜 차단이 겹치면 둘 다 채웁니다.
+type OccupancyCellResponse struct {
+	Date        JSONDate                      `json:"date"`
+	State       string                        `json:"state"`
+	Reservation *OccupancyReservationResponse `json:"reservation,omitempty"`
+	DateBlock   *OccupancyDateBlockResponse   `json:"dateBlock,omitempty"`
+}
+
+type OccupancyReservationResponse struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	CheckInState string `json:"checkInState"` // NOT_CHECKED_IN, CHECKED_IN, CHECKED_OUT
+}
+
+type OccupancyDateBlockResponse struct {
+	ID     uint   `json:"id"`
+	Reason string `json:"reason"`
+}
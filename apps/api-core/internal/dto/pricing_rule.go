@@ -0,0 +1,44 @@
+package dto
+
+type PricingRuleResponse struct {
+	ID          uint       `json:"id"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	RoomGroupID *uint      `json:"roomGroupId"`
+	Amount      int        `json:"amount"`
+	Percent     int        `json:"percent"`
+	MinNights   int        `json:"minNights"`
+	Enabled     bool       `json:"enabled"`
+	CreatedAt   CustomTime `json:"createdAt"`
+	UpdatedAt   CustomTime `json:"updatedAt"`
+}
+
+type CreatePricingRuleRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=50"`
+	Type        string `json:"type" binding:"required,oneof=WEEKEND_SURCHARGE LONG_STAY_DISCOUNT EXTRA_PERSON_FEE MONTHLY_RENT"`
+	RoomGroupID *uint  `json:"roomGroupId"`
+	Amount      int    `json:"amount" binding:"min=0"`
+	Percent     int    `json:"percent" binding:"min=0,max=100"`
+	MinNights   int    `json:"minNights" binding:"min=0"`
+	Enabled     *bool  `json:"enabled"`
+}
+
+type UpdatePricingRuleRequest struct {
+	Name           *string `json:"name" binding:"omitempty,min=1,max=50"`
+	RoomGroupID    *uint   `json:"roomGroupId"`
+	ClearRoomGroup bool    `json:"clearRoomGroup"`
+	Amount         *int    `json:"amount" binding:"omitempty,min=0"`
+	Percent        *int    `json:"percent" binding:"omitempty,min=0,max=100"`
+	MinNights      *int    `json:"minNights" binding:"omitempty,min=0"`
+	Enabled        *bool   `json:"enabled"`
+}
+
+// AppliedPricingRuleResponse는 견적이나 예약 요금에 적용된 규칙입니다. 할인은 음수 금액입니다.
+type AppliedPricingRuleResponse struct {
+	RuleID uint   `json:"ruleId"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	RoomID uint   `json:"roomId,omitempty"`
+	Nights int    `json:"nights,omitempty"`
+	Amount int    `json:"amount"`
+}
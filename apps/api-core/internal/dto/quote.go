@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+// QuoteQuery는 GET /quotes 쿼리 파라미터입니다. roomIds는 반복(roomIds=1&roomIds=2)과 쉼표 구분(roomIds=1,2)을 모두 지원합니다.
+type QuoteQuery struct {
+	RoomIDs     []string `form:"roomIds" binding:"required"`
+	StayStartAt string   `form:"stayStartAt" binding:"required"`
+	StayEndAt   string   `form:"stayEndAt" binding:"required"`
+	PeopleCount int      `form:"peopleCount" binding:"min=0"`
+	Type        string   `form:"type" binding:"omitempty,oneof=STAY MONTHLY_RENT"`
+}
+
+// ToQuoteRequest는 쿼리 파라미터를 견적 요청으로 변환합니다.
+func (q *QuoteQuery) ToQuoteRequest() (QuoteRequest, error) {
+	req := QuoteRequest{PeopleCount: q.PeopleCount, Type: models.ReservationTypeStay}
+
+	for _, value := range q.RoomIDs {
+		for _, part := range strings.Split(value, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			id, err := strconv.ParseUint(part, 10, 32)
+			if err != nil {
+				return QuoteRequest{}, fmt.Errorf("invalid roomIds: %s", part)
+			}
+			req.RoomIDs = append(req.RoomIDs, uint(id))
+		}
+	}
+
+	stayStartAt, err := time.Parse("2006-01-02", q.StayStartAt)
+	if err != nil {
+		return QuoteRequest{}, fmt.Errorf("invalid stayStartAt format, expected YYYY-MM-DD")
+	}
+	stayEndAt, err := time.Parse("2006-01-02", q.StayEndAt)
+	if err != nil {
+		return QuoteRequest{}, fmt.Errorf("invalid stayEndAt format, expected YYYY-MM-DD")
+	}
+	req.StayStartAt = stayStartAt
+	req.StayEndAt = stayEndAt
+
+	if q.Type == "MONTHLY_RENT" {
+		req.Type = models.ReservationTypeMonthlyRent
+	}
+
+	return req, nil
+}
+
+// QuoteRequest는 견적 계산 입력입니다.
+type QuoteRequest struct {
+	RoomIDs     []uint
+	StayStartAt time.Time
+	StayEndAt   time.Time
+	PeopleCount int
+	Type        models.ReservationType
+}
+
+// QuoteResponse는 객실과 숙박 기간에 대한 요금 견적입니다.
+// TotalPrice는 객실별 소계와 예약 전체에 적용된 규칙(RoomID가 없는 AppliedRules) 금액의 합입니다.
+type QuoteResponse struct {
+	StayStartAt  JSONDate                     `json:"stayStartAt"`
+	StayEndAt    JSONDate                     `json:"stayEndAt"`
+	Nights       int                          `json:"nights"`
+	PeopleCount  int                          `json:"peopleCount"`
+	Type         string                       `json:"type"`
+	Rooms        []RoomQuoteResponse          `json:"rooms"`
+	AppliedRules []AppliedPricingRuleResponse `json:"appliedRules"`
+	TotalPrice   int                          `json:"totalPrice"`
+}
+
+// RoomQuoteResponse는 객실 하나의 박별 요금 내역입니다.
+// Subtotal은 박별 요금(Price) 합계에 이 객실에 적용된 규칙(RoomID가 같은 AppliedRules) 금액을 더한 값입니다.
+type RoomQuoteResponse struct {
+	RoomID        uint                   `json:"roomId"`
+	RoomNumber    string                 `json:"roomNumber"`
+	RoomGroupID   uint                   `json:"roomGroupId"`
+	RoomGroupName string                 `json:"roomGroupName"`
+	Nights        []NightlyPriceResponse `json:"nights"`
+	Subtotal      int                    `json:"subtotal"`
+}
+
+// NightlyPriceResponse는 하루 숙박의 요금입니다. 성수기 시즌에 해당하면 SeasonID가 채워집니다.
+// Price는 시즌 기준 요금이며, Surcharge는 이 날에 붙은 주말 할증으로 객실의 WEEKEND_SURCHARGE 규칙 금액에 합산됩니다.
+type NightlyPriceResponse struct {
+	Date       JSONDate `json:"date"`
+	Peak       bool     `json:"peak"`
+	SeasonID   *uint    `json:"seasonId"`
+	SeasonName string   `json:"seasonName,omitempty"`
+	Surcharge  int      `json:"surcharge,omitempty"`
+	Price      int      `json:"price"`
+}
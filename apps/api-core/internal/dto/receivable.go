@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// ReceivableQuery는 미수금 현황 조회 조건입니다. 숙박 기간이 [stayStartAt, stayEndAt]와 겹치는 예약을 조회합니다.
+type ReceivableQuery struct {
+	PaymentMethodID *uint      `form:"paymentMethodId"`
+	RoomGroupID     *uint      `form:"roomGroupId"`
+	StayStartAt     *time.Time `form:"stayStartAt" time_format:"2006-01-02"`
+	StayEndAt       *time.Time `form:"stayEndAt" time_format:"2006-01-02"`
+}
+
+type ReceivableFilterResponse struct {
+	PaymentMethodID *uint     `json:"paymentMethodId,omitempty"`
+	RoomGroupID     *uint     `json:"roomGroupId,omitempty"`
+	StayStartAt     *JSONDate `json:"stayStartAt,omitempty"`
+	StayEndAt       *JSONDate `json:"stayEndAt,omitempty"`
+}
+
+type ReceivableFilter struct {
+	PaymentMethodID *uint
+	RoomGroupID     *uint
+	StartDate       *time.Time
+	EndDate         *time.Time
+}
+
+// ReceivableResponse는 미수금이 남은 예약 한 건입니다. AgeDays는 숙박 시작일부터 지난 일수입니다.
+type ReceivableResponse struct {
+	ReservationID            uint     `json:"reservationId"`
+	Name                     string   `json:"name"`
+	Phone                    string   `json:"phone"`
+	PaymentMethodID          uint     `json:"paymentMethodId"`
+	PaymentMethodName        string   `json:"paymentMethodName"`
+	RequireUnpaidAmountCheck bool     `json:"requireUnpaidAmountCheck"`
+	RoomNumbers              []string `json:"roomNumbers"`
+	StayStartAt              JSONDate `json:"stayStartAt"`
+	StayEndAt                JSONDate `json:"stayEndAt"`
+	Status                   string   `json:"status"`
+	Price                    int      `json:"price"`
+	Deposit                  int      `json:"deposit"`
+	PaymentAmount            int      `json:"paymentAmount"`
+	UnpaidAmount             int      `json:"unpaidAmount"`
+	AgeDays                  int      `json:"ageDays"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+// RentChargeResponse는 달방 예약의 한 달치 청구입니다. PeriodEnd는 다음 기간 시작일(미포함)입니다.
+type RentChargeResponse struct {
+	ID                   uint        `json:"id"`
+	ReservationID        uint        `json:"reservationId"`
+	Sequence             int         `json:"sequence"`
+	PeriodStart          JSONDate    `json:"periodStart"`
+	PeriodEnd            JSONDate    `json:"periodEnd"`
+	DueDate              JSONDate    `json:"dueDate"`
+	Amount               int         `json:"amount"`
+	Status               string      `json:"status"` // UNPAID, PAID, OVERDUE
+	PaidAt               *CustomTime `json:"paidAt,omitempty"`
+	ReservationPaymentID *uint       `json:"reservationPaymentId,omitempty"`
+}
+
+type RentScheduleResponse struct {
+	ReservationID uint                 `json:"reservationId"`
+	TotalAmount   int                  `json:"totalAmount"`
+	PaidAmount    int                  `json:"paidAmount"`
+	UnpaidAmount  int                  `json:"unpaidAmount"`
+	OverdueAmount int                  `json:"overdueAmount"`
+	Charges       []RentChargeResponse `json:"charges"`
+}
+
+// OverdueRentChargeResponse는 납부 기한이 지난 청구와 예약자 정보입니다.
+type OverdueRentChargeResponse struct {
+	RentChargeResponse
+	Name        string `json:"name"`
+	Phone       string `json:"phone"`
+	OverdueDays int    `json:"overdueDays"`
+}
+
+// PayRentChargeRequest는 청구를 납부 처리합니다. 청구 금액만큼 결제 내역이 함께 기록됩니다.
+type PayRentChargeRequest struct {
+	PaymentMethodID *uint     `json:"paymentMethodId"` // 생략하면 예약의 결제 수단
+	PaidAt          *JSONTime `json:"paidAt"`          // 생략하면 현재 시각
+	Note            string    `json:"note" binding:"max=200"`
+}
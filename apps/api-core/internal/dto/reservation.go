@@ -31,6 +31,33 @@ type ReservationResponse struct {
 	UpdatedAt       CustomTime             `json:"updatedAt"`
 	CreatedBy       *UserSummaryResponse   `json:"createdBy"` // Spring Boot 호환성
 	UpdatedBy       *UserSummaryResponse   `json:"updatedBy"` // Spring Boot 호환성
+	// AppliedPricingRules는 서버가 견적으로 판매 금액을 계산한 예약에만 채워짐
+	AppliedPricingRules []AppliedPricingRuleResponse `json:"appliedPricingRules,omitempty"`
+	// BrokerFeeSettlementID는 중개 수수료 정산이 끝난 예약에만 채워짐
+	BrokerFeeSettlementID *uint `json:"brokerFeeSettlementId,omitempty"`
+	// RoomGroupID는 객실 그룹으로 예약해 서버가 객실을 배정한 예약에만 채워짐
+	RoomGroupID *uint `json:"roomGroupId,omitempty"`
+	// GuestID는 예약이 연결된 고객 ID
+	GuestID *uint `json:"guestId,omitempty"`
+	// RoomStays는 숙박 중 객실을 옮긴 예약에만 채워지며, 객실별 사용 구간을 날짜순으로 담음
+	RoomStays []ReservationRoomStayResponse `json:"roomStays,omitempty"`
+	// Warnings는 처리는 되었지만 확인이 필요한 사항 (예: 체크인 시 청소가 끝나지 않은 객실)
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ReservationRoomStayResponse는 예약 객실 하나를 사용하는 구간 [StayStartAt, StayEndAt)
+type ReservationRoomStayResponse struct {
+	RoomID      uint     `json:"roomId"`
+	RoomNumber  string   `json:"roomNumber,omitempty"`
+	StayStartAt JSONDate `json:"stayStartAt"`
+	StayEndAt   JSONDate `json:"stayEndAt"`
+}
+
+// MoveReservationRoomRequest는 숙박 중 객실 이동 요청. MoveDate(YYYY-MM-DD) 숙박일부터 ToRoomID 객실을 사용함
+type MoveReservationRoomRequest struct {
+	FromRoomID uint   `json:"fromRoomId" binding:"required"`
+	ToRoomID   uint   `json:"toRoomId" binding:"required"`
+	MoveDate   string `json:"moveDate" binding:"required"`
 }
 
 // ReservationRoomResponse는 더 이상 사용하지 않음 - Spring Boot 호환성을 위해 제거
@@ -45,13 +72,20 @@ type CreateReservationRequest struct {
 	PeopleCount     int               `json:"peopleCount" binding:"min=0"`
 	StayStartAt     JSONTime          `json:"stayStartAt" binding:"required"`
 	StayEndAt       JSONTime          `json:"stayEndAt" binding:"required"`
-	Price           int               `json:"price" binding:"min=0"`
+	Price           *int              `json:"price" binding:"omitempty,min=0"` // 생략하면 서버가 견적으로 채움
 	Deposit         int               `json:"deposit" binding:"min=0"`
 	PaymentAmount   int               `json:"paymentAmount" binding:"min=0"`
 	BrokerFee       int               `json:"brokerFee" binding:"min=0"`
 	Note            string            `json:"note" binding:"max=200"`
 	Status          string            `json:"status,omitempty"`
 	Type            string            `json:"type" binding:"omitempty,oneof=STAY MONTHLY_RENT"`
+	// RoomGroupID를 지정하고 객실을 비우면 해당 객실 그룹에서 RoomCount(기본 1)개 객실을 서버가 배정합니다.
+	RoomGroupID *uint `json:"roomGroupId"`
+	RoomCount   int   `json:"roomCount" binding:"min=0"`
+	// GuestID를 비우면 전화번호가 같은 고객에 연결하고, 없으면 새 고객을 만듭니다.
+	GuestID *uint `json:"guestId"`
+	// ConfirmDuplicate가 true면 중복으로 의심되는 예약이 있어도 예약을 만들고 경고만 돌려줍니다.
+	ConfirmDuplicate bool `json:"confirmDuplicate"`
 }
 
 func (r *CreateReservationRequest) GetRoomIDs() []uint {
@@ -84,7 +118,7 @@ type EntityReference struct {
 }
 
 type UpdateReservationRequest struct {
-	PaymentMethodID *uint              `json:"paymentMethodId"`
+	PaymentMethodID *uint              `json:"paymentMethodId"`         // 이후 새 결제 내역에만 적용
 	PaymentMethod   *EntityReference   `json:"paymentMethod,omitempty"` // 프론트엔드 호환성
 	RoomIDs         []uint             `json:"roomIds,omitempty"`
 	Rooms           *[]EntityReference `json:"rooms,omitempty"` // 프론트엔드 호환성을 위해 추가
@@ -100,7 +134,7 @@ type UpdateReservationRequest struct {
 	PaymentAmount   *int               `json:"paymentAmount" binding:"omitempty,min=0"`
 	RefundAmount    *int               `json:"refundAmount" binding:"omitempty,min=0"`
 	Note            *string            `json:"note" binding:"omitempty,max=200"`
-	Status          *string            `json:"status" binding:"omitempty,oneof=REFUND CANCEL PENDING NORMAL"`
+	Status          *string            `json:"status" binding:"omitempty,oneof=REFUND NO_SHOW CANCEL PENDING NORMAL COMPLETED"`
 	Type            *string            `json:"type" binding:"omitempty,oneof=STAY MONTHLY_RENT"`
 }
 
@@ -136,12 +170,13 @@ func (r *UpdateReservationRequest) HasRoomsUpdate() bool {
 }
 
 type ReservationFilter struct {
-	Status      *string    `form:"status" binding:"omitempty,oneof=REFUND CANCEL PENDING NORMAL"`
+	Status      *string    `form:"status" binding:"omitempty,oneof=REFUND NO_SHOW CANCEL PENDING NORMAL COMPLETED"`
 	Type        *string    `form:"type" binding:"omitempty,oneof=STAY MONTHLY_RENT"`
 	RoomID      *uint      `form:"roomId"`
 	StayStartAt *time.Time `form:"stayStartAt" time_format:"2006-01-02"`
 	StayEndAt   *time.Time `form:"stayEndAt" time_format:"2006-01-02"`
 	Search      string     `form:"search"`
+	UnpaidOnly  bool       `form:"unpaidOnly"`
 }
 
 type ReservationStatisticsQuery struct {
@@ -157,6 +192,7 @@ type ReservationFilterResponse struct {
 	StayStartAt *JSONDate `json:"stayStartAt,omitempty"`
 	StayEndAt   *JSONDate `json:"stayEndAt,omitempty"`
 	Search      string    `json:"search,omitempty"`
+	UnpaidOnly  bool      `json:"unpaidOnly,omitempty"`
 }
 
 type ReservationRepositoryFilter struct {
@@ -166,6 +202,8 @@ type ReservationRepositoryFilter struct {
 	StartDate *time.Time
 	EndDate   *time.Time
 	Search    string
+	// UnpaidOnly는 미수금(판매 금액 - 결제 금액 - 예약금)이 남은 예약만 조회합니다.
+	UnpaidOnly bool
 }
 
 // ReservationStatisticsResponse represents the response for reservation statistics
@@ -0,0 +1,34 @@
+package dto
+
+type ReservationPaymentResponse struct {
+	ID              uint                   `json:"id"`
+	ReservationID   uint                   `json:"reservationId"`
+	PaymentMethodID uint                   `json:"paymentMethodId"`
+	PaymentMethod   *PaymentMethodResponse `json:"paymentMethod,omitempty"`
+	Type            string                 `json:"type"`
+	Amount          int                    `json:"amount"`
+	BrokerFee       int                    `json:"brokerFee"`
+	PaidAt          CustomTime             `json:"paidAt"`
+	Note            string                 `json:"note"`
+	CreatedBy       *UserSummaryResponse   `json:"createdBy"`
+	CreatedAt       CustomTime             `json:"createdAt"`
+	UpdatedAt       CustomTime             `json:"updatedAt"`
+	// BrokerFeeSettlementID는 중개 수수료 정산에 포함된 결제 내역에만 채워짐
+	BrokerFeeSettlementID *uint `json:"brokerFeeSettlementId,omitempty"`
+}
+
+type CreateReservationPaymentRequest struct {
+	PaymentMethodID *uint     `json:"paymentMethodId"` // 생략하면 예약의 결제 수단
+	Type            string    `json:"type" binding:"required,oneof=DEPOSIT PAYMENT REFUND"`
+	Amount          int       `json:"amount" binding:"required,min=1"`
+	PaidAt          *JSONTime `json:"paidAt"` // 생략하면 현재 시각
+	Note            string    `json:"note" binding:"max=200"`
+}
+
+type UpdateReservationPaymentRequest struct {
+	PaymentMethodID *uint     `json:"paymentMethodId"`
+	Type            *string   `json:"type" binding:"omitempty,oneof=DEPOSIT PAYMENT REFUND"`
+	Amount          *int      `json:"amount" binding:"omitempty,min=1"`
+	PaidAt          *JSONTime `json:"paidAt"`
+	Note            *string   `json:"note" binding:"omitempty,max=200"`
+}
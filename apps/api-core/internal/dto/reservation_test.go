@@ -203,6 +203,7 @@ func TestReservationStatisticsQuery(t *testing.T) {
 
 func TestCreateReservationRequest(t *testing.T) {
 	// Given: 정상적인 예약 생성 요청 데이터
+	price := 200000
 	validRequest := dto.CreateReservationRequest{
 		PaymentMethodID: 1,
 		RoomIDs:         []uint{101, 102},
@@ -211,7 +212,7 @@ func TestCreateReservationRequest(t *testing.T) {
 		PeopleCount:     4,
 		StayStartAt:     dto.JSONTime{Time: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
 		StayEndAt:       dto.JSONTime{Time: time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC)},
-		Price:           200000,
+		Price:           &price,
 		Deposit:         100000,
 		PaymentAmount:   100000,
 		Note:            "조용한 방 요청",
@@ -252,6 +253,13 @@ func TestCreateReservationRequest(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "판매 금액을 생략해도 정상 처리된다 (견적으로 채움)",
+			modify: func(r *dto.CreateReservationRequest) {
+				r.Price = nil
+			},
+			expectError: false,
+		},
 		{
 			name: "월세 타입도 정상적으로 처리된다",
 			modify: func(r *dto.CreateReservationRequest) {
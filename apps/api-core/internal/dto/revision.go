@@ -77,6 +77,8 @@ type RoomHistorySnapshot struct {
 	UpdatedBy   uint   `json:"updatedBy"`
 	CreatedAt   string `json:"createdAt"`
 	UpdatedAt   string `json:"updatedAt"`
+
+	HousekeepingStatus string `json:"housekeepingStatus"`
 }
 
 // RoomSnapshot represents a room in audit snapshot (minimal fields for display)
@@ -121,14 +123,20 @@ type ReservationHistorySnapshot struct {
 
 // DateBlockHistorySnapshot represents the date_block entity data stored in audit logs
 type DateBlockHistorySnapshot struct {
-	ID        uint   `json:"id"`
-	StartDate string `json:"startDate"`
-	EndDate   string `json:"endDate"`
-	Reason    string `json:"reason"`
-	CreatedBy uint   `json:"createdBy"`
-	UpdatedBy uint   `json:"updatedBy"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
+	ID           uint   `json:"id"`
+	StartDate    string `json:"startDate"`
+	EndDate      string `json:"endDate"`
+	Reason       string `json:"reason"`
+	RoomGroupIDs []uint `json:"roomGroupIds"`
+	RoomIDs      []uint `json:"roomIds"`
+	CreatedBy    uint   `json:"createdBy"`
+	UpdatedBy    uint   `json:"updatedBy"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
+
+	Recurrence      string   `json:"recurrence"`
+	RecurrenceUntil *string  `json:"recurrenceUntil"`
+	ExceptionDates  []string `json:"exceptionDates"`
 }
 
 // DateBlockRevisionResponse is a revision response for DateBlock entity
@@ -139,3 +147,78 @@ type DateBlockRevisionResponse struct {
 	UpdatedFields    []string          `json:"updatedFields"`
 	HistoryUsername  string            `json:"historyUsername,omitempty"`
 }
+
+// SeasonHistorySnapshot represents the season entity data stored in audit logs
+type SeasonHistorySnapshot struct {
+	ID              uint   `json:"id"`
+	Name            string `json:"name"`
+	StartDate       string `json:"startDate"`
+	EndDate         string `json:"endDate"`
+	RecurringYearly bool   `json:"recurringYearly"`
+	RoomGroupIDs    []uint `json:"roomGroupIds"`
+	CreatedBy       uint   `json:"createdBy"`
+	UpdatedBy       uint   `json:"updatedBy"`
+	CreatedAt       string `json:"createdAt"`
+	UpdatedAt       string `json:"updatedAt"`
+}
+
+// SeasonRevisionResponse is a revision response for Season entity
+type SeasonRevisionResponse struct {
+	Entity           SeasonResponse `json:"entity"`
+	HistoryType      HistoryType    `json:"historyType"`
+	HistoryCreatedAt CustomTime     `json:"historyCreatedAt"`
+	UpdatedFields    []string       `json:"updatedFields"`
+	HistoryUsername  string         `json:"historyUsername,omitempty"`
+}
+
+// HousekeepingTaskHistorySnapshot represents the housekeeping_task entity data stored in audit logs
+type HousekeepingTaskHistorySnapshot struct {
+	ID            uint    `json:"id"`
+	RoomID        uint    `json:"roomId"`
+	ReservationID *uint   `json:"reservationId"`
+	TaskDate      string  `json:"taskDate"`
+	Type          string  `json:"type"`
+	Status        string  `json:"status"`
+	AssigneeID    *uint   `json:"assigneeId"`
+	Note          string  `json:"note"`
+	CompletedAt   *string `json:"completedAt"`
+	CreatedBy     uint    `json:"createdBy"`
+	UpdatedBy     uint    `json:"updatedBy"`
+	CreatedAt     string  `json:"createdAt"`
+	UpdatedAt     string  `json:"updatedAt"`
+}
+
+// HousekeepingTaskRevisionResponse is a revision response for HousekeepingTask entity
+type HousekeepingTaskRevisionResponse struct {
+	Entity           HousekeepingTaskResponse `json:"entity"`
+	HistoryType      HistoryType              `json:"historyType"`
+	HistoryCreatedAt CustomTime               `json:"historyCreatedAt"`
+	UpdatedFields    []string                 `json:"updatedFields"`
+	HistoryUsername  string                   `json:"historyUsername,omitempty"`
+}
+
+// MaintenanceTicketHistorySnapshot represents the maintenance_ticket entity data stored in audit logs
+type MaintenanceTicketHistorySnapshot struct {
+	ID                 uint    `json:"id"`
+	RoomID             uint    `json:"roomId"`
+	Description        string  `json:"description"`
+	Priority           string  `json:"priority"`
+	Status             string  `json:"status"`
+	AssigneeID         *uint   `json:"assigneeId"`
+	ExpectedReturnDate *string `json:"expectedReturnDate"`
+	Resolution         string  `json:"resolution"`
+	ResolvedAt         *string `json:"resolvedAt"`
+	CreatedBy          uint    `json:"createdBy"`
+	UpdatedBy          uint    `json:"updatedBy"`
+	CreatedAt          string  `json:"createdAt"`
+	UpdatedAt          string  `json:"updatedAt"`
+}
+
+// MaintenanceTicketRevisionResponse is a revision response for MaintenanceTicket entity
+type MaintenanceTicketRevisionResponse struct {
+	Entity           MaintenanceTicketResponse `json:"entity"`
+	HistoryType      HistoryType               `json:"historyType"`
+	HistoryCreatedAt CustomTime                `json:"historyCreatedAt"`
+	UpdatedFields    []string                  `json:"updatedFields"`
+	HistoryUsername  string                    `json:"historyUsername,omitempty"`
+}
@@ -15,6 +15,14 @@ type RoomResponse struct {
 	UpdatedAt   CustomTime           `json:"updatedAt"`
 	CreatedBy   *UserSummaryResponse `json:"createdBy"` // Spring Boot 호환성
 	UpdatedBy   *UserSummaryResponse `json:"updatedBy"` // Spring Boot 호환성
+
+	HousekeepingStatus string `json:"housekeepingStatus,omitempty"`
+
+	// StandardOccupancy와 MaxOccupancy는 이 객실에만 따로 정한 인원 기준이며, 없으면 객실 그룹 기준을 따릅니다.
+	// Amenities는 객실에 직접 붙인 편의시설이며, 객실 그룹의 편의시설은 RoomGroup에 있습니다.
+	StandardOccupancy *int              `json:"standardOccupancy"`
+	MaxOccupancy      *int              `json:"maxOccupancy"`
+	Amenities         []AmenityResponse `json:"amenities,omitempty"`
 }
 
 type CreateRoomRequest struct {
@@ -25,6 +33,11 @@ type CreateRoomRequest struct {
 	} `json:"roomGroup,omitempty"`
 	Note   string `json:"note" binding:"max=200"`
 	Status string `json:"status" binding:"omitempty,oneof=DAMAGED CONSTRUCTION INACTIVE NORMAL"`
+
+	// StandardOccupancy와 MaxOccupancy를 생략하거나 0으로 보내면 객실 그룹 기준을 따릅니다.
+	StandardOccupancy *int   `json:"standardOccupancy" binding:"omitempty,min=0"`
+	MaxOccupancy      *int   `json:"maxOccupancy" binding:"omitempty,min=0"`
+	AmenityIDs        []uint `json:"amenityIds"`
 }
 
 type UpdateRoomRequest struct {
@@ -32,6 +45,12 @@ type UpdateRoomRequest struct {
 	RoomGroupID *uint   `json:"roomGroupId" binding:"omitempty"`
 	Note        *string `json:"note" binding:"omitempty,max=200"`
 	Status      *string `json:"status" binding:"omitempty,oneof=DAMAGED CONSTRUCTION INACTIVE NORMAL"`
+
+	// StandardOccupancy와 MaxOccupancy를 0으로 보내면 객실별 기준을 지우고 객실 그룹 기준을 따릅니다.
+	// AmenityIDs를 보내면 객실에 직접 붙인 편의시설을 교체합니다 (빈 배열이면 모두 해제).
+	StandardOccupancy *int    `json:"standardOccupancy" binding:"omitempty,min=0"`
+	MaxOccupancy      *int    `json:"maxOccupancy" binding:"omitempty,min=0"`
+	AmenityIDs        *[]uint `json:"amenityIds"`
 }
 
 type RoomFilter struct {
@@ -41,6 +60,10 @@ type RoomFilter struct {
 	StayStartAt          *string `form:"stayStartAt"`
 	StayEndAt            *string `form:"stayEndAt"`
 	ExcludeReservationID *uint   `form:"excludeReservationId"`
+
+	// PeopleCount는 이 인원이 묵을 수 있는 객실만, AmenityIDs(amenityIds=1&amenityIds=2)는 편의시설을 모두 가진 객실만 조회합니다.
+	PeopleCount *int   `form:"peopleCount" binding:"omitempty,min=1"`
+	AmenityIDs  []uint `form:"amenityIds"`
 }
 
 type RoomFilterResponse struct {
@@ -50,10 +73,26 @@ type RoomFilterResponse struct {
 	StayStartAt          *string `json:"stayStartAt,omitempty"`
 	StayEndAt            *string `json:"stayEndAt,omitempty"`
 	ExcludeReservationID *uint   `json:"excludeReservationId,omitempty"`
+
+	PeopleCount *int   `json:"peopleCount,omitempty"`
+	AmenityIDs  []uint `json:"amenityIds,omitempty"`
 }
 
 type RoomRepositoryFilter struct {
 	RoomGroupID *uint
 	Status      *models.RoomStatus
 	Search      string
+
+	// PeopleCount는 최대 인원이 이 인원 이상인 객실만, AmenityIDs는 편의시설을 모두 가진 객실만 조회합니다.
+	PeopleCount *int
+	AmenityIDs  []uint
+}
+
+// Matches는 조회한 객실이 인원과 편의시설 조건을 만족하는지 확인합니다. 예약 가능한 객실 조회처럼 SQL로 거르지 않은 목록에 사용하며,
+// 객실과 객실 그룹의 편의시설을 함께 조회해야 합니다.
+func (f RoomRepositoryFilter) Matches(room *models.Room) bool {
+	if f.PeopleCount != nil && !room.CanAccommodate(*f.PeopleCount) {
+		return false
+	}
+	return room.HasAmenities(f.AmenityIDs)
 }
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+// RoomAssignmentProposalQuery는 GET /room-assignments/proposal 쿼리 파라미터입니다.
+// [startDate, endDate) 기간에 숙박을 시작하는 체크인 전 정상/대기 예약의 객실 배정을 다시 계산합니다.
+// reshuffle이 false면 지금 객실을 그대로 쓸 수 있는 예약은 옮기지 않고, true면 빈 날이 적도록 모든 예약을 다시 배정합니다.
+type RoomAssignmentProposalQuery struct {
+	StartDate   string `form:"startDate" binding:"required"`
+	EndDate     string `form:"endDate" binding:"required"`
+	RoomGroupID *uint  `form:"roomGroupId"`
+	Reshuffle   bool   `form:"reshuffle"`
+}
+
+// ToRoomAssignmentProposalRequest는 쿼리 파라미터를 객실 배정 제안 요청으로 변환합니다.
+func (q *RoomAssignmentProposalQuery) ToRoomAssignmentProposalRequest() (RoomAssignmentProposalRequest, error) {
+	startDate, err := time.Parse("2006-01-02", q.StartDate)
+	if err != nil {
+		return RoomAssignmentProposalRequest{}, fmt.Errorf("invalid startDate format, expected YYYY-MM-DD")
+	}
+	endDate, err := time.Parse("2006-01-02", q.EndDate)
+	if err != nil {
+		return RoomAssignmentProposalRequest{}, fmt.Errorf("invalid endDate format, expected YYYY-MM-DD")
+	}
+
+	return RoomAssignmentProposalRequest{
+		StartDate:   startDate,
+		EndDate:     endDate,
+		RoomGroupID: q.RoomGroupID,
+		Reshuffle:   q.Reshuffle,
+	}, nil
+}
+
+type RoomAssignmentProposalRequest struct {
+	StartDate   time.Time
+	EndDate     time.Time
+	RoomGroupID *uint
+	Reshuffle   bool
+}
+
+// RoomAssignmentProposalResponse는 검토 후 POST /room-assignments로 적용할 수 있는 객실 배정 제안입니다.
+// Assignments에는 객실이 바뀌는 예약만 담기며, 배정할 객실을 찾지 못한 예약은 Unassigned에 담깁니다.
+type RoomAssignmentProposalResponse struct {
+	StartDate   JSONDate                        `json:"startDate"`
+	EndDate     JSONDate                        `json:"endDate"`
+	Assignments []RoomAssignmentResponse        `json:"assignments"`
+	Unassigned  []UnassignedReservationResponse `json:"unassigned"`
+}
+
+type RoomAssignmentResponse struct {
+	ReservationID   uint     `json:"reservationId"`
+	Name            string   `json:"name"`
+	StayStartAt     JSONDate `json:"stayStartAt"`
+	StayEndAt       JSONDate `json:"stayEndAt"`
+	FromRoomIDs     []uint   `json:"fromRoomIds"`
+	FromRoomNumbers []string `json:"fromRoomNumbers"`
+	ToRoomIDs       []uint   `json:"toRoomIds"`
+	ToRoomNumbers   []string `json:"toRoomNumbers"`
+}
+
+type UnassignedReservationResponse struct {
+	ReservationID uint     `json:"reservationId"`
+	Name          string   `json:"name"`
+	StayStartAt   JSONDate `json:"stayStartAt"`
+	StayEndAt     JSONDate `json:"stayEndAt"`
+	Reason        string   `json:"reason"`
+}
+
+// ApplyRoomAssignmentRequest는 제안된 객실 배정을 적용합니다.
+// FromRoomIDs가 예약의 현재 객실과 다르면 제안 이후 예약이 바뀐 것으로 보고 전체 적용을 거절합니다.
+type ApplyRoomAssignmentRequest struct {
+	Assignments []ApplyRoomAssignmentItem `json:"assignments" binding:"required,min=1,dive"`
+}
+
+type ApplyRoomAssignmentItem struct {
+	ReservationID uint   `json:"reservationId" binding:"required"`
+	FromRoomIDs   []uint `json:"fromRoomIds"`
+	ToRoomIDs     []uint `json:"toRoomIds" binding:"required,min=1"`
+}
@@ -11,6 +11,10 @@ type RoomGroupResponse struct {
 	CreatedBy    *UserSummaryResponse         `json:"createdBy"`
 	UpdatedAt    CustomTime                   `json:"updatedAt"`
 	UpdatedBy    *UserSummaryResponse         `json:"updatedBy"`
+
+	StandardOccupancy int               `json:"standardOccupancy"`
+	MaxOccupancy      int               `json:"maxOccupancy"`
+	Amenities         []AmenityResponse `json:"amenities,omitempty"`
 }
 
 // RoomLastStayDetailResponse represents a room with its last reservation
@@ -24,6 +28,11 @@ type CreateRoomGroupRequest struct {
 	PeekPrice    int    `json:"peekPrice" binding:"min=0"`
 	OffPeekPrice int    `json:"offPeekPrice" binding:"min=0"`
 	Description  string `json:"description" binding:"max=200"`
+
+	// StandardOccupancy는 기준 인원, MaxOccupancy는 최대 인원입니다. 0이면 인원을 확인하지 않습니다.
+	StandardOccupancy int    `json:"standardOccupancy" binding:"min=0"`
+	MaxOccupancy      int    `json:"maxOccupancy" binding:"min=0"`
+	AmenityIDs        []uint `json:"amenityIds"`
 }
 
 type UpdateRoomGroupRequest struct {
@@ -31,6 +40,11 @@ type UpdateRoomGroupRequest struct {
 	PeekPrice    *int    `json:"peekPrice" binding:"omitempty,min=0"`
 	OffPeekPrice *int    `json:"offPeekPrice" binding:"omitempty,min=0"`
 	Description  *string `json:"description" binding:"omitempty,max=200"`
+
+	// AmenityIDs를 보내면 객실 그룹의 편의시설을 교체합니다 (빈 배열이면 모두 해제).
+	StandardOccupancy *int    `json:"standardOccupancy" binding:"omitempty,min=0"`
+	MaxOccupancy      *int    `json:"maxOccupancy" binding:"omitempty,min=0"`
+	AmenityIDs        *[]uint `json:"amenityIds"`
 }
 
 // RoomGroupRoomFilter는 룸그룹 내 객실 조회 시 필터링 조건
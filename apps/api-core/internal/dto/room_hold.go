@@ -0,0 +1,49 @@
+package dto
+
+type CreateRoomHoldRequest struct {
+	RoomIDs     []uint   `json:"roomIds" binding:"required,min=1"`
+	StayStartAt JSONTime `json:"stayStartAt" binding:"required"`
+	StayEndAt   JSONTime `json:"stayEndAt" binding:"required"`
+	TTLMinutes  int      `json:"ttlMinutes" binding:"omitempty,min=1,max=60"`
+	Note        string   `json:"note" binding:"max=200"`
+}
+
+// ConvertRoomHoldRequest는 홀드를 예약으로 전환할 때의 요청입니다.
+// 객실과 숙박 기간은 홀드에 저장된 값을 그대로 사용합니다.
+type ConvertRoomHoldRequest struct {
+	PaymentMethodID uint             `json:"paymentMethodId"`
+	PaymentMethod   *EntityReference `json:"paymentMethod,omitempty"`
+	Name            string           `json:"name" binding:"required,min=2,max=30"`
+	Phone           string           `json:"phone" binding:"omitempty,max=20"`
+	PeopleCount     int              `json:"peopleCount" binding:"min=0"`
+	Price           *int             `json:"price" binding:"omitempty,min=0"` // 생략하면 서버가 견적으로 채움
+	Deposit         int              `json:"deposit" binding:"min=0"`
+	PaymentAmount   int              `json:"paymentAmount" binding:"min=0"`
+	BrokerFee       int              `json:"brokerFee" binding:"min=0"`
+	Note            string           `json:"note" binding:"max=200"`
+	Status          string           `json:"status,omitempty"`
+	Type            string           `json:"type" binding:"omitempty,oneof=STAY MONTHLY_RENT"`
+	// ConfirmDuplicate가 true면 중복으로 의심되는 예약이 있어도 예약으로 전환합니다.
+	ConfirmDuplicate bool `json:"confirmDuplicate"`
+}
+
+func (r *ConvertRoomHoldRequest) GetPaymentMethodID() uint {
+	if r.PaymentMethodID != 0 {
+		return r.PaymentMethodID
+	}
+	if r.PaymentMethod != nil && r.PaymentMethod.ID != 0 {
+		return r.PaymentMethod.ID
+	}
+	return 0
+}
+
+type RoomHoldResponse struct {
+	ID          uint                 `json:"id"`
+	RoomIDs     []uint               `json:"roomIds"`
+	StayStartAt JSONDate             `json:"stayStartAt"`
+	StayEndAt   JSONDate             `json:"stayEndAt"`
+	Note        string               `json:"note"`
+	HeldBy      *UserSummaryResponse `json:"heldBy"`
+	CreatedAt   CustomTime           `json:"createdAt"`
+	ExpiresAt   CustomTime           `json:"expiresAt"`
+}
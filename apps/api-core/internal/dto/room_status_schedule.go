@@ -0,0 +1,113 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+type RoomStatusScheduleResponse struct {
+	ID             uint        `json:"id"`
+	RoomID         uint        `json:"roomId"`
+	Status         string      `json:"status"`
+	StartDate      JSONDate    `json:"startDate"`
+	EndDate        JSONDate    `json:"endDate"`
+	Note           string      `json:"note"`
+	State          string      `json:"state"`
+	PreviousStatus *string     `json:"previousStatus"`
+	AppliedAt      *CustomTime `json:"appliedAt"`
+	RevertedAt     *CustomTime `json:"revertedAt"`
+	CreatedAt      CustomTime  `json:"createdAt"`
+	UpdatedAt      CustomTime  `json:"updatedAt"`
+}
+
+// CreateRoomStatusScheduleRequest는 객실을 startDate부터 endDate까지(종료일 포함) status 상태로 운영하도록 예약합니다.
+type CreateRoomStatusScheduleRequest struct {
+	Status    string `json:"status" binding:"required,oneof=DAMAGED CONSTRUCTION INACTIVE"`
+	StartDate string `json:"startDate" binding:"required"`
+	EndDate   string `json:"endDate" binding:"required"`
+	Note      string `json:"note" binding:"max=200"`
+}
+
+// ToRoomStatusSchedule은 요청을 roomID 객실의 객실 상태 예약 모델로 변환합니다.
+func (r *CreateRoomStatusScheduleRequest) ToRoomStatusSchedule(roomID uint) (*models.RoomStatusSchedule, error) {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid startDate format, expected YYYY-MM-DD")
+	}
+	endDate, err := time.Parse("2006-01-02", r.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endDate format, expected YYYY-MM-DD")
+	}
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("endDate must be on or after startDate")
+	}
+
+	schedule := &models.RoomStatusSchedule{
+		RoomID:    roomID,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Note:      r.Note,
+	}
+	switch r.Status {
+	case "DAMAGED":
+		schedule.Status = models.RoomStatusDamaged
+	case "CONSTRUCTION":
+		schedule.Status = models.RoomStatusConstruction
+	case "INACTIVE":
+		schedule.Status = models.RoomStatusInactive
+	}
+
+	return schedule, nil
+}
+
+// RoomStatusTimelineQuery는 GET /rooms/:id/status-timeline 쿼리 파라미터입니다.
+// [startDate, endDate) 기간을 조회하며, 생략하면 오늘부터 90일을 조회합니다.
+type RoomStatusTimelineQuery struct {
+	StartDate string `form:"startDate"`
+	EndDate   string `form:"endDate"`
+}
+
+// ToDateRange는 쿼리 파라미터를 조회 기간으로 변환합니다.
+func (q *RoomStatusTimelineQuery) ToDateRange(today time.Time) (time.Time, time.Time, error) {
+	startDate := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if q.StartDate != "" {
+		parsed, err := time.Parse("2006-01-02", q.StartDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid startDate format, expected YYYY-MM-DD")
+		}
+		startDate = parsed
+	}
+
+	endDate := startDate.AddDate(0, 0, 90)
+	if q.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", q.EndDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid endDate format, expected YYYY-MM-DD")
+		}
+		endDate = parsed
+	}
+
+	return startDate, endDate, nil
+}
+
+// RoomStatusTimelineResponse는 객실의 날짜별 운영 상태를 같은 상태가 이어지는 구간으로 묶은 타임라인입니다.
+// 객실 상태 예약이 없는 날은 예약이 끝난 뒤 돌아갈 상태(적용 중인 예약이 없으면 현재 상태)로 표시합니다.
+type RoomStatusTimelineResponse struct {
+	RoomID        uint                         `json:"roomId"`
+	RoomNumber    string                       `json:"roomNumber"`
+	CurrentStatus string                       `json:"currentStatus"`
+	StartDate     JSONDate                     `json:"startDate"`
+	EndDate       JSONDate                     `json:"endDate"`
+	Periods       []RoomStatusPeriodResponse   `json:"periods"`
+	Schedules     []RoomStatusScheduleResponse `json:"schedules"`
+}
+
+// RoomStatusPeriodResponse의 EndDate는 구간의 마지막 날(포함)입니다. ScheduleID는 객실 상태 예약으로 정해진 구간에만 있습니다.
+type RoomStatusPeriodResponse struct {
+	StartDate  JSONDate `json:"startDate"`
+	EndDate    JSONDate `json:"endDate"`
+	Status     string   `json:"status"`
+	ScheduleID *uint    `json:"scheduleId"`
+}
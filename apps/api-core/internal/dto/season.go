@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+type SeasonResponse struct {
+	ID              uint                 `json:"id"`
+	Name            string               `json:"name"`
+	StartDate       JSONDate             `json:"startDate"`
+	EndDate         JSONDate             `json:"endDate"`
+	RecurringYearly bool                 `json:"recurringYearly"`
+	RoomGroupIDs    []uint               `json:"roomGroupIds"`
+	CreatedBy       *UserSummaryResponse `json:"createdBy"`
+	CreatedAt       CustomTime           `json:"createdAt"`
+}
+
+type CreateSeasonRequest struct {
+	Name            string `json:"name" binding:"required,min=1,max=50"`
+	StartDate       string `json:"startDate" binding:"required"`
+	EndDate         string `json:"endDate" binding:"required"`
+	RecurringYearly bool   `json:"recurringYearly"`
+	RoomGroupIDs    []uint `json:"roomGroupIds"`
+}
+
+type UpdateSeasonRequest struct {
+	Name            *string `json:"name" binding:"omitempty,min=1,max=50"`
+	StartDate       *string `json:"startDate"`
+	EndDate         *string `json:"endDate"`
+	RecurringYearly *bool   `json:"recurringYearly"`
+	RoomGroupIDs    *[]uint `json:"roomGroupIds"`
+}
+
+func (r *CreateSeasonRequest) Validate() error {
+	startDate, err := time.Parse("2006-01-02", r.StartDate)
+	if err != nil {
+		return fmt.Errorf("invalid startDate format, expected YYYY-MM-DD")
+	}
+
+	endDate, err := time.Parse("2006-01-02", r.EndDate)
+	if err != nil {
+		return fmt.Errorf("invalid endDate format, expected YYYY-MM-DD")
+	}
+
+	return ValidateSeasonPeriod(startDate, endDate, r.RecurringYearly)
+}
+
+// ValidateSeasonPeriod는 시즌 기간을 검증합니다. 매년 반복 시즌은 1년 미만이어야 합니다.
+func ValidateSeasonPeriod(startDate, endDate time.Time, recurringYearly bool) error {
+	if startDate.After(endDate) {
+		return fmt.Errorf("startDate must be before or equal to endDate")
+	}
+	if recurringYearly && !endDate.Before(startDate.AddDate(1, 0, 0)) {
+		return fmt.Errorf("recurring season must be shorter than a year")
+	}
+	return nil
+}
+
+type SeasonFilter struct {
+	StartDate   string `form:"startDate"`
+	EndDate     string `form:"endDate"`
+	RoomGroupID *uint  `form:"roomGroupId"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+// StayChangeRequest는 POST /reservations/:id/extend, /reservations/:id/shorten 요청 본문입니다.
+// StayEndAt(YYYY-MM-DD)은 바꿀 퇴실일입니다.
+type StayChangeRequest struct {
+	StayEndAt string `json:"stayEndAt" binding:"required"`
+}
+
+// StayChangeResponse는 숙박 연장/조기 퇴실 결과입니다. PriceDifference만큼 판매 금액이 바뀌고
+// UnpaidAmount는 바뀐 판매 금액 기준 미수금입니다(음수면 돌려줄 금액).
+type StayChangeResponse struct {
+	ReservationID     uint     `json:"reservationId"`
+	PreviousStayEndAt JSONDate `json:"previousStayEndAt"`
+	StayEndAt         JSONDate `json:"stayEndAt"`
+	PreviousPrice     int      `json:"previousPrice"`
+	Price             int      `json:"price"`
+	PriceDifference   int      `json:"priceDifference"`
+	UnpaidAmount      int      `json:"unpaidAmount"`
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type AmenityHandler struct {
+	service services.AmenityService
+}
+
+func NewAmenityHandler(service services.AmenityService) *AmenityHandler {
+	return &AmenityHandler{service: service}
+}
+
+// ListAmenities는 편의시설 카탈로그를 종류 순으로 조회합니다. category로 종류를 거를 수 있습니다.
+func (h *AmenityHandler) ListAmenities(c *gin.Context) {
+	var filter dto.AmenityFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "잘못된 필터 파라미터", err.Error())
+		return
+	}
+
+	amenities, err := h.service.GetAll(c.Request.Context(), filter.Category)
+	if err != nil {
+		response.InternalServerError(c, "편의시설 목록 조회 실패")
+		return
+	}
+
+	response.Success(c, amenities)
+}
+
+func (h *AmenityHandler) CreateAmenity(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateAmenityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	created, err := h.service.Create(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrAmenityCodeExists) {
+			response.Conflict(c, "이미 존재하는 편의시설 코드")
+			return
+		}
+		response.InternalServerError(c, "편의시설 등록 실패")
+		return
+	}
+
+	response.Created(c, created)
+}
+
+func (h *AmenityHandler) UpdateAmenity(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 편의시설 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateAmenityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	updated, err := h.service.Update(ctx, uint(id), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAmenityNotFound):
+			response.NotFound(c, "존재하지 않는 편의시설")
+		case errors.Is(err, services.ErrAmenityCodeExists):
+			response.Conflict(c, "이미 존재하는 편의시설 코드")
+		default:
+			response.InternalServerError(c, "편의시설 수정 실패")
+		}
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+func (h *AmenityHandler) DeleteAmenity(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 편의시설 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Delete(ctx, uint(id)); err != nil {
+		if errors.Is(err, services.ErrAmenityNotFound) {
+			response.NotFound(c, "존재하지 않는 편의시설")
+			return
+		}
+		response.InternalServerError(c, "편의시설 삭제 실패")
+		return
+	}
+
+	response.NoContent(c)
+}
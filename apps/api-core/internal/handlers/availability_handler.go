@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type AvailabilityHandler struct {
+	availabilityService services.AvailabilityService
+}
+
+func NewAvailabilityHandler(availabilityService services.AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{availabilityService: availabilityService}
+}
+
+// SearchAvailability는 숙박 기간의 객실 그룹별 빈 객실 수와 박별 기준 요금을 반환합니다.
+func (h *AvailabilityHandler) SearchAvailability(c *gin.Context) {
+	var query dto.AvailabilityQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	req, err := query.ToAvailabilityRequest()
+	if err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	// 본인이 잡아 둔 임시 홀드는 빈 객실로 보도록 사용자 정보를 넘긴다
+	ctx := c.Request.Context()
+	if userID, exists := middleware.GetUserID(c); exists {
+		ctx = appContext.WithUserID(ctx, userID)
+	}
+
+	availability, err := h.availabilityService.Search(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrAvailabilityRangeTooLong):
+			response.BadRequest(c, "잘못된 날짜 범위", err.Error())
+		default:
+			response.InternalServerError(c, "빈 객실 조회 실패")
+		}
+		return
+	}
+
+	response.Success(c, availability)
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type BrokerFeeSettlementHandler struct {
+	service services.BrokerFeeSettlementService
+}
+
+func NewBrokerFeeSettlementHandler(service services.BrokerFeeSettlementService) *BrokerFeeSettlementHandler {
+	return &BrokerFeeSettlementHandler{service: service}
+}
+
+// GetReport는 결제 수단별, 기간별 판매 금액, 수수료, 환불 금액, 실수령액을 조회합니다.
+func (h *BrokerFeeSettlementHandler) GetReport(c *gin.Context) {
+	var query dto.BrokerFeeSettlementReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	if query.PeriodType == "" {
+		query.PeriodType = "MONTHLY"
+	}
+
+	report, err := h.service.GetReport(c.Request.Context(), query)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidBrokerFeeSettlementRequest) {
+			response.BadRequest(c, "잘못된 날짜 범위", "시작일은 종료일보다 이전이거나 같아야 합니다")
+			return
+		}
+		response.InternalServerError(c, "정산 현황 조회 실패")
+		return
+	}
+
+	response.Success(c, report)
+}
+
+func (h *BrokerFeeSettlementHandler) ListSettlements(c *gin.Context) {
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	var filter dto.BrokerFeeSettlementFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "잘못된 필터 파라미터", err.Error())
+		return
+	}
+
+	settlements, total, err := h.service.GetAll(c.Request.Context(), filter, query.Page, query.Size)
+	if err != nil {
+		response.InternalServerError(c, "정산 목록 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size > 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessListWithFilter(c, settlements, pagination, filter)
+}
+
+func (h *BrokerFeeSettlementHandler) GetSettlement(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 정산 ID")
+		return
+	}
+
+	settlement, err := h.service.GetByID(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrBrokerFeeSettlementNotFound) {
+			response.NotFound(c, "존재하지 않는 정산")
+			return
+		}
+		response.InternalServerError(c, "정산 조회 실패")
+		return
+	}
+
+	response.Success(c, settlement)
+}
+
+// CreateSettlement는 결제 수단의 기간을 정산 완료 처리합니다.
+func (h *BrokerFeeSettlementHandler) CreateSettlement(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateBrokerFeeSettlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	created, err := h.service.Create(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPaymentMethodNotFound):
+			response.BadRequest(c, "존재하지 않는 결제 수단")
+		case errors.Is(err, services.ErrInvalidBrokerFeeSettlementRequest),
+			errors.Is(err, services.ErrNoPaymentsToSettle):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, services.ErrBrokerFeeSettlementOverlap):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, "정산 처리 실패")
+		}
+		return
+	}
+
+	response.Created(c, created)
+}
+
+// DeleteSettlement는 정산을 취소하고 포함된 예약을 다시 수정할 수 있게 합니다.
+func (h *BrokerFeeSettlementHandler) DeleteSettlement(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 정산 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Delete(ctx, uint(id)); err != nil {
+		if errors.Is(err, services.ErrBrokerFeeSettlementNotFound) {
+			response.NotFound(c, "존재하지 않는 정산")
+			return
+		}
+		response.InternalServerError(c, "정산 취소 실패")
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -71,6 +73,11 @@ func (h *DateBlockHandler) CreateDateBlock(c *gin.Context) {
 	ctx := appContext.WithUserID(c.Request.Context(), userID)
 	created, err := h.service.Create(ctx, req)
 	if err != nil {
+		var conflictErr *services.DateBlockConflictError
+		if errors.As(err, &conflictErr) {
+			respondDateBlockConflict(c, conflictErr)
+			return
+		}
 		response.BadRequest(c, "잘못된 요청", err.Error())
 		return
 	}
@@ -157,6 +164,11 @@ func (h *DateBlockHandler) UpdateDateBlock(c *gin.Context) {
 			response.BadRequest(c, "잘못된 요청", err.Error())
 			return
 		}
+		var conflictErr *services.DateBlockConflictError
+		if errors.As(err, &conflictErr) {
+			respondDateBlockConflict(c, conflictErr)
+			return
+		}
 		response.InternalServerError(c, "날짜 차단 수정 실패")
 		return
 	}
@@ -198,3 +210,69 @@ func (h *DateBlockHandler) GetDateBlockHistories(c *gin.Context) {
 
 	response.SuccessList(c, histories, pagination)
 }
+
+// GetDateBlockConflicts는 날짜 차단과 겹치는 정상/대기 예약 목록을 조회합니다.
+// 강제로 생성한 차단의 예약 객실 이동이나 취소 대상을 확인하는 용도입니다.
+func (h *DateBlockHandler) GetDateBlockConflicts(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 날짜 차단 ID")
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	conflicts, err := h.service.GetConflicts(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrDateBlockNotFound) {
+			response.NotFound(c, "존재하지 않는 날짜 차단")
+			return
+		}
+		response.InternalServerError(c, "날짜 차단 충돌 예약 조회 실패")
+		return
+	}
+
+	total := int64(len(conflicts))
+	offset := query.Page * query.Size
+	end := offset + query.Size
+	if offset > len(conflicts) {
+		conflicts = []dto.DateBlockConflictResponse{}
+	} else if end > len(conflicts) {
+		conflicts = conflicts[offset:]
+	} else {
+		conflicts = conflicts[offset:end]
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size != 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessList(c, conflicts, pagination)
+}
+
+func respondDateBlockConflict(c *gin.Context, err *services.DateBlockConflictError) {
+	messages := make([]string, len(err.Conflicts))
+	for i, conflict := range err.Conflicts {
+		messages[i] = fmt.Sprintf("예약 #%d %s (%s ~ %s)", conflict.ReservationID, conflict.Name,
+			conflict.StayStartAt.Format("2006-01-02"), conflict.StayEndAt.Format("2006-01-02"))
+	}
+
+	c.JSON(http.StatusConflict, dto.DateBlockConflictErrorResponse{
+		Message:   services.ErrDateBlockConflict.Error(),
+		Errors:    messages,
+		Conflicts: err.Conflicts,
+	})
+}
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockDateBlockService struct {
+	mock.Mock
+}
+
+func (m *MockDateBlockService) Create(ctx context.Context, req dto.CreateDateBlockRequest) (*dto.DateBlockResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DateBlockResponse), args.Error(1)
+}
+
+func (m *MockDateBlockService) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockDateBlockService) GetDateBlock(ctx context.Context, id uint) (*dto.DateBlockResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DateBlockResponse), args.Error(1)
+}
+
+func (m *MockDateBlockService) GetAll(ctx context.Context, filter dto.DateBlockFilter, page, size int) ([]dto.DateBlockResponse, int64, error) {
+	args := m.Called(ctx, filter, page, size)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]dto.DateBlockResponse), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockDateBlockService) UpdateDateBlock(ctx context.Context, id uint, req dto.UpdateDateBlockRequest) (*dto.DateBlockResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.DateBlockResponse), args.Error(1)
+}
+
+func (m *MockDateBlockService) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time, roomIDs []uint) (bool, error) {
+	args := m.Called(ctx, startDate, endDate, roomIDs)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDateBlockService) GetConflicts(ctx context.Context, id uint) ([]dto.DateBlockConflictResponse, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.DateBlockConflictResponse), args.Error(1)
+}
+
+func setupDateBlockRouter(mockService *MockDateBlockService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewDateBlockHandler(mockService, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, uint(1))
+		c.Next()
+	})
+	router.POST("/api/v1/date-blocks", handler.CreateDateBlock)
+	router.PATCH("/api/v1/date-blocks/:id", handler.UpdateDateBlock)
+	router.GET("/api/v1/date-blocks/:id/conflicts", handler.GetDateBlockConflicts)
+	return router
+}
+
+func TestDateBlockHandler_CreateDateBlock_충돌이_있으면_409와_충돌_목록을_반환한다(t *testing.T) {
+	mockService := new(MockDateBlockService)
+	router := setupDateBlockRouter(mockService)
+	conflicts := []dto.DateBlockConflictResponse{{
+		ReservationID: 70,
+		Name:          "홍길동",
+		Status:        "NORMAL",
+		StayStartAt:   dto.JSONDate{Time: time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC)},
+		StayEndAt:     dto.JSONDate{Time: time.Date(2026, 4, 13, 0, 0, 0, 0, time.UTC)},
+		Rooms:         []dto.DateBlockConflictRoomResponse{{ID: 3, Number: "201"}},
+	}}
+	mockService.On("Create", mock.Anything, mock.Anything).Return(nil, &services.DateBlockConflictError{Conflicts: conflicts})
+
+	body := `{"startDate":"2026-04-10","endDate":"2026-04-12","reason":"보일러 교체"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/date-blocks", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	var resp dto.DateBlockConflictErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"예약 #70 홍길동 (2026-04-11 ~ 2026-04-13)"}, resp.Errors)
+	assert.Len(t, resp.Conflicts, 1)
+	assert.Equal(t, "201", resp.Conflicts[0].Rooms[0].Number)
+}
+
+func TestDateBlockHandler_UpdateDateBlock_force를_서비스에_전달한다(t *testing.T) {
+	mockService := new(MockDateBlockService)
+	router := setupDateBlockRouter(mockService)
+	mockService.On("UpdateDateBlock", mock.Anything, uint(5), mock.MatchedBy(func(req dto.UpdateDateBlockRequest) bool {
+		return req.Force && req.EndDate != nil && *req.EndDate == "2026-04-15"
+	})).Return(&dto.DateBlockResponse{ID: 5}, nil)
+
+	body := `{"endDate":"2026-04-15","force":true}`
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/date-blocks/5", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDateBlockHandler_GetDateBlockConflicts(t *testing.T) {
+	tests := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"충돌 예약 목록을 조회한다", nil, http.StatusOK},
+		{"존재하지 않는 날짜 차단이면 404", services.ErrDateBlockNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockDateBlockService)
+			router := setupDateBlockRouter(mockService)
+			if tt.serviceErr != nil {
+				mockService.On("GetConflicts", mock.Anything, uint(5)).Return(nil, tt.serviceErr)
+			} else {
+				mockService.On("GetConflicts", mock.Anything, uint(5)).Return([]dto.DateBlockConflictResponse{{ReservationID: 70}}, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/date-blocks/5/conflicts", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type GuestHandler struct {
+	service services.GuestService
+}
+
+func NewGuestHandler(service services.GuestService) *GuestHandler {
+	return &GuestHandler{service: service}
+}
+
+// ListGuests는 고객을 최근 등록 순으로 조회합니다. search는 이름 일부나 형식과 무관한 전화번호 일부로 찾습니다.
+func (h *GuestHandler) ListGuests(c *gin.Context) {
+	var filter dto.GuestFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	guests, total, err := h.service.GetGuests(c.Request.Context(), filter, query.GetOffset(), query.GetLimit())
+	if err != nil {
+		response.InternalServerError(c, "고객 목록 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size != 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessList(c, mappers.ToGuestListResponse(guests), pagination)
+}
+
+func (h *GuestHandler) GetGuest(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 고객 ID")
+		return
+	}
+
+	guest, err := h.service.GetGuest(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrGuestNotFound) {
+			response.NotFound(c, "존재하지 않는 고객")
+			return
+		}
+		response.InternalServerError(c, "고객 조회 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToGuestResponse(guest))
+}
+
+// GetGuestHistory는 고객의 지난 숙박과 예정된 숙박, 누적 결제 금액과 노쇼 횟수를 조회합니다.
+func (h *GuestHandler) GetGuestHistory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 고객 ID")
+		return
+	}
+
+	history, err := h.service.GetHistory(c.Request.Context(), uint(id), time.Now())
+	if err != nil {
+		if errors.Is(err, services.ErrGuestNotFound) {
+			response.NotFound(c, "존재하지 않는 고객")
+			return
+		}
+		response.InternalServerError(c, "고객 이력 조회 실패")
+		return
+	}
+
+	response.Success(c, history)
+}
+
+func (h *GuestHandler) CreateGuest(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	guest, err := h.service.Create(ctx, req)
+	if err != nil {
+		respondGuestError(c, err, "고객 등록 실패")
+		return
+	}
+
+	response.Created(c, mappers.ToGuestResponse(guest))
+}
+
+func (h *GuestHandler) UpdateGuest(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 고객 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	guest, err := h.service.Update(ctx, uint(id), req)
+	if err != nil {
+		respondGuestError(c, err, "고객 수정 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToGuestResponse(guest))
+}
+
+func respondGuestError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrGuestNotFound):
+		response.NotFound(c, "존재하지 않는 고객")
+	case errors.Is(err, services.ErrInvalidPhone):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, services.ErrGuestPhoneExists):
+		response.Conflict(c, err.Error())
+	default:
+		response.InternalServerError(c, message)
+	}
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type HousekeepingHandler struct {
+	housekeepingService services.HousekeepingService
+	historyService      services.HistoryService
+}
+
+func NewHousekeepingHandler(housekeepingService services.HousekeepingService, historyService services.HistoryService) *HousekeepingHandler {
+	return &HousekeepingHandler{housekeepingService: housekeepingService, historyService: historyService}
+}
+
+// ListRooms는 객실별 청소 상태 현황을 조회합니다.
+func (h *HousekeepingHandler) ListRooms(c *gin.Context) {
+	var filter dto.HousekeepingRoomFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	rooms, err := h.housekeepingService.GetRooms(c.Request.Context(), filter)
+	if err != nil {
+		response.InternalServerError(c, "객실 청소 상태 조회 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToHousekeepingRoomListResponse(rooms))
+}
+
+func (h *HousekeepingHandler) UpdateRoom(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateHousekeepingRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+	status, _ := models.ParseHousekeepingStatus(req.HousekeepingStatus)
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	room, err := h.housekeepingService.UpdateRoomStatus(ctx, uint(id), status)
+	if err != nil {
+		if errors.Is(err, services.ErrRoomNotFound) {
+			response.NotFound(c, "존재하지 않는 객실")
+			return
+		}
+		response.InternalServerError(c, "객실 청소 상태 변경 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToHousekeepingRoomResponse(room))
+}
+
+// ListTasks는 작업일(기본 오늘)의 청소 작업을 조회합니다.
+func (h *HousekeepingHandler) ListTasks(c *gin.Context) {
+	var query dto.HousekeepingTaskQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	filter, err := query.ToHousekeepingTaskFilter(time.Now())
+	if err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	tasks, err := h.housekeepingService.GetTasks(c.Request.Context(), filter)
+	if err != nil {
+		response.InternalServerError(c, "청소 작업 조회 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToHousekeepingTaskListResponse(tasks))
+}
+
+// GenerateTasks는 작업일(기본 오늘)의 퇴실/연박 예약으로 청소 작업을 생성하고, 새로 만든 작업을 반환합니다.
+func (h *HousekeepingHandler) GenerateTasks(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	// 본문 없이 호출하면 오늘 작업을 생성한다
+	var req dto.GenerateHousekeepingTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	date := time.Now()
+	if req.Date != "" {
+		parsed, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			response.BadRequest(c, "잘못된 요청", "invalid date format, expected YYYY-MM-DD")
+			return
+		}
+		date = parsed
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	tasks, err := h.housekeepingService.GenerateTasks(ctx, date)
+	if err != nil {
+		response.InternalServerError(c, "청소 작업 생성 실패")
+		return
+	}
+
+	response.Created(c, mappers.ToHousekeepingTaskListResponse(tasks))
+}
+
+func (h *HousekeepingHandler) UpdateTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 청소 작업 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateHousekeepingTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	task, err := h.housekeepingService.UpdateTask(ctx, uint(id), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrHousekeepingTaskNotFound):
+			response.NotFound(c, "존재하지 않는 청소 작업")
+		case errors.Is(err, services.ErrAssigneeNotFound):
+			response.BadRequest(c, "존재하지 않는 담당자")
+		default:
+			response.InternalServerError(c, "청소 작업 수정 실패")
+		}
+		return
+	}
+
+	response.Success(c, mappers.ToHousekeepingTaskResponse(task))
+}
+
+func (h *HousekeepingHandler) GetTaskHistories(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 청소 작업 ID")
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	histories, total, err := h.historyService.GetHousekeepingTaskHistory(c.Request.Context(), uint(id), query.Page, query.Size)
+	if err != nil {
+		response.InternalServerError(c, "청소 작업 이력 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size != 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessList(c, histories, pagination)
+}
@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type MaintenanceTicketHandler struct {
+	maintenanceTicketService services.MaintenanceTicketService
+	historyService           services.HistoryService
+}
+
+func NewMaintenanceTicketHandler(maintenanceTicketService services.MaintenanceTicketService, historyService services.HistoryService) *MaintenanceTicketHandler {
+	return &MaintenanceTicketHandler{maintenanceTicketService: maintenanceTicketService, historyService: historyService}
+}
+
+// ListTickets는 유지보수 티켓 목록을 조회합니다. 열린 티켓이 먼저, 우선순위가 높은 티켓이 먼저 나옵니다.
+func (h *MaintenanceTicketHandler) ListTickets(c *gin.Context) {
+	var filter dto.MaintenanceTicketFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	tickets, total, err := h.maintenanceTicketService.GetTickets(c.Request.Context(), filter.ToRepositoryFilter(), query.GetOffset(), query.GetLimit())
+	if err != nil {
+		response.InternalServerError(c, "유지보수 티켓 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size != 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessList(c, mappers.ToMaintenanceTicketListResponse(tickets), pagination)
+}
+
+// GetTicket은 유지보수 티켓과 예상 고장 기간에 걸린 예약을 조회합니다.
+func (h *MaintenanceTicketHandler) GetTicket(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 유지보수 티켓 ID")
+		return
+	}
+
+	ticket, err := h.maintenanceTicketService.GetTicket(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrMaintenanceTicketNotFound) {
+			response.NotFound(c, "존재하지 않는 유지보수 티켓")
+			return
+		}
+		response.InternalServerError(c, "유지보수 티켓 조회 실패")
+		return
+	}
+
+	resp, err := h.toTicketResponseWithAffected(c.Request.Context(), ticket)
+	if err != nil {
+		response.InternalServerError(c, "영향받는 예약 조회 실패")
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+func (h *MaintenanceTicketHandler) CreateTicket(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateMaintenanceTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ticket, roomStatus, err := req.ToMaintenanceTicket()
+	if err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	created, err := h.maintenanceTicketService.CreateTicket(ctx, ticket, roomStatus)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.BadRequest(c, "존재하지 않는 객실")
+		case errors.Is(err, services.ErrAssigneeNotFound):
+			response.BadRequest(c, "존재하지 않는 담당자")
+		default:
+			response.InternalServerError(c, "유지보수 티켓 등록 실패")
+		}
+		return
+	}
+
+	resp, err := h.toTicketResponseWithAffected(ctx, created)
+	if err != nil {
+		response.InternalServerError(c, "영향받는 예약 조회 실패")
+		return
+	}
+
+	response.Created(c, resp)
+}
+
+func (h *MaintenanceTicketHandler) UpdateTicket(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 유지보수 티켓 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateMaintenanceTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	ticket, err := h.maintenanceTicketService.UpdateTicket(ctx, uint(id), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrMaintenanceTicketNotFound):
+			response.NotFound(c, "존재하지 않는 유지보수 티켓")
+		case errors.Is(err, services.ErrMaintenanceTicketResolved):
+			response.Conflict(c, "이미 해결된 유지보수 티켓")
+		case errors.Is(err, services.ErrAssigneeNotFound):
+			response.BadRequest(c, "존재하지 않는 담당자")
+		case errors.Is(err, services.ErrInvalidExpectedReturnDate):
+			response.BadRequest(c, "잘못된 요청", "invalid expectedReturnDate format, expected YYYY-MM-DD")
+		default:
+			response.InternalServerError(c, "유지보수 티켓 수정 실패")
+		}
+		return
+	}
+
+	resp, err := h.toTicketResponseWithAffected(ctx, ticket)
+	if err != nil {
+		response.InternalServerError(c, "영향받는 예약 조회 실패")
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ResolveTicket은 티켓을 해결 처리합니다. 객실의 마지막 열린 티켓이면 객실이 NORMAL로 돌아옵니다.
+func (h *MaintenanceTicketHandler) ResolveTicket(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 유지보수 티켓 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.ResolveMaintenanceTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	ticket, err := h.maintenanceTicketService.ResolveTicket(ctx, uint(id), req.Resolution)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrMaintenanceTicketNotFound):
+			response.NotFound(c, "존재하지 않는 유지보수 티켓")
+		case errors.Is(err, services.ErrMaintenanceTicketResolved):
+			response.Conflict(c, "이미 해결된 유지보수 티켓")
+		default:
+			response.InternalServerError(c, "유지보수 티켓 해결 처리 실패")
+		}
+		return
+	}
+
+	response.Success(c, mappers.ToMaintenanceTicketResponse(ticket))
+}
+
+func (h *MaintenanceTicketHandler) GetTicketHistories(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 유지보수 티켓 ID")
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	histories, total, err := h.historyService.GetMaintenanceTicketHistory(c.Request.Context(), uint(id), query.Page, query.Size)
+	if err != nil {
+		response.InternalServerError(c, "유지보수 티켓 이력 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size != 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessList(c, histories, pagination)
+}
+
+// toTicketResponseWithAffected는 티켓 응답에 예상 고장 기간에 걸린 예약을 채웁니다.
+func (h *MaintenanceTicketHandler) toTicketResponseWithAffected(ctx context.Context, ticket *models.MaintenanceTicket) (dto.MaintenanceTicketResponse, error) {
+	resp := mappers.ToMaintenanceTicketResponse(ticket)
+	reservations, err := h.maintenanceTicketService.GetAffectedReservations(ctx, ticket)
+	if err != nil {
+		return dto.MaintenanceTicketResponse{}, err
+	}
+	resp.AffectedReservations = mappers.ToMaintenanceAffectedReservationListResponse(reservations)
+	return resp, nil
+}
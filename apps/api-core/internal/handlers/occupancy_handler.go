@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type OccupancyHandler struct {
+	occupancyService services.OccupancyService
+}
+
+func NewOccupancyHandler(occupancyService services.OccupancyService) *OccupancyHandler {
+	return &OccupancyHandler{occupancyService: occupancyService}
+}
+
+// GetOccupancy는 기간의 객실 × 날짜 점유 현황(예약, 날짜 차단, 사용 불가 객실)을 반환합니다.
+func (h *OccupancyHandler) GetOccupancy(c *gin.Context) {
+	var query dto.OccupancyQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	req, err := query.ToOccupancyRequest()
+	if err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	grid, err := h.occupancyService.GetGrid(c.Request.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrOccupancyRangeTooLong):
+			response.BadRequest(c, "잘못된 날짜 범위", err.Error())
+		default:
+			response.InternalServerError(c, "점유 현황 조회 실패")
+		}
+		return
+	}
+
+	response.Success(c, grid)
+}
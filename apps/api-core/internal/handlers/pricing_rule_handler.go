@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type PricingRuleHandler struct {
+	service services.PricingRuleService
+}
+
+func NewPricingRuleHandler(service services.PricingRuleService) *PricingRuleHandler {
+	return &PricingRuleHandler{service: service}
+}
+
+func (h *PricingRuleHandler) ListPricingRules(c *gin.Context) {
+	rules, err := h.service.GetAll(c.Request.Context())
+	if err != nil {
+		response.InternalServerError(c, "요금 규칙 목록 조회 실패")
+		return
+	}
+
+	response.Success(c, rules)
+}
+
+func (h *PricingRuleHandler) GetPricingRule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 요금 규칙 ID")
+		return
+	}
+
+	rule, err := h.service.GetPricingRule(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrPricingRuleNotFound) {
+			response.NotFound(c, "존재하지 않는 요금 규칙")
+			return
+		}
+		response.InternalServerError(c, "요금 규칙 조회 실패")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+func (h *PricingRuleHandler) CreatePricingRule(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreatePricingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	created, err := h.service.Create(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidPricingRuleRequest) {
+			response.BadRequest(c, "잘못된 요청", err.Error())
+			return
+		}
+		response.InternalServerError(c, "요금 규칙 생성 실패")
+		return
+	}
+
+	response.Created(c, created)
+}
+
+func (h *PricingRuleHandler) UpdatePricingRule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 요금 규칙 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdatePricingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	updated, err := h.service.Update(ctx, uint(id), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPricingRuleNotFound):
+			response.NotFound(c, "존재하지 않는 요금 규칙")
+		case errors.Is(err, services.ErrInvalidPricingRuleRequest):
+			response.BadRequest(c, "잘못된 요청", err.Error())
+		default:
+			response.InternalServerError(c, "요금 규칙 수정 실패")
+		}
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+func (h *PricingRuleHandler) DeletePricingRule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 요금 규칙 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Delete(ctx, uint(id)); err != nil {
+		if errors.Is(err, services.ErrPricingRuleNotFound) {
+			response.NotFound(c, "존재하지 않는 요금 규칙")
+			return
+		}
+		response.InternalServerError(c, "요금 규칙 삭제 실패")
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type QuoteHandler struct {
+	quoteService services.QuoteService
+}
+
+func NewQuoteHandler(quoteService services.QuoteService) *QuoteHandler {
+	return &QuoteHandler{quoteService: quoteService}
+}
+
+// GetQuote는 객실과 숙박 기간에 대한 박별 요금 견적을 반환합니다.
+func (h *QuoteHandler) GetQuote(c *gin.Context) {
+	var query dto.QuoteQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	req, err := query.ToQuoteRequest()
+	if err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	quote, err := h.quoteService.Quote(c.Request.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidQuoteRequest):
+			response.BadRequest(c, "잘못된 견적 요청")
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.NotFound(c, "존재하지 않는 객실")
+		default:
+			response.InternalServerError(c, "견적 계산 실패")
+		}
+		return
+	}
+
+	response.Success(c, quote)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type RentScheduleHandler struct {
+	service services.RentScheduleService
+}
+
+func NewRentScheduleHandler(service services.RentScheduleService) *RentScheduleHandler {
+	return &RentScheduleHandler{service: service}
+}
+
+func (h *RentScheduleHandler) GetRentSchedule(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+
+	schedule, err := h.service.GetSchedule(c.Request.Context(), reservationID)
+	if err != nil {
+		respondRentScheduleError(c, err, "월세 일정 조회 실패")
+		return
+	}
+
+	response.Success(c, schedule)
+}
+
+func (h *RentScheduleHandler) PayRentCharge(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+	chargeID, ok := parseReservationPaymentParam(c, "chargeId", "잘못된 월세 청구 ID")
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.PayRentChargeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	charge, err := h.service.Pay(ctx, reservationID, chargeID, req)
+	if err != nil {
+		respondRentScheduleError(c, err, "월세 납부 처리 실패")
+		return
+	}
+
+	response.Success(c, charge)
+}
+
+func (h *RentScheduleHandler) CancelRentChargePayment(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+	chargeID, ok := parseReservationPaymentParam(c, "chargeId", "잘못된 월세 청구 ID")
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	charge, err := h.service.CancelPayment(ctx, reservationID, chargeID)
+	if err != nil {
+		respondRentScheduleError(c, err, "월세 납부 취소 실패")
+		return
+	}
+
+	response.Success(c, charge)
+}
+
+// ListOverdueRentCharges는 납부 기한이 지난 월세 청구를 오래된 순으로 조회합니다.
+func (h *RentScheduleHandler) ListOverdueRentCharges(c *gin.Context) {
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	charges, total, err := h.service.GetOverdue(c.Request.Context(), query.Page, query.Size)
+	if err != nil {
+		response.InternalServerError(c, "연체 월세 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size > 0 {
+		totalPages++
+	}
+
+	response.SuccessList(c, charges, &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	})
+}
+
+func respondRentScheduleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrReservationNotFound):
+		response.NotFound(c, "존재하지 않는 예약")
+	case errors.Is(err, services.ErrRentChargeNotFound):
+		response.NotFound(c, "존재하지 않는 월세 청구")
+	case errors.Is(err, services.ErrNotMonthlyRent),
+		errors.Is(err, services.ErrPaymentMethodNotFound),
+		errors.Is(err, services.ErrPaymentMethodInactive):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, services.ErrRentChargeAlreadyPaid),
+		errors.Is(err, services.ErrRentChargeNotPaid),
+		errors.Is(err, services.ErrReservationSettled):
+		response.Conflict(c, err.Error())
+	default:
+		response.InternalServerError(c, message)
+	}
+}
@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
 	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
 	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
 	"gitlab.bellsoft.net/rms/api-core/internal/models"
 	"gitlab.bellsoft.net/rms/api-core/internal/services"
@@ -17,14 +20,22 @@ type ReservationHandler struct {
 	reservationService services.ReservationService
 	userService        services.UserService
 	historyService     services.HistoryService
+	quoteService       services.QuoteService
 }
 
-func NewReservationHandler(reservationService services.ReservationService, userService services.UserService, historyService services.HistoryService) *ReservationHandler {
-	return &ReservationHandler{
+// NewReservationHandler는 예약 핸들러를 생성합니다.
+// quoteService를 넘기면 예약 등록 시 price를 생략한 요청의 판매 금액을 견적으로 채웁니다.
+func NewReservationHandler(reservationService services.ReservationService, userService services.UserService, historyService services.HistoryService,
+	quoteService ...services.QuoteService) *ReservationHandler {
+	handler := &ReservationHandler{
 		reservationService: reservationService,
 		userService:        userService,
 		historyService:     historyService,
 	}
+	if len(quoteService) > 0 {
+		handler.quoteService = quoteService[0]
+	}
+	return handler
 }
 
 func (h *ReservationHandler) ListReservations(c *gin.Context) {
@@ -41,10 +52,11 @@ func (h *ReservationHandler) ListReservations(c *gin.Context) {
 	}
 
 	filter := dto.ReservationRepositoryFilter{
-		RoomID:    filterQuery.RoomID,
-		StartDate: filterQuery.StayStartAt,
-		EndDate:   filterQuery.StayEndAt,
-		Search:    filterQuery.Search,
+		RoomID:     filterQuery.RoomID,
+		StartDate:  filterQuery.StayStartAt,
+		EndDate:    filterQuery.StayEndAt,
+		Search:     filterQuery.Search,
+		UnpaidOnly: filterQuery.UnpaidOnly,
 	}
 
 	if filterQuery.Status != nil {
@@ -61,6 +73,12 @@ func (h *ReservationHandler) ListReservations(c *gin.Context) {
 		case "NORMAL":
 			s := models.ReservationStatusNormal
 			filter.Status = &s
+		case "NO_SHOW":
+			s := models.ReservationStatusNoShow
+			filter.Status = &s
+		case "COMPLETED":
+			s := models.ReservationStatusCompleted
+			filter.Status = &s
 		}
 	}
 
@@ -100,10 +118,11 @@ func (h *ReservationHandler) ListReservations(c *gin.Context) {
 
 	// Filter response 생성
 	filterResponse := dto.ReservationFilterResponse{
-		Status: filterQuery.Status,
-		Type:   filterQuery.Type,
-		RoomID: filterQuery.RoomID,
-		Search: filterQuery.Search,
+		Status:     filterQuery.Status,
+		Type:       filterQuery.Type,
+		RoomID:     filterQuery.RoomID,
+		Search:     filterQuery.Search,
+		UnpaidOnly: filterQuery.UnpaidOnly,
 	}
 
 	// 날짜 필터 변환
@@ -166,7 +185,6 @@ func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 		PeopleCount:     req.PeopleCount,
 		StayStartAt:     req.StayStartAt.Time,
 		StayEndAt:       req.StayEndAt.Time,
-		Price:           req.Price,
 		Deposit:         req.Deposit,
 		PaymentAmount:   req.PaymentAmount,
 		BrokerFee:       req.BrokerFee,
@@ -202,8 +220,43 @@ func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 		reservation.Status = models.ReservationStatusPending
 	}
 
+	reservation.GuestID = req.GuestID
+
 	ctx := appContext.WithUserID(c.Request.Context(), userID)
-	if err := h.reservationService.Create(ctx, reservation, req.GetRoomIDs()); err != nil {
+	if req.ConfirmDuplicate {
+		ctx = services.WithDuplicateConfirmed(ctx)
+	}
+	roomIDs := req.GetRoomIDs()
+	if len(roomIDs) == 0 && req.RoomGroupID != nil {
+		// 객실 그룹으로 예약하면 서버가 객실을 골라 배정한다
+		selectedRoomIDs, err := h.reservationService.SelectRooms(ctx, *req.RoomGroupID, req.RoomCount, reservation.StayStartAt, reservation.StayEndAt)
+		if err != nil {
+			switch {
+			case errors.Is(err, services.ErrInvalidDateRange):
+				response.BadRequest(c, "잘못된 날짜 범위")
+			case errors.Is(err, services.ErrNoRoomToAssign):
+				response.Conflict(c, "객실 그룹에 배정할 수 있는 빈 객실이 없습니다")
+			default:
+				response.InternalServerError(c, "객실 배정 실패")
+			}
+			return
+		}
+		roomIDs = selectedRoomIDs
+		reservation.RoomGroupID = req.RoomGroupID
+	}
+
+	if req.Price != nil {
+		reservation.Price = *req.Price
+	} else if !fillPriceFromQuote(c, ctx, h.quoteService, reservation, roomIDs) {
+		return
+	}
+
+	if err := h.reservationService.Create(ctx, reservation, roomIDs); err != nil {
+		var duplicateErr *services.DuplicateReservationError
+		if errors.As(err, &duplicateErr) {
+			respondDuplicateReservation(c, duplicateErr)
+			return
+		}
 		switch {
 		case errors.Is(err, services.ErrInvalidDateRange):
 			response.BadRequest(c, "잘못된 날짜 범위")
@@ -215,6 +268,12 @@ func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 			response.BadRequest(c, "차단된 날짜 범위에는 예약할 수 없습니다")
 		case errors.Is(err, services.ErrRoomNotAvailable):
 			response.BadRequest(c, "선택한 날짜에 사용할 수 없는 객실이 있습니다")
+		case errors.Is(err, services.ErrPeopleCountExceeded):
+			response.BadRequest(c, "예약 인원이 객실 최대 인원을 초과합니다")
+		case errors.Is(err, services.ErrInvalidPhone):
+			response.BadRequest(c, "올바른 전화번호 형식이 아닙니다")
+		case errors.Is(err, services.ErrGuestNotFound):
+			response.BadRequest(c, "존재하지 않는 고객")
 		default:
 			response.InternalServerError(c, "예약 등록 실패")
 		}
@@ -229,6 +288,13 @@ func (h *ReservationHandler) CreateReservation(c *gin.Context) {
 	}
 
 	reservationResponse := h.toReservationResponse(ctx, createdReservation)
+	reservationResponse.Warnings = append(reservationResponse.Warnings, occupancyWarnings(createdReservation)...)
+	if req.ConfirmDuplicate {
+		// 중복을 확인하고 만든 예약도 어떤 예약과 겹치는지 경고로 남긴다
+		if duplicates, err := h.reservationService.FindDuplicates(ctx, createdReservation); err == nil {
+			reservationResponse.Warnings = append(reservationResponse.Warnings, duplicateReservationMessages(duplicates)...)
+		}
+	}
 	response.Created(c, reservationResponse)
 }
 
@@ -302,6 +368,10 @@ func (h *ReservationHandler) UpdateReservation(c *gin.Context) {
 			updates["status"] = models.ReservationStatusPending
 		case "NORMAL":
 			updates["status"] = models.ReservationStatusNormal
+		case "NO_SHOW":
+			updates["status"] = models.ReservationStatusNoShow
+		case "COMPLETED":
+			updates["status"] = models.ReservationStatusCompleted
 		}
 	}
 	if req.Type != nil {
@@ -332,6 +402,21 @@ func (h *ReservationHandler) UpdateReservation(c *gin.Context) {
 			response.BadRequest(c, "차단된 날짜 범위에는 예약할 수 없습니다")
 		case errors.Is(err, services.ErrRoomNotAvailable):
 			response.BadRequest(c, "선택한 날짜에 사용할 수 없는 객실이 있습니다")
+		case errors.Is(err, services.ErrPeopleCountExceeded):
+			response.BadRequest(c, "예약 인원이 객실 최대 인원을 초과합니다")
+		case errors.Is(err, services.ErrInvalidPhone):
+			response.BadRequest(c, "올바른 전화번호 형식이 아닙니다")
+		case errors.Is(err, services.ErrRoomMoveOutOfStay):
+			response.BadRequest(c, err.Error())
+		case errors.Is(err, models.ErrInvalidStatusTransition),
+			errors.Is(err, services.ErrReservationSettled):
+			response.Conflict(c, err.Error())
+		case errors.Is(err, models.ErrRefundAmountRequired),
+			errors.Is(err, models.ErrNoShowAfterCheckIn),
+			errors.Is(err, models.ErrNoShowBeforeStay),
+			errors.Is(err, models.ErrCompleteBeforeCheckIn),
+			errors.Is(err, services.ErrPaymentAmountDecrease):
+			response.BadRequest(c, err.Error())
 		default:
 			response.InternalServerError(c, "예약 수정 실패")
 		}
@@ -339,6 +424,7 @@ func (h *ReservationHandler) UpdateReservation(c *gin.Context) {
 	}
 
 	reservationResponse := h.toReservationResponseWithDetails(c.Request.Context(), reservation)
+	reservationResponse.Warnings = append(reservationResponse.Warnings, occupancyWarnings(reservation)...)
 	response.Success(c, reservationResponse)
 }
 
@@ -363,6 +449,10 @@ func (h *ReservationHandler) DeleteReservation(c *gin.Context) {
 			response.NotFound(c, "존재하지 않는 예약")
 			return
 		}
+		if errors.Is(err, services.ErrReservationSettled) {
+			response.Conflict(c, err.Error())
+			return
+		}
 		response.InternalServerError(c, "예약 삭제 실패")
 		return
 	}
@@ -370,6 +460,227 @@ func (h *ReservationHandler) DeleteReservation(c *gin.Context) {
 	response.NoContent(c)
 }
 
+func (h *ReservationHandler) CheckInReservation(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 예약 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	reservation, err := h.reservationService.CheckIn(ctx, uint(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReservationNotFound):
+			response.NotFound(c, "존재하지 않는 예약")
+		case errors.Is(err, services.ErrCheckInBeforeStay):
+			response.BadRequest(c, "숙박 시작일 이전에는 체크인할 수 없습니다")
+		case errors.Is(err, services.ErrCheckInCanceled):
+			response.BadRequest(c, "취소되었거나 종료된 예약은 체크인할 수 없습니다")
+		case errors.Is(err, services.ErrAlreadyCheckedIn):
+			response.BadRequest(c, "이미 체크인한 예약입니다")
+		case errors.Is(err, models.ErrInvalidStatusTransition):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, "체크인 처리 실패")
+		}
+		return
+	}
+
+	// 청소가 끝나지 않은 객실이 있어도 체크인은 막지 않고 경고만 돌려준다. 숙박 중 옮길 객실은 첫날 객실이 아니므로 보지 않는다
+	resp := h.toReservationResponse(ctx, reservation)
+	for _, rr := range reservation.Rooms {
+		if startAt, _ := rr.StayPeriod(reservation); startAt.After(reservation.StayStartAt) {
+			continue
+		}
+		if rr.Room != nil && !rr.Room.IsReadyForCheckIn() {
+			resp.Warnings = append(resp.Warnings,
+				fmt.Sprintf("%s호 객실 청소 상태가 %s입니다", rr.Room.Number, rr.Room.HousekeepingStatus.String()))
+		}
+	}
+	response.Success(c, resp)
+}
+
+func (h *ReservationHandler) CheckOutReservation(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 예약 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	reservation, err := h.reservationService.CheckOut(ctx, uint(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReservationNotFound):
+			response.NotFound(c, "존재하지 않는 예약")
+		case errors.Is(err, services.ErrNotCheckedIn):
+			response.BadRequest(c, "체크인하지 않은 예약은 체크아웃할 수 없습니다")
+		case errors.Is(err, services.ErrAlreadyCheckedOut):
+			response.BadRequest(c, "이미 체크아웃한 예약입니다")
+		case errors.Is(err, services.ErrUnpaidAmountRemaining):
+			response.BadRequest(c, "미수금이 남아 있어 체크아웃할 수 없습니다")
+		case errors.Is(err, models.ErrInvalidStatusTransition):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, "체크아웃 처리 실패")
+		}
+		return
+	}
+
+	response.Success(c, h.toReservationResponse(ctx, reservation))
+}
+
+// MoveReservationRoom은 숙박 중 객실을 옮깁니다. 옮기는 날짜를 기준으로 객실 사용 구간이 나뉩니다.
+func (h *ReservationHandler) MoveReservationRoom(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 예약 ID")
+		return
+	}
+
+	var req dto.MoveReservationRoomRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	moveDate, err := time.Parse("2006-01-02", req.MoveDate)
+	if err != nil {
+		response.BadRequest(c, "잘못된 날짜 형식 (YYYY-MM-DD)")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	reservation, err := h.reservationService.MoveRoom(ctx, uint(id), req.FromRoomID, req.ToRoomID, moveDate)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReservationNotFound):
+			response.NotFound(c, "존재하지 않는 예약")
+		case errors.Is(err, services.ErrRoomMoveInactive):
+			response.BadRequest(c, "취소되었거나 종료된 예약은 객실을 옮길 수 없습니다")
+		case errors.Is(err, models.ErrRoomMoveOutOfStay):
+			response.BadRequest(c, "옮길 날짜에 사용 중인 객실이 아닙니다")
+		case errors.Is(err, models.ErrRoomMoveToSameRoom):
+			response.BadRequest(c, "같은 객실로는 옮길 수 없습니다")
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.BadRequest(c, "존재하지 않는 객실")
+		case errors.Is(err, services.ErrRoomNotAvailable):
+			response.BadRequest(c, "옮길 객실이 해당 기간에 사용 중입니다")
+		case errors.Is(err, services.ErrDateRangeBlocked):
+			response.BadRequest(c, "옮길 객실이 해당 기간에 예약이 차단되어 있습니다")
+		case errors.Is(err, services.ErrPeopleCountExceeded):
+			response.BadRequest(c, "예약 인원이 객실 최대 인원을 초과합니다")
+		default:
+			response.InternalServerError(c, "객실 이동 실패")
+		}
+		return
+	}
+
+	response.Success(c, h.toReservationResponseWithDetails(ctx, reservation))
+}
+
+// GetReceivables는 미수금이 남은 예약 목록을 조회합니다.
+func (h *ReservationHandler) GetReceivables(c *gin.Context) {
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	var receivableQuery dto.ReceivableQuery
+	if err := c.ShouldBindQuery(&receivableQuery); err != nil {
+		response.BadRequest(c, "잘못된 필터 파라미터", err.Error())
+		return
+	}
+
+	if receivableQuery.StayStartAt != nil && receivableQuery.StayEndAt != nil && receivableQuery.StayStartAt.After(*receivableQuery.StayEndAt) {
+		response.BadRequest(c, "잘못된 날짜 범위", "시작일은 종료일보다 이전이거나 같아야 합니다")
+		return
+	}
+
+	filter := dto.ReceivableFilter{
+		PaymentMethodID: receivableQuery.PaymentMethodID,
+		RoomGroupID:     receivableQuery.RoomGroupID,
+		StartDate:       receivableQuery.StayStartAt,
+		EndDate:         receivableQuery.StayEndAt,
+	}
+
+	reservations, total, err := h.reservationService.GetReceivables(c.Request.Context(), filter, query.Page, query.Size, query.Sort)
+	if err != nil {
+		response.InternalServerError(c, "미수금 현황 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size > 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	filterResponse := dto.ReceivableFilterResponse{
+		PaymentMethodID: receivableQuery.PaymentMethodID,
+		RoomGroupID:     receivableQuery.RoomGroupID,
+	}
+	if receivableQuery.StayStartAt != nil {
+		filterResponse.StayStartAt = &dto.JSONDate{Time: *receivableQuery.StayStartAt}
+	}
+	if receivableQuery.StayEndAt != nil {
+		filterResponse.StayEndAt = &dto.JSONDate{Time: *receivableQuery.StayEndAt}
+	}
+
+	response.SuccessListWithFilter(c, mappers.ToReceivableListResponse(reservations, time.Now()), pagination, filterResponse)
+}
+
+// GetDuplicateReport는 기간 안에서 같은 예약을 두 번 입력한 것으로 의심되는 예약 쌍을 조회합니다.
+func (h *ReservationHandler) GetDuplicateReport(c *gin.Context) {
+	var query dto.DuplicateReservationReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	if query.StartDate.After(query.EndDate) {
+		response.BadRequest(c, "잘못된 날짜 범위", "시작일은 종료일보다 이전이거나 같아야 합니다")
+		return
+	}
+
+	pairs, err := h.reservationService.GetDuplicateReport(c.Request.Context(), query.StartDate, query.EndDate.AddDate(0, 0, 1))
+	if err != nil {
+		response.InternalServerError(c, "중복 의심 예약 조회 실패")
+		return
+	}
+
+	response.Success(c, pairs)
+}
+
 func (h *ReservationHandler) GetReservationStatistics(c *gin.Context) {
 	var query dto.ReservationStatisticsQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+func TestReservationHandler_CreateReservation_Duplicate(t *testing.T) {
+	duplicates := []dto.DuplicateReservationResponse{{
+		ReservationID: 3,
+		Name:          "홍길동",
+		StayStartAt:   dto.JSONDate{Time: time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC)},
+		StayEndAt:     dto.JSONDate{Time: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)},
+		MatchedBy:     []string{"NAME"},
+	}}
+
+	t.Run("중복 의심 예약이 있으면 409와 중복 목록을 반환한다", func(t *testing.T) {
+		// Given
+		mockReservationService := new(MockReservationService)
+		router := setupReservationQuoteRouter(mockReservationService, new(MockQuoteService))
+		mockReservationService.On("Create", mock.Anything, mock.Anything, []uint{101}).
+			Return(&services.DuplicateReservationError{Duplicates: duplicates})
+
+		// When
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations",
+			strings.NewReader(strings.Replace(createReservationBody, "%s", `,"price":180000`, 1)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Then
+		assert.Equal(t, http.StatusConflict, w.Code)
+		var body dto.DuplicateReservationErrorResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, services.ErrDuplicateReservation.Error(), body.Message)
+		assert.Equal(t, []string{"중복 의심: 예약 #3 홍길동 (2026-07-31 ~ 2026-08-01)"}, body.Errors)
+		if assert.Len(t, body.Duplicates, 1) {
+			assert.Equal(t, uint(3), body.Duplicates[0].ReservationID)
+		}
+	})
+
+	t.Run("중복을 확인하면 예약을 만들고 경고를 담는다", func(t *testing.T) {
+		// Given
+		mockReservationService := new(MockReservationService)
+		router := setupReservationQuoteRouter(mockReservationService, new(MockQuoteService))
+
+		created := &models.Reservation{Name: "홍길동", Price: 180000}
+		created.ID = 7
+		mockReservationService.On("Create", mock.Anything, mock.Anything, []uint{101}).Return(nil)
+		mockReservationService.On("GetByIDWithDetails", mock.Anything, mock.Anything).Return(created, nil)
+		mockReservationService.On("FindDuplicates", mock.Anything, created).Return(duplicates, nil)
+
+		// When
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations",
+			strings.NewReader(strings.Replace(createReservationBody, "%s", `,"price":180000,"confirmDuplicate":true`, 1)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Then
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var body struct {
+			Value dto.ReservationResponse `json:"value"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Contains(t, body.Value.Warnings, "중복 의심: 예약 #3 홍길동 (2026-07-31 ~ 2026-08-01)")
+		mockReservationService.AssertExpectations(t)
+	})
+}
@@ -2,34 +2,46 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
 
 	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
 	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
 	"gitlab.bellsoft.net/rms/api-core/internal/utils"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
 	pkgutils "gitlab.bellsoft.net/rms/api-core/pkg/utils"
 )
 
 // toReservationResponse converts a Reservation model to ReservationResponse DTO
 func (h *ReservationHandler) toReservationResponse(ctx context.Context, reservation *models.Reservation) dto.ReservationResponse {
 	resp := dto.ReservationResponse{
-		ID:              reservation.ID,
-		PaymentMethodID: reservation.PaymentMethodID,
-		Name:            reservation.Name,
-		Phone:           reservation.Phone,
-		PeopleCount:     reservation.PeopleCount,
-		StayStartAt:     dto.JSONDate{Time: reservation.StayStartAt},
-		StayEndAt:       dto.JSONDate{Time: reservation.StayEndAt},
-		Price:           reservation.Price,
-		Deposit:         reservation.Deposit,
-		PaymentAmount:   reservation.PaymentAmount,
-		RefundAmount:    reservation.RefundAmount,
-		BrokerFee:       reservation.BrokerFee,
-		Note:            reservation.Note,
-		Status:          reservation.Status.String(),
-		Type:            reservation.Type.String(),
-		CreatedAt:       dto.CustomTime{Time: reservation.CreatedAt},
-		UpdatedAt:       dto.CustomTime{Time: reservation.UpdatedAt},
-		Rooms:           []dto.RoomResponse{}, // 빈 배열로 초기화
+		ID:                  reservation.ID,
+		PaymentMethodID:     reservation.PaymentMethodID,
+		Name:                reservation.Name,
+		Phone:               reservation.Phone,
+		PeopleCount:         reservation.PeopleCount,
+		StayStartAt:         dto.JSONDate{Time: reservation.StayStartAt},
+		StayEndAt:           dto.JSONDate{Time: reservation.StayEndAt},
+		Price:               reservation.Price,
+		Deposit:             reservation.Deposit,
+		PaymentAmount:       reservation.PaymentAmount,
+		RefundAmount:        reservation.RefundAmount,
+		BrokerFee:           reservation.BrokerFee,
+		Note:                reservation.Note,
+		Status:              reservation.Status.String(),
+		Type:                reservation.Type.String(),
+		CreatedAt:           dto.CustomTime{Time: reservation.CreatedAt},
+		UpdatedAt:           dto.CustomTime{Time: reservation.UpdatedAt},
+		Rooms:               []dto.RoomResponse{}, // 빈 배열로 초기화
+		AppliedPricingRules: mappers.ToAppliedPricingRuleResponses(reservation.AppliedPricingRules),
+		RoomGroupID:         reservation.RoomGroupID,
+		GuestID:             reservation.GuestID,
 	}
 
 	// CheckInAt, CheckOutAt, CanceledAt 설정
@@ -75,6 +87,8 @@ func (h *ReservationHandler) toReservationResponse(ctx context.Context, reservat
 					UpdatedAt:   dto.CustomTime{Time: rr.Room.UpdatedAt},
 					CreatedBy:   h.getUserSummary(ctx, rr.Room.CreatedBy),
 					UpdatedBy:   h.getUserSummary(ctx, rr.Room.UpdatedBy),
+
+					HousekeepingStatus: rr.Room.HousekeepingStatus.String(),
 				}
 
 				if rr.Room.RoomGroup != nil {
@@ -92,15 +106,49 @@ func (h *ReservationHandler) toReservationResponse(ctx context.Context, reservat
 		}
 	}
 
+	if reservation.HasRoomMoves() {
+		resp.RoomStays = toReservationRoomStayResponses(reservation)
+	}
+
 	return resp
 }
 
+// toReservationRoomStayResponses는 객실별 사용 구간을 시작일 순으로 변환합니다.
+func toReservationRoomStayResponses(reservation *models.Reservation) []dto.ReservationRoomStayResponse {
+	stays := make([]dto.ReservationRoomStayResponse, len(reservation.Rooms))
+	for i := range reservation.Rooms {
+		rr := &reservation.Rooms[i]
+		start, end := rr.StayPeriod(reservation)
+		stays[i] = dto.ReservationRoomStayResponse{
+			RoomID:      rr.RoomID,
+			StayStartAt: dto.JSONDate{Time: start},
+			StayEndAt:   dto.JSONDate{Time: end},
+		}
+		if rr.Room != nil {
+			stays[i].RoomNumber = rr.Room.Number
+		}
+	}
+	sort.SliceStable(stays, func(i, j int) bool {
+		return stays[i].StayStartAt.Before(stays[j].StayStartAt.Time)
+	})
+	return stays
+}
+
 // toReservationResponseWithDetails converts a Reservation model with details to ReservationResponse DTO
 func (h *ReservationHandler) toReservationResponseWithDetails(ctx context.Context, reservation *models.Reservation) dto.ReservationResponse {
 	// 기본 응답 생성은 toReservationResponse와 동일
 	return h.toReservationResponse(ctx, reservation)
 }
 
+// occupancyWarnings는 예약 인원이 객실 기준 인원 합계를 넘으면 경고를 돌려줍니다. 최대 인원 초과는 서비스에서 막습니다.
+func occupancyWarnings(reservation *models.Reservation) []string {
+	standard, _ := reservation.Occupancy()
+	if standard > 0 && reservation.PeopleCount > standard {
+		return []string{fmt.Sprintf("예약 인원 %d명이 객실 기준 인원 %d명을 초과합니다", reservation.PeopleCount, standard)}
+	}
+	return nil
+}
+
 // getUserSummary retrieves user summary information
 func (h *ReservationHandler) getUserSummary(ctx context.Context, userID uint) *dto.UserSummaryResponse {
 	if userID == 0 {
@@ -121,3 +169,55 @@ func (h *ReservationHandler) getUserSummary(ctx context.Context, userID uint) *d
 		ID: userID,
 	}
 }
+
+// fillPriceFromQuote는 price를 생략한 예약 등록·홀드 전환 요청의 판매 금액과 적용 규칙을 견적으로 채웁니다.
+// 응답을 이미 작성했으면 false를 반환합니다.
+func fillPriceFromQuote(c *gin.Context, ctx context.Context, quoteService services.QuoteService, reservation *models.Reservation, roomIDs []uint) bool {
+	if quoteService == nil {
+		response.BadRequest(c, "판매 금액 필수")
+		return false
+	}
+
+	price, appliedRules, err := quoteService.Price(ctx, dto.QuoteRequest{
+		RoomIDs:     roomIDs,
+		StayStartAt: reservation.StayStartAt,
+		StayEndAt:   reservation.StayEndAt,
+		PeopleCount: reservation.PeopleCount,
+		Type:        reservation.Type,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidQuoteRequest):
+			response.BadRequest(c, "판매 금액을 계산할 객실 정보가 없습니다")
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.BadRequest(c, "존재하지 않는 객실")
+		default:
+			response.InternalServerError(c, "판매 금액 계산 실패")
+		}
+		return false
+	}
+
+	reservation.Price = price
+	reservation.AppliedPricingRules = appliedRules
+	return true
+}
+
+// duplicateReservationMessages는 중복 의심 예약을 "예약 #1 홍길동 (2024-03-20 ~ 2024-03-22)" 형식으로 보여 줍니다.
+func duplicateReservationMessages(duplicates []dto.DuplicateReservationResponse) []string {
+	messages := make([]string, len(duplicates))
+	for i, duplicate := range duplicates {
+		messages[i] = fmt.Sprintf("중복 의심: 예약 #%d %s (%s ~ %s)", duplicate.ReservationID, duplicate.Name,
+			duplicate.StayStartAt.Format("2006-01-02"), duplicate.StayEndAt.Format("2006-01-02"))
+	}
+	return messages
+}
+
+func respondDuplicateReservation(c *gin.Context, err *services.DuplicateReservationError) {
+	c.JSON(http.StatusConflict, dto.DuplicateReservationErrorResponse{
+		Message:    services.ErrDuplicateReservation.Error(),
+		Errors:     duplicateReservationMessages(err.Duplicates),
+		Duplicates: err.Duplicates,
+	})
+}
@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockQuoteService struct {
+	mock.Mock
+}
+
+func (m *MockQuoteService) Quote(ctx context.Context, req dto.QuoteRequest) (*dto.QuoteResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.QuoteResponse), args.Error(1)
+}
+
+func (m *MockQuoteService) Price(ctx context.Context, req dto.QuoteRequest) (int, models.AppliedPricingRules, error) {
+	args := m.Called(ctx, req)
+	if args.Get(1) == nil {
+		return args.Int(0), nil, args.Error(2)
+	}
+	return args.Int(0), args.Get(1).(models.AppliedPricingRules), args.Error(2)
+}
+
+const createReservationBody = `{"paymentMethodId":1,"rooms":[{"id":101}],"name":"홍길동","peopleCount":2,` +
+	`"stayStartAt":"2026-07-31","stayEndAt":"2026-08-02"%s}`
+
+func setupReservationQuoteRouter(mockReservationService *MockReservationService, mockQuoteService *MockQuoteService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	mockUserService := new(MockUserService)
+	mockUserService.On("GetByID", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+	handler := NewReservationHandler(mockReservationService, mockUserService, new(MockHistoryService), mockQuoteService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, uint(1))
+		c.Next()
+	})
+	router.POST("/api/v1/reservations", handler.CreateReservation)
+	return router
+}
+
+func TestReservationHandler_CreateReservation_PriceFromQuote(t *testing.T) {
+	t.Run("판매 금액을 생략하면 견적 금액과 적용 규칙을 저장한다", func(t *testing.T) {
+		// Given
+		mockReservationService := new(MockReservationService)
+		mockQuoteService := new(MockQuoteService)
+		router := setupReservationQuoteRouter(mockReservationService, mockQuoteService)
+
+		appliedRules := models.AppliedPricingRules{
+			{RuleID: 1, Type: "WEEKEND_SURCHARGE", Name: "주말 할증", RoomID: 101, Nights: 2, Amount: 40000},
+		}
+		mockQuoteService.On("Price", mock.Anything, mock.MatchedBy(func(req dto.QuoteRequest) bool {
+			return len(req.RoomIDs) == 1 && req.RoomIDs[0] == 101 && req.PeopleCount == 2 &&
+				req.Type == models.ReservationTypeStay
+		})).Return(240000, appliedRules, nil)
+
+		created := &models.Reservation{Price: 240000, AppliedPricingRules: appliedRules}
+		created.ID = 7
+		mockReservationService.On("Create", mock.Anything, mock.MatchedBy(func(r *models.Reservation) bool {
+			return r.Price == 240000 && len(r.AppliedPricingRules) == 1
+		}), []uint{101}).Return(nil)
+		mockReservationService.On("GetByIDWithDetails", mock.Anything, mock.Anything).Return(created, nil)
+
+		// When
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations",
+			strings.NewReader(strings.Replace(createReservationBody, "%s", "", 1)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Then
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var body struct {
+			Value dto.ReservationResponse `json:"value"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, 240000, body.Value.Price)
+		assert.Len(t, body.Value.AppliedPricingRules, 1)
+		mockReservationService.AssertExpectations(t)
+	})
+
+	t.Run("판매 금액을 보내면 견적을 계산하지 않는다", func(t *testing.T) {
+		mockReservationService := new(MockReservationService)
+		mockQuoteService := new(MockQuoteService)
+		router := setupReservationQuoteRouter(mockReservationService, mockQuoteService)
+
+		created := &models.Reservation{Price: 180000}
+		created.ID = 7
+		mockReservationService.On("Create", mock.Anything, mock.MatchedBy(func(r *models.Reservation) bool {
+			return r.Price == 180000 && r.AppliedPricingRules == nil
+		}), []uint{101}).Return(nil)
+		mockReservationService.On("GetByIDWithDetails", mock.Anything, mock.Anything).Return(created, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations",
+			strings.NewReader(strings.Replace(createReservationBody, "%s", `,"price":180000`, 1)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockQuoteService.AssertNotCalled(t, "Price", mock.Anything, mock.Anything)
+	})
+
+	t.Run("견적 대상 객실이 없으면 400", func(t *testing.T) {
+		mockReservationService := new(MockReservationService)
+		mockQuoteService := new(MockQuoteService)
+		router := setupReservationQuoteRouter(mockReservationService, mockQuoteService)
+		mockQuoteService.On("Price", mock.Anything, mock.Anything).Return(0, nil, services.ErrRoomNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations",
+			strings.NewReader(strings.Replace(createReservationBody, "%s", "", 1)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockReservationService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+const createGroupReservationBody = `{"paymentMethodId":1,"roomGroupId":3,"roomCount":2,"name":"홍길동","peopleCount":4,` +
+	`"stayStartAt":"2026-07-31","stayEndAt":"2026-08-02","price":300000}`
+
+func TestReservationHandler_CreateReservation_ByRoomGroup(t *testing.T) {
+	t.Run("객실 그룹으로 예약하면 서버가 고른 객실로 생성한다", func(t *testing.T) {
+		// Given
+		mockReservationService := new(MockReservationService)
+		router := setupReservationQuoteRouter(mockReservationService, new(MockQuoteService))
+
+		mockReservationService.On("SelectRooms", mock.Anything, uint(3), 2, mock.Anything, mock.Anything).Return([]uint{11, 12}, nil)
+		created := &models.Reservation{Price: 300000}
+		created.ID = 7
+		mockReservationService.On("Create", mock.Anything, mock.MatchedBy(func(r *models.Reservation) bool {
+			return r.RoomGroupID != nil && *r.RoomGroupID == 3
+		}), []uint{11, 12}).Return(nil)
+		mockReservationService.On("GetByIDWithDetails", mock.Anything, mock.Anything).Return(created, nil)
+
+		// When
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations", strings.NewReader(createGroupReservationBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Then
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockReservationService.AssertExpectations(t)
+	})
+
+	t.Run("배정할 빈 객실이 없으면 409", func(t *testing.T) {
+		mockReservationService := new(MockReservationService)
+		router := setupReservationQuoteRouter(mockReservationService, new(MockQuoteService))
+		mockReservationService.On("SelectRooms", mock.Anything, uint(3), 2, mock.Anything, mock.Anything).Return(nil, services.ErrNoRoomToAssign)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations", strings.NewReader(createGroupReservationBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockReservationService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuoteHandler_GetQuote(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{
+			name:           "쉼표로 구분한 객실 ID로 견적을 조회한다",
+			query:          "roomIds=1,2&stayStartAt=2026-07-30&stayEndAt=2026-08-02&peopleCount=3",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "날짜 형식이 잘못되면 400",
+			query:          "roomIds=1&stayStartAt=2026/07/30&stayEndAt=2026-08-02",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "존재하지 않는 객실이면 404",
+			query:          "roomIds=99&stayStartAt=2026-07-30&stayEndAt=2026-08-02",
+			serviceErr:     services.ErrRoomNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			mockQuoteService := new(MockQuoteService)
+			router := gin.New()
+			router.GET("/api/v1/quotes", NewQuoteHandler(mockQuoteService).GetQuote)
+
+			if tt.serviceErr != nil {
+				mockQuoteService.On("Quote", mock.Anything, mock.Anything).Return(nil, tt.serviceErr)
+			} else {
+				mockQuoteService.On("Quote", mock.Anything, mock.MatchedBy(func(req dto.QuoteRequest) bool {
+					return len(req.RoomIDs) == 2 && req.PeopleCount == 3
+				})).Return(&dto.QuoteResponse{TotalPrice: 340000}, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/quotes?"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
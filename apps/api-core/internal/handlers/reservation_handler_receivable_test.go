@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func setupReceivableRouter(mockReservationService *MockReservationService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewReservationHandler(mockReservationService, new(MockUserService), new(MockHistoryService))
+
+	router := gin.New()
+	router.GET("/api/v1/reservation-receivables", handler.GetReceivables)
+	return router
+}
+
+func TestReservationHandler_GetReceivables(t *testing.T) {
+	t.Run("필터와 정렬을 전달하고 미수금을 계산해 응답한다", func(t *testing.T) {
+		// Given - 결제 수단 1번, 객실 그룹 2번으로 미수금 큰 순 조회를 요청하면
+		mockReservationService := new(MockReservationService)
+		router := setupReceivableRouter(mockReservationService)
+
+		reservation := models.Reservation{
+			Name:          "홍길동",
+			Price:         300000,
+			Deposit:       50000,
+			PaymentAmount: 150000,
+			Status:        models.ReservationStatusCompleted,
+			StayStartAt:   time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC),
+			StayEndAt:     time.Date(2026, 7, 12, 0, 0, 0, 0, time.UTC),
+			PaymentMethod: &models.PaymentMethod{Name: "현장 결제", RequireUnpaidAmountCheck: true},
+			Rooms:         []models.ReservationRoom{{RoomID: 101, Room: &models.Room{Number: "101호"}}},
+		}
+		reservation.ID = 7
+		mockReservationService.On("GetReceivables", mock.Anything, mock.MatchedBy(func(filter dto.ReceivableFilter) bool {
+			return filter.PaymentMethodID != nil && *filter.PaymentMethodID == 1 &&
+				filter.RoomGroupID != nil && *filter.RoomGroupID == 2 &&
+				filter.StartDate != nil && filter.StartDate.Format("2006-01-02") == "2026-07-01"
+		}), 0, 20, "unpaidAmount,desc").Return([]models.Reservation{reservation}, int64(1), nil)
+
+		// When
+		req := httptest.NewRequest(http.MethodGet,
+			"/api/v1/reservation-receivables?paymentMethodId=1&roomGroupId=2&stayStartAt=2026-07-01&sort=unpaidAmount,desc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Then - 미수금 100000원과 객실 번호가 응답된다
+		assert.Equal(t, http.StatusOK, w.Code)
+		var body struct {
+			Values []dto.ReceivableResponse `json:"values"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Len(t, body.Values, 1)
+		assert.Equal(t, 100000, body.Values[0].UnpaidAmount)
+		assert.Equal(t, []string{"101호"}, body.Values[0].RoomNumbers)
+		assert.True(t, body.Values[0].RequireUnpaidAmountCheck)
+		mockReservationService.AssertExpectations(t)
+	})
+
+	t.Run("시작일이 종료일보다 늦으면 400", func(t *testing.T) {
+		mockReservationService := new(MockReservationService)
+		router := setupReceivableRouter(mockReservationService)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/reservation-receivables?stayStartAt=2026-07-10&stayEndAt=2026-07-01", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockReservationService.AssertNotCalled(t, "GetReceivables", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("조회에 실패하면 500", func(t *testing.T) {
+		mockReservationService := new(MockReservationService)
+		router := setupReceivableRouter(mockReservationService)
+		mockReservationService.On("GetReceivables", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, int64(0), errors.New("db error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/reservation-receivables", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
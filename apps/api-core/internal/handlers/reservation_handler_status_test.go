@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+func setupReservationStatusRouter(mockReservationService *MockReservationService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewReservationHandler(mockReservationService, new(MockUserService), new(MockHistoryService))
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, uint(1))
+		c.Next()
+	})
+	router.PATCH("/api/v1/reservations/:id", handler.UpdateReservation)
+	router.POST("/api/v1/reservations/:id/check-in", handler.CheckInReservation)
+	router.POST("/api/v1/reservations/:id/check-out", handler.CheckOutReservation)
+	return router
+}
+
+func TestReservationHandler_UpdateReservation_StatusTransition(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{
+			name:           "허용되지 않는 상태 변경은 409",
+			body:           `{"status":"PENDING"}`,
+			serviceErr:     &models.StatusTransitionError{From: models.ReservationStatusRefund, To: models.ReservationStatusPending},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "환불 금액 없이 환불 처리하면 400",
+			body:           `{"status":"REFUND"}`,
+			serviceErr:     models.ErrRefundAmountRequired,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "새 상태 값도 요청할 수 있다",
+			body:           `{"status":"NO_SHOW"}`,
+			serviceErr:     nil,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "알 수 없는 상태 값은 400",
+			body:           `{"status":"UNKNOWN"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReservationService := new(MockReservationService)
+			router := setupReservationStatusRouter(mockReservationService)
+
+			if tt.serviceErr != nil {
+				mockReservationService.On("Update", mock.Anything, uint(1), mock.Anything, mock.Anything, false).
+					Return(nil, tt.serviceErr)
+			} else {
+				reservation := &models.Reservation{Status: models.ReservationStatusNoShow}
+				reservation.ID = 1
+				mockReservationService.On("Update", mock.Anything, uint(1),
+					map[string]interface{}{"status": models.ReservationStatusNoShow}, mock.Anything, false).
+					Return(reservation, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/reservations/1", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestReservationHandler_UpdateReservation_StayPeriod(t *testing.T) {
+	tests := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"숙박 중 객실 이동 구간을 벗어나게 기간을 줄이면 400", services.ErrRoomMoveOutOfStay, http.StatusBadRequest},
+		{"늘어난 기간에 객실이 차 있으면 400", services.ErrRoomNotAvailable, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReservationService := new(MockReservationService)
+			router := setupReservationStatusRouter(mockReservationService)
+			mockReservationService.On("Update", mock.Anything, uint(1), mock.Anything, mock.Anything, false).
+				Return(nil, tt.serviceErr)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/reservations/1", strings.NewReader(`{"stayEndAt":"2026-07-03T00:00:00Z"}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockReservationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReservationHandler_CheckInOut(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		method         string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"체크인 성공", "/api/v1/reservations/1/check-in", "CheckIn", nil, http.StatusOK},
+		{"숙박 시작일 이전 체크인은 400", "/api/v1/reservations/1/check-in", "CheckIn", services.ErrCheckInBeforeStay, http.StatusBadRequest},
+		{"없는 예약 체크인은 404", "/api/v1/reservations/1/check-in", "CheckIn", services.ErrReservationNotFound, http.StatusNotFound},
+		{"체크아웃 성공", "/api/v1/reservations/1/check-out", "CheckOut", nil, http.StatusOK},
+		{"미수금이 남은 체크아웃은 400", "/api/v1/reservations/1/check-out", "CheckOut", services.ErrUnpaidAmountRemaining, http.StatusBadRequest},
+		{
+			"이용 완료로 변경할 수 없는 상태의 체크아웃은 409", "/api/v1/reservations/1/check-out", "CheckOut",
+			&models.StatusTransitionError{From: models.ReservationStatusPending, To: models.ReservationStatusCompleted},
+			http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReservationService := new(MockReservationService)
+			router := setupReservationStatusRouter(mockReservationService)
+
+			if tt.serviceErr != nil {
+				mockReservationService.On(tt.method, mock.Anything, uint(1)).Return(nil, tt.serviceErr)
+			} else {
+				reservation := &models.Reservation{Status: models.ReservationStatusNormal}
+				reservation.ID = 1
+				mockReservationService.On(tt.method, mock.Anything, uint(1)).Return(reservation, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockReservationService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReservationHandler_CheckInReservation_HousekeepingWarning(t *testing.T) {
+	tests := []struct {
+		name               string
+		housekeepingStatus models.HousekeepingStatus
+		expectedWarnings   []string
+	}{
+		{"청소가 끝난 객실이면 경고가 없다", models.HousekeepingStatusClean, nil},
+		{"점검까지 끝난 객실이면 경고가 없다", models.HousekeepingStatusInspected, nil},
+		{"청소 전 객실이면 경고와 함께 체크인한다", models.HousekeepingStatusDirty, []string{"101호 객실 청소 상태가 DIRTY입니다"}},
+		{"청소 중인 객실이면 경고와 함께 체크인한다", models.HousekeepingStatusCleaning, []string{"101호 객실 청소 상태가 CLEANING입니다"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockReservationService := new(MockReservationService)
+			router := setupReservationStatusRouter(mockReservationService)
+
+			room := &models.Room{Number: "101", Status: models.RoomStatusNormal, HousekeepingStatus: tt.housekeepingStatus}
+			room.ID = 10
+			reservation := &models.Reservation{
+				Status: models.ReservationStatusNormal,
+				Rooms:  []models.ReservationRoom{{RoomID: room.ID, Room: room}},
+			}
+			reservation.ID = 1
+			mockReservationService.On("CheckIn", mock.Anything, uint(1)).Return(reservation, nil)
+
+			// When
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations/1/check-in", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Then
+			assert.Equal(t, http.StatusOK, w.Code)
+			var body struct {
+				Value dto.ReservationResponse `json:"value"`
+			}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedWarnings, body.Value.Warnings)
+		})
+	}
+}
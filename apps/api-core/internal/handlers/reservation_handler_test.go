@@ -49,6 +49,14 @@ func (m *MockReservationService) GetAll(ctx context.Context, filter dto.Reservat
 	return args.Get(0).([]models.Reservation), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockReservationService) GetReceivables(ctx context.Context, filter dto.ReceivableFilter, page, size int, sort string) ([]models.Reservation, int64, error) {
+	args := m.Called(ctx, filter, page, size, sort)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Reservation), args.Get(1).(int64), args.Error(2)
+}
+
 func (m *MockReservationService) GetStatistics(ctx context.Context, startDate, endDate time.Time, periodType string) ([]repositories.ReservationStatistics, error) {
 	args := m.Called(ctx, startDate, endDate, periodType)
 	if args.Get(0) == nil {
@@ -75,6 +83,30 @@ func (m *MockReservationService) Delete(ctx context.Context, id uint) error {
 	return args.Error(0)
 }
 
+func (m *MockReservationService) CheckIn(ctx context.Context, id uint) (*models.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationService) MoveRoom(ctx context.Context, id uint, fromRoomID, toRoomID uint, moveDate time.Time) (*models.Reservation, error) {
+	args := m.Called(ctx, id, fromRoomID, toRoomID, moveDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationService) CheckOut(ctx context.Context, id uint) (*models.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
 func (m *MockReservationService) GetAvailableRooms(ctx context.Context, startDate, endDate time.Time, excludeReservationID *uint) ([]models.Room, error) {
 	args := m.Called(ctx, startDate, endDate, excludeReservationID)
 	if args.Get(0) == nil {
@@ -83,6 +115,14 @@ func (m *MockReservationService) GetAvailableRooms(ctx context.Context, startDat
 	return args.Get(0).([]models.Room), args.Error(1)
 }
 
+func (m *MockReservationService) SelectRooms(ctx context.Context, roomGroupID uint, count int, startDate, endDate time.Time) ([]uint, error) {
+	args := m.Called(ctx, roomGroupID, count, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uint), args.Error(1)
+}
+
 func (m *MockReservationService) GetLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error) {
 	args := m.Called(ctx, roomID)
 	if args.Get(0) == nil {
@@ -91,6 +131,22 @@ func (m *MockReservationService) GetLastReservationForRoom(ctx context.Context,
 	return args.Get(0).(*models.Reservation), args.Error(1)
 }
 
+func (m *MockReservationService) FindDuplicates(ctx context.Context, reservation *models.Reservation) ([]dto.DuplicateReservationResponse, error) {
+	args := m.Called(ctx, reservation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.DuplicateReservationResponse), args.Error(1)
+}
+
+func (m *MockReservationService) GetDuplicateReport(ctx context.Context, startDate, endDate time.Time) ([]dto.DuplicateReservationPairResponse, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.DuplicateReservationPairResponse), args.Error(1)
+}
+
 // MockUserService는 UserService의 모킹 구현
 type MockUserService struct {
 	mock.Mock
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type ReservationPaymentHandler struct {
+	service services.ReservationPaymentService
+}
+
+func NewReservationPaymentHandler(service services.ReservationPaymentService) *ReservationPaymentHandler {
+	return &ReservationPaymentHandler{service: service}
+}
+
+func (h *ReservationPaymentHandler) ListPayments(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+
+	payments, err := h.service.GetPayments(c.Request.Context(), reservationID)
+	if err != nil {
+		if errors.Is(err, services.ErrReservationNotFound) {
+			response.NotFound(c, "존재하지 않는 예약")
+			return
+		}
+		response.InternalServerError(c, "결제 내역 조회 실패")
+		return
+	}
+
+	response.Success(c, payments)
+}
+
+func (h *ReservationPaymentHandler) CreatePayment(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateReservationPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	created, err := h.service.Create(ctx, reservationID, req)
+	if err != nil {
+		respondReservationPaymentError(c, err, "결제 내역 등록 실패")
+		return
+	}
+
+	response.Created(c, created)
+}
+
+func (h *ReservationPaymentHandler) UpdatePayment(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+	paymentID, ok := parseReservationPaymentParam(c, "paymentId", "잘못된 결제 내역 ID")
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateReservationPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	updated, err := h.service.Update(ctx, reservationID, paymentID, req)
+	if err != nil {
+		respondReservationPaymentError(c, err, "결제 내역 수정 실패")
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+func (h *ReservationPaymentHandler) DeletePayment(c *gin.Context) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+	paymentID, ok := parseReservationPaymentParam(c, "paymentId", "잘못된 결제 내역 ID")
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Delete(ctx, reservationID, paymentID); err != nil {
+		respondReservationPaymentError(c, err, "결제 내역 삭제 실패")
+		return
+	}
+
+	response.NoContent(c)
+}
+
+func parseReservationPaymentParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil {
+		response.BadRequest(c, message)
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func respondReservationPaymentError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrReservationNotFound):
+		response.NotFound(c, "존재하지 않는 예약")
+	case errors.Is(err, services.ErrReservationPaymentNotFound):
+		response.NotFound(c, "존재하지 않는 결제 내역")
+	case errors.Is(err, services.ErrPaymentMethodNotFound):
+		response.BadRequest(c, "존재하지 않는 결제 수단")
+	case errors.Is(err, services.ErrPaymentMethodInactive):
+		response.BadRequest(c, "비활성화된 결제 수단")
+	case errors.Is(err, services.ErrInvalidReservationPaymentRequest),
+		errors.Is(err, services.ErrRefundExceedsPaidAmount):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, services.ErrReservationPaymentSettled),
+		errors.Is(err, services.ErrPaymentInSettledPeriod):
+		response.Conflict(c, err.Error())
+	default:
+		response.InternalServerError(c, message)
+	}
+}
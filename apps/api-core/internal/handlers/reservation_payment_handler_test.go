@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockReservationPaymentService struct {
+	mock.Mock
+}
+
+func (m *MockReservationPaymentService) GetPayments(ctx context.Context, reservationID uint) ([]dto.ReservationPaymentResponse, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.ReservationPaymentResponse), args.Error(1)
+}
+
+func (m *MockReservationPaymentService) Create(ctx context.Context, reservationID uint, req dto.CreateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error) {
+	args := m.Called(ctx, reservationID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReservationPaymentResponse), args.Error(1)
+}
+
+func (m *MockReservationPaymentService) Update(ctx context.Context, reservationID, paymentID uint, req dto.UpdateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error) {
+	args := m.Called(ctx, reservationID, paymentID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReservationPaymentResponse), args.Error(1)
+}
+
+func (m *MockReservationPaymentService) Delete(ctx context.Context, reservationID, paymentID uint) error {
+	args := m.Called(ctx, reservationID, paymentID)
+	return args.Error(0)
+}
+
+func setupReservationPaymentRouter(mockService *MockReservationPaymentService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewReservationPaymentHandler(mockService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, uint(1))
+		c.Next()
+	})
+	router.GET("/api/v1/reservations/:id/payments", handler.ListPayments)
+	router.POST("/api/v1/reservations/:id/payments", handler.CreatePayment)
+	router.DELETE("/api/v1/reservations/:id/payments/:paymentId", handler.DeletePayment)
+	return router
+}
+
+func TestReservationPaymentHandler_CreatePayment(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		serviceErr     error
+		expectedStatus int
+	}{
+		{
+			name:           "결제 내역을 등록한다",
+			body:           `{"type":"DEPOSIT","amount":50000,"paidAt":"2026-07-01T10:00:00"}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "알 수 없는 유형은 400",
+			body:           `{"type":"CASH","amount":50000}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "금액이 0이면 400",
+			body:           `{"type":"PAYMENT","amount":0}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "입금액보다 많이 환불하면 400",
+			body:           `{"type":"REFUND","amount":80000}`,
+			serviceErr:     services.ErrRefundExceedsPaidAmount,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "존재하지 않는 예약이면 404",
+			body:           `{"type":"PAYMENT","amount":10000}`,
+			serviceErr:     services.ErrReservationNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockReservationPaymentService)
+			router := setupReservationPaymentRouter(mockService)
+			if tt.serviceErr != nil {
+				mockService.On("Create", mock.Anything, uint(10), mock.Anything).Return(nil, tt.serviceErr)
+			} else {
+				mockService.On("Create", mock.Anything, uint(10), mock.Anything).
+					Return(&dto.ReservationPaymentResponse{ID: 1, Type: "DEPOSIT", Amount: 50000}, nil)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/reservations/10/payments", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestReservationPaymentHandler_DeletePayment(t *testing.T) {
+	mockService := new(MockReservationPaymentService)
+	router := setupReservationPaymentRouter(mockService)
+	mockService.On("Delete", mock.Anything, uint(10), uint(3)).Return(services.ErrReservationPaymentNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/reservations/10/payments/3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type RoomAssignmentHandler struct {
+	roomAssignmentService services.RoomAssignmentService
+}
+
+func NewRoomAssignmentHandler(roomAssignmentService services.RoomAssignmentService) *RoomAssignmentHandler {
+	return &RoomAssignmentHandler{roomAssignmentService: roomAssignmentService}
+}
+
+// GetRoomAssignmentProposal은 기간에 숙박을 시작하는 예약의 객실 배정 제안을 반환합니다. 제안은 저장하지 않습니다.
+func (h *RoomAssignmentHandler) GetRoomAssignmentProposal(c *gin.Context) {
+	var query dto.RoomAssignmentProposalQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	req, err := query.ToRoomAssignmentProposalRequest()
+	if err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	// 본인이 잡아 둔 임시 홀드는 비어 있는 것으로 보도록 사용자 정보를 넘긴다
+	ctx := c.Request.Context()
+	if userID, exists := middleware.GetUserID(c); exists {
+		ctx = appContext.WithUserID(ctx, userID)
+	}
+
+	proposal, err := h.roomAssignmentService.Propose(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrRoomAssignmentRangeTooLong):
+			response.BadRequest(c, "잘못된 날짜 범위", err.Error())
+		default:
+			response.InternalServerError(c, "객실 배정 제안 실패")
+		}
+		return
+	}
+
+	response.Success(c, proposal)
+}
+
+// ApplyRoomAssignments는 검토한 객실 배정 제안을 한 번에 적용합니다. 하나라도 실패하면 아무것도 바뀌지 않습니다.
+func (h *RoomAssignmentHandler) ApplyRoomAssignments(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.ApplyRoomAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.roomAssignmentService.Apply(ctx, req); err != nil {
+		switch {
+		case errors.Is(err, services.ErrRoomAssignmentStale):
+			response.Conflict(c, "제안 이후 예약 객실이 바뀌었습니다. 배정 제안을 다시 받아 주세요")
+		case errors.Is(err, services.ErrReservationNotFound):
+			response.NotFound(c, err.Error())
+		case errors.Is(err, services.ErrInvalidRoomAssignment):
+			response.BadRequest(c, "잘못된 객실 배정", err.Error())
+		case errors.Is(err, services.ErrDateRangeBlocked):
+			response.BadRequest(c, "차단된 날짜 범위에는 배정할 수 없습니다", err.Error())
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.BadRequest(c, "존재하지 않는 객실", err.Error())
+		case errors.Is(err, services.ErrRoomNotAvailable):
+			response.Conflict(c, err.Error())
+		default:
+			response.InternalServerError(c, "객실 배정 실패")
+		}
+		return
+	}
+
+	response.Success(c, gin.H{"appliedCount": len(req.Assignments)})
+}
@@ -140,10 +140,18 @@ func (h *RoomGroupHandler) CreateRoomGroup(c *gin.Context) {
 	}
 
 	roomGroup := &models.RoomGroup{
-		Name:         req.Name,
-		PeekPrice:    req.PeekPrice,
-		OffPeekPrice: req.OffPeekPrice,
-		Description:  req.Description,
+		Name:              req.Name,
+		PeekPrice:         req.PeekPrice,
+		OffPeekPrice:      req.OffPeekPrice,
+		Description:       req.Description,
+		StandardOccupancy: req.StandardOccupancy,
+		MaxOccupancy:      req.MaxOccupancy,
+	}
+	for _, amenityID := range req.AmenityIDs {
+		// 서비스에서 ID로 편의시설을 조회해 채운다
+		var amenity models.Amenity
+		amenity.ID = amenityID
+		roomGroup.Amenities = append(roomGroup.Amenities, amenity)
 	}
 
 	// Pass user ID in context
@@ -153,6 +161,14 @@ func (h *RoomGroupHandler) CreateRoomGroup(c *gin.Context) {
 			response.Conflict(c, "이미 존재하는 객실 그룹")
 			return
 		}
+		if errors.Is(err, services.ErrInvalidOccupancy) {
+			response.BadRequest(c, "기준 인원은 최대 인원보다 많을 수 없습니다")
+			return
+		}
+		if errors.Is(err, services.ErrAmenityNotFound) {
+			response.BadRequest(c, "존재하지 않는 편의시설")
+			return
+		}
 		response.InternalServerError(c, "객실 그룹 등록 실패")
 		return
 	}
@@ -201,6 +217,15 @@ func (h *RoomGroupHandler) UpdateRoomGroup(c *gin.Context) {
 	if req.Description != nil {
 		updates["description"] = *req.Description
 	}
+	if req.StandardOccupancy != nil {
+		updates["standardOccupancy"] = *req.StandardOccupancy
+	}
+	if req.MaxOccupancy != nil {
+		updates["maxOccupancy"] = *req.MaxOccupancy
+	}
+	if req.AmenityIDs != nil {
+		updates["amenityIds"] = *req.AmenityIDs
+	}
 
 	// Pass user ID in context
 	ctx := appContext.WithUserID(c.Request.Context(), userID)
@@ -214,6 +239,14 @@ func (h *RoomGroupHandler) UpdateRoomGroup(c *gin.Context) {
 			response.Conflict(c, "이미 존재하는 객실 그룹")
 			return
 		}
+		if errors.Is(err, services.ErrInvalidOccupancy) {
+			response.BadRequest(c, "기준 인원은 최대 인원보다 많을 수 없습니다")
+			return
+		}
+		if errors.Is(err, services.ErrAmenityNotFound) {
+			response.BadRequest(c, "존재하지 않는 편의시설")
+			return
+		}
 		response.InternalServerError(c, "객실 그룹 수정 실패")
 		return
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
 	"gitlab.bellsoft.net/rms/api-core/internal/models"
 	"gitlab.bellsoft.net/rms/api-core/internal/utils"
 	pkgutils "gitlab.bellsoft.net/rms/api-core/pkg/utils"
@@ -19,6 +20,10 @@ func (h *RoomGroupHandler) toRoomGroupResponse(roomGroup *models.RoomGroup) dto.
 		Rooms:        make([]dto.RoomLastStayDetailResponse, 0), // Initialize empty array
 		CreatedAt:    dto.CustomTime{Time: roomGroup.CreatedAt},
 		UpdatedAt:    dto.CustomTime{Time: roomGroup.UpdatedAt},
+
+		StandardOccupancy: roomGroup.StandardOccupancy,
+		MaxOccupancy:      roomGroup.MaxOccupancy,
+		Amenities:         mappers.ToAmenityListResponse(roomGroup.Amenities),
 	}
 }
 
@@ -71,6 +76,10 @@ func (h *RoomGroupHandler) toRoomGroupResponseWithUsers(roomGroup *models.RoomGr
 		Rooms:        make([]dto.RoomLastStayDetailResponse, 0), // Initialize empty array
 		CreatedAt:    dto.CustomTime{Time: roomGroup.CreatedAt},
 		UpdatedAt:    dto.CustomTime{Time: roomGroup.UpdatedAt},
+
+		StandardOccupancy: roomGroup.StandardOccupancy,
+		MaxOccupancy:      roomGroup.MaxOccupancy,
+		Amenities:         mappers.ToAmenityListResponse(roomGroup.Amenities),
 	}
 
 	if roomGroup.CreatedByUser != nil {
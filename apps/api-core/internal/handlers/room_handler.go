@@ -48,6 +48,8 @@ func (h *RoomHandler) ListRooms(c *gin.Context) {
 	filter := dto.RoomRepositoryFilter{
 		RoomGroupID: filterQuery.RoomGroupID,
 		Search:      filterQuery.Search,
+		PeopleCount: filterQuery.PeopleCount,
+		AmenityIDs:  filterQuery.AmenityIDs,
 	}
 
 	if filterQuery.Status != nil {
@@ -83,11 +85,25 @@ func (h *RoomHandler) ListRooms(c *gin.Context) {
 			return
 		}
 
-		rooms, err = h.roomService.GetAvailableRooms(c.Request.Context(), startDate, endDate, filterQuery.ExcludeReservationID)
+		// 본인이 잡은 임시 홀드 객실은 예약 가능한 객실로 보여야 하므로 사용자 정보를 함께 넘긴다
+		ctx := c.Request.Context()
+		if userID, exists := middleware.GetUserID(c); exists {
+			ctx = appContext.WithUserID(ctx, userID)
+		}
+
+		rooms, err = h.roomService.GetAvailableRooms(ctx, startDate, endDate, filterQuery.ExcludeReservationID)
 		if err != nil {
 			response.InternalServerError(c, "객실 목록 조회 실패")
 			return
 		}
+
+		matched := make([]models.Room, 0, len(rooms))
+		for i := range rooms {
+			if filter.Matches(&rooms[i]) {
+				matched = append(matched, rooms[i])
+			}
+		}
+		rooms = matched
 		total = int64(len(rooms))
 
 		offset := query.Page * query.Size
@@ -131,6 +147,8 @@ func (h *RoomHandler) ListRooms(c *gin.Context) {
 		StayStartAt:          filterQuery.StayStartAt,
 		StayEndAt:            filterQuery.StayEndAt,
 		ExcludeReservationID: filterQuery.ExcludeReservationID,
+		PeopleCount:          filterQuery.PeopleCount,
+		AmenityIDs:           filterQuery.AmenityIDs,
 	}
 
 	response.SuccessListWithFilter(c, roomResponses, pagination, filterResponse)
@@ -185,6 +203,18 @@ func (h *RoomHandler) CreateRoom(c *gin.Context) {
 		RoomGroupID: req.RoomGroupID,
 		Note:        req.Note,
 	}
+	if req.StandardOccupancy != nil && *req.StandardOccupancy > 0 {
+		room.StandardOccupancy = req.StandardOccupancy
+	}
+	if req.MaxOccupancy != nil && *req.MaxOccupancy > 0 {
+		room.MaxOccupancy = req.MaxOccupancy
+	}
+	for _, amenityID := range req.AmenityIDs {
+		// 서비스에서 ID로 편의시설을 조회해 채운다
+		var amenity models.Amenity
+		amenity.ID = amenityID
+		room.Amenities = append(room.Amenities, amenity)
+	}
 
 	if req.Status != "" {
 		switch req.Status {
@@ -211,6 +241,14 @@ func (h *RoomHandler) CreateRoom(c *gin.Context) {
 			response.BadRequest(c, "존재하지 않는 객실 그룹")
 			return
 		}
+		if errors.Is(err, services.ErrInvalidOccupancy) {
+			response.BadRequest(c, "기준 인원은 최대 인원보다 많을 수 없습니다")
+			return
+		}
+		if errors.Is(err, services.ErrAmenityNotFound) {
+			response.BadRequest(c, "존재하지 않는 편의시설")
+			return
+		}
 		response.InternalServerError(c, "객실 등록 실패")
 		return
 	}
@@ -261,6 +299,15 @@ func (h *RoomHandler) UpdateRoom(c *gin.Context) {
 			updates["status"] = models.RoomStatusNormal
 		}
 	}
+	if req.StandardOccupancy != nil {
+		updates["standard_occupancy"] = *req.StandardOccupancy
+	}
+	if req.MaxOccupancy != nil {
+		updates["max_occupancy"] = *req.MaxOccupancy
+	}
+	if req.AmenityIDs != nil {
+		updates["amenity_ids"] = *req.AmenityIDs
+	}
 
 	ctx := appContext.WithUserID(c.Request.Context(), userID)
 	room, err := h.roomService.Update(ctx, uint(id), updates)
@@ -277,6 +324,14 @@ func (h *RoomHandler) UpdateRoom(c *gin.Context) {
 			response.BadRequest(c, "존재하지 않는 객실 그룹")
 			return
 		}
+		if errors.Is(err, services.ErrInvalidOccupancy) {
+			response.BadRequest(c, "기준 인원은 최대 인원보다 많을 수 없습니다")
+			return
+		}
+		if errors.Is(err, services.ErrAmenityNotFound) {
+			response.BadRequest(c, "존재하지 않는 편의시설")
+			return
+		}
 		response.InternalServerError(c, "객실 수정 실패")
 		return
 	}
@@ -49,6 +49,30 @@ func (m *MockHistoryService) GetDateBlockHistory(ctx context.Context, dateBlockI
 	return args.Get(0).([]dto.DateBlockRevisionResponse), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockHistoryService) GetSeasonHistory(ctx context.Context, seasonID uint, page, size int) ([]dto.SeasonRevisionResponse, int64, error) {
+	args := m.Called(ctx, seasonID, page, size)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]dto.SeasonRevisionResponse), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockHistoryService) GetHousekeepingTaskHistory(ctx context.Context, taskID uint, page, size int) ([]dto.HousekeepingTaskRevisionResponse, int64, error) {
+	args := m.Called(ctx, taskID, page, size)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]dto.HousekeepingTaskRevisionResponse), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockHistoryService) GetMaintenanceTicketHistory(ctx context.Context, ticketID uint, page, size int) ([]dto.MaintenanceTicketRevisionResponse, int64, error) {
+	args := m.Called(ctx, ticketID, page, size)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]dto.MaintenanceTicketRevisionResponse), args.Get(1).(int64), args.Error(2)
+}
+
 // MockRoomService는 RoomService의 모킹 구현
 type MockRoomService struct {
 	mock.Mock
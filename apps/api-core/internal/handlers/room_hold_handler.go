@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type RoomHoldHandler struct {
+	roomHoldService    services.RoomHoldService
+	reservationService services.ReservationService
+	quoteService       services.QuoteService
+	getUserSummaryFn   mappers.GetUserSummaryFunc
+}
+
+// NewRoomHoldHandler는 객실 홀드 핸들러를 생성합니다.
+// quoteService는 price를 생략한 홀드 전환 요청의 판매 금액을 견적으로 채울 때 사용합니다.
+func NewRoomHoldHandler(roomHoldService services.RoomHoldService, reservationService services.ReservationService,
+	quoteService services.QuoteService, userService services.UserService) *RoomHoldHandler {
+	return &RoomHoldHandler{
+		roomHoldService:    roomHoldService,
+		reservationService: reservationService,
+		quoteService:       quoteService,
+		getUserSummaryFn:   mappers.GetUserSummaryHelper(userService.GetByID),
+	}
+}
+
+func (h *RoomHoldHandler) ListRoomHolds(c *gin.Context) {
+	holds, err := h.roomHoldService.GetAll(c.Request.Context())
+	if err != nil {
+		response.InternalServerError(c, "객실 홀드 목록 조회 실패")
+		return
+	}
+
+	holdResponses := make([]dto.RoomHoldResponse, len(holds))
+	for i := range holds {
+		holdResponses[i] = mappers.ToRoomHoldResponse(c.Request.Context(), &holds[i], h.getUserSummaryFn)
+	}
+
+	response.Success(c, holdResponses)
+}
+
+func (h *RoomHoldHandler) GetRoomHold(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 홀드 ID")
+		return
+	}
+
+	hold, err := h.roomHoldService.GetByID(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrRoomHoldNotFound) {
+			response.NotFound(c, "존재하지 않거나 만료된 객실 홀드")
+			return
+		}
+		response.InternalServerError(c, "객실 홀드 조회 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToRoomHoldResponse(c.Request.Context(), hold, h.getUserSummaryFn))
+}
+
+func (h *RoomHoldHandler) CreateRoomHold(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateRoomHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	hold := &models.RoomHold{
+		RoomIDs:     req.RoomIDs,
+		StayStartAt: req.StayStartAt.Time,
+		StayEndAt:   req.StayEndAt.Time,
+		Note:        req.Note,
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.roomHoldService.Create(ctx, hold, time.Duration(req.TTLMinutes)*time.Minute); err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrInvalidRoomHoldTTL):
+			response.BadRequest(c, "홀드 유지 시간은 1분 이상 60분 이하여야 합니다")
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.BadRequest(c, "존재하지 않는 객실")
+		case errors.Is(err, services.ErrDateRangeBlocked):
+			response.BadRequest(c, "차단된 날짜 범위에는 홀드할 수 없습니다")
+		case errors.Is(err, services.ErrRoomNotAvailable):
+			response.BadRequest(c, "선택한 날짜에 사용할 수 없는 객실이 있습니다")
+		default:
+			response.InternalServerError(c, "객실 홀드 등록 실패")
+		}
+		return
+	}
+
+	response.Created(c, mappers.ToRoomHoldResponse(ctx, hold, h.getUserSummaryFn))
+}
+
+func (h *RoomHoldHandler) ReleaseRoomHold(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 홀드 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.roomHoldService.Release(ctx, uint(id)); err != nil {
+		switch {
+		case errors.Is(err, services.ErrRoomHoldNotFound):
+			response.NotFound(c, "존재하지 않거나 만료된 객실 홀드")
+		case errors.Is(err, services.ErrRoomHoldNotOwned):
+			response.Forbidden(c, "다른 사용자가 잡은 객실 홀드는 해제할 수 없습니다")
+		default:
+			response.InternalServerError(c, "객실 홀드 해제 실패")
+		}
+		return
+	}
+
+	response.NoContent(c)
+}
+
+func (h *RoomHoldHandler) ConvertRoomHold(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 홀드 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.ConvertRoomHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	paymentMethodID := req.GetPaymentMethodID()
+	if paymentMethodID == 0 {
+		response.BadRequest(c, "결제 수단 ID 필수")
+		return
+	}
+
+	reservation := &models.Reservation{
+		PaymentMethodID: paymentMethodID,
+		Name:            req.Name,
+		Phone:           req.Phone,
+		PeopleCount:     req.PeopleCount,
+		Deposit:         req.Deposit,
+		PaymentAmount:   req.PaymentAmount,
+		BrokerFee:       req.BrokerFee,
+		Note:            req.Note,
+		Type:            models.ReservationTypeStay,
+		Status:          models.ReservationStatusPending,
+	}
+
+	if req.Type == "MONTHLY_RENT" {
+		reservation.Type = models.ReservationTypeMonthlyRent
+	}
+
+	switch req.Status {
+	case "NORMAL":
+		reservation.Status = models.ReservationStatusNormal
+	case "CANCEL":
+		reservation.Status = models.ReservationStatusCancel
+	case "REFUND":
+		reservation.Status = models.ReservationStatusRefund
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if req.ConfirmDuplicate {
+		ctx = services.WithDuplicateConfirmed(ctx)
+	}
+
+	if req.Price != nil {
+		reservation.Price = *req.Price
+	} else {
+		// 예약 등록과 같이 홀드의 객실과 기간으로 견적을 내 판매 금액을 채운다
+		hold, err := h.roomHoldService.GetByID(ctx, uint(id))
+		if err != nil {
+			if errors.Is(err, services.ErrRoomHoldNotFound) {
+				response.NotFound(c, "존재하지 않거나 만료된 객실 홀드")
+				return
+			}
+			response.InternalServerError(c, "객실 홀드 조회 실패")
+			return
+		}
+		reservation.StayStartAt = hold.StayStartAt
+		reservation.StayEndAt = hold.StayEndAt
+		if !fillPriceFromQuote(c, ctx, h.quoteService, reservation, hold.RoomIDs) {
+			return
+		}
+	}
+
+	if err := h.roomHoldService.Convert(ctx, uint(id), reservation); err != nil {
+		var duplicateErr *services.DuplicateReservationError
+		if errors.As(err, &duplicateErr) {
+			respondDuplicateReservation(c, duplicateErr)
+			return
+		}
+		switch {
+		case errors.Is(err, services.ErrRoomHoldNotFound):
+			response.NotFound(c, "존재하지 않거나 만료된 객실 홀드")
+		case errors.Is(err, services.ErrRoomHoldNotOwned):
+			response.Forbidden(c, "다른 사용자가 잡은 객실 홀드는 예약으로 전환할 수 없습니다")
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrPaymentMethodNotFound):
+			response.BadRequest(c, "존재하지 않는 결제 수단")
+		case errors.Is(err, services.ErrPaymentMethodInactive):
+			response.BadRequest(c, "비활성화된 결제 수단")
+		case errors.Is(err, services.ErrDateRangeBlocked):
+			response.BadRequest(c, "차단된 날짜 범위에는 예약할 수 없습니다")
+		case errors.Is(err, services.ErrRoomNotAvailable):
+			response.BadRequest(c, "선택한 날짜에 사용할 수 없는 객실이 있습니다")
+		case errors.Is(err, services.ErrPeopleCountExceeded):
+			response.BadRequest(c, "예약 인원이 객실 최대 인원을 초과합니다")
+		case errors.Is(err, services.ErrInvalidPhone):
+			response.BadRequest(c, "올바른 전화번호 형식이 아닙니다")
+		default:
+			response.InternalServerError(c, "객실 홀드 예약 전환 실패")
+		}
+		return
+	}
+
+	createdReservation, err := h.reservationService.GetByIDWithDetails(ctx, reservation.ID)
+	if err != nil {
+		response.InternalServerError(c, "생성된 예약 상세 조회 실패")
+		return
+	}
+
+	reservationResponse := mappers.ToReservationResponse(ctx, createdReservation, h.getUserSummaryFn)
+	reservationResponse.Warnings = append(reservationResponse.Warnings, occupancyWarnings(createdReservation)...)
+	if req.ConfirmDuplicate {
+		if duplicates, err := h.reservationService.FindDuplicates(ctx, createdReservation); err == nil {
+			reservationResponse.Warnings = append(reservationResponse.Warnings, duplicateReservationMessages(duplicates)...)
+		}
+	}
+	response.Created(c, reservationResponse)
+}
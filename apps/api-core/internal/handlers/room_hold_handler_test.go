@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockRoomHoldService struct {
+	mock.Mock
+}
+
+func (m *MockRoomHoldService) Create(ctx context.Context, hold *models.RoomHold, ttl time.Duration) error {
+	args := m.Called(ctx, hold, ttl)
+	return args.Error(0)
+}
+
+func (m *MockRoomHoldService) GetAll(ctx context.Context) ([]models.RoomHold, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomHold), args.Error(1)
+}
+
+func (m *MockRoomHoldService) GetByID(ctx context.Context, id uint) (*models.RoomHold, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RoomHold), args.Error(1)
+}
+
+func (m *MockRoomHoldService) Release(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRoomHoldService) Convert(ctx context.Context, id uint, reservation *models.Reservation) error {
+	args := m.Called(ctx, id, reservation)
+	return args.Error(0)
+}
+
+func setupRoomHoldRouter(mockRoomHoldService *MockRoomHoldService, mockReservationService *MockReservationService, mockQuoteService *MockQuoteService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	mockUserService := new(MockUserService)
+	mockUserService.On("GetByID", mock.Anything, mock.Anything).Return(nil, errors.New("not found"))
+	handler := NewRoomHoldHandler(mockRoomHoldService, mockReservationService, mockQuoteService, mockUserService)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, uint(1))
+		c.Next()
+	})
+	router.DELETE("/api/v1/room-holds/:id", handler.ReleaseRoomHold)
+	router.POST("/api/v1/room-holds/:id/reservation", handler.ConvertRoomHold)
+	return router
+}
+
+func TestRoomHoldHandler_ConvertRoomHold(t *testing.T) {
+	hold := &models.RoomHold{
+		ID:          7,
+		RoomIDs:     []uint{101},
+		StayStartAt: time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2026, 8, 2, 0, 0, 0, 0, time.UTC),
+		HolderID:    1,
+	}
+	standard := 2
+
+	t.Run("판매 금액을 생략하면 홀드의 객실과 기간으로 견적을 내 채우고 인원 경고를 담는다", func(t *testing.T) {
+		// Given
+		mockRoomHoldService := new(MockRoomHoldService)
+		mockReservationService := new(MockReservationService)
+		mockQuoteService := new(MockQuoteService)
+		router := setupRoomHoldRouter(mockRoomHoldService, mockReservationService, mockQuoteService)
+
+		appliedRules := models.AppliedPricingRules{
+			{RuleID: 1, Type: "WEEKEND_SURCHARGE", Name: "주말 할증", RoomID: 101, Nights: 2, Amount: 40000},
+		}
+		mockRoomHoldService.On("GetByID", mock.Anything, uint(7)).Return(hold, nil)
+		mockQuoteService.On("Price", mock.Anything, dto.QuoteRequest{
+			RoomIDs:     []uint{101},
+			StayStartAt: hold.StayStartAt,
+			StayEndAt:   hold.StayEndAt,
+			PeopleCount: 3,
+			Type:        models.ReservationTypeStay,
+		}).Return(240000, appliedRules, nil)
+		mockRoomHoldService.On("Convert", mock.Anything, uint(7), mock.MatchedBy(func(r *models.Reservation) bool {
+			return r.Price == 240000 && len(r.AppliedPricingRules) == 1
+		})).Return(nil)
+
+		created := &models.Reservation{
+			Price:               240000,
+			PeopleCount:         3,
+			AppliedPricingRules: appliedRules,
+			Rooms:               []models.ReservationRoom{{RoomID: 101, Room: &models.Room{Number: "101", StandardOccupancy: &standard}}},
+		}
+		created.ID = 9
+		mockReservationService.On("GetByIDWithDetails", mock.Anything, mock.Anything).Return(created, nil)
+
+		// When
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/room-holds/7/reservation",
+			strings.NewReader(`{"paymentMethodId":1,"name":"홍길동","peopleCount":3}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Then
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var body struct {
+			Value dto.ReservationResponse `json:"value"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, 240000, body.Value.Price)
+		assert.Contains(t, body.Value.Warnings, "예약 인원 3명이 객실 기준 인원 2명을 초과합니다")
+		mockRoomHoldService.AssertExpectations(t)
+		mockQuoteService.AssertExpectations(t)
+	})
+
+	t.Run("판매 금액을 보내면 견적을 계산하지 않는다", func(t *testing.T) {
+		mockRoomHoldService := new(MockRoomHoldService)
+		mockReservationService := new(MockReservationService)
+		mockQuoteService := new(MockQuoteService)
+		router := setupRoomHoldRouter(mockRoomHoldService, mockReservationService, mockQuoteService)
+
+		mockRoomHoldService.On("Convert", mock.Anything, uint(7), mock.MatchedBy(func(r *models.Reservation) bool {
+			return r.Price == 180000 && r.AppliedPricingRules == nil
+		})).Return(nil)
+		created := &models.Reservation{Price: 180000}
+		created.ID = 9
+		mockReservationService.On("GetByIDWithDetails", mock.Anything, mock.Anything).Return(created, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/room-holds/7/reservation",
+			strings.NewReader(`{"paymentMethodId":1,"name":"홍길동","price":180000}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		mockQuoteService.AssertNotCalled(t, "Price", mock.Anything, mock.Anything)
+		mockRoomHoldService.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRoomHoldHandler_ReleaseRoomHold(t *testing.T) {
+	t.Run("다른 사용자가 잡은 홀드는 403을 반환한다", func(t *testing.T) {
+		mockRoomHoldService := new(MockRoomHoldService)
+		router := setupRoomHoldRouter(mockRoomHoldService, new(MockReservationService), new(MockQuoteService))
+		mockRoomHoldService.On("Release", mock.Anything, uint(7)).Return(services.ErrRoomHoldNotOwned)
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/room-holds/7", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
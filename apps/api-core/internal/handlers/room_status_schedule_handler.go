@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type RoomStatusScheduleHandler struct {
+	service services.RoomStatusScheduleService
+}
+
+func NewRoomStatusScheduleHandler(service services.RoomStatusScheduleService) *RoomStatusScheduleHandler {
+	return &RoomStatusScheduleHandler{service: service}
+}
+
+// ListSchedules는 객실의 객실 상태 예약을 시작일 순으로 조회합니다.
+func (h *RoomStatusScheduleHandler) ListSchedules(c *gin.Context) {
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 ID")
+		return
+	}
+
+	schedules, err := h.service.GetSchedules(c.Request.Context(), uint(roomID))
+	if err != nil {
+		if errors.Is(err, services.ErrRoomNotFound) {
+			response.NotFound(c, "존재하지 않는 객실")
+			return
+		}
+		response.InternalServerError(c, "객실 상태 예약 조회 실패")
+		return
+	}
+
+	response.Success(c, mappers.ToRoomStatusScheduleListResponse(schedules, time.Now()))
+}
+
+// CreateSchedule은 객실 상태 예약을 등록합니다. 오늘부터 시작하는 예약은 바로 객실 상태에 반영됩니다.
+func (h *RoomStatusScheduleHandler) CreateSchedule(c *gin.Context) {
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateRoomStatusScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	schedule, err := req.ToRoomStatusSchedule(uint(roomID))
+	if err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Create(ctx, schedule); err != nil {
+		switch {
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.NotFound(c, "존재하지 않는 객실")
+		case errors.Is(err, services.ErrRoomStatusSchedulePast):
+			response.BadRequest(c, "이미 지난 기간은 예약할 수 없습니다")
+		case errors.Is(err, services.ErrRoomStatusScheduleOverlap):
+			response.Conflict(c, "기간이 겹치는 객실 상태 예약이 있습니다")
+		default:
+			response.InternalServerError(c, "객실 상태 예약 등록 실패")
+		}
+		return
+	}
+
+	response.Created(c, mappers.ToRoomStatusScheduleResponse(schedule, time.Now()))
+}
+
+// DeleteSchedule은 객실 상태 예약을 삭제합니다. 적용 중인 예약이면 객실 상태가 되돌아갑니다.
+func (h *RoomStatusScheduleHandler) DeleteSchedule(c *gin.Context) {
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 ID")
+		return
+	}
+	scheduleID, err := strconv.ParseUint(c.Param("scheduleId"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 상태 예약 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Delete(ctx, uint(roomID), uint(scheduleID)); err != nil {
+		if errors.Is(err, services.ErrRoomStatusScheduleNotFound) {
+			response.NotFound(c, "존재하지 않는 객실 상태 예약")
+			return
+		}
+		response.InternalServerError(c, "객실 상태 예약 삭제 실패")
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// GetTimeline은 객실의 날짜별 운영 상태 타임라인을 조회합니다.
+func (h *RoomStatusScheduleHandler) GetTimeline(c *gin.Context) {
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 객실 ID")
+		return
+	}
+
+	var query dto.RoomStatusTimelineQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	today := time.Now()
+	startDate, endDate, err := query.ToDateRange(today)
+	if err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	timeline, err := h.service.GetTimeline(c.Request.Context(), uint(roomID), startDate, endDate, today)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrRoomNotFound):
+			response.NotFound(c, "존재하지 않는 객실")
+		case errors.Is(err, services.ErrInvalidDateRange):
+			response.BadRequest(c, "잘못된 날짜 범위")
+		case errors.Is(err, services.ErrRoomStatusTimelineRangeTooLong):
+			response.BadRequest(c, "조회 기간은 366일 이하여야 합니다")
+		default:
+			response.InternalServerError(c, "객실 상태 타임라인 조회 실패")
+		}
+		return
+	}
+
+	response.Success(c, timeline)
+}
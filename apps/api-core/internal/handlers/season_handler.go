@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type SeasonHandler struct {
+	service        services.SeasonService
+	historyService services.HistoryService
+}
+
+func NewSeasonHandler(service services.SeasonService, historyService services.HistoryService) *SeasonHandler {
+	return &SeasonHandler{service: service, historyService: historyService}
+}
+
+func (h *SeasonHandler) ListSeasons(c *gin.Context) {
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 쿼리 파라미터", err.Error())
+		return
+	}
+
+	var filter dto.SeasonFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.BadRequest(c, "잘못된 필터 파라미터", err.Error())
+		return
+	}
+
+	seasons, total, err := h.service.GetAll(c.Request.Context(), filter, query.Page, query.Size)
+	if err != nil {
+		response.InternalServerError(c, "시즌 목록 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size > 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessListWithFilter(c, seasons, pagination, filter)
+}
+
+func (h *SeasonHandler) CreateSeason(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.CreateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	created, err := h.service.Create(ctx, req)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidSeasonRequest) {
+			response.BadRequest(c, "잘못된 요청", err.Error())
+			return
+		}
+		response.InternalServerError(c, "시즌 생성 실패")
+		return
+	}
+
+	response.Created(c, created)
+}
+
+func (h *SeasonHandler) DeleteSeason(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 시즌 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	if err := h.service.Delete(ctx, uint(id)); err != nil {
+		if errors.Is(err, services.ErrSeasonNotFound) {
+			response.NotFound(c, "존재하지 않는 시즌")
+			return
+		}
+		response.InternalServerError(c, "시즌 삭제 실패")
+		return
+	}
+
+	response.NoContent(c)
+}
+
+func (h *SeasonHandler) GetSeason(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 시즌 ID")
+		return
+	}
+
+	season, err := h.service.GetSeason(c.Request.Context(), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrSeasonNotFound) {
+			response.NotFound(c, "존재하지 않는 시즌")
+			return
+		}
+		response.InternalServerError(c, "시즌 조회 실패")
+		return
+	}
+
+	response.Success(c, season)
+}
+
+func (h *SeasonHandler) UpdateSeason(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 시즌 ID")
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.UpdateSeasonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	updated, err := h.service.UpdateSeason(ctx, uint(id), req)
+	if err != nil {
+		if errors.Is(err, services.ErrSeasonNotFound) {
+			response.NotFound(c, "존재하지 않는 시즌")
+			return
+		}
+		if errors.Is(err, services.ErrInvalidSeasonRequest) {
+			response.BadRequest(c, "잘못된 요청", err.Error())
+			return
+		}
+		response.InternalServerError(c, "시즌 수정 실패")
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+func (h *SeasonHandler) GetSeasonHistories(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "잘못된 시즌 ID")
+		return
+	}
+
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.BadRequest(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	histories, total, err := h.historyService.GetSeasonHistory(c.Request.Context(), uint(id), query.Page, query.Size)
+	if err != nil {
+		response.InternalServerError(c, "시즌 이력 조회 실패")
+		return
+	}
+
+	totalPages := int(total) / query.Size
+	if int(total)%query.Size != 0 {
+		totalPages++
+	}
+
+	pagination := &response.Pagination{
+		Page:          query.Page,
+		Size:          query.Size,
+		TotalPages:    totalPages,
+		TotalElements: total,
+	}
+
+	response.SuccessList(c, histories, pagination)
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/middleware"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gitlab.bellsoft.net/rms/api-core/pkg/response"
+)
+
+type StayChangeHandler struct {
+	service services.StayChangeService
+}
+
+func NewStayChangeHandler(service services.StayChangeService) *StayChangeHandler {
+	return &StayChangeHandler{service: service}
+}
+
+// ExtendStay는 퇴실일을 늦춰 숙박을 연장합니다.
+func (h *StayChangeHandler) ExtendStay(c *gin.Context) {
+	h.changeStay(c, h.service.Extend, "숙박 연장 실패")
+}
+
+// ShortenStay는 퇴실일을 앞당깁니다(조기 퇴실).
+func (h *StayChangeHandler) ShortenStay(c *gin.Context) {
+	h.changeStay(c, h.service.Shorten, "조기 퇴실 처리 실패")
+}
+
+func (h *StayChangeHandler) changeStay(c *gin.Context,
+	change func(ctx context.Context, reservationID uint, stayEndAt time.Time) (*dto.StayChangeResponse, error), message string) {
+	reservationID, ok := parseReservationPaymentParam(c, "id", "잘못된 예약 ID")
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		response.Unauthorized(c, "로그인 필요")
+		return
+	}
+
+	var req dto.StayChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "잘못된 요청", err.Error())
+		return
+	}
+	stayEndAt, err := time.Parse("2006-01-02", req.StayEndAt)
+	if err != nil {
+		response.BadRequest(c, "잘못된 날짜 형식 (YYYY-MM-DD)")
+		return
+	}
+
+	ctx := appContext.WithUserID(c.Request.Context(), userID)
+	result, err := change(ctx, reservationID, stayEndAt)
+	if err != nil {
+		respondStayChangeError(c, err, message)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+func respondStayChangeError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, services.ErrReservationNotFound):
+		response.NotFound(c, "존재하지 않는 예약")
+	case errors.Is(err, services.ErrStayChangeInactive),
+		errors.Is(err, services.ErrInvalidStayExtension),
+		errors.Is(err, services.ErrInvalidStayShortening),
+		errors.Is(err, services.ErrRoomMoveOutOfStay),
+		errors.Is(err, services.ErrStayChangeOverpaid),
+		errors.Is(err, services.ErrRoomNotAvailable),
+		errors.Is(err, services.ErrDateRangeBlocked),
+		errors.Is(err, services.ErrInvalidQuoteRequest),
+		errors.Is(err, services.ErrRoomNotFound):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, services.ErrReservationSettled):
+		response.Conflict(c, err.Error())
+	default:
+		response.InternalServerError(c, message)
+	}
+}
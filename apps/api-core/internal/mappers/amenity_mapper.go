@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToAmenityResponse(amenity *models.Amenity) dto.AmenityResponse {
+	return dto.AmenityResponse{
+		ID:        amenity.ID,
+		Code:      amenity.Code,
+		Name:      amenity.Name,
+		Category:  amenity.Category.String(),
+		CreatedAt: dto.CustomTime{Time: amenity.CreatedAt},
+		UpdatedAt: dto.CustomTime{Time: amenity.UpdatedAt},
+	}
+}
+
+func ToAmenityListResponse(amenities []models.Amenity) []dto.AmenityResponse {
+	responses := make([]dto.AmenityResponse, len(amenities))
+	for i := range amenities {
+		responses[i] = ToAmenityResponse(&amenities[i])
+	}
+	return responses
+}
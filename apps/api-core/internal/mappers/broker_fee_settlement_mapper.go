@@ -0,0 +1,65 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToBrokerFeeSettlementResponse(model *models.BrokerFeeSettlement) dto.BrokerFeeSettlementResponse {
+	response := dto.BrokerFeeSettlementResponse{
+		ID:               model.ID,
+		PaymentMethodID:  model.PaymentMethodID,
+		PeriodStart:      dto.JSONDate{Time: model.PeriodStart},
+		PeriodEnd:        dto.JSONDate{Time: model.PeriodEnd},
+		ReservationCount: model.ReservationCount,
+		PaymentCount:     model.PaymentCount,
+		GrossPrice:       model.GrossPrice,
+		PaidAmount:       model.PaidAmount,
+		RefundAmount:     model.RefundAmount,
+		BrokerFee:        model.BrokerFee,
+		NetPayout:        model.NetPayout,
+		Note:             model.Note,
+		CreatedAt:        dto.CustomTime{Time: model.CreatedAt},
+	}
+
+	if model.PaymentMethod != nil {
+		paymentMethod := ToPaymentMethodResponse(model.PaymentMethod)
+		response.PaymentMethod = &paymentMethod
+	}
+
+	if model.CreatedByUser != nil {
+		createdBy := ToUserSummaryResponse(model.CreatedByUser)
+		response.CreatedBy = &createdBy
+	}
+
+	return response
+}
+
+func ToBrokerFeeSettlementListResponse(models []models.BrokerFeeSettlement) []dto.BrokerFeeSettlementResponse {
+	responses := make([]dto.BrokerFeeSettlementResponse, len(models))
+	for i, model := range models {
+		responses[i] = ToBrokerFeeSettlementResponse(&model)
+	}
+	return responses
+}
+
+func ToBrokerFeeSettlementReportResponse(summaries []models.BrokerFeeSettlementSummary) []dto.BrokerFeeSettlementReportResponse {
+	responses := make([]dto.BrokerFeeSettlementReportResponse, len(summaries))
+	for i, summary := range summaries {
+		responses[i] = dto.BrokerFeeSettlementReportResponse{
+			Period:            summary.Period,
+			PaymentMethodID:   summary.PaymentMethodID,
+			PaymentMethodName: summary.PaymentMethodName,
+			ReservationCount:  summary.ReservationCount,
+			PaymentCount:      summary.PaymentCount,
+			SettledCount:      summary.SettledCount,
+			Settled:           summary.PaymentCount > 0 && summary.SettledCount == summary.PaymentCount,
+			GrossPrice:        summary.GrossPrice,
+			PaidAmount:        summary.PaidAmount,
+			RefundAmount:      summary.RefundAmount,
+			BrokerFee:         summary.BrokerFee,
+			NetPayout:         summary.NetPayout(),
+		}
+	}
+	return responses
+}
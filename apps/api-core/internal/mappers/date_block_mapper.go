@@ -7,11 +7,23 @@ import (
 
 func ToDateBlockResponse(model *models.DateBlock) dto.DateBlockResponse {
 	response := dto.DateBlockResponse{
-		ID:        model.ID,
-		StartDate: dto.JSONDate{Time: model.StartDate},
-		EndDate:   dto.JSONDate{Time: model.EndDate},
-		Reason:    model.Reason,
-		CreatedAt: dto.CustomTime{Time: model.CreatedAt},
+		ID:           model.ID,
+		StartDate:    dto.JSONDate{Time: model.StartDate},
+		EndDate:      dto.JSONDate{Time: model.EndDate},
+		Reason:       model.Reason,
+		RoomGroupIDs: model.RoomGroupIDs(),
+		RoomIDs:      model.RoomIDs(),
+		CreatedAt:    dto.CustomTime{Time: model.CreatedAt},
+		Recurrence:   model.Recurrence.String(),
+	}
+
+	if model.RecurrenceUntil != nil {
+		response.RecurrenceUntil = &dto.JSONDate{Time: *model.RecurrenceUntil}
+	}
+
+	response.ExceptionDates = make([]string, len(model.Exceptions))
+	for i, exception := range model.Exceptions {
+		response.ExceptionDates[i] = exception.ExceptionDate.Format("2006-01-02")
 	}
 
 	if model.CreatedByUser != nil {
@@ -38,3 +50,39 @@ func ToDateBlockListResponse(models []models.DateBlock) []dto.DateBlockResponse
 	}
 	return responses
 }
+
+func ToDateBlockOccurrenceResponses(occurrences []models.DateBlockOccurrence) []dto.DateBlockOccurrenceResponse {
+	responses := make([]dto.DateBlockOccurrenceResponse, len(occurrences))
+	for i, occurrence := range occurrences {
+		responses[i] = dto.DateBlockOccurrenceResponse{
+			StartDate: dto.JSONDate{Time: occurrence.StartDate},
+			EndDate:   dto.JSONDate{Time: occurrence.EndDate},
+		}
+	}
+	return responses
+}
+
+// ToDateBlockConflictListResponse는 날짜 차단과 겹치는 예약을 차단 대상 객실과 함께 변환합니다.
+func ToDateBlockConflictListResponse(dateBlock *models.DateBlock, reservations []models.Reservation) []dto.DateBlockConflictResponse {
+	responses := make([]dto.DateBlockConflictResponse, len(reservations))
+	for i := range reservations {
+		reservation := &reservations[i]
+		rooms, _ := dateBlock.Conflict(reservation)
+
+		roomResponses := make([]dto.DateBlockConflictRoomResponse, len(rooms))
+		for j, room := range rooms {
+			roomResponses[j] = dto.DateBlockConflictRoomResponse{ID: room.ID, Number: room.Number}
+		}
+
+		responses[i] = dto.DateBlockConflictResponse{
+			ReservationID: reservation.ID,
+			Name:          reservation.Name,
+			Phone:         reservation.Phone,
+			Status:        reservation.Status.String(),
+			StayStartAt:   dto.JSONDate{Time: reservation.StayStartAt},
+			StayEndAt:     dto.JSONDate{Time: reservation.StayEndAt},
+			Rooms:         roomResponses,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+// ToDuplicateReservationResponse는 중복 의심 예약 요약입니다. matches가 비어 있으면 MatchedBy를 채우지 않습니다.
+func ToDuplicateReservationResponse(reservation *models.Reservation, matches []models.DuplicateMatch) dto.DuplicateReservationResponse {
+	return dto.DuplicateReservationResponse{
+		ReservationID: reservation.ID,
+		Name:          reservation.Name,
+		Phone:         reservation.Phone,
+		Status:        reservation.Status.String(),
+		StayStartAt:   dto.JSONDate{Time: reservation.StayStartAt},
+		StayEndAt:     dto.JSONDate{Time: reservation.StayEndAt},
+		RoomNumbers:   reservationRoomNumbers(reservation),
+		MatchedBy:     duplicateMatchStrings(matches),
+	}
+}
+
+// ToDuplicateReservationPairResponse는 보고서의 중복 의심 예약 쌍이며, 먼저 등록된(ID가 작은) 예약을 Reservation에 담습니다.
+func ToDuplicateReservationPairResponse(a, b *models.Reservation, matches []models.DuplicateMatch) dto.DuplicateReservationPairResponse {
+	if b.ID < a.ID {
+		a, b = b, a
+	}
+	return dto.DuplicateReservationPairResponse{
+		Reservation: ToDuplicateReservationResponse(a, nil),
+		Duplicate:   ToDuplicateReservationResponse(b, nil),
+		MatchedBy:   duplicateMatchStrings(matches),
+	}
+}
+
+func duplicateMatchStrings(matches []models.DuplicateMatch) []string {
+	if len(matches) == 0 {
+		return nil
+	}
+	result := make([]string, len(matches))
+	for i, match := range matches {
+		result[i] = string(match)
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToGuestResponse(guest *models.Guest) dto.GuestResponse {
+	resp := dto.GuestResponse{
+		ID:               guest.ID,
+		Name:             guest.Name,
+		Phone:            guest.Phone,
+		Email:            guest.Email,
+		Note:             guest.Note,
+		IsVIP:            guest.IsVIP,
+		IsBlacklisted:    guest.IsBlacklisted,
+		MarketingConsent: guest.MarketingConsent,
+		CreatedAt:        dto.CustomTime{Time: guest.CreatedAt},
+		UpdatedAt:        dto.CustomTime{Time: guest.UpdatedAt},
+	}
+	if guest.MarketingConsentAt != nil {
+		resp.MarketingConsentAt = &dto.CustomTime{Time: *guest.MarketingConsentAt}
+	}
+	return resp
+}
+
+func ToGuestListResponse(guests []models.Guest) []dto.GuestResponse {
+	responses := make([]dto.GuestResponse, len(guests))
+	for i := range guests {
+		responses[i] = ToGuestResponse(&guests[i])
+	}
+	return responses
+}
+
+// ToGuestStayResponse는 고객 이력에 보여 줄 예약 요약입니다. 숙박 중 객실을 옮긴 예약은 사용한 객실을 모두 보여 줍니다.
+func ToGuestStayResponse(reservation *models.Reservation) dto.GuestStayResponse {
+	return dto.GuestStayResponse{
+		ReservationID: reservation.ID,
+		StayStartAt:   dto.JSONDate{Time: reservation.StayStartAt},
+		StayEndAt:     dto.JSONDate{Time: reservation.StayEndAt},
+		RoomNumbers:   reservationRoomNumbers(reservation),
+		Status:        reservation.Status.String(),
+		Price:         reservation.Price,
+	}
+}
+
+// reservationRoomNumbers는 예약이 사용하는 객실 번호를 한 번씩만 담아 반환합니다.
+func reservationRoomNumbers(reservation *models.Reservation) []string {
+	roomNumbers := []string{}
+	seen := make(map[uint]bool, len(reservation.Rooms))
+	for _, rr := range reservation.Rooms {
+		if rr.Room == nil || seen[rr.RoomID] {
+			continue
+		}
+		seen[rr.RoomID] = true
+		roomNumbers = append(roomNumbers, rr.Room.Number)
+	}
+	return roomNumbers
+}
@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToHousekeepingRoomResponse(room *models.Room) dto.HousekeepingRoomResponse {
+	return dto.HousekeepingRoomResponse{
+		RoomID:             room.ID,
+		RoomNumber:         room.Number,
+		RoomGroupID:        room.RoomGroupID,
+		Status:             room.Status.String(),
+		HousekeepingStatus: room.HousekeepingStatus.String(),
+	}
+}
+
+func ToHousekeepingRoomListResponse(rooms []models.Room) []dto.HousekeepingRoomResponse {
+	responses := make([]dto.HousekeepingRoomResponse, len(rooms))
+	for i := range rooms {
+		responses[i] = ToHousekeepingRoomResponse(&rooms[i])
+	}
+	return responses
+}
+
+func ToHousekeepingTaskResponse(task *models.HousekeepingTask) dto.HousekeepingTaskResponse {
+	response := dto.HousekeepingTaskResponse{
+		ID:            task.ID,
+		RoomID:        task.RoomID,
+		ReservationID: task.ReservationID,
+		TaskDate:      dto.JSONDate{Time: task.TaskDate},
+		Type:          task.Type.String(),
+		Status:        task.Status.String(),
+		Note:          task.Note,
+		CreatedAt:     dto.CustomTime{Time: task.CreatedAt},
+		UpdatedAt:     dto.CustomTime{Time: task.UpdatedAt},
+	}
+
+	if task.Room != nil {
+		response.RoomNumber = task.Room.Number
+	}
+	if task.CompletedAt != nil {
+		response.CompletedAt = &dto.CustomTime{Time: *task.CompletedAt}
+	}
+
+	if task.Assignee != nil {
+		email := ""
+		if task.Assignee.Email != nil {
+			email = *task.Assignee.Email
+		}
+		response.Assignee = &dto.UserSummaryResponse{
+			ID:     task.Assignee.ID,
+			UserID: task.Assignee.UserID,
+			Email:  email,
+			Name:   task.Assignee.Name,
+		}
+	}
+
+	return response
+}
+
+func ToHousekeepingTaskListResponse(tasks []models.HousekeepingTask) []dto.HousekeepingTaskResponse {
+	responses := make([]dto.HousekeepingTaskResponse, len(tasks))
+	for i := range tasks {
+		responses[i] = ToHousekeepingTaskResponse(&tasks[i])
+	}
+	return responses
+}
@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToMaintenanceTicketResponse(ticket *models.MaintenanceTicket) dto.MaintenanceTicketResponse {
+	response := dto.MaintenanceTicketResponse{
+		ID:          ticket.ID,
+		RoomID:      ticket.RoomID,
+		Description: ticket.Description,
+		Priority:    ticket.Priority.String(),
+		Status:      ticket.Status.String(),
+		Resolution:  ticket.Resolution,
+		CreatedAt:   dto.CustomTime{Time: ticket.CreatedAt},
+		UpdatedAt:   dto.CustomTime{Time: ticket.UpdatedAt},
+	}
+
+	if ticket.Room != nil {
+		response.RoomNumber = ticket.Room.Number
+		response.RoomStatus = ticket.Room.Status.String()
+	}
+	if ticket.ExpectedReturnDate != nil {
+		response.ExpectedReturnDate = &dto.JSONDate{Time: *ticket.ExpectedReturnDate}
+	}
+	if ticket.ResolvedAt != nil {
+		response.ResolvedAt = &dto.CustomTime{Time: *ticket.ResolvedAt}
+	}
+
+	if ticket.Assignee != nil {
+		email := ""
+		if ticket.Assignee.Email != nil {
+			email = *ticket.Assignee.Email
+		}
+		response.Assignee = &dto.UserSummaryResponse{
+			ID:     ticket.Assignee.ID,
+			UserID: ticket.Assignee.UserID,
+			Email:  email,
+			Name:   ticket.Assignee.Name,
+		}
+	}
+
+	return response
+}
+
+func ToMaintenanceTicketListResponse(tickets []models.MaintenanceTicket) []dto.MaintenanceTicketResponse {
+	responses := make([]dto.MaintenanceTicketResponse, len(tickets))
+	for i := range tickets {
+		responses[i] = ToMaintenanceTicketResponse(&tickets[i])
+	}
+	return responses
+}
+
+func ToMaintenanceAffectedReservationListResponse(reservations []models.Reservation) []dto.MaintenanceAffectedReservationResponse {
+	responses := make([]dto.MaintenanceAffectedReservationResponse, len(reservations))
+	for i, reservation := range reservations {
+		responses[i] = dto.MaintenanceAffectedReservationResponse{
+			ReservationID: reservation.ID,
+			Name:          reservation.Name,
+			Phone:         reservation.Phone,
+			Status:        reservation.Status.String(),
+			StayStartAt:   dto.JSONDate{Time: reservation.StayStartAt},
+			StayEndAt:     dto.JSONDate{Time: reservation.StayEndAt},
+		}
+	}
+	return responses
+}
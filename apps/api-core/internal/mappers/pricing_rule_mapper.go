@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToPricingRuleResponse(model *models.PricingRule) dto.PricingRuleResponse {
+	return dto.PricingRuleResponse{
+		ID:          model.ID,
+		Name:        model.Name,
+		Type:        model.Type.String(),
+		RoomGroupID: model.RoomGroupID,
+		Amount:      model.Amount,
+		Percent:     model.Percent,
+		MinNights:   model.MinNights,
+		Enabled:     model.Enabled,
+		CreatedAt:   dto.CustomTime{Time: model.CreatedAt},
+		UpdatedAt:   dto.CustomTime{Time: model.UpdatedAt},
+	}
+}
+
+func ToPricingRuleListResponse(models []models.PricingRule) []dto.PricingRuleResponse {
+	responses := make([]dto.PricingRuleResponse, len(models))
+	for i, model := range models {
+		responses[i] = ToPricingRuleResponse(&model)
+	}
+	return responses
+}
+
+func ToAppliedPricingRuleResponses(rules models.AppliedPricingRules) []dto.AppliedPricingRuleResponse {
+	if len(rules) == 0 {
+		return nil
+	}
+	responses := make([]dto.AppliedPricingRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = dto.AppliedPricingRuleResponse{
+			RuleID: rule.RuleID,
+			Type:   rule.Type,
+			Name:   rule.Name,
+			RoomID: rule.RoomID,
+			Nights: rule.Nights,
+			Amount: rule.Amount,
+		}
+	}
+	return responses
+}
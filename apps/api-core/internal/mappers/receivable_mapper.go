@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToReceivableResponse(reservation *models.Reservation, now time.Time) dto.ReceivableResponse {
+	response := dto.ReceivableResponse{
+		ReservationID:   reservation.ID,
+		Name:            reservation.Name,
+		Phone:           reservation.Phone,
+		PaymentMethodID: reservation.PaymentMethodID,
+		RoomNumbers:     []string{},
+		StayStartAt:     dto.JSONDate{Time: reservation.StayStartAt},
+		StayEndAt:       dto.JSONDate{Time: reservation.StayEndAt},
+		Status:          reservation.Status.String(),
+		Price:           reservation.Price,
+		Deposit:         reservation.Deposit,
+		PaymentAmount:   reservation.PaymentAmount,
+		UnpaidAmount:    reservation.UnpaidAmount(),
+		AgeDays:         reservation.ReceivableAgeDays(now),
+	}
+
+	if reservation.PaymentMethod != nil {
+		response.PaymentMethodName = reservation.PaymentMethod.Name
+		response.RequireUnpaidAmountCheck = bool(reservation.PaymentMethod.RequireUnpaidAmountCheck)
+	}
+
+	for _, reservationRoom := range reservation.Rooms {
+		if reservationRoom.Room != nil {
+			response.RoomNumbers = append(response.RoomNumbers, reservationRoom.Room.Number)
+		}
+	}
+
+	return response
+}
+
+func ToReceivableListResponse(reservations []models.Reservation, now time.Time) []dto.ReceivableResponse {
+	responses := make([]dto.ReceivableResponse, len(reservations))
+	for i, reservation := range reservations {
+		responses[i] = ToReceivableResponse(&reservation, now)
+	}
+	return responses
+}
@@ -0,0 +1,65 @@
+package mappers
+
+import (
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToRentChargeResponse(charge *models.RentCharge, now time.Time) dto.RentChargeResponse {
+	response := dto.RentChargeResponse{
+		ID:                   charge.ID,
+		ReservationID:        charge.ReservationID,
+		Sequence:             charge.Sequence,
+		PeriodStart:          dto.JSONDate{Time: charge.PeriodStart},
+		PeriodEnd:            dto.JSONDate{Time: charge.PeriodEnd},
+		DueDate:              dto.JSONDate{Time: charge.DueDate},
+		Amount:               charge.Amount,
+		Status:               charge.Status(now),
+		ReservationPaymentID: charge.ReservationPaymentID,
+	}
+
+	if charge.PaidAt != nil {
+		response.PaidAt = &dto.CustomTime{Time: *charge.PaidAt}
+	}
+
+	return response
+}
+
+func ToRentScheduleResponse(reservationID uint, charges []models.RentCharge, now time.Time) dto.RentScheduleResponse {
+	response := dto.RentScheduleResponse{
+		ReservationID: reservationID,
+		Charges:       make([]dto.RentChargeResponse, len(charges)),
+	}
+
+	for i, charge := range charges {
+		response.Charges[i] = ToRentChargeResponse(&charge, now)
+		response.TotalAmount += charge.Amount
+		switch {
+		case charge.IsPaid():
+			response.PaidAmount += charge.Amount
+		case charge.IsOverdue(now):
+			response.OverdueAmount += charge.Amount
+		}
+	}
+	response.UnpaidAmount = response.TotalAmount - response.PaidAmount
+
+	return response
+}
+
+func ToOverdueRentChargeListResponse(charges []models.RentCharge, now time.Time) []dto.OverdueRentChargeResponse {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	responses := make([]dto.OverdueRentChargeResponse, len(charges))
+	for i, charge := range charges {
+		responses[i] = dto.OverdueRentChargeResponse{
+			RentChargeResponse: ToRentChargeResponse(&charge, now),
+			OverdueDays:        int(today.Sub(charge.DueDate).Hours() / 24),
+		}
+		if charge.Reservation != nil {
+			responses[i].Name = charge.Reservation.Name
+			responses[i].Phone = charge.Reservation.Phone
+		}
+	}
+	return responses
+}
@@ -10,24 +10,27 @@ import (
 // ToReservationResponse converts a Reservation model to ReservationResponse DTO
 func ToReservationResponse(ctx context.Context, reservation *models.Reservation, getUserSummary GetUserSummaryFunc) dto.ReservationResponse {
 	resp := dto.ReservationResponse{
-		ID:              reservation.ID,
-		PaymentMethodID: reservation.PaymentMethodID,
-		Name:            reservation.Name,
-		Phone:           reservation.Phone,
-		PeopleCount:     reservation.PeopleCount,
-		StayStartAt:     dto.JSONDate{Time: reservation.StayStartAt},
-		StayEndAt:       dto.JSONDate{Time: reservation.StayEndAt},
-		Price:           reservation.Price,
-		Deposit:         reservation.Deposit,
-		PaymentAmount:   reservation.PaymentAmount,
-		RefundAmount:    reservation.RefundAmount,
-		BrokerFee:       reservation.BrokerFee,
-		Note:            reservation.Note,
-		Status:          reservation.Status.String(),
-		Type:            reservation.Type.String(),
-		CreatedAt:       dto.CustomTime{Time: reservation.CreatedAt},
-		UpdatedAt:       dto.CustomTime{Time: reservation.UpdatedAt},
-		Rooms:           []dto.RoomResponse{},
+		ID:                    reservation.ID,
+		PaymentMethodID:       reservation.PaymentMethodID,
+		Name:                  reservation.Name,
+		Phone:                 reservation.Phone,
+		PeopleCount:           reservation.PeopleCount,
+		StayStartAt:           dto.JSONDate{Time: reservation.StayStartAt},
+		StayEndAt:             dto.JSONDate{Time: reservation.StayEndAt},
+		Price:                 reservation.Price,
+		Deposit:               reservation.Deposit,
+		PaymentAmount:         reservation.PaymentAmount,
+		RefundAmount:          reservation.RefundAmount,
+		BrokerFee:             reservation.BrokerFee,
+		Note:                  reservation.Note,
+		Status:                reservation.Status.String(),
+		Type:                  reservation.Type.String(),
+		CreatedAt:             dto.CustomTime{Time: reservation.CreatedAt},
+		UpdatedAt:             dto.CustomTime{Time: reservation.UpdatedAt},
+		Rooms:                 []dto.RoomResponse{},
+		AppliedPricingRules:   ToAppliedPricingRuleResponses(reservation.AppliedPricingRules),
+		BrokerFeeSettlementID: reservation.BrokerFeeSettlementID,
+		RoomGroupID:           reservation.RoomGroupID,
 	}
 
 	if reservation.CheckInAt != nil {
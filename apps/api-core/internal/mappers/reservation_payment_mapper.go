@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToReservationPaymentResponse(model *models.ReservationPayment) dto.ReservationPaymentResponse {
+	response := dto.ReservationPaymentResponse{
+		ID:                    model.ID,
+		ReservationID:         model.ReservationID,
+		PaymentMethodID:       model.PaymentMethodID,
+		Type:                  model.Type.String(),
+		Amount:                model.Amount,
+		BrokerFee:             model.BrokerFee,
+		PaidAt:                dto.CustomTime{Time: model.PaidAt},
+		Note:                  model.Note,
+		CreatedAt:             dto.CustomTime{Time: model.CreatedAt},
+		UpdatedAt:             dto.CustomTime{Time: model.UpdatedAt},
+		BrokerFeeSettlementID: model.BrokerFeeSettlementID,
+	}
+
+	if model.PaymentMethod != nil {
+		paymentMethod := ToPaymentMethodResponse(model.PaymentMethod)
+		response.PaymentMethod = &paymentMethod
+	}
+
+	if model.CreatedByUser != nil {
+		createdBy := ToUserSummaryResponse(model.CreatedByUser)
+		response.CreatedBy = &createdBy
+	}
+
+	return response
+}
+
+func ToReservationPaymentListResponse(models []models.ReservationPayment) []dto.ReservationPaymentResponse {
+	responses := make([]dto.ReservationPaymentResponse, len(models))
+	for i, model := range models {
+		responses[i] = ToReservationPaymentResponse(&model)
+	}
+	return responses
+}
@@ -20,6 +20,10 @@ func ToRoomGroupResponse(roomGroup *models.RoomGroup) dto.RoomGroupResponse {
 		Rooms:        make([]dto.RoomLastStayDetailResponse, 0),
 		CreatedAt:    dto.CustomTime{Time: roomGroup.CreatedAt},
 		UpdatedAt:    dto.CustomTime{Time: roomGroup.UpdatedAt},
+
+		StandardOccupancy: roomGroup.StandardOccupancy,
+		MaxOccupancy:      roomGroup.MaxOccupancy,
+		Amenities:         ToAmenityListResponse(roomGroup.Amenities),
 	}
 }
 
@@ -75,6 +79,10 @@ func ToRoomGroupResponseWithUsers(roomGroup *models.RoomGroup) dto.RoomGroupResp
 		Rooms:        make([]dto.RoomLastStayDetailResponse, 0),
 		CreatedAt:    dto.CustomTime{Time: roomGroup.CreatedAt},
 		UpdatedAt:    dto.CustomTime{Time: roomGroup.UpdatedAt},
+
+		StandardOccupancy: roomGroup.StandardOccupancy,
+		MaxOccupancy:      roomGroup.MaxOccupancy,
+		Amenities:         ToAmenityListResponse(roomGroup.Amenities),
 	}
 
 	if roomGroup.CreatedByUser != nil {
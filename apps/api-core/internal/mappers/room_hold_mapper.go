@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	"context"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToRoomHoldResponse(ctx context.Context, hold *models.RoomHold, getUserSummary GetUserSummaryFunc) dto.RoomHoldResponse {
+	return dto.RoomHoldResponse{
+		ID:          hold.ID,
+		RoomIDs:     hold.RoomIDs,
+		StayStartAt: dto.JSONDate{Time: hold.StayStartAt},
+		StayEndAt:   dto.JSONDate{Time: hold.StayEndAt},
+		Note:        hold.Note,
+		HeldBy:      getUserSummary(ctx, hold.HolderID),
+		CreatedAt:   dto.CustomTime{Time: hold.CreatedAt},
+		ExpiresAt:   dto.CustomTime{Time: hold.ExpiresAt},
+	}
+}
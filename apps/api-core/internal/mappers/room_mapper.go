@@ -22,6 +22,11 @@ func ToRoomResponse(ctx context.Context, room *models.Room, getUserSummary GetUs
 		UpdatedAt:   dto.CustomTime{Time: room.UpdatedAt},
 		CreatedBy:   getUserSummary(ctx, room.CreatedBy),
 		UpdatedBy:   getUserSummary(ctx, room.UpdatedBy),
+
+		HousekeepingStatus: room.HousekeepingStatus.String(),
+		StandardOccupancy:  room.StandardOccupancy,
+		MaxOccupancy:       room.MaxOccupancy,
+		Amenities:          ToAmenityListResponse(room.Amenities),
 	}
 
 	if room.RoomGroup != nil {
@@ -33,6 +38,10 @@ func ToRoomResponse(ctx context.Context, room *models.Room, getUserSummary GetUs
 			Description:  room.RoomGroup.Description,
 			CreatedAt:    dto.CustomTime{Time: room.RoomGroup.CreatedAt},
 			UpdatedAt:    dto.CustomTime{Time: room.RoomGroup.UpdatedAt},
+
+			StandardOccupancy: room.RoomGroup.StandardOccupancy,
+			MaxOccupancy:      room.RoomGroup.MaxOccupancy,
+			Amenities:         ToAmenityListResponse(room.RoomGroup.Amenities),
 		}
 	}
 
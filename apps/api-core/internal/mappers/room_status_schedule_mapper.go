@@ -0,0 +1,44 @@
+package mappers
+
+import (
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+// ToRoomStatusScheduleResponse는 객실 상태 예약을 응답으로 변환합니다. State는 today 기준 진행 상태입니다.
+func ToRoomStatusScheduleResponse(schedule *models.RoomStatusSchedule, today time.Time) dto.RoomStatusScheduleResponse {
+	response := dto.RoomStatusScheduleResponse{
+		ID:        schedule.ID,
+		RoomID:    schedule.RoomID,
+		Status:    schedule.Status.String(),
+		StartDate: dto.JSONDate{Time: schedule.StartDate},
+		EndDate:   dto.JSONDate{Time: schedule.EndDate},
+		Note:      schedule.Note,
+		State:     schedule.State(today),
+		CreatedAt: dto.CustomTime{Time: schedule.CreatedAt},
+		UpdatedAt: dto.CustomTime{Time: schedule.UpdatedAt},
+	}
+
+	if schedule.PreviousStatus != nil {
+		previousStatus := schedule.PreviousStatus.String()
+		response.PreviousStatus = &previousStatus
+	}
+	if schedule.AppliedAt != nil {
+		response.AppliedAt = &dto.CustomTime{Time: *schedule.AppliedAt}
+	}
+	if schedule.RevertedAt != nil {
+		response.RevertedAt = &dto.CustomTime{Time: *schedule.RevertedAt}
+	}
+
+	return response
+}
+
+func ToRoomStatusScheduleListResponse(schedules []models.RoomStatusSchedule, today time.Time) []dto.RoomStatusScheduleResponse {
+	responses := make([]dto.RoomStatusScheduleResponse, len(schedules))
+	for i := range schedules {
+		responses[i] = ToRoomStatusScheduleResponse(&schedules[i], today)
+	}
+	return responses
+}
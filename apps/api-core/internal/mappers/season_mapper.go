@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func ToSeasonResponse(model *models.Season) dto.SeasonResponse {
+	roomGroupIDs := make([]uint, len(model.RoomGroups))
+	for i, group := range model.RoomGroups {
+		roomGroupIDs[i] = group.ID
+	}
+
+	response := dto.SeasonResponse{
+		ID:              model.ID,
+		Name:            model.Name,
+		StartDate:       dto.JSONDate{Time: model.StartDate},
+		EndDate:         dto.JSONDate{Time: model.EndDate},
+		RecurringYearly: model.RecurringYearly,
+		RoomGroupIDs:    roomGroupIDs,
+		CreatedAt:       dto.CustomTime{Time: model.CreatedAt},
+	}
+
+	if model.CreatedByUser != nil {
+		email := ""
+		if model.CreatedByUser.Email != nil {
+			email = *model.CreatedByUser.Email
+		}
+		response.CreatedBy = &dto.UserSummaryResponse{
+			ID:              model.CreatedByUser.ID,
+			UserID:          model.CreatedByUser.UserID,
+			Email:           email,
+			Name:            model.CreatedByUser.Name,
+			ProfileImageURL: "",
+		}
+	}
+
+	return response
+}
+
+func ToSeasonListResponse(models []models.Season) []dto.SeasonResponse {
+	responses := make([]dto.SeasonResponse, len(models))
+	for i, model := range models {
+		responses[i] = ToSeasonResponse(&model)
+	}
+	return responses
+}
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration008AddSeasons creates the season and season_room_group tables
+var Migration008AddSeasons = Migration{
+	ID:          "008_add_seasons",
+	Description: "Create season tables for peak pricing calendar",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			CREATE TABLE season (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				name VARCHAR(50) NOT NULL,
+				start_date DATE NOT NULL,
+				end_date DATE NOT NULL,
+				recurring_yearly BOOLEAN NOT NULL DEFAULT FALSE,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_season_dates (start_date, end_date),
+				INDEX idx_season_deleted_at (deleted_at),
+				CONSTRAINT FK_SEASON_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_SEASON_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			CREATE TABLE season_room_group (
+				season_id BIGINT NOT NULL,
+				room_group_id BIGINT NOT NULL,
+				PRIMARY KEY (season_id, room_group_id),
+				CONSTRAINT FK_SEASON_ROOM_GROUP_ON_SEASON FOREIGN KEY (season_id) REFERENCES season (id),
+				CONSTRAINT FK_SEASON_ROOM_GROUP_ON_ROOM_GROUP FOREIGN KEY (room_group_id) REFERENCES room_group (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec("DROP TABLE IF EXISTS season_room_group").Error; err != nil {
+			return err
+		}
+		return db.Exec("DROP TABLE IF EXISTS season").Error
+	},
+}
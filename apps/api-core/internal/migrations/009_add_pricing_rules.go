@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration009AddPricingRules creates the pricing_rule table and stores applied rules on reservations
+var Migration009AddPricingRules = Migration{
+	ID:          "009_add_pricing_rules",
+	Description: "Create pricing_rule table and add applied_pricing_rules to reservation",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			CREATE TABLE pricing_rule (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				name VARCHAR(50) NOT NULL,
+				type TINYINT NOT NULL,
+				room_group_id BIGINT NULL,
+				amount INT NOT NULL DEFAULT 0,
+				percent INT NOT NULL DEFAULT 0,
+				min_nights INT NOT NULL DEFAULT 0,
+				base_people_count INT NOT NULL DEFAULT 0,
+				enabled BOOLEAN NOT NULL DEFAULT TRUE,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_pricing_rule_type (type),
+				INDEX idx_pricing_rule_deleted_at (deleted_at),
+				CONSTRAINT FK_PRICING_RULE_ON_ROOM_GROUP FOREIGN KEY (room_group_id) REFERENCES room_group (id),
+				CONSTRAINT FK_PRICING_RULE_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_PRICING_RULE_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec("ALTER TABLE reservation ADD COLUMN applied_pricing_rules JSON NULL").Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec("ALTER TABLE reservation DROP COLUMN applied_pricing_rules").Error; err != nil {
+			return err
+		}
+		return db.Exec("DROP TABLE IF EXISTS pricing_rule").Error
+	},
+}
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration010AddReservationPayments creates the reservation_payment ledger and backfills it from
+// the aggregate deposit, payment_amount and refund_amount columns of existing reservations.
+// Existing broker_fee values are recalculated from the ledger by 024_recalculate_reservation_broker_fees.
+var Migration010AddReservationPayments = Migration{
+	ID:          "010_add_reservation_payments",
+	Description: "Create reservation_payment ledger and backfill from reservation amounts",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			CREATE TABLE reservation_payment (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				reservation_id BIGINT NOT NULL,
+				payment_method_id BIGINT NOT NULL,
+				type TINYINT NOT NULL,
+				amount INT NOT NULL,
+				broker_fee INT NOT NULL DEFAULT 0,
+				paid_at DATETIME NOT NULL,
+				note VARCHAR(200) NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_reservation_payment_reservation_id (reservation_id),
+				INDEX idx_reservation_payment_paid_at (paid_at),
+				INDEX idx_reservation_payment_deleted_at (deleted_at),
+				CONSTRAINT FK_RESERVATION_PAYMENT_ON_RESERVATION FOREIGN KEY (reservation_id) REFERENCES reservation (id),
+				CONSTRAINT FK_RESERVATION_PAYMENT_ON_PAYMENT_METHOD FOREIGN KEY (payment_method_id) REFERENCES payment_method (id),
+				CONSTRAINT FK_RESERVATION_PAYMENT_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_RESERVATION_PAYMENT_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		backfills := []struct {
+			paymentType int
+			amount      string
+			paidAt      string
+			feeSign     int
+		}{
+			{1, "r.deposit", "r.created_at", 1},
+			{2, "r.payment_amount", "r.created_at", 1},
+			{3, "r.refund_amount", "COALESCE(r.canceled_at, r.updated_at)", -1},
+		}
+		for _, backfill := range backfills {
+			if err := db.Exec(`
+				INSERT INTO reservation_payment (reservation_id, payment_method_id, type, amount, broker_fee, paid_at, note,
+					created_at, updated_at, deleted_at, created_by, updated_by)
+				SELECT r.id, r.payment_method_id, ?, `+backfill.amount+`, ? * ROUND(`+backfill.amount+` * pm.commission_rate), `+backfill.paidAt+`,
+					'기존 예약 금액 이관', NOW(), NOW(), '1970-01-01 00:00:00', r.created_by, r.created_by
+				FROM reservation r
+				JOIN payment_method pm ON pm.id = r.payment_method_id
+				WHERE r.deleted_at = '1970-01-01 00:00:00' AND `+backfill.amount+` > 0
+			`, backfill.paymentType, backfill.feeSign).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Down: func(db *gorm.DB) error {
+		return db.Exec("DROP TABLE IF EXISTS reservation_payment").Error
+	},
+}
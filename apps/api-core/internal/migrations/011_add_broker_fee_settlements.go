@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration011AddBrokerFeeSettlements creates the broker_fee_settlement table and links settled reservations to it
+var Migration011AddBrokerFeeSettlements = Migration{
+	ID:          "011_add_broker_fee_settlements",
+	Description: "Create broker_fee_settlement table and add broker_fee_settlement_id to reservation",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			CREATE TABLE broker_fee_settlement (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				payment_method_id BIGINT NOT NULL,
+				period_start DATE NOT NULL,
+				period_end DATE NOT NULL,
+				reservation_count INT NOT NULL DEFAULT 0,
+				gross_price INT NOT NULL DEFAULT 0,
+				paid_amount INT NOT NULL DEFAULT 0,
+				refund_amount INT NOT NULL DEFAULT 0,
+				broker_fee INT NOT NULL DEFAULT 0,
+				net_payout INT NOT NULL DEFAULT 0,
+				note VARCHAR(200) NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_broker_fee_settlement_period (payment_method_id, period_start, period_end),
+				INDEX idx_broker_fee_settlement_deleted_at (deleted_at),
+				CONSTRAINT FK_BROKER_FEE_SETTLEMENT_ON_PAYMENT_METHOD FOREIGN KEY (payment_method_id) REFERENCES payment_method (id),
+				CONSTRAINT FK_BROKER_FEE_SETTLEMENT_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_BROKER_FEE_SETTLEMENT_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			ALTER TABLE reservation
+				ADD COLUMN broker_fee_settlement_id BIGINT NULL,
+				ADD CONSTRAINT FK_RESERVATION_ON_BROKER_FEE_SETTLEMENT FOREIGN KEY (broker_fee_settlement_id) REFERENCES broker_fee_settlement (id)
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE reservation
+				DROP FOREIGN KEY FK_RESERVATION_ON_BROKER_FEE_SETTLEMENT,
+				DROP COLUMN broker_fee_settlement_id
+		`).Error; err != nil {
+			return err
+		}
+		return db.Exec("DROP TABLE IF EXISTS broker_fee_settlement").Error
+	},
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration012AddRentCharges creates the rent_charge table for MONTHLY_RENT billing schedules.
+// Existing MONTHLY_RENT reservations get their schedule from 026_backfill_rent_charges.
+var Migration012AddRentCharges = Migration{
+	ID:          "012_add_rent_charges",
+	Description: "Create rent_charge table",
+	Up: func(db *gorm.DB) error {
+		return db.Exec(`
+			CREATE TABLE rent_charge (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				reservation_id BIGINT NOT NULL,
+				sequence INT NOT NULL,
+				period_start DATE NOT NULL,
+				period_end DATE NOT NULL,
+				due_date DATE NOT NULL,
+				amount INT NOT NULL,
+				paid_at DATETIME NULL,
+				reservation_payment_id BIGINT NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_rent_charge_reservation_id (reservation_id),
+				INDEX idx_rent_charge_due_date (due_date),
+				INDEX idx_rent_charge_deleted_at (deleted_at),
+				CONSTRAINT FK_RENT_CHARGE_ON_RESERVATION FOREIGN KEY (reservation_id) REFERENCES reservation (id),
+				CONSTRAINT FK_RENT_CHARGE_ON_RESERVATION_PAYMENT FOREIGN KEY (reservation_payment_id) REFERENCES reservation_payment (id),
+				CONSTRAINT FK_RENT_CHARGE_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_RENT_CHARGE_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		return db.Exec("DROP TABLE IF EXISTS rent_charge").Error
+	},
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration013AddDateBlockTargets creates the date_block_room_group and date_block_room tables
+var Migration013AddDateBlockTargets = Migration{
+	ID:          "013_add_date_block_targets",
+	Description: "Create date block target tables for room group and room scoped date blocks",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			CREATE TABLE date_block_room_group (
+				date_block_id BIGINT NOT NULL,
+				room_group_id BIGINT NOT NULL,
+				PRIMARY KEY (date_block_id, room_group_id),
+				INDEX idx_date_block_room_group_room_group_id (room_group_id),
+				CONSTRAINT FK_DATE_BLOCK_ROOM_GROUP_ON_DATE_BLOCK FOREIGN KEY (date_block_id) REFERENCES date_block (id),
+				CONSTRAINT FK_DATE_BLOCK_ROOM_GROUP_ON_ROOM_GROUP FOREIGN KEY (room_group_id) REFERENCES room_group (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			CREATE TABLE date_block_room (
+				date_block_id BIGINT NOT NULL,
+				room_id BIGINT NOT NULL,
+				PRIMARY KEY (date_block_id, room_id),
+				INDEX idx_date_block_room_room_id (room_id),
+				CONSTRAINT FK_DATE_BLOCK_ROOM_ON_DATE_BLOCK FOREIGN KEY (date_block_id) REFERENCES date_block (id),
+				CONSTRAINT FK_DATE_BLOCK_ROOM_ON_ROOM FOREIGN KEY (room_id) REFERENCES room (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec("DROP TABLE IF EXISTS date_block_room").Error; err != nil {
+			return err
+		}
+		return db.Exec("DROP TABLE IF EXISTS date_block_room_group").Error
+	},
+}
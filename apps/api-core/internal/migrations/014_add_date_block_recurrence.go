@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration014AddDateBlockRecurrence adds recurrence rules and exceptions to date blocks
+var Migration014AddDateBlockRecurrence = Migration{
+	ID:          "014_add_date_block_recurrence",
+	Description: "Add recurrence rule columns and date_block_exception table for recurring date blocks",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE date_block
+				ADD COLUMN recurrence TINYINT NOT NULL DEFAULT 0 AFTER reason,
+				ADD COLUMN recurrence_until DATE NULL AFTER recurrence,
+				ADD INDEX idx_date_block_recurrence (recurrence);
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			CREATE TABLE date_block_exception (
+				date_block_id BIGINT NOT NULL,
+				exception_date DATE NOT NULL,
+				PRIMARY KEY (date_block_id, exception_date),
+				CONSTRAINT FK_DATE_BLOCK_EXCEPTION_ON_DATE_BLOCK FOREIGN KEY (date_block_id) REFERENCES date_block (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec("DROP TABLE IF EXISTS date_block_exception").Error; err != nil {
+			return err
+		}
+		return db.Exec(`
+			ALTER TABLE date_block
+				DROP INDEX idx_date_block_recurrence,
+				DROP COLUMN recurrence_until,
+				DROP COLUMN recurrence;
+		`).Error
+	},
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration015AddReservationRoomGroup adds the requested room group to reservations booked by room group
+var Migration015AddReservationRoomGroup = Migration{
+	ID:          "015_add_reservation_room_group",
+	Description: "Add room_group_id to reservation for reservations booked by room group",
+	Up: func(db *gorm.DB) error {
+		return db.Exec(`
+			ALTER TABLE reservation
+				ADD COLUMN room_group_id BIGINT NULL,
+				ADD CONSTRAINT FK_RESERVATION_ON_ROOM_GROUP FOREIGN KEY (room_group_id) REFERENCES room_group (id)
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		return db.Exec(`
+			ALTER TABLE reservation
+				DROP FOREIGN KEY FK_RESERVATION_ON_ROOM_GROUP,
+				DROP COLUMN room_group_id
+		`).Error
+	},
+}
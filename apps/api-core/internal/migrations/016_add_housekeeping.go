@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration016AddHousekeeping adds the room housekeeping status and the daily housekeeping_task table.
+// Existing rooms start as CLEAN (3).
+var Migration016AddHousekeeping = Migration{
+	ID:          "016_add_housekeeping",
+	Description: "Add room.housekeeping_status and create housekeeping_task table",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE room
+				ADD COLUMN housekeeping_status TINYINT NOT NULL DEFAULT 3 AFTER status;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			CREATE TABLE housekeeping_task (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				room_id BIGINT NOT NULL,
+				reservation_id BIGINT NULL,
+				task_date DATE NOT NULL,
+				type TINYINT NOT NULL,
+				status TINYINT NOT NULL,
+				assignee_id BIGINT NULL,
+				note VARCHAR(200) NOT NULL DEFAULT '',
+				completed_at DATETIME NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				UNIQUE KEY uc_housekeeping_task_room_date (room_id, task_date, deleted_at),
+				INDEX idx_housekeeping_task_task_date (task_date),
+				INDEX idx_housekeeping_task_assignee_id (assignee_id),
+				CONSTRAINT FK_HOUSEKEEPING_TASK_ON_ROOM FOREIGN KEY (room_id) REFERENCES room (id),
+				CONSTRAINT FK_HOUSEKEEPING_TASK_ON_RESERVATION FOREIGN KEY (reservation_id) REFERENCES reservation (id),
+				CONSTRAINT FK_HOUSEKEEPING_TASK_ON_ASSIGNEE FOREIGN KEY (assignee_id) REFERENCES user (id),
+				CONSTRAINT FK_HOUSEKEEPING_TASK_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_HOUSEKEEPING_TASK_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec("DROP TABLE IF EXISTS housekeeping_task").Error; err != nil {
+			return err
+		}
+		return db.Exec("ALTER TABLE room DROP COLUMN housekeeping_status").Error
+	},
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration017AddMaintenanceTickets creates the maintenance_ticket table for room repair tracking.
+var Migration017AddMaintenanceTickets = Migration{
+	ID:          "017_add_maintenance_tickets",
+	Description: "Create maintenance_ticket table",
+	Up: func(db *gorm.DB) error {
+		return db.Exec(`
+			CREATE TABLE maintenance_ticket (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				room_id BIGINT NOT NULL,
+				description VARCHAR(500) NOT NULL,
+				priority TINYINT NOT NULL,
+				status TINYINT NOT NULL,
+				assignee_id BIGINT NULL,
+				expected_return_date DATE NULL,
+				resolution VARCHAR(500) NOT NULL DEFAULT '',
+				resolved_at DATETIME NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_maintenance_ticket_room_status (room_id, status),
+				INDEX idx_maintenance_ticket_assignee_id (assignee_id),
+				CONSTRAINT FK_MAINTENANCE_TICKET_ON_ROOM FOREIGN KEY (room_id) REFERENCES room (id),
+				CONSTRAINT FK_MAINTENANCE_TICKET_ON_ASSIGNEE FOREIGN KEY (assignee_id) REFERENCES user (id),
+				CONSTRAINT FK_MAINTENANCE_TICKET_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_MAINTENANCE_TICKET_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		return db.Exec("DROP TABLE IF EXISTS maintenance_ticket").Error
+	},
+}
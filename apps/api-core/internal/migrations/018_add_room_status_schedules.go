@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration018AddRoomStatusSchedules creates the room_status_schedule table for planned room status periods.
+var Migration018AddRoomStatusSchedules = Migration{
+	ID:          "018_add_room_status_schedules",
+	Description: "Create room_status_schedule table",
+	Up: func(db *gorm.DB) error {
+		return db.Exec(`
+			CREATE TABLE room_status_schedule (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				room_id BIGINT NOT NULL,
+				status TINYINT NOT NULL,
+				start_date DATE NOT NULL,
+				end_date DATE NOT NULL,
+				note VARCHAR(200) NOT NULL DEFAULT '',
+				previous_status TINYINT NULL,
+				applied_at DATETIME NULL,
+				reverted_at DATETIME NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_room_status_schedule_room_dates (room_id, start_date, end_date),
+				INDEX idx_room_status_schedule_dates (start_date, end_date),
+				CONSTRAINT FK_ROOM_STATUS_SCHEDULE_ON_ROOM FOREIGN KEY (room_id) REFERENCES room (id),
+				CONSTRAINT FK_ROOM_STATUS_SCHEDULE_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_ROOM_STATUS_SCHEDULE_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		return db.Exec("DROP TABLE IF EXISTS room_status_schedule").Error
+	},
+}
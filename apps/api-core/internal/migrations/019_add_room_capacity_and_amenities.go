@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration019AddRoomCapacityAndAmenities adds standard/max occupancy to room groups (with per-room overrides)
+// and creates the amenity catalogue with its room group and room link tables.
+// Existing room groups start with 0 (not configured), so existing reservations are not rejected until occupancy is set.
+var Migration019AddRoomCapacityAndAmenities = Migration{
+	ID:          "019_add_room_capacity_and_amenities",
+	Description: "Add room group/room occupancy and create amenity, room_group_amenity, room_amenity tables",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE room_group
+				ADD COLUMN standard_occupancy INT NOT NULL DEFAULT 0 AFTER description,
+				ADD COLUMN max_occupancy INT NOT NULL DEFAULT 0 AFTER standard_occupancy;
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			ALTER TABLE room
+				ADD COLUMN standard_occupancy INT NULL AFTER housekeeping_status,
+				ADD COLUMN max_occupancy INT NULL AFTER standard_occupancy;
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			CREATE TABLE amenity (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				code VARCHAR(30) NOT NULL,
+				name VARCHAR(50) NOT NULL,
+				category TINYINT NOT NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				UNIQUE KEY uc_amenity_code (code, deleted_at),
+				CONSTRAINT FK_AMENITY_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_AMENITY_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			CREATE TABLE room_group_amenity (
+				room_group_id BIGINT NOT NULL,
+				amenity_id BIGINT NOT NULL,
+				PRIMARY KEY (room_group_id, amenity_id),
+				INDEX idx_room_group_amenity_amenity_id (amenity_id),
+				CONSTRAINT FK_ROOM_GROUP_AMENITY_ON_ROOM_GROUP FOREIGN KEY (room_group_id) REFERENCES room_group (id),
+				CONSTRAINT FK_ROOM_GROUP_AMENITY_ON_AMENITY FOREIGN KEY (amenity_id) REFERENCES amenity (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			CREATE TABLE room_amenity (
+				room_id BIGINT NOT NULL,
+				amenity_id BIGINT NOT NULL,
+				PRIMARY KEY (room_id, amenity_id),
+				INDEX idx_room_amenity_amenity_id (amenity_id),
+				CONSTRAINT FK_ROOM_AMENITY_ON_ROOM FOREIGN KEY (room_id) REFERENCES room (id),
+				CONSTRAINT FK_ROOM_AMENITY_ON_AMENITY FOREIGN KEY (amenity_id) REFERENCES amenity (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		for _, table := range []string{"room_amenity", "room_group_amenity", "amenity"} {
+			if err := db.Exec("DROP TABLE IF EXISTS " + table).Error; err != nil {
+				return err
+			}
+		}
+		if err := db.Exec("ALTER TABLE room DROP COLUMN max_occupancy, DROP COLUMN standard_occupancy").Error; err != nil {
+			return err
+		}
+		return db.Exec("ALTER TABLE room_group DROP COLUMN max_occupancy, DROP COLUMN standard_occupancy").Error
+	},
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration020AddReservationRoomStayPeriod gives each reservation_room its own stay period so a reservation can
+// move rooms mid-stay. Existing rows are backfilled with the reservation's stay period.
+var Migration020AddReservationRoomStayPeriod = Migration{
+	ID:          "020_add_reservation_room_stay_period",
+	Description: "Add stay_start_at, stay_end_at to reservation_room and backfill from reservation",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE reservation_room
+				ADD COLUMN stay_start_at DATE NULL AFTER room_id,
+				ADD COLUMN stay_end_at DATE NULL AFTER stay_start_at
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			UPDATE reservation_room
+			JOIN reservation ON reservation.id = reservation_room.reservation_id
+			SET reservation_room.stay_start_at = reservation.stay_start_at,
+				reservation_room.stay_end_at = reservation.stay_end_at
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			ALTER TABLE reservation_room
+				MODIFY COLUMN stay_start_at DATE NOT NULL,
+				MODIFY COLUMN stay_end_at DATE NOT NULL,
+				ADD INDEX idx_reservation_room_room_id_stay (room_id, stay_start_at, stay_end_at)
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		return db.Exec(`
+			ALTER TABLE reservation_room
+				DROP INDEX idx_reservation_room_room_id_stay,
+				DROP COLUMN stay_end_at,
+				DROP COLUMN stay_start_at
+		`).Error
+	},
+}
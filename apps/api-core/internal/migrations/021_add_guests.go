@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration021AddGuests creates the guest (CRM) table and links reservations to it.
+// Existing reservations are grouped by phone number with non-digits stripped; each group becomes one guest
+// named after its most recent reservation. Reservations without a phone number stay unlinked.
+var Migration021AddGuests = Migration{
+	ID:          "021_add_guests",
+	Description: "Create guest table, add reservation.guest_id and backfill guests from reservation phone numbers",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			CREATE TABLE guest (
+				id BIGINT PRIMARY KEY AUTO_INCREMENT,
+				name VARCHAR(30) NOT NULL,
+				phone VARCHAR(15) NOT NULL,
+				email VARCHAR(100) NULL,
+				note VARCHAR(500) NOT NULL DEFAULT '',
+				is_vip BOOLEAN NOT NULL DEFAULT FALSE,
+				is_blacklisted BOOLEAN NOT NULL DEFAULT FALSE,
+				marketing_consent BOOLEAN NOT NULL DEFAULT FALSE,
+				marketing_consent_at DATETIME NULL,
+				created_at DATETIME NOT NULL,
+				updated_at DATETIME NOT NULL,
+				deleted_at DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00',
+				created_by BIGINT NOT NULL,
+				updated_by BIGINT NOT NULL,
+				INDEX idx_guest_phone (phone, deleted_at),
+				INDEX idx_guest_name (name),
+				CONSTRAINT FK_GUEST_ON_CREATED_BY FOREIGN KEY (created_by) REFERENCES user (id),
+				CONSTRAINT FK_GUEST_ON_UPDATED_BY FOREIGN KEY (updated_by) REFERENCES user (id)
+			) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			ALTER TABLE reservation
+				ADD COLUMN guest_id BIGINT NULL AFTER room_group_id,
+				ADD INDEX idx_reservation_guest_id (guest_id),
+				ADD CONSTRAINT FK_RESERVATION_ON_GUEST FOREIGN KEY (guest_id) REFERENCES guest (id);
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			INSERT INTO guest (name, phone, created_at, updated_at, created_by, updated_by)
+			SELECT r.name, REGEXP_REPLACE(r.phone, '[^0-9]', ''), NOW(), NOW(), r.created_by, r.created_by
+			FROM reservation r
+			JOIN (
+				SELECT MAX(id) AS id
+				FROM reservation
+				WHERE deleted_at = '1970-01-01 00:00:00' AND REGEXP_REPLACE(phone, '[^0-9]', '') <> ''
+				GROUP BY REGEXP_REPLACE(phone, '[^0-9]', '')
+			) latest ON latest.id = r.id;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			UPDATE reservation r
+			JOIN guest g ON g.phone = REGEXP_REPLACE(r.phone, '[^0-9]', '') AND g.deleted_at = '1970-01-01 00:00:00'
+			SET r.guest_id = g.id
+			WHERE r.deleted_at = '1970-01-01 00:00:00';
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE reservation
+				DROP FOREIGN KEY FK_RESERVATION_ON_GUEST,
+				DROP INDEX idx_reservation_guest_id,
+				DROP COLUMN guest_id;
+		`).Error; err != nil {
+			return err
+		}
+		return db.Exec("DROP TABLE IF EXISTS guest").Error
+	},
+}
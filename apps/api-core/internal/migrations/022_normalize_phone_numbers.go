@@ -0,0 +1,102 @@
+package migrations
+
+import (
+	"fmt"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+// Migration022NormalizePhoneNumbers rewrites reservation and guest phone numbers in the canonical Korean format
+// ("010-1234-5678", "02-123-4567", "1588-1234"). Numbers that cannot be parsed are left unchanged and reported.
+// Guests backfilled in 021 were grouped by raw digits, so "+82 10 ..." and "010 ..." could become two guests;
+// guests sharing a phone number after normalization are merged into the oldest one.
+var Migration022NormalizePhoneNumbers = Migration{
+	ID:          "022_normalize_phone_numbers",
+	Description: "Normalize reservation and guest phone numbers to the canonical Korean format and merge duplicate guests",
+	Up: func(db *gorm.DB) error {
+		for _, table := range []string{"reservation", "guest"} {
+			if err := normalizePhoneColumn(db, table); err != nil {
+				return err
+			}
+		}
+		return mergeGuestsByPhone(db)
+	},
+	Down: func(db *gorm.DB) error {
+		// The original formatting is not kept and merged guests cannot be split again — cannot be restored
+		return nil
+	},
+}
+
+type phoneRow struct {
+	ID    uint
+	Phone string
+}
+
+func normalizePhoneColumn(db *gorm.DB, table string) error {
+	var rows []phoneRow
+	if err := db.Raw(fmt.Sprintf("SELECT id, phone FROM `%s` WHERE phone <> ''", table)).Scan(&rows).Error; err != nil {
+		return fmt.Errorf("failed to read %s phone numbers: %w", table, err)
+	}
+
+	normalized, unparsed := 0, 0
+	for _, row := range rows {
+		canonical, ok := models.CanonicalPhone(row.Phone)
+		if !ok {
+			fmt.Printf("[WARNING] %s #%d: phone %q could not be normalized and was left unchanged.\n", table, row.ID, row.Phone)
+			unparsed++
+			continue
+		}
+		if canonical == row.Phone {
+			continue
+		}
+		if err := db.Exec(fmt.Sprintf("UPDATE `%s` SET phone = ? WHERE id = ?", table), canonical, row.ID).Error; err != nil {
+			return fmt.Errorf("failed to normalize %s #%d phone: %w", table, row.ID, err)
+		}
+		normalized++
+	}
+
+	fmt.Printf("Normalized %d %s phone numbers (%d could not be parsed).\n", normalized, table, unparsed)
+	return nil
+}
+
+func mergeGuestsByPhone(db *gorm.DB) error {
+	const keepers = `
+		SELECT phone, MIN(id) AS keep_id, MAX(is_vip) AS is_vip, MAX(is_blacklisted) AS is_blacklisted
+		FROM guest
+		WHERE deleted_at = '1970-01-01 00:00:00'
+		GROUP BY phone
+		HAVING COUNT(*) > 1`
+
+	if err := db.Exec(`
+		UPDATE guest g
+		JOIN (` + keepers + `) k ON k.keep_id = g.id
+		SET g.is_vip = k.is_vip, g.is_blacklisted = k.is_blacklisted
+	`).Error; err != nil {
+		return fmt.Errorf("failed to carry over merged guest flags: %w", err)
+	}
+
+	if err := db.Exec(`
+		UPDATE reservation r
+		JOIN guest g ON g.id = r.guest_id AND g.deleted_at = '1970-01-01 00:00:00'
+		JOIN (` + keepers + `) k ON k.phone = g.phone
+		SET r.guest_id = k.keep_id
+		WHERE g.id <> k.keep_id
+	`).Error; err != nil {
+		return fmt.Errorf("failed to relink reservations of merged guests: %w", err)
+	}
+
+	result := db.Exec(`
+		UPDATE guest g
+		JOIN (` + keepers + `) k ON k.phone = g.phone
+		SET g.deleted_at = NOW()
+		WHERE g.id <> k.keep_id AND g.deleted_at = '1970-01-01 00:00:00'
+	`)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete merged guests: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		fmt.Printf("Merged %d guests that share a phone number after normalization.\n", result.RowsAffected)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration023DropPricingRuleBasePeopleCount removes base_people_count from pricing_rule.
+// Extra person fees now charge guests above the room standard occupancy (room group standard_occupancy with per-room
+// overrides), so the per-rule base people count is no longer used. Before dropping the column, room groups whose
+// standard occupancy is not configured (0) take the base people count of their enabled EXTRA_PERSON_FEE rule, preferring a rule
+// for the room group over a rule for all room groups, so existing extra person fees keep the same threshold.
+var Migration023DropPricingRuleBasePeopleCount = Migration{
+	ID:          "023_drop_pricing_rule_base_people_count",
+	Description: "Copy extra person fee base_people_count to unset room group standard_occupancy and drop pricing_rule.base_people_count",
+	Up: func(db *gorm.DB) error {
+		// type 3 = EXTRA_PERSON_FEE
+		if err := db.Exec(`
+			UPDATE room_group rg
+			JOIN (
+				SELECT room_group_id, MAX(base_people_count) AS base_people_count
+				FROM pricing_rule
+				WHERE type = 3 AND enabled = TRUE AND room_group_id IS NOT NULL AND base_people_count > 0 AND deleted_at = '1970-01-01 00:00:00'
+				GROUP BY room_group_id
+			) pr ON pr.room_group_id = rg.id
+			SET rg.standard_occupancy = pr.base_people_count
+			WHERE rg.standard_occupancy = 0;
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			UPDATE room_group rg
+			JOIN (
+				SELECT MAX(base_people_count) AS base_people_count
+				FROM pricing_rule
+				WHERE type = 3 AND enabled = TRUE AND room_group_id IS NULL AND base_people_count > 0 AND deleted_at = '1970-01-01 00:00:00'
+			) pr ON pr.base_people_count IS NOT NULL
+			SET rg.standard_occupancy = pr.base_people_count
+			WHERE rg.standard_occupancy = 0;
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec("ALTER TABLE pricing_rule DROP COLUMN base_people_count").Error
+	},
+	Down: func(db *gorm.DB) error {
+		// The copied standard occupancy cannot be told apart from values set by hand — only the column is restored
+		return db.Exec("ALTER TABLE pricing_rule ADD COLUMN base_people_count INT NOT NULL DEFAULT 0 AFTER min_nights").Error
+	},
+}
@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"fmt"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+// Migration024RecalculateReservationBrokerFees recalculates the aggregate amount columns of existing reservations
+// from the reservation_payment ledger backfilled in 010, so broker_fee is the sum of the per-payment broker fees
+// instead of the value calculated before the ledger existed.
+// Reservations already included in a broker fee settlement keep their amounts.
+var Migration024RecalculateReservationBrokerFees = Migration{
+	ID:          "024_recalculate_reservation_broker_fees",
+	Description: "Recalculate reservation amounts and broker fees from the reservation_payment ledger",
+	Up: func(db *gorm.DB) error {
+		var reservations []reservationAmountRow
+		if err := db.Raw(`
+			SELECT id, deposit, payment_amount, refund_amount, broker_fee
+			FROM reservation
+			WHERE deleted_at = '1970-01-01 00:00:00' AND broker_fee_settlement_id IS NULL
+		`).Scan(&reservations).Error; err != nil {
+			return fmt.Errorf("failed to read reservation amounts: %w", err)
+		}
+
+		var payments []models.ReservationPayment
+		if err := db.Raw(`
+			SELECT reservation_id, type, amount, broker_fee
+			FROM reservation_payment
+			WHERE deleted_at = '1970-01-01 00:00:00'
+		`).Scan(&payments).Error; err != nil {
+			return fmt.Errorf("failed to read reservation payments: %w", err)
+		}
+		paymentsByReservation := make(map[uint][]models.ReservationPayment)
+		for _, payment := range payments {
+			paymentsByReservation[payment.ReservationID] = append(paymentsByReservation[payment.ReservationID], payment)
+		}
+
+		updated := 0
+		for _, row := range reservations {
+			reservation := models.Reservation{}
+			reservation.ApplyPayments(paymentsByReservation[row.ID])
+			if reservation.Deposit == row.Deposit && reservation.PaymentAmount == row.PaymentAmount &&
+				reservation.RefundAmount == row.RefundAmount && reservation.BrokerFee == row.BrokerFee {
+				continue
+			}
+			if err := db.Exec(`
+				UPDATE reservation SET deposit = ?, payment_amount = ?, refund_amount = ?, broker_fee = ? WHERE id = ?
+			`, reservation.Deposit, reservation.PaymentAmount, reservation.RefundAmount, reservation.BrokerFee, row.ID).Error; err != nil {
+				return fmt.Errorf("failed to recalculate reservation #%d amounts: %w", row.ID, err)
+			}
+			updated++
+		}
+
+		fmt.Printf("Recalculated amounts of %d reservations from the payment ledger.\n", updated)
+		return nil
+	},
+	Down: func(db *gorm.DB) error {
+		// The previous broker_fee values are not kept — cannot be restored
+		return nil
+	},
+}
+
+type reservationAmountRow struct {
+	ID            uint
+	Deposit       int
+	PaymentAmount int
+	RefundAmount  int
+	BrokerFee     int
+}
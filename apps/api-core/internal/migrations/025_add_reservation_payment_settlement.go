@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Migration025AddReservationPaymentSettlement links broker fee settlements to individual reservation_payment rows,
+// so a settlement covers the payments of one payment method paid in its period rather than whole reservations.
+// Payments of reservations settled before this migration are linked to the reservation's settlement.
+var Migration025AddReservationPaymentSettlement = Migration{
+	ID:          "025_add_reservation_payment_settlement",
+	Description: "Add broker_fee_settlement_id to reservation_payment and payment_count to broker_fee_settlement",
+	Up: func(db *gorm.DB) error {
+		if err := db.Exec(`
+			ALTER TABLE reservation_payment
+				ADD COLUMN broker_fee_settlement_id BIGINT NULL,
+				ADD INDEX idx_reservation_payment_broker_fee_settlement_id (broker_fee_settlement_id),
+				ADD CONSTRAINT FK_RESERVATION_PAYMENT_ON_BROKER_FEE_SETTLEMENT FOREIGN KEY (broker_fee_settlement_id) REFERENCES broker_fee_settlement (id)
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			ALTER TABLE broker_fee_settlement
+				ADD COLUMN payment_count INT NOT NULL DEFAULT 0 AFTER reservation_count
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := db.Exec(`
+			UPDATE reservation_payment rp
+			JOIN reservation r ON r.id = rp.reservation_id
+			SET rp.broker_fee_settlement_id = r.broker_fee_settlement_id
+			WHERE r.broker_fee_settlement_id IS NOT NULL AND rp.deleted_at = '1970-01-01 00:00:00'
+		`).Error; err != nil {
+			return err
+		}
+
+		return db.Exec(`
+			UPDATE broker_fee_settlement s
+			SET s.payment_count = (SELECT COUNT(*) FROM reservation_payment rp WHERE rp.broker_fee_settlement_id = s.id)
+		`).Error
+	},
+	Down: func(db *gorm.DB) error {
+		if err := db.Exec("ALTER TABLE broker_fee_settlement DROP COLUMN payment_count").Error; err != nil {
+			return err
+		}
+		return db.Exec(`
+			ALTER TABLE reservation_payment
+				DROP FOREIGN KEY FK_RESERVATION_PAYMENT_ON_BROKER_FEE_SETTLEMENT,
+				DROP INDEX idx_reservation_payment_broker_fee_settlement_id,
+				DROP COLUMN broker_fee_settlement_id
+		`).Error
+	},
+}
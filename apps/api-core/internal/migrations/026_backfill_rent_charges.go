@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+// Migration026BackfillRentCharges creates the monthly rent_charge schedule for MONTHLY_RENT reservations that
+// have none, such as reservations created before 012. The schedule used to be generated when it was first viewed;
+// viewing is now read-only and charges are only created when a reservation is created or updated.
+var Migration026BackfillRentCharges = Migration{
+	ID:          "026_backfill_rent_charges",
+	Description: "Create rent_charge schedules for MONTHLY_RENT reservations without charges",
+	Up: func(db *gorm.DB) error {
+		var rows []rentReservationRow
+		if err := db.Raw(`
+			SELECT r.id, r.price, r.stay_start_at, r.stay_end_at, r.created_by
+			FROM reservation r
+			WHERE r.type = ? AND r.deleted_at = '1970-01-01 00:00:00'
+				AND NOT EXISTS (
+					SELECT 1 FROM rent_charge rc
+					WHERE rc.reservation_id = r.id AND rc.deleted_at = '1970-01-01 00:00:00'
+				)
+		`, models.ReservationTypeMonthlyRent).Scan(&rows).Error; err != nil {
+			return fmt.Errorf("failed to read monthly rent reservations: %w", err)
+		}
+
+		created := 0
+		for _, row := range rows {
+			reservation := models.Reservation{Price: row.Price, StayStartAt: row.StayStartAt, StayEndAt: row.StayEndAt}
+			reservation.ID = row.ID
+			for _, charge := range models.BuildRentCharges(&reservation, nil) {
+				if err := db.Exec(`
+					INSERT INTO rent_charge (reservation_id, sequence, period_start, period_end, due_date, amount,
+						created_at, updated_at, deleted_at, created_by, updated_by)
+					VALUES (?, ?, ?, ?, ?, ?, NOW(), NOW(), '1970-01-01 00:00:00', ?, ?)
+				`, row.ID, charge.Sequence, charge.PeriodStart, charge.PeriodEnd, charge.DueDate, charge.Amount,
+					row.CreatedBy, row.CreatedBy).Error; err != nil {
+					return fmt.Errorf("failed to create rent charges of reservation #%d: %w", row.ID, err)
+				}
+				created++
+			}
+		}
+
+		fmt.Printf("Created %d rent charges for %d monthly rent reservations.\n", created, len(rows))
+		return nil
+	},
+	Down: func(db *gorm.DB) error {
+		// Backfilled charges cannot be told apart from charges created by reservations — cannot be restored
+		return nil
+	},
+}
+
+type rentReservationRow struct {
+	ID          uint
+	Price       int
+	StayStartAt time.Time
+	StayEndAt   time.Time
+	CreatedBy   uint
+}
@@ -10,5 +10,24 @@ func AllMigrations() []Migration {
 		Migration005ConvertKstTimestampsToUtc,
 		Migration006CleanupFalsePaymentMethodAuditLogs,
 		Migration007AddDateBlocks,
+		Migration008AddSeasons,
+		Migration009AddPricingRules,
+		Migration010AddReservationPayments,
+		Migration011AddBrokerFeeSettlements,
+		Migration012AddRentCharges,
+		Migration013AddDateBlockTargets,
+		Migration014AddDateBlockRecurrence,
+		Migration015AddReservationRoomGroup,
+		Migration016AddHousekeeping,
+		Migration017AddMaintenanceTickets,
+		Migration018AddRoomStatusSchedules,
+		Migration019AddRoomCapacityAndAmenities,
+		Migration020AddReservationRoomStayPeriod,
+		Migration021AddGuests,
+		Migration022NormalizePhoneNumbers,
+		Migration023DropPricingRuleBasePeopleCount,
+		Migration024RecalculateReservationBrokerFees,
+		Migration025AddReservationPaymentSettlement,
+		Migration026BackfillRentCharges,
 	}
 }
@@ -181,6 +181,53 @@ func (_c *MockReservationRepository_DeleteRooms_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// DeleteUnpaidRentCharges provides a mock function with given fields: ctx, reservationID
+func (_m *MockReservationRepository) DeleteUnpaidRentCharges(ctx context.Context, reservationID uint) error {
+	ret := _m.Called(ctx, reservationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUnpaidRentCharges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) error); ok {
+		r0 = rf(ctx, reservationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReservationRepository_DeleteUnpaidRentCharges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUnpaidRentCharges'
+type MockReservationRepository_DeleteUnpaidRentCharges_Call struct {
+	*mock.Call
+}
+
+// DeleteUnpaidRentCharges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservationID uint
+func (_e *MockReservationRepository_Expecter) DeleteUnpaidRentCharges(ctx interface{}, reservationID interface{}) *MockReservationRepository_DeleteUnpaidRentCharges_Call {
+	return &MockReservationRepository_DeleteUnpaidRentCharges_Call{Call: _e.mock.On("DeleteUnpaidRentCharges", ctx, reservationID)}
+}
+
+func (_c *MockReservationRepository_DeleteUnpaidRentCharges_Call) Run(run func(ctx context.Context, reservationID uint)) *MockReservationRepository_DeleteUnpaidRentCharges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_DeleteUnpaidRentCharges_Call) Return(_a0 error) *MockReservationRepository_DeleteUnpaidRentCharges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReservationRepository_DeleteUnpaidRentCharges_Call) RunAndReturn(run func(context.Context, uint) error) *MockReservationRepository_DeleteUnpaidRentCharges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindAll provides a mock function with given fields: ctx, filter, offset, limit, sort
 func (_m *MockReservationRepository) FindAll(ctx context.Context, filter dto.ReservationRepositoryFilter, offset int, limit int, sort string) ([]models.Reservation, int64, error) {
 	ret := _m.Called(ctx, filter, offset, limit, sort)
@@ -368,6 +415,65 @@ func (_c *MockReservationRepository_FindByIDWithDetails_Call) RunAndReturn(run f
 	return _c
 }
 
+// FindDuplicateCandidates provides a mock function with given fields: ctx, filter
+func (_m *MockReservationRepository) FindDuplicateCandidates(ctx context.Context, filter dto.DuplicateCandidateFilter) ([]models.Reservation, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicateCandidates")
+	}
+
+	var r0 []models.Reservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.DuplicateCandidateFilter) ([]models.Reservation, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.DuplicateCandidateFilter) []models.Reservation); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.DuplicateCandidateFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReservationRepository_FindDuplicateCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicateCandidates'
+type MockReservationRepository_FindDuplicateCandidates_Call struct {
+	*mock.Call
+}
+
+// FindDuplicateCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter dto.DuplicateCandidateFilter
+func (_e *MockReservationRepository_Expecter) FindDuplicateCandidates(ctx interface{}, filter interface{}) *MockReservationRepository_FindDuplicateCandidates_Call {
+	return &MockReservationRepository_FindDuplicateCandidates_Call{Call: _e.mock.On("FindDuplicateCandidates", ctx, filter)}
+}
+
+func (_c *MockReservationRepository_FindDuplicateCandidates_Call) Run(run func(ctx context.Context, filter dto.DuplicateCandidateFilter)) *MockReservationRepository_FindDuplicateCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.DuplicateCandidateFilter))
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_FindDuplicateCandidates_Call) Return(_a0 []models.Reservation, _a1 error) *MockReservationRepository_FindDuplicateCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReservationRepository_FindDuplicateCandidates_Call) RunAndReturn(run func(context.Context, dto.DuplicateCandidateFilter) ([]models.Reservation, error)) *MockReservationRepository_FindDuplicateCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLastReservationForRoom provides a mock function with given fields: ctx, roomID
 func (_m *MockReservationRepository) FindLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error) {
 	ret := _m.Called(ctx, roomID)
@@ -427,6 +533,75 @@ func (_c *MockReservationRepository_FindLastReservationForRoom_Call) RunAndRetur
 	return _c
 }
 
+// FindReceivables provides a mock function with given fields: ctx, filter, offset, limit, sort
+func (_m *MockReservationRepository) FindReceivables(ctx context.Context, filter dto.ReceivableFilter, offset int, limit int, sort string) ([]models.Reservation, int64, error) {
+	ret := _m.Called(ctx, filter, offset, limit, sort)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReceivables")
+	}
+
+	var r0 []models.Reservation
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ReceivableFilter, int, int, string) ([]models.Reservation, int64, error)); ok {
+		return rf(ctx, filter, offset, limit, sort)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ReceivableFilter, int, int, string) []models.Reservation); ok {
+		r0 = rf(ctx, filter, offset, limit, sort)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ReceivableFilter, int, int, string) int64); ok {
+		r1 = rf(ctx, filter, offset, limit, sort)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, dto.ReceivableFilter, int, int, string) error); ok {
+		r2 = rf(ctx, filter, offset, limit, sort)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockReservationRepository_FindReceivables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindReceivables'
+type MockReservationRepository_FindReceivables_Call struct {
+	*mock.Call
+}
+
+// FindReceivables is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter dto.ReceivableFilter
+//   - offset int
+//   - limit int
+//   - sort string
+func (_e *MockReservationRepository_Expecter) FindReceivables(ctx interface{}, filter interface{}, offset interface{}, limit interface{}, sort interface{}) *MockReservationRepository_FindReceivables_Call {
+	return &MockReservationRepository_FindReceivables_Call{Call: _e.mock.On("FindReceivables", ctx, filter, offset, limit, sort)}
+}
+
+func (_c *MockReservationRepository_FindReceivables_Call) Run(run func(ctx context.Context, filter dto.ReceivableFilter, offset int, limit int, sort string)) *MockReservationRepository_FindReceivables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dto.ReceivableFilter), args[2].(int), args[3].(int), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_FindReceivables_Call) Return(_a0 []models.Reservation, _a1 int64, _a2 error) *MockReservationRepository_FindReceivables_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockReservationRepository_FindReceivables_Call) RunAndReturn(run func(context.Context, dto.ReceivableFilter, int, int, string) ([]models.Reservation, int64, error)) *MockReservationRepository_FindReceivables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatistics provides a mock function with given fields: ctx, startDate, endDate, periodType
 func (_m *MockReservationRepository) GetStatistics(ctx context.Context, startDate time.Time, endDate time.Time, periodType string) ([]repositories.ReservationStatistics, error) {
 	ret := _m.Called(ctx, startDate, endDate, periodType)
@@ -488,6 +663,53 @@ func (_c *MockReservationRepository_GetStatistics_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// Transaction provides a mock function with given fields: ctx, fn
+func (_m *MockReservationRepository) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReservationRepository_Transaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transaction'
+type MockReservationRepository_Transaction_Call struct {
+	*mock.Call
+}
+
+// Transaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *MockReservationRepository_Expecter) Transaction(ctx interface{}, fn interface{}) *MockReservationRepository_Transaction_Call {
+	return &MockReservationRepository_Transaction_Call{Call: _e.mock.On("Transaction", ctx, fn)}
+}
+
+func (_c *MockReservationRepository_Transaction_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *MockReservationRepository_Transaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Transaction_Call) Return(_a0 error) *MockReservationRepository_Transaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReservationRepository_Transaction_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *MockReservationRepository_Transaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, reservation
 func (_m *MockReservationRepository) Update(ctx context.Context, reservation *models.Reservation) error {
 	ret := _m.Called(ctx, reservation)
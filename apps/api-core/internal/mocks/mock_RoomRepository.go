@@ -616,6 +616,53 @@ func (_c *MockRoomRepository_IsRoomAvailable_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// LockRooms provides a mock function with given fields: ctx, roomIDs
+func (_m *MockRoomRepository) LockRooms(ctx context.Context, roomIDs []uint) error {
+	ret := _m.Called(ctx, roomIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRooms")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint) error); ok {
+		r0 = rf(ctx, roomIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRoomRepository_LockRooms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockRooms'
+type MockRoomRepository_LockRooms_Call struct {
+	*mock.Call
+}
+
+// LockRooms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomIDs []uint
+func (_e *MockRoomRepository_Expecter) LockRooms(ctx interface{}, roomIDs interface{}) *MockRoomRepository_LockRooms_Call {
+	return &MockRoomRepository_LockRooms_Call{Call: _e.mock.On("LockRooms", ctx, roomIDs)}
+}
+
+func (_c *MockRoomRepository_LockRooms_Call) Run(run func(ctx context.Context, roomIDs []uint)) *MockRoomRepository_LockRooms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint))
+	})
+	return _c
+}
+
+func (_c *MockRoomRepository_LockRooms_Call) Return(_a0 error) *MockRoomRepository_LockRooms_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRoomRepository_LockRooms_Call) RunAndReturn(run func(context.Context, []uint) error) *MockRoomRepository_LockRooms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, room
 func (_m *MockRoomRepository) Update(ctx context.Context, room *models.Room) error {
 	ret := _m.Called(ctx, room)
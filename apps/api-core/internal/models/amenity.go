@@ -0,0 +1,109 @@
+package models
+
+import (
+	"database/sql/driver"
+)
+
+// AmenityCategory는 객실 편의시설/속성의 종류입니다.
+type AmenityCategory int8
+
+const (
+	AmenityCategoryView     AmenityCategory = 1 // 전망 (오션뷰 등)
+	AmenityCategoryFacility AmenityCategory = 2 // 시설 (주방, 욕조 등)
+	AmenityCategoryPolicy   AmenityCategory = 3 // 이용 조건 (반려동물 동반 등)
+	AmenityCategoryBed      AmenityCategory = 4 // 침대 구성
+)
+
+func (c AmenityCategory) String() string {
+	switch c {
+	case AmenityCategoryView:
+		return "VIEW"
+	case AmenityCategoryFacility:
+		return "FACILITY"
+	case AmenityCategoryPolicy:
+		return "POLICY"
+	case AmenityCategoryBed:
+		return "BED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseAmenityCategory는 문자열을 편의시설 종류로 변환합니다.
+func ParseAmenityCategory(value string) (AmenityCategory, bool) {
+	for _, c := range []AmenityCategory{
+		AmenityCategoryView,
+		AmenityCategoryFacility,
+		AmenityCategoryPolicy,
+		AmenityCategoryBed,
+	} {
+		if c.String() == value {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+func (c AmenityCategory) Value() (driver.Value, error) {
+	return int64(c), nil
+}
+
+func (c *AmenityCategory) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*c = AmenityCategory(v)
+	case int8:
+		*c = AmenityCategory(v)
+	default:
+		*c = 0
+	}
+	return nil
+}
+
+// Amenity는 객실 그룹과 객실에 붙이는 편의시설/속성 카탈로그 항목입니다 (예: 오션뷰, 반려동물 동반, 주방, 퀸 침대 1개).
+// 객실은 객실 그룹의 편의시설과 객실에 직접 붙인 편의시설을 모두 가진 것으로 봅니다.
+type Amenity struct {
+	BaseMustAuditEntity
+	Code          string          `gorm:"type:varchar(30);not null;uniqueIndex:uc_amenity_code,where:deleted_at = '1970-01-01 00:00:00'" json:"code"`
+	Name          string          `gorm:"type:varchar(50);not null" json:"name"`
+	Category      AmenityCategory `gorm:"type:tinyint;not null" json:"category"`
+	CreatedByUser *User           `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
+	UpdatedByUser *User           `gorm:"foreignKey:UpdatedBy" json:"updatedBy,omitempty"`
+}
+
+func (Amenity) TableName() string {
+	return "amenity"
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (a *Amenity) GetAuditEntityType() string {
+	return "amenity"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (a *Amenity) GetAuditEntityID() uint {
+	return a.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (a *Amenity) GetAuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        a.ID,
+		"code":      a.Code,
+		"name":      a.Name,
+		"category":  a.Category.String(),
+		"createdBy": a.CreatedBy,
+		"updatedBy": a.UpdatedBy,
+		"createdAt": a.CreatedAt,
+		"updatedAt": a.UpdatedAt,
+	}
+}
+
+// amenityIDs는 편의시설 목록의 ID 집합입니다.
+func amenityIDs(amenities []Amenity) map[uint]bool {
+	ids := make(map[uint]bool, len(amenities))
+	for _, amenity := range amenities {
+		ids[amenity.ID] = true
+	}
+	return ids
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BrokerFeeSettlement는 결제 수단(예약 플랫폼)별로 한 기간(입금일 기준)의 중개 수수료를 정산 완료 처리한 기록입니다.
+// 정산 시점의 합계를 저장하며, 포함된 결제 내역과 그 예약은 BrokerFeeSettlementID로 연결되어 금액을 수정할 수 없습니다.
+type BrokerFeeSettlement struct {
+	BaseMustAuditEntity
+	PaymentMethodID  uint           `gorm:"column:payment_method_id;not null" json:"paymentMethodId"`
+	PaymentMethod    *PaymentMethod `gorm:"foreignKey:PaymentMethodID" json:"paymentMethod,omitempty"`
+	PeriodStart      time.Time      `gorm:"column:period_start;type:date;not null" json:"periodStart"`
+	PeriodEnd        time.Time      `gorm:"column:period_end;type:date;not null" json:"periodEnd"`
+	ReservationCount int            `gorm:"column:reservation_count;not null;default:0" json:"reservationCount"`
+	PaymentCount     int            `gorm:"column:payment_count;not null;default:0" json:"paymentCount"`
+	GrossPrice       int            `gorm:"column:gross_price;not null;default:0" json:"grossPrice"`
+	PaidAmount       int            `gorm:"column:paid_amount;not null;default:0" json:"paidAmount"`
+	RefundAmount     int            `gorm:"column:refund_amount;not null;default:0" json:"refundAmount"`
+	BrokerFee        int            `gorm:"column:broker_fee;not null;default:0" json:"brokerFee"`
+	NetPayout        int            `gorm:"column:net_payout;not null;default:0" json:"netPayout"`
+	Note             string         `gorm:"type:varchar(200)" json:"note"`
+	CreatedByUser    *User          `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
+}
+
+func (BrokerFeeSettlement) TableName() string {
+	return "broker_fee_settlement"
+}
+
+func (s *BrokerFeeSettlement) BeforeCreate(tx *gorm.DB) error {
+	if err := s.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (s *BrokerFeeSettlement) GetAuditEntityType() string {
+	return "broker_fee_settlement"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (s *BrokerFeeSettlement) GetAuditEntityID() uint {
+	return s.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (s *BrokerFeeSettlement) GetAuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"id":               s.ID,
+		"paymentMethodId":  s.PaymentMethodID,
+		"periodStart":      s.PeriodStart.Format("2006-01-02"),
+		"periodEnd":        s.PeriodEnd.Format("2006-01-02"),
+		"reservationCount": s.ReservationCount,
+		"paymentCount":     s.PaymentCount,
+		"grossPrice":       s.GrossPrice,
+		"paidAmount":       s.PaidAmount,
+		"refundAmount":     s.RefundAmount,
+		"brokerFee":        s.BrokerFee,
+		"netPayout":        s.NetPayout,
+		"note":             s.Note,
+		"createdBy":        s.CreatedBy,
+		"updatedBy":        s.UpdatedBy,
+		"createdAt":        s.CreatedAt,
+		"updatedAt":        s.UpdatedAt,
+	}
+}
+
+// BrokerFeeSettlementSummary는 결제 내역을 결제 수단별, 입금일 기간별로 합산한 정산 합계입니다.
+// GrossPrice는 기간에 결제 내역이 있는 예약의 판매 금액 합계이며, 여러 기간에 나눠 결제한 예약은 각 기간에 들어갑니다.
+// SettledCount는 정산에 포함된 결제 내역 수입니다.
+// NetPayout은 받은 금액(예약금 + 결제 금액)에서 환불 금액과 중개 수수료를 뺀 실수령액입니다.
+type BrokerFeeSettlementSummary struct {
+	Period            string
+	PaymentMethodID   uint
+	PaymentMethodName string
+	ReservationCount  int
+	PaymentCount      int
+	SettledCount      int
+	GrossPrice        int
+	PaidAmount        int
+	RefundAmount      int
+	BrokerFee         int
+}
+
+func (s BrokerFeeSettlementSummary) NetPayout() int {
+	return s.PaidAmount - s.RefundAmount - s.BrokerFee
+}
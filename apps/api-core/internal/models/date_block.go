@@ -1,24 +1,109 @@
 package models
 
 import (
+	"database/sql/driver"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+type DateBlockRecurrence int8
+
+const (
+	DateBlockRecurrenceNone    DateBlockRecurrence = 0
+	DateBlockRecurrenceWeekly  DateBlockRecurrence = 1
+	DateBlockRecurrenceMonthly DateBlockRecurrence = 2
+	DateBlockRecurrenceYearly  DateBlockRecurrence = 3
+)
+
+func (r DateBlockRecurrence) String() string {
+	switch r {
+	case DateBlockRecurrenceNone:
+		return "NONE"
+	case DateBlockRecurrenceWeekly:
+		return "WEEKLY"
+	case DateBlockRecurrenceMonthly:
+		return "MONTHLY"
+	case DateBlockRecurrenceYearly:
+		return "YEARLY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseDateBlockRecurrence는 문자열을 날짜 차단 반복 규칙으로 변환합니다.
+func ParseDateBlockRecurrence(value string) (DateBlockRecurrence, bool) {
+	for _, r := range []DateBlockRecurrence{
+		DateBlockRecurrenceNone,
+		DateBlockRecurrenceWeekly,
+		DateBlockRecurrenceMonthly,
+		DateBlockRecurrenceYearly,
+	} {
+		if r.String() == value {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+func (r DateBlockRecurrence) Value() (driver.Value, error) {
+	return int64(r), nil
+}
+
+func (r *DateBlockRecurrence) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*r = DateBlockRecurrence(v)
+	case int8:
+		*r = DateBlockRecurrence(v)
+	default:
+		*r = 0
+	}
+	return nil
+}
+
+// DateBlock은 예약을 받지 않는 기간입니다. 종료일을 포함합니다.
+// RoomGroups와 Rooms가 모두 비어 있으면 모든 객실을 차단하고, 지정하면 해당 객실 그룹에 속한 객실과
+// 지정한 객실만 차단합니다.
+//
+// Recurrence가 NONE이 아니면 StartDate ~ EndDate를 첫 회차로 매주(같은 요일), 매월(같은 일),
+// 매년(같은 월/일) 반복합니다. 해당 일이 없는 달/해(31일, 2월 29일)는 건너뜁니다.
+// RecurrenceUntil은 회차가 시작할 수 있는 마지막 날이며, Exceptions에 시작일이 있는 회차는 제외합니다.
 type DateBlock struct {
 	BaseMustAuditEntity
-	StartDate     time.Time `gorm:"column:start_date;type:date;not null" json:"startDate"`
-	EndDate       time.Time `gorm:"column:end_date;type:date;not null" json:"endDate"`
-	Reason        string    `gorm:"type:varchar(200);not null" json:"reason"`
-	CreatedByUser *User     `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
-	UpdatedByUser *User     `gorm:"foreignKey:UpdatedBy" json:"updatedBy,omitempty"`
+	StartDate     time.Time   `gorm:"column:start_date;type:date;not null" json:"startDate"`
+	EndDate       time.Time   `gorm:"column:end_date;type:date;not null" json:"endDate"`
+	Reason        string      `gorm:"type:varchar(200);not null" json:"reason"`
+	RoomGroups    []RoomGroup `gorm:"many2many:date_block_room_group;joinForeignKey:DateBlockID;joinReferences:RoomGroupID" json:"roomGroups,omitempty"`
+	Rooms         []Room      `gorm:"many2many:date_block_room;joinForeignKey:DateBlockID;joinReferences:RoomID" json:"rooms,omitempty"`
+	CreatedByUser *User       `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
+	UpdatedByUser *User       `gorm:"foreignKey:UpdatedBy" json:"updatedBy,omitempty"`
+
+	Recurrence      DateBlockRecurrence  `gorm:"type:tinyint;not null;default:0" json:"recurrence"`
+	RecurrenceUntil *time.Time           `gorm:"column:recurrence_until;type:date" json:"recurrenceUntil"`
+	Exceptions      []DateBlockException `gorm:"foreignKey:DateBlockID" json:"exceptions,omitempty"`
 }
 
 func (DateBlock) TableName() string {
 	return "date_block"
 }
 
+// DateBlockException은 반복 날짜 차단에서 제외할 회차의 시작일입니다.
+type DateBlockException struct {
+	DateBlockID   uint      `gorm:"column:date_block_id;primaryKey" json:"-"`
+	ExceptionDate time.Time `gorm:"column:exception_date;type:date;primaryKey" json:"exceptionDate"`
+}
+
+func (DateBlockException) TableName() string {
+	return "date_block_exception"
+}
+
+// DateBlockOccurrence는 날짜 차단이 실제로 적용되는 한 회차의 기간입니다. 종료일을 포함합니다.
+type DateBlockOccurrence struct {
+	StartDate time.Time
+	EndDate   time.Time
+}
+
 func (d *DateBlock) BeforeCreate(tx *gorm.DB) error {
 	if err := d.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
 		return err
@@ -26,6 +111,154 @@ func (d *DateBlock) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// AppliesToAllRooms는 객실 그룹이나 객실을 지정하지 않아 모든 객실을 차단하는지 확인합니다.
+func (d *DateBlock) AppliesToAllRooms() bool {
+	return len(d.RoomGroups) == 0 && len(d.Rooms) == 0
+}
+
+// AppliesToRoom은 날짜 차단이 해당 객실에 적용되는지 확인합니다.
+func (d *DateBlock) AppliesToRoom(roomID, roomGroupID uint) bool {
+	if d.AppliesToAllRooms() {
+		return true
+	}
+	for _, room := range d.Rooms {
+		if room.ID == roomID {
+			return true
+		}
+	}
+	for _, group := range d.RoomGroups {
+		if group.ID == roomGroupID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRecurring은 반복 날짜 차단인지 확인합니다.
+func (d *DateBlock) IsRecurring() bool {
+	return d.Recurrence != DateBlockRecurrenceNone
+}
+
+// ExceptionDates는 제외 회차 시작일 목록을 반환합니다.
+func (d *DateBlock) ExceptionDates() []time.Time {
+	dates := make([]time.Time, len(d.Exceptions))
+	for i, exception := range d.Exceptions {
+		dates[i] = exception.ExceptionDate
+	}
+	return dates
+}
+
+// Occurrences는 [from, to) 기간과 겹치는 회차를 시작일 순으로 반환합니다.
+// 반복하지 않는 차단은 기간과 겹치면 자신의 기간 하나를 반환합니다.
+func (d *DateBlock) Occurrences(from, to time.Time) []DateBlockOccurrence {
+	from = truncateToDate(from)
+	to = truncateToDate(to)
+	span := truncateToDate(d.EndDate).Sub(truncateToDate(d.StartDate))
+
+	var occurrences []DateBlockOccurrence
+	for k := d.firstOccurrenceIndex(from.Add(-span)); ; k++ {
+		start, ok := d.occurrenceStart(k)
+		if !start.Before(to) || (d.RecurrenceUntil != nil && start.After(truncateToDate(*d.RecurrenceUntil))) {
+			break
+		}
+		end := start.Add(span)
+		if ok && !end.Before(from) && !d.isException(start) {
+			occurrences = append(occurrences, DateBlockOccurrence{StartDate: start, EndDate: end})
+		}
+		if !d.IsRecurring() {
+			break
+		}
+	}
+	return occurrences
+}
+
+// occurrenceStart는 k번째(0부터) 회차의 시작일을 반환합니다.
+// 해당 일이 없는 달/해면 ok가 false이고, 반환한 날짜는 반복 종료 판단에만 사용합니다.
+func (d *DateBlock) occurrenceStart(k int) (time.Time, bool) {
+	first := truncateToDate(d.StartDate)
+	var start time.Time
+	switch d.Recurrence {
+	case DateBlockRecurrenceWeekly:
+		return first.AddDate(0, 0, 7*k), true
+	case DateBlockRecurrenceMonthly:
+		start = time.Date(first.Year(), first.Month()+time.Month(k), first.Day(), 0, 0, 0, 0, time.UTC)
+	case DateBlockRecurrenceYearly:
+		start = time.Date(first.Year()+k, first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return first, true
+	}
+	return start, start.Day() == first.Day()
+}
+
+// firstOccurrenceIndex는 시작일이 date 이후인 회차를 놓치지 않는 첫 회차 번호를 반환합니다.
+func (d *DateBlock) firstOccurrenceIndex(date time.Time) int {
+	first := truncateToDate(d.StartDate)
+	if !date.After(first) {
+		return 0
+	}
+
+	var k int
+	switch d.Recurrence {
+	case DateBlockRecurrenceWeekly:
+		k = int(date.Sub(first).Hours()/24) / 7
+	case DateBlockRecurrenceMonthly:
+		k = (date.Year()-first.Year())*12 + int(date.Month()-first.Month()) - 1
+	case DateBlockRecurrenceYearly:
+		k = date.Year() - first.Year() - 1
+	}
+	if k < 0 {
+		return 0
+	}
+	return k
+}
+
+func (d *DateBlock) isException(start time.Time) bool {
+	for _, exception := range d.Exceptions {
+		if truncateToDate(exception.ExceptionDate).Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// Conflict는 예약이 날짜 차단의 회차와 겹치는지 확인하고, 겹치면 예약 객실 중 차단 대상 객실을 반환합니다.
+// reservation.Rooms의 Room이 로드되어 있어야 하며, 모든 객실을 차단하면 객실이 없는 예약도 겹치는 것으로 봅니다.
+// 숙박 중 객실을 옮긴 예약은 객실마다 그 객실을 사용하는 구간과 회차가 겹치는지 확인합니다.
+func (d *DateBlock) Conflict(reservation *Reservation) ([]Room, bool) {
+	if len(d.Occurrences(reservation.StayStartAt, reservation.StayEndAt)) == 0 {
+		return nil, false
+	}
+
+	rooms := make([]Room, 0, len(reservation.Rooms))
+	for _, rr := range reservation.Rooms {
+		if rr.Room == nil || !d.AppliesToRoom(rr.Room.ID, rr.Room.RoomGroupID) {
+			continue
+		}
+		if start, end := rr.StayPeriod(reservation); len(d.Occurrences(start, end)) > 0 {
+			rooms = append(rooms, *rr.Room)
+		}
+	}
+	return rooms, len(rooms) > 0 || d.AppliesToAllRooms()
+}
+
+// RoomGroupIDs는 차단 대상 객실 그룹 ID 목록을 반환합니다.
+func (d *DateBlock) RoomGroupIDs() []uint {
+	roomGroupIDs := make([]uint, len(d.RoomGroups))
+	for i, group := range d.RoomGroups {
+		roomGroupIDs[i] = group.ID
+	}
+	return roomGroupIDs
+}
+
+// RoomIDs는 차단 대상 객실 ID 목록을 반환합니다.
+func (d *DateBlock) RoomIDs() []uint {
+	roomIDs := make([]uint, len(d.Rooms))
+	for i, room := range d.Rooms {
+		roomIDs[i] = room.ID
+	}
+	return roomIDs
+}
+
 // GetAuditEntityType implements audit.Auditable interface
 func (d *DateBlock) GetAuditEntityType() string {
 	return "date_block"
@@ -38,14 +271,28 @@ func (d *DateBlock) GetAuditEntityID() uint {
 
 // GetAuditFields implements audit.Auditable interface
 func (d *DateBlock) GetAuditFields() map[string]interface{} {
+	var recurrenceUntil interface{}
+	if d.RecurrenceUntil != nil {
+		recurrenceUntil = d.RecurrenceUntil.Format("2006-01-02")
+	}
+	exceptionDates := make([]string, len(d.Exceptions))
+	for i, exception := range d.Exceptions {
+		exceptionDates[i] = exception.ExceptionDate.Format("2006-01-02")
+	}
+
 	return map[string]interface{}{
-		"id":        d.ID,
-		"startDate": d.StartDate.Format("2006-01-02"),
-		"endDate":   d.EndDate.Format("2006-01-02"),
-		"reason":    d.Reason,
-		"createdBy": d.CreatedBy,
-		"updatedBy": d.UpdatedBy,
-		"createdAt": d.CreatedAt,
-		"updatedAt": d.UpdatedAt,
+		"id":              d.ID,
+		"startDate":       d.StartDate.Format("2006-01-02"),
+		"endDate":         d.EndDate.Format("2006-01-02"),
+		"reason":          d.Reason,
+		"roomGroupIds":    d.RoomGroupIDs(),
+		"roomIds":         d.RoomIDs(),
+		"recurrence":      d.Recurrence.String(),
+		"recurrenceUntil": recurrenceUntil,
+		"exceptionDates":  exceptionDates,
+		"createdBy":       d.CreatedBy,
+		"updatedBy":       d.UpdatedBy,
+		"createdAt":       d.CreatedAt,
+		"updatedAt":       d.UpdatedAt,
 	}
 }
@@ -0,0 +1,104 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func TestDateBlock_AppliesToRoom(t *testing.T) {
+	group := models.RoomGroup{}
+	group.ID = 1
+	room := models.Room{RoomGroupID: 2}
+	room.ID = 20
+
+	allRooms := &models.DateBlock{}
+	groupBlock := &models.DateBlock{RoomGroups: []models.RoomGroup{group}}
+	roomBlock := &models.DateBlock{Rooms: []models.Room{room}}
+
+	assert.True(t, allRooms.AppliesToRoom(10, 1), "대상을 지정하지 않으면 모든 객실에 적용")
+	assert.True(t, groupBlock.AppliesToRoom(10, 1))
+	assert.False(t, groupBlock.AppliesToRoom(20, 2))
+	assert.True(t, roomBlock.AppliesToRoom(20, 2))
+	assert.False(t, roomBlock.AppliesToRoom(21, 2), "같은 그룹이어도 지정하지 않은 객실은 제외")
+}
+
+func TestDateBlock_Occurrences(t *testing.T) {
+	until := date(2026, 3, 31)
+	weekly := &models.DateBlock{
+		StartDate:       date(2026, 1, 6),
+		EndDate:         date(2026, 1, 6),
+		Recurrence:      models.DateBlockRecurrenceWeekly,
+		RecurrenceUntil: &until,
+		Exceptions:      []models.DateBlockException{{ExceptionDate: date(2026, 3, 17)}},
+	}
+	monthly := &models.DateBlock{StartDate: date(2026, 1, 31), EndDate: date(2026, 1, 31), Recurrence: models.DateBlockRecurrenceMonthly}
+	yearly := &models.DateBlock{StartDate: date(2024, 12, 24), EndDate: date(2024, 12, 26), Recurrence: models.DateBlockRecurrenceYearly}
+	leapDay := &models.DateBlock{StartDate: date(2024, 2, 29), EndDate: date(2024, 2, 29), Recurrence: models.DateBlockRecurrenceYearly}
+	once := &models.DateBlock{StartDate: date(2026, 5, 1), EndDate: date(2026, 5, 3)}
+
+	startDates := func(occurrences []models.DateBlockOccurrence) []time.Time {
+		dates := make([]time.Time, len(occurrences))
+		for i, occurrence := range occurrences {
+			dates[i] = occurrence.StartDate
+		}
+		return dates
+	}
+
+	tests := []struct {
+		name     string
+		block    *models.DateBlock
+		from     time.Time
+		to       time.Time
+		expected []time.Time
+	}{
+		{"매주 반복 - 제외 회차와 종료일 이후 회차 제외", weekly, date(2026, 3, 1), date(2026, 5, 1),
+			[]time.Time{date(2026, 3, 3), date(2026, 3, 10), date(2026, 3, 24), date(2026, 3, 31)}},
+		{"매주 반복 - 첫 회차 이전 기간", weekly, date(2025, 12, 1), date(2026, 1, 6), []time.Time{}},
+		{"매월 반복 - 31일이 없는 달은 건너뜀", monthly, date(2026, 2, 1), date(2026, 6, 1),
+			[]time.Time{date(2026, 3, 31), date(2026, 5, 31)}},
+		{"매년 반복 - 여러 날 회차가 기간 시작에 걸치면 포함", yearly, date(2030, 12, 26), date(2031, 1, 1),
+			[]time.Time{date(2030, 12, 24)}},
+		{"매년 반복 - 2월 29일은 윤년에만", leapDay, date(2025, 1, 1), date(2029, 1, 1),
+			[]time.Time{date(2028, 2, 29)}},
+		{"반복하지 않으면 기간과 겹칠 때 한 번", once, date(2026, 5, 3), date(2026, 5, 10), []time.Time{date(2026, 5, 1)}},
+		{"반복하지 않으면 기간 밖은 없음", once, date(2026, 5, 4), date(2026, 5, 10), []time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, startDates(tt.block.Occurrences(tt.from, tt.to)))
+		})
+	}
+}
+
+func TestDateBlock_Conflict(t *testing.T) {
+	room101 := &models.Room{RoomGroupID: 1, Number: "101"}
+	room101.ID = 1
+	room201 := &models.Room{RoomGroupID: 2, Number: "201"}
+	room201.ID = 2
+	reservation := &models.Reservation{
+		StayStartAt: date(2026, 4, 11),
+		StayEndAt:   date(2026, 4, 13),
+		Rooms:       []models.ReservationRoom{{RoomID: 1, Room: room101}, {RoomID: 2, Room: room201}},
+	}
+
+	group := models.RoomGroup{}
+	group.ID = 2
+	groupBlock := &models.DateBlock{StartDate: date(2026, 4, 12), EndDate: date(2026, 4, 12), RoomGroups: []models.RoomGroup{group}}
+	rooms, conflict := groupBlock.Conflict(reservation)
+	assert.True(t, conflict)
+	assert.Equal(t, []models.Room{*room201}, rooms, "차단 대상 그룹의 객실만 반환")
+
+	checkOutDayBlock := &models.DateBlock{StartDate: date(2026, 4, 13), EndDate: date(2026, 4, 14)}
+	_, conflict = checkOutDayBlock.Conflict(reservation)
+	assert.False(t, conflict, "퇴실일부터 시작하는 차단은 겹치지 않음")
+
+	otherGroup := models.RoomGroup{}
+	otherGroup.ID = 3
+	otherGroupBlock := &models.DateBlock{StartDate: date(2026, 4, 12), EndDate: date(2026, 4, 12), RoomGroups: []models.RoomGroup{otherGroup}}
+	_, conflict = otherGroupBlock.Conflict(reservation)
+	assert.False(t, conflict)
+}
@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// Guest는 예약자 고객 정보입니다. 예약은 정규화한 전화번호로 같은 고객에 연결되어,
+// 재방문 고객을 알아보고 숙박 이력을 모아 볼 수 있습니다.
+type Guest struct {
+	BaseMustAuditEntity
+	Name string `gorm:"type:varchar(30);not null" json:"name"`
+	// Phone은 CanonicalPhone으로 정규화한 전화번호입니다.
+	Phone            string  `gorm:"type:varchar(15);not null;index:idx_guest_phone" json:"phone"`
+	Email            *string `gorm:"type:varchar(100)" json:"email,omitempty"`
+	Note             string  `gorm:"type:varchar(500);not null" json:"note"`
+	IsVIP            bool    `gorm:"column:is_vip;not null" json:"isVip"`
+	IsBlacklisted    bool    `gorm:"column:is_blacklisted;not null" json:"isBlacklisted"`
+	MarketingConsent bool    `gorm:"column:marketing_consent;not null" json:"marketingConsent"`
+	// MarketingConsentAt은 마케팅 수신에 마지막으로 동의한 시각이며, 동의를 철회하면 비웁니다.
+	MarketingConsentAt *time.Time `gorm:"column:marketing_consent_at" json:"marketingConsentAt,omitempty"`
+}
+
+func (Guest) TableName() string {
+	return "guest"
+}
+
+// SetMarketingConsent는 마케팅 수신 동의 여부를 바꾸고, 새로 동의하면 동의 시각을 now로 기록합니다.
+func (g *Guest) SetMarketingConsent(consent bool, now time.Time) {
+	if consent && !g.MarketingConsent {
+		g.MarketingConsentAt = &now
+	}
+	if !consent {
+		g.MarketingConsentAt = nil
+	}
+	g.MarketingConsent = consent
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (g *Guest) GetAuditEntityType() string {
+	return "guest"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (g *Guest) GetAuditEntityID() uint {
+	return g.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (g *Guest) GetAuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"id":                 g.ID,
+		"name":               g.Name,
+		"phone":              g.Phone,
+		"email":              g.Email,
+		"note":               g.Note,
+		"isVip":              g.IsVIP,
+		"isBlacklisted":      g.IsBlacklisted,
+		"marketingConsent":   g.MarketingConsent,
+		"marketingConsentAt": formatTimePtr(g.MarketingConsentAt),
+		"createdBy":          g.CreatedBy,
+		"updatedBy":          g.UpdatedBy,
+		"createdAt":          g.CreatedAt,
+		"updatedAt":          g.UpdatedAt,
+	}
+}
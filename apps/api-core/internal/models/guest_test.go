@@ -0,0 +1,27 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func TestGuest_SetMarketingConsent(t *testing.T) {
+	first := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	later := time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC)
+	guest := &models.Guest{}
+
+	guest.SetMarketingConsent(true, first)
+	assert.True(t, guest.MarketingConsent)
+	assert.Equal(t, first, *guest.MarketingConsentAt)
+
+	// 이미 동의한 고객은 동의 시각을 바꾸지 않는다
+	guest.SetMarketingConsent(true, later)
+	assert.Equal(t, first, *guest.MarketingConsentAt)
+
+	guest.SetMarketingConsent(false, later)
+	assert.False(t, guest.MarketingConsent)
+	assert.Nil(t, guest.MarketingConsentAt)
+}
@@ -0,0 +1,156 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// HousekeepingTaskType은 청소 작업의 종류입니다.
+type HousekeepingTaskType int8
+
+const (
+	// HousekeepingTaskTypeDeparture는 그날 퇴실하는 객실의 퇴실 청소입니다.
+	HousekeepingTaskTypeDeparture HousekeepingTaskType = 1
+	// HousekeepingTaskTypeStayOver는 연박 중인 객실의 중간 청소입니다.
+	HousekeepingTaskTypeStayOver HousekeepingTaskType = 2
+)
+
+func (t HousekeepingTaskType) String() string {
+	switch t {
+	case HousekeepingTaskTypeDeparture:
+		return "DEPARTURE"
+	case HousekeepingTaskTypeStayOver:
+		return "STAY_OVER"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func (t HousekeepingTaskType) Value() (driver.Value, error) {
+	return int64(t), nil
+}
+
+func (t *HousekeepingTaskType) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*t = HousekeepingTaskType(v)
+	case int8:
+		*t = HousekeepingTaskType(v)
+	default:
+		*t = 0
+	}
+	return nil
+}
+
+// HousekeepingTaskStatus는 청소 작업의 진행 상태입니다.
+type HousekeepingTaskStatus int8
+
+const (
+	HousekeepingTaskStatusPending    HousekeepingTaskStatus = 1
+	HousekeepingTaskStatusInProgress HousekeepingTaskStatus = 2
+	HousekeepingTaskStatusDone       HousekeepingTaskStatus = 3
+)
+
+func (s HousekeepingTaskStatus) String() string {
+	switch s {
+	case HousekeepingTaskStatusPending:
+		return "PENDING"
+	case HousekeepingTaskStatusInProgress:
+		return "IN_PROGRESS"
+	case HousekeepingTaskStatusDone:
+		return "DONE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseHousekeepingTaskStatus는 문자열을 청소 작업 상태로 변환합니다.
+func ParseHousekeepingTaskStatus(value string) (HousekeepingTaskStatus, bool) {
+	for _, s := range []HousekeepingTaskStatus{
+		HousekeepingTaskStatusPending,
+		HousekeepingTaskStatusInProgress,
+		HousekeepingTaskStatusDone,
+	} {
+		if s.String() == value {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func (s HousekeepingTaskStatus) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+func (s *HousekeepingTaskStatus) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*s = HousekeepingTaskStatus(v)
+	case int8:
+		*s = HousekeepingTaskStatus(v)
+	default:
+		*s = HousekeepingTaskStatusPending
+	}
+	return nil
+}
+
+// HousekeepingTask는 하루 단위의 객실 청소 작업입니다. 그날의 퇴실/연박 예약으로부터 생성되며,
+// 담당자(AssigneeID)를 지정해 진행 상태를 관리합니다. 객실과 날짜마다 작업은 하나만 생성됩니다.
+type HousekeepingTask struct {
+	BaseMustAuditEntity
+	RoomID        uint                   `gorm:"column:room_id;not null" json:"roomId"`
+	Room          *Room                  `gorm:"foreignKey:RoomID" json:"room,omitempty"`
+	ReservationID *uint                  `gorm:"column:reservation_id" json:"reservationId,omitempty"`
+	TaskDate      time.Time              `gorm:"column:task_date;type:date;not null" json:"taskDate"`
+	Type          HousekeepingTaskType   `gorm:"type:tinyint;not null" json:"type"`
+	Status        HousekeepingTaskStatus `gorm:"type:tinyint;not null" json:"status"`
+	AssigneeID    *uint                  `gorm:"column:assignee_id" json:"assigneeId,omitempty"`
+	Assignee      *User                  `gorm:"foreignKey:AssigneeID" json:"assignee,omitempty"`
+	Note          string                 `gorm:"type:varchar(200);not null" json:"note"`
+	CompletedAt   *time.Time             `gorm:"column:completed_at;type:datetime" json:"completedAt,omitempty"`
+}
+
+func (HousekeepingTask) TableName() string {
+	return "housekeeping_task"
+}
+
+func (t *HousekeepingTask) BeforeCreate(tx *gorm.DB) error {
+	if err := t.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	if t.Status == 0 {
+		t.Status = HousekeepingTaskStatusPending
+	}
+	return nil
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (t *HousekeepingTask) GetAuditEntityType() string {
+	return "housekeeping_task"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (t *HousekeepingTask) GetAuditEntityID() uint {
+	return t.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (t *HousekeepingTask) GetAuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            t.ID,
+		"roomId":        t.RoomID,
+		"reservationId": t.ReservationID,
+		"taskDate":      t.TaskDate.Format("2006-01-02"),
+		"type":          t.Type.String(),
+		"status":        t.Status.String(),
+		"assigneeId":    t.AssigneeID,
+		"note":          t.Note,
+		"completedAt":   t.CompletedAt,
+		"createdBy":     t.CreatedBy,
+		"updatedBy":     t.UpdatedBy,
+		"createdAt":     t.CreatedAt,
+		"updatedAt":     t.UpdatedAt,
+	}
+}
@@ -0,0 +1,186 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MaintenanceTicketPriority는 유지보수 티켓의 처리 우선순위입니다.
+type MaintenanceTicketPriority int8
+
+const (
+	MaintenanceTicketPriorityLow    MaintenanceTicketPriority = 1
+	MaintenanceTicketPriorityNormal MaintenanceTicketPriority = 2
+	MaintenanceTicketPriorityHigh   MaintenanceTicketPriority = 3
+	MaintenanceTicketPriorityUrgent MaintenanceTicketPriority = 4
+)
+
+func (p MaintenanceTicketPriority) String() string {
+	switch p {
+	case MaintenanceTicketPriorityLow:
+		return "LOW"
+	case MaintenanceTicketPriorityNormal:
+		return "NORMAL"
+	case MaintenanceTicketPriorityHigh:
+		return "HIGH"
+	case MaintenanceTicketPriorityUrgent:
+		return "URGENT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseMaintenanceTicketPriority는 문자열을 유지보수 티켓 우선순위로 변환합니다.
+func ParseMaintenanceTicketPriority(value string) (MaintenanceTicketPriority, bool) {
+	for _, p := range []MaintenanceTicketPriority{
+		MaintenanceTicketPriorityLow,
+		MaintenanceTicketPriorityNormal,
+		MaintenanceTicketPriorityHigh,
+		MaintenanceTicketPriorityUrgent,
+	} {
+		if p.String() == value {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+func (p MaintenanceTicketPriority) Value() (driver.Value, error) {
+	return int64(p), nil
+}
+
+func (p *MaintenanceTicketPriority) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*p = MaintenanceTicketPriority(v)
+	case int8:
+		*p = MaintenanceTicketPriority(v)
+	default:
+		*p = MaintenanceTicketPriorityNormal
+	}
+	return nil
+}
+
+// MaintenanceTicketStatus는 유지보수 티켓의 처리 상태입니다.
+type MaintenanceTicketStatus int8
+
+const (
+	MaintenanceTicketStatusOpen     MaintenanceTicketStatus = 1
+	MaintenanceTicketStatusResolved MaintenanceTicketStatus = 2
+)
+
+func (s MaintenanceTicketStatus) String() string {
+	switch s {
+	case MaintenanceTicketStatusOpen:
+		return "OPEN"
+	case MaintenanceTicketStatusResolved:
+		return "RESOLVED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseMaintenanceTicketStatus는 문자열을 유지보수 티켓 상태로 변환합니다.
+func ParseMaintenanceTicketStatus(value string) (MaintenanceTicketStatus, bool) {
+	for _, s := range []MaintenanceTicketStatus{
+		MaintenanceTicketStatusOpen,
+		MaintenanceTicketStatusResolved,
+	} {
+		if s.String() == value {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func (s MaintenanceTicketStatus) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+func (s *MaintenanceTicketStatus) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*s = MaintenanceTicketStatus(v)
+	case int8:
+		*s = MaintenanceTicketStatus(v)
+	default:
+		*s = MaintenanceTicketStatusOpen
+	}
+	return nil
+}
+
+// MaintenanceTicket은 객실의 고장/수리 내역입니다. 티켓을 열면서 객실을 DAMAGED 등 사용 불가 상태로 바꿀 수 있고,
+// 객실의 마지막 열린 티켓을 해결하면 객실이 NORMAL로 돌아옵니다. ExpectedReturnDate는 객실을 다시 쓸 수 있을 것으로
+// 예상하는 날짜이며, 비어 있으면 복구 시점을 알 수 없는 것으로 봅니다.
+type MaintenanceTicket struct {
+	BaseMustAuditEntity
+	RoomID             uint                      `gorm:"column:room_id;not null" json:"roomId"`
+	Room               *Room                     `gorm:"foreignKey:RoomID" json:"room,omitempty"`
+	Description        string                    `gorm:"type:varchar(500);not null" json:"description"`
+	Priority           MaintenanceTicketPriority `gorm:"type:tinyint;not null" json:"priority"`
+	Status             MaintenanceTicketStatus   `gorm:"type:tinyint;not null" json:"status"`
+	AssigneeID         *uint                     `gorm:"column:assignee_id" json:"assigneeId,omitempty"`
+	Assignee           *User                     `gorm:"foreignKey:AssigneeID" json:"assignee,omitempty"`
+	ExpectedReturnDate *time.Time                `gorm:"column:expected_return_date;type:date" json:"expectedReturnDate,omitempty"`
+	Resolution         string                    `gorm:"type:varchar(500);not null" json:"resolution"`
+	ResolvedAt         *time.Time                `gorm:"column:resolved_at;type:datetime" json:"resolvedAt,omitempty"`
+}
+
+func (MaintenanceTicket) TableName() string {
+	return "maintenance_ticket"
+}
+
+func (t *MaintenanceTicket) BeforeCreate(tx *gorm.DB) error {
+	if err := t.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	if t.Status == 0 {
+		t.Status = MaintenanceTicketStatusOpen
+	}
+	if t.Priority == 0 {
+		t.Priority = MaintenanceTicketPriorityNormal
+	}
+	return nil
+}
+
+// IsOpen은 아직 해결하지 않은 티켓인지 확인합니다.
+func (t *MaintenanceTicket) IsOpen() bool {
+	return t.Status == MaintenanceTicketStatusOpen
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (t *MaintenanceTicket) GetAuditEntityType() string {
+	return "maintenance_ticket"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (t *MaintenanceTicket) GetAuditEntityID() uint {
+	return t.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (t *MaintenanceTicket) GetAuditFields() map[string]interface{} {
+	var expectedReturnDate *string
+	if t.ExpectedReturnDate != nil {
+		formatted := t.ExpectedReturnDate.Format("2006-01-02")
+		expectedReturnDate = &formatted
+	}
+
+	return map[string]interface{}{
+		"id":                 t.ID,
+		"roomId":             t.RoomID,
+		"description":        t.Description,
+		"priority":           t.Priority.String(),
+		"status":             t.Status.String(),
+		"assigneeId":         t.AssigneeID,
+		"expectedReturnDate": expectedReturnDate,
+		"resolution":         t.Resolution,
+		"resolvedAt":         t.ResolvedAt,
+		"createdBy":          t.CreatedBy,
+		"updatedBy":          t.UpdatedBy,
+		"createdAt":          t.CreatedAt,
+		"updatedAt":          t.UpdatedAt,
+	}
+}
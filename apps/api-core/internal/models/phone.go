@@ -0,0 +1,90 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// koreanAreaCodes는 서울(02)을 뺀 지역 번호입니다.
+var koreanAreaCodes = []string{
+	"031", "032", "033",
+	"041", "042", "043", "044",
+	"051", "052", "053", "054", "055",
+	"061", "062", "063", "064",
+}
+
+// legacyMobilePrefixes는 010 통합 전 이동전화 식별 번호입니다. 가입자 번호가 7자리 또는 8자리입니다.
+var legacyMobilePrefixes = []string{"011", "016", "017", "018", "019"}
+
+// NormalizePhone은 전화번호에서 숫자만 남기고, 국가 번호(+82, 0082)로 시작하면 국내 번호로 바꿉니다.
+// "010-1234-5678", "01012345678", "+82 10 1234 5678"은 모두 "01012345678"이 됩니다.
+// 형식을 검증하지 않으므로 검색어나 비교용 키로 쓰고, 저장할 값은 CanonicalPhone으로 만듭니다.
+func NormalizePhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+
+	switch {
+	case strings.HasPrefix(strings.TrimSpace(phone), "+82"):
+		digits = digits[2:]
+	case strings.HasPrefix(digits, "0082"):
+		digits = digits[4:]
+	default:
+		return digits
+	}
+	// "+82 (0)10 ..."처럼 국내 번호의 0을 남겨 적은 경우도 있다
+	if !strings.HasPrefix(digits, "0") {
+		digits = "0" + digits
+	}
+	return digits
+}
+
+// phoneSearchMinDigits는 검색어를 전화번호로도 찾는 최소 숫자 개수입니다. 더 짧으면 관계없는 번호가 너무 많이 걸립니다.
+const phoneSearchMinDigits = 3
+
+// PhoneSearch는 검색어가 전화번호처럼 숫자와 기호(+ - . 공백 괄호)로만 이루어져 있으면 NormalizePhone으로 숫자만 남겨
+// 돌려줍니다. "102호 홍길동"처럼 다른 글자가 섞였거나 숫자가 phoneSearchMinDigits개보다 적으면 빈 문자열입니다.
+func PhoneSearch(query string) string {
+	for _, r := range query {
+		if !unicode.IsDigit(r) && !strings.ContainsRune("+-.() ", r) {
+			return ""
+		}
+	}
+	digits := NormalizePhone(query)
+	if len(digits) < phoneSearchMinDigits {
+		return ""
+	}
+	return digits
+}
+
+// CanonicalPhone은 한국 전화번호를 "010-1234-5678", "02-123-4567", "1588-1234" 형식으로 바꿉니다.
+// 휴대전화(010, 011, 016~019), 지역 번호 유선전화, 인터넷 전화(070), 대표 번호(15xx, 16xx, 18xx)만 받으며
+// 자릿수가 맞지 않으면 false를 반환합니다.
+func CanonicalPhone(phone string) (string, bool) {
+	digits := NormalizePhone(phone)
+
+	switch {
+	case len(digits) == 8 && (strings.HasPrefix(digits, "15") || strings.HasPrefix(digits, "16") || strings.HasPrefix(digits, "18")):
+		return digits[:4] + "-" + digits[4:], true
+	case strings.HasPrefix(digits, "02"):
+		return formatPhone(digits, 2, 9, 10)
+	case strings.HasPrefix(digits, "010"), strings.HasPrefix(digits, "070"):
+		return formatPhone(digits, 3, 11)
+	case len(digits) >= 3 && (slices.Contains(legacyMobilePrefixes, digits[:3]) || slices.Contains(koreanAreaCodes, digits[:3])):
+		return formatPhone(digits, 3, 10, 11)
+	}
+	return "", false
+}
+
+// formatPhone은 앞 prefixLen자리 식별 번호 뒤를 국번과 뒤 4자리로 나눠 하이픈으로 잇습니다. 전체 자릿수가 lengths 중 하나여야 합니다.
+func formatPhone(digits string, prefixLen int, lengths ...int) (string, bool) {
+	if !slices.Contains(lengths, len(digits)) {
+		return "", false
+	}
+	last := len(digits) - 4
+	return digits[:prefixLen] + "-" + digits[prefixLen:last] + "-" + digits[last:], true
+}
@@ -0,0 +1,79 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name     string
+		phone    string
+		expected string
+	}{
+		{"하이픈을 뺀다", "010-1234-5678", "01012345678"},
+		{"공백과 괄호를 뺀다", "(02) 123 4567", "021234567"},
+		{"+82 국가 번호를 국내 번호로 바꾼다", "+82 10 1234 5678", "01012345678"},
+		{"+82 뒤에 0을 남겨 적어도 국내 번호가 된다", "+82 (0)10-1234-5678", "01012345678"},
+		{"0082 국가 번호를 국내 번호로 바꾼다", "0082-2-123-4567", "021234567"},
+		{"숫자가 없으면 빈 문자열", "없음", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, models.NormalizePhone(tt.phone))
+		})
+	}
+}
+
+func TestPhoneSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{"전화번호 일부", "5678", "5678"},
+		{"형식을 갖춘 전화번호", "+82 10-1234", "0101234"},
+		{"숫자가 세 자리보다 적으면 찾지 않는다", "12", ""},
+		{"다른 글자가 섞이면 찾지 않는다", "102호 홍길동", ""},
+		{"숫자가 없는 검색어", "홍길동", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, models.PhoneSearch(tt.query))
+		})
+	}
+}
+
+func TestCanonicalPhone(t *testing.T) {
+	tests := []struct {
+		name     string
+		phone    string
+		expected string
+		ok       bool
+	}{
+		{"휴대전화", "01012345678", "010-1234-5678", true},
+		{"국가 번호로 적은 휴대전화", "+82 10 1234 5678", "010-1234-5678", true},
+		{"예전 휴대전화 번호", "011 123 4567", "011-123-4567", true},
+		{"서울 7자리 국번", "02 123 4567", "02-123-4567", true},
+		{"서울 8자리 국번", "0212345678", "02-1234-5678", true},
+		{"지역 번호", "031.123.4567", "031-123-4567", true},
+		{"인터넷 전화", "07012345678", "070-1234-5678", true},
+		{"대표 번호", "1588 1234", "1588-1234", true},
+		{"자릿수가 모자란 휴대전화", "010-123-456", "", false},
+		{"자릿수가 넘치는 서울 번호", "02-12345-67890", "", false},
+		{"없는 식별 번호", "099-123-4567", "", false},
+		{"숫자가 없는 값", "없음", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			phone, ok := models.CanonicalPhone(tt.phone)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, phone)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type PricingRuleType int8
+
+const (
+	PricingRuleTypeWeekendSurcharge PricingRuleType = 1
+	PricingRuleTypeLongStayDiscount PricingRuleType = 2
+	PricingRuleTypeExtraPersonFee   PricingRuleType = 3
+	PricingRuleTypeMonthlyRent      PricingRuleType = 4
+)
+
+func (t PricingRuleType) String() string {
+	switch t {
+	case PricingRuleTypeWeekendSurcharge:
+		return "WEEKEND_SURCHARGE"
+	case PricingRuleTypeLongStayDiscount:
+		return "LONG_STAY_DISCOUNT"
+	case PricingRuleTypeExtraPersonFee:
+		return "EXTRA_PERSON_FEE"
+	case PricingRuleTypeMonthlyRent:
+		return "MONTHLY_RENT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParsePricingRuleType은 문자열을 요금 규칙 유형으로 변환합니다.
+func ParsePricingRuleType(value string) (PricingRuleType, bool) {
+	for _, t := range []PricingRuleType{
+		PricingRuleTypeWeekendSurcharge,
+		PricingRuleTypeLongStayDiscount,
+		PricingRuleTypeExtraPersonFee,
+		PricingRuleTypeMonthlyRent,
+	} {
+		if t.String() == value {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+func (t PricingRuleType) Value() (driver.Value, error) {
+	return int64(t), nil
+}
+
+func (t *PricingRuleType) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*t = PricingRuleType(v)
+	case int8:
+		*t = PricingRuleType(v)
+	default:
+		*t = 0
+	}
+	return nil
+}
+
+// PricingRule은 견적 계산 시 객실 그룹 요금에 더하거나 빼는 요금 규칙입니다.
+// 유형별로 사용하는 값이 다릅니다.
+//   - WEEKEND_SURCHARGE: 금요일/토요일 숙박 1박당 Amount원 + 해당 박 요금의 Percent% 할증
+//   - LONG_STAY_DISCOUNT: MinNights박 이상 숙박 시 객실 요금의 Percent% + Amount원 할인
+//   - EXTRA_PERSON_FEE: 객실 기준 인원(Room.Occupancy)을 넘는 인원 1명, 1박당 Amount원
+//   - MONTHLY_RENT: 달방(MONTHLY_RENT) 예약에 30박당 Amount원 정액 적용
+//
+// RoomGroupID가 없으면 모든 객실 그룹에 적용됩니다.
+type PricingRule struct {
+	BaseMustAuditEntity
+	Name        string          `gorm:"type:varchar(50);not null" json:"name"`
+	Type        PricingRuleType `gorm:"type:tinyint;not null" json:"type"`
+	RoomGroupID *uint           `gorm:"column:room_group_id" json:"roomGroupId"`
+	Amount      int             `gorm:"not null;default:0" json:"amount"`
+	Percent     int             `gorm:"not null;default:0" json:"percent"`
+	MinNights   int             `gorm:"column:min_nights;not null;default:0" json:"minNights"`
+	Enabled     bool            `gorm:"not null" json:"enabled"`
+}
+
+func (PricingRule) TableName() string {
+	return "pricing_rule"
+}
+
+func (p *PricingRule) BeforeCreate(tx *gorm.DB) error {
+	if err := p.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AppliesToRoomGroup은 규칙이 해당 객실 그룹에 적용되는지 확인합니다.
+func (p *PricingRule) AppliesToRoomGroup(roomGroupID uint) bool {
+	return p.RoomGroupID == nil || *p.RoomGroupID == roomGroupID
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (p *PricingRule) GetAuditEntityType() string {
+	return "pricing_rule"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (p *PricingRule) GetAuditEntityID() uint {
+	return p.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (p *PricingRule) GetAuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"id":          p.ID,
+		"name":        p.Name,
+		"type":        p.Type.String(),
+		"roomGroupId": p.RoomGroupID,
+		"amount":      p.Amount,
+		"percent":     p.Percent,
+		"minNights":   p.MinNights,
+		"enabled":     p.Enabled,
+		"createdBy":   p.CreatedBy,
+		"updatedBy":   p.UpdatedBy,
+		"createdAt":   p.CreatedAt,
+		"updatedAt":   p.UpdatedAt,
+	}
+}
+
+// AppliedPricingRule은 예약 요금 계산에 적용된 규칙 한 건입니다.
+// Amount는 할증이면 양수, 할인이면 음수이며 RoomID가 0이면 예약 전체에 적용된 규칙입니다.
+type AppliedPricingRule struct {
+	RuleID uint   `json:"ruleId"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	RoomID uint   `json:"roomId,omitempty"`
+	Nights int    `json:"nights,omitempty"`
+	Amount int    `json:"amount"`
+}
+
+// AppliedPricingRules는 예약에 JSON 컬럼으로 저장되는 적용 규칙 목록입니다.
+type AppliedPricingRules []AppliedPricingRule
+
+func (a AppliedPricingRules) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *AppliedPricingRules) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("cannot scan type into AppliedPricingRules")
+	}
+	return json.Unmarshal(data, a)
+}
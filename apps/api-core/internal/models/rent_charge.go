@@ -0,0 +1,135 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	RentChargeStatusUnpaid  = "UNPAID"
+	RentChargeStatusPaid    = "PAID"
+	RentChargeStatusOverdue = "OVERDUE"
+)
+
+// RentCharge는 달방(MONTHLY_RENT) 예약의 한 달치 청구입니다. 기간은 [PeriodStart, PeriodEnd)이며
+// 월세는 선불이므로 기간 시작일이 납부 기한입니다. 납부 처리하면 결제 내역(ReservationPayment)과 연결됩니다.
+type RentCharge struct {
+	BaseMustAuditEntity
+	ReservationID        uint         `gorm:"column:reservation_id;not null;index" json:"reservationId"`
+	Reservation          *Reservation `gorm:"foreignKey:ReservationID" json:"-"`
+	Sequence             int          `gorm:"not null" json:"sequence"`
+	PeriodStart          time.Time    `gorm:"column:period_start;type:date;not null" json:"periodStart"`
+	PeriodEnd            time.Time    `gorm:"column:period_end;type:date;not null" json:"periodEnd"`
+	DueDate              time.Time    `gorm:"column:due_date;type:date;not null" json:"dueDate"`
+	Amount               int          `gorm:"not null" json:"amount"`
+	PaidAt               *time.Time   `gorm:"column:paid_at;type:datetime" json:"paidAt,omitempty"`
+	ReservationPaymentID *uint        `gorm:"column:reservation_payment_id" json:"reservationPaymentId,omitempty"`
+}
+
+func (RentCharge) TableName() string {
+	return "rent_charge"
+}
+
+func (c *RentCharge) BeforeCreate(tx *gorm.DB) error {
+	if err := c.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *RentCharge) IsPaid() bool {
+	return c.PaidAt != nil
+}
+
+// IsOverdue는 납부 기한이 today 이전인데 아직 납부되지 않았는지 반환합니다.
+func (c *RentCharge) IsOverdue(today time.Time) bool {
+	return !c.IsPaid() && c.DueDate.Before(truncateToDate(today))
+}
+
+func (c *RentCharge) Status(today time.Time) string {
+	switch {
+	case c.IsPaid():
+		return RentChargeStatusPaid
+	case c.IsOverdue(today):
+		return RentChargeStatusOverdue
+	default:
+		return RentChargeStatusUnpaid
+	}
+}
+
+// BuildRentCharges는 예약의 숙박 기간을 숙박 시작일 기준 한 달 단위로 나눠 청구를 만듭니다.
+// paid에 이미 납부된 청구가 있으면 같은 기간은 건너뛰고, 판매 금액에서 납부된 금액을 뺀 나머지를 남은 기간에 나눕니다.
+// 한 달이 안 되는 마지막 기간은 일수 비율만큼만 청구하며, 반올림 차액은 마지막 청구에 더합니다.
+func BuildRentCharges(reservation *Reservation, paid []RentCharge) []RentCharge {
+	paidPeriods := make(map[time.Time]bool, len(paid))
+	remaining := reservation.Price
+	for _, charge := range paid {
+		paidPeriods[truncateToDate(charge.PeriodStart)] = true
+		remaining -= charge.Amount
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	start := truncateToDate(reservation.StayStartAt)
+	end := truncateToDate(reservation.StayEndAt)
+
+	var charges []RentCharge
+	var weights []float64
+	var totalWeight float64
+	for i := 0; ; i++ {
+		periodStart := addMonths(start, i)
+		if !periodStart.Before(end) {
+			break
+		}
+		fullPeriodEnd := addMonths(start, i+1)
+		periodEnd := fullPeriodEnd
+		weight := 1.0
+		if end.Before(fullPeriodEnd) {
+			periodEnd = end
+			weight = periodEnd.Sub(periodStart).Hours() / fullPeriodEnd.Sub(periodStart).Hours()
+		}
+		if paidPeriods[periodStart] {
+			continue
+		}
+
+		charges = append(charges, RentCharge{
+			ReservationID: reservation.ID,
+			Sequence:      i + 1,
+			PeriodStart:   periodStart,
+			PeriodEnd:     periodEnd,
+			DueDate:       periodStart,
+		})
+		weights = append(weights, weight)
+		totalWeight += weight
+	}
+
+	allocated := 0
+	for i := range charges {
+		if i == len(charges)-1 {
+			charges[i].Amount = remaining - allocated
+			break
+		}
+		charges[i].Amount = int(math.Round(float64(remaining) * weights[i] / totalWeight))
+		allocated += charges[i].Amount
+	}
+
+	return charges
+}
+
+// addMonths는 date에서 months개월 뒤의 같은 날을 반환합니다. 해당 월에 같은 날이 없으면 말일로 맞춥니다. (1/31 → 2/28)
+func addMonths(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := date.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,85 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func TestBuildRentCharges(t *testing.T) {
+	t.Run("숙박 기간을 한 달 단위로 나눠 판매 금액을 똑같이 청구한다", func(t *testing.T) {
+		// Given - 3월 10일부터 6월 10일까지 900,000원 달방 예약
+		reservation := &models.Reservation{Price: 900000, StayStartAt: date(2026, 3, 10), StayEndAt: date(2026, 6, 10)}
+
+		// When
+		charges := models.BuildRentCharges(reservation, nil)
+
+		// Then - 3개월로 나뉘고 기간 시작일이 납부 기한이다
+		assert.Len(t, charges, 3)
+		for i, charge := range charges {
+			assert.Equal(t, i+1, charge.Sequence)
+			assert.Equal(t, 300000, charge.Amount)
+			assert.Equal(t, charge.PeriodStart, charge.DueDate)
+		}
+		assert.Equal(t, date(2026, 4, 10), charges[0].PeriodEnd)
+		assert.Equal(t, date(2026, 6, 10), charges[2].PeriodEnd)
+	})
+
+	t.Run("한 달이 안 되는 마지막 기간은 일수 비율만큼 청구한다", func(t *testing.T) {
+		// Given - 4월 1일부터 5월 16일까지 (한 달 + 15일)
+		reservation := &models.Reservation{Price: 450000, StayStartAt: date(2026, 4, 1), StayEndAt: date(2026, 5, 16)}
+
+		// When
+		charges := models.BuildRentCharges(reservation, nil)
+
+		// Then - 마지막 기간은 15/31 비율이며 합계는 판매 금액과 같다
+		assert.Len(t, charges, 2)
+		assert.Equal(t, date(2026, 5, 16), charges[1].PeriodEnd)
+		assert.Greater(t, charges[0].Amount, charges[1].Amount)
+		assert.Equal(t, 450000, charges[0].Amount+charges[1].Amount)
+	})
+
+	t.Run("말일에 시작하면 짧은 달은 말일로 맞춘다", func(t *testing.T) {
+		reservation := &models.Reservation{Price: 600000, StayStartAt: date(2026, 1, 31), StayEndAt: date(2026, 3, 31)}
+
+		charges := models.BuildRentCharges(reservation, nil)
+
+		assert.Len(t, charges, 2)
+		assert.Equal(t, date(2026, 2, 28), charges[0].PeriodEnd)
+		assert.Equal(t, date(2026, 2, 28), charges[1].PeriodStart)
+	})
+
+	t.Run("납부된 기간은 건너뛰고 남은 금액을 나머지 기간에 나눈다", func(t *testing.T) {
+		// Given - 첫 달 300,000원을 낸 뒤 판매 금액이 1,000,000원, 4개월로 늘어나면
+		paidAt := date(2026, 3, 10)
+		paid := []models.RentCharge{{Sequence: 1, PeriodStart: date(2026, 3, 10), Amount: 300000, PaidAt: &paidAt}}
+		reservation := &models.Reservation{Price: 1000000, StayStartAt: date(2026, 3, 10), StayEndAt: date(2026, 7, 10)}
+
+		// When
+		charges := models.BuildRentCharges(reservation, paid)
+
+		// Then - 남은 700,000원을 3개월에 나눈다
+		assert.Len(t, charges, 3)
+		assert.Equal(t, 2, charges[0].Sequence)
+		total := 0
+		for _, charge := range charges {
+			total += charge.Amount
+		}
+		assert.Equal(t, 700000, total)
+	})
+}
+
+func TestRentCharge_Status(t *testing.T) {
+	today := date(2026, 5, 1)
+
+	charge := models.RentCharge{DueDate: date(2026, 4, 10)}
+	assert.Equal(t, models.RentChargeStatusOverdue, charge.Status(today))
+
+	charge.DueDate = date(2026, 5, 1)
+	assert.Equal(t, models.RentChargeStatusUnpaid, charge.Status(today))
+
+	paidAt := date(2026, 4, 20)
+	charge.PaidAt = &paidAt
+	assert.Equal(t, models.RentChargeStatusPaid, charge.Status(today))
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"database/sql/driver"
 	"sort"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -11,22 +12,28 @@ import (
 type ReservationStatus int8
 
 const (
-	ReservationStatusRefund  ReservationStatus = -10
-	ReservationStatusCancel  ReservationStatus = -1
-	ReservationStatusPending ReservationStatus = 0
-	ReservationStatusNormal  ReservationStatus = 1
+	ReservationStatusRefund    ReservationStatus = -10
+	ReservationStatusNoShow    ReservationStatus = -5
+	ReservationStatusCancel    ReservationStatus = -1
+	ReservationStatusPending   ReservationStatus = 0
+	ReservationStatusNormal    ReservationStatus = 1
+	ReservationStatusCompleted ReservationStatus = 10
 )
 
 func (s ReservationStatus) String() string {
 	switch s {
 	case ReservationStatusRefund:
 		return "REFUND"
+	case ReservationStatusNoShow:
+		return "NO_SHOW"
 	case ReservationStatusCancel:
 		return "CANCEL"
 	case ReservationStatusPending:
 		return "PENDING"
 	case ReservationStatusNormal:
 		return "NORMAL"
+	case ReservationStatusCompleted:
+		return "COMPLETED"
 	default:
 		return "UNKNOWN"
 	}
@@ -92,25 +99,40 @@ func (t *ReservationType) Scan(value interface{}) error {
 
 type Reservation struct {
 	BaseMustAuditEntity
+	// PaymentMethodID는 결제 수단을 지정하지 않은 새 결제 내역에 쓰는 기본 결제 수단입니다.
+	// 바꿔도 이미 기록된 결제 내역의 결제 수단과 중개 수수료는 바뀌지 않습니다.
 	PaymentMethodID uint              `gorm:"column:payment_method_id;not null" json:"paymentMethodId"`
 	PaymentMethod   *PaymentMethod    `gorm:"foreignKey:PaymentMethodID" json:"paymentMethod,omitempty"`
 	Rooms           []ReservationRoom `gorm:"foreignKey:ReservationID" json:"rooms,omitempty"`
-	Name            string            `gorm:"column:name;type:varchar(30);not null" json:"name"`
-	Phone           string            `gorm:"column:phone;type:varchar(15);not null" json:"phone"`
-	PeopleCount     int               `gorm:"column:people_count;not null;default:0" json:"peopleCount"`
-	StayStartAt     time.Time         `gorm:"column:stay_start_at;type:date;not null" json:"stayStartAt"`
-	StayEndAt       time.Time         `gorm:"column:stay_end_at;type:date;not null" json:"stayEndAt"`
-	CheckInAt       *time.Time        `gorm:"column:check_in_at;type:datetime" json:"checkInAt,omitempty"`
-	CheckOutAt      *time.Time        `gorm:"column:check_out_at;type:datetime" json:"checkOutAt,omitempty"`
-	Price           int               `gorm:"not null" json:"price"`
-	Deposit         int               `gorm:"not null;default:0" json:"deposit"`
-	PaymentAmount   int               `gorm:"column:payment_amount;not null;default:0" json:"paymentAmount"`
-	RefundAmount    int               `gorm:"column:refund_amount;not null;default:0" json:"refundAmount"`
-	BrokerFee       int               `gorm:"column:broker_fee;not null;default:0" json:"brokerFee"`
-	Note            string            `gorm:"type:varchar(200)" json:"note"`
-	CanceledAt      *time.Time        `gorm:"column:canceled_at" json:"canceledAt,omitempty"`
-	Status          ReservationStatus `gorm:"type:tinyint;not null;default:0" json:"status"`
-	Type            ReservationType   `gorm:"type:tinyint;not null;default:0" json:"type"`
+	// Payments는 입금/환불 내역이며, 예약 생성 시 초기 예약금과 결제 금액 내역을 함께 저장할 때 사용합니다.
+	Payments      []ReservationPayment `gorm:"foreignKey:ReservationID" json:"payments,omitempty"`
+	Name          string               `gorm:"column:name;type:varchar(30);not null" json:"name"`
+	Phone         string               `gorm:"column:phone;type:varchar(15);not null" json:"phone"`
+	PeopleCount   int                  `gorm:"column:people_count;not null;default:0" json:"peopleCount"`
+	StayStartAt   time.Time            `gorm:"column:stay_start_at;type:date;not null" json:"stayStartAt"`
+	StayEndAt     time.Time            `gorm:"column:stay_end_at;type:date;not null" json:"stayEndAt"`
+	CheckInAt     *time.Time           `gorm:"column:check_in_at;type:datetime" json:"checkInAt,omitempty"`
+	CheckOutAt    *time.Time           `gorm:"column:check_out_at;type:datetime" json:"checkOutAt,omitempty"`
+	Price         int                  `gorm:"not null" json:"price"`
+	Deposit       int                  `gorm:"not null;default:0" json:"deposit"`
+	PaymentAmount int                  `gorm:"column:payment_amount;not null;default:0" json:"paymentAmount"`
+	RefundAmount  int                  `gorm:"column:refund_amount;not null;default:0" json:"refundAmount"`
+	BrokerFee     int                  `gorm:"column:broker_fee;not null;default:0" json:"brokerFee"`
+	Note          string               `gorm:"type:varchar(200)" json:"note"`
+	CanceledAt    *time.Time           `gorm:"column:canceled_at" json:"canceledAt,omitempty"`
+	Status        ReservationStatus    `gorm:"type:tinyint;not null;default:0" json:"status"`
+	Type          ReservationType      `gorm:"type:tinyint;not null;default:0" json:"type"`
+	// AppliedPricingRules는 서버가 견적으로 판매 금액을 채운 경우 적용된 요금 규칙입니다.
+	AppliedPricingRules AppliedPricingRules `gorm:"column:applied_pricing_rules;type:json" json:"appliedPricingRules,omitempty"`
+	// BrokerFeeSettlementID는 결제 내역이 중개 수수료 정산에 포함된 예약에만 가장 최근 정산으로 채워집니다.
+	// 채워진 예약은 판매 금액과 금액 필드를 직접 수정할 수 없고, 결제 내역은 정산되지 않은 기간에만 새로 기록할 수 있습니다.
+	BrokerFeeSettlementID *uint `gorm:"column:broker_fee_settlement_id" json:"brokerFeeSettlementId,omitempty"`
+	// RentCharges는 달방 예약의 월별 청구이며, 예약 생성과 숙박 기간 변경 시 납부되지 않은 청구를 함께 저장할 때 사용합니다.
+	RentCharges []RentCharge `gorm:"foreignKey:ReservationID" json:"rentCharges,omitempty"`
+	// RoomGroupID는 객실을 지정하지 않고 객실 그룹으로 예약한 경우의 요청 객실 그룹입니다. 객실은 서버가 배정합니다.
+	RoomGroupID *uint `gorm:"column:room_group_id" json:"roomGroupId,omitempty"`
+	// GuestID는 예약자 고객입니다. 예약 생성 시 전화번호로 찾은 고객을 연결하고, 없으면 새 고객을 만듭니다.
+	GuestID *uint `gorm:"column:guest_id" json:"guestId,omitempty"`
 }
 
 func (Reservation) TableName() string {
@@ -133,18 +155,224 @@ func (r *Reservation) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// IsActive는 아직 이용 중이거나 앞으로 이용할 예약인지 확인합니다. 객실·기간 변경처럼 예약을 바꿀 수 있는지 판단할 때 씁니다.
+// 노쇼(NO_SHOW)와 이용 완료(COMPLETED)는 이미 끝난 예약이라 활성 상태가 아닙니다.
 func (r *Reservation) IsActive() bool {
 	return r.Status == ReservationStatusNormal || r.Status == ReservationStatusPending
 }
 
+// OccupyingReservationStatuses는 숙박 기간 동안 객실을 점유하는 예약 상태입니다.
+// 정상/대기 예약에 더해 실제로 객실을 쓴 이용 완료(COMPLETED) 예약도 점유로 봅니다.
+var OccupyingReservationStatuses = []ReservationStatus{
+	ReservationStatusNormal, ReservationStatusPending, ReservationStatusCompleted,
+}
+
+// CountsAsOccupied는 예약이 숙박 기간 동안 객실을 점유하는지 확인합니다.
+// 점유 현황, 빈 객실 검색, 객실 배정, 중복 예약 확인이 모두 이 기준을 씁니다.
+func (r *Reservation) CountsAsOccupied() bool {
+	for _, status := range OccupyingReservationStatuses {
+		if r.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Reservation) IsCanceled() bool {
 	return r.Status == ReservationStatusCancel || r.Status == ReservationStatusRefund
 }
 
+// IsSettled는 중개 수수료 정산이 끝나 금액이 확정된 예약인지 반환합니다.
+func (r *Reservation) IsSettled() bool {
+	return r.BrokerFeeSettlementID != nil
+}
+
+// UnpaidAmount는 판매 금액 중 예약금과 결제 금액으로 아직 받지 못한 금액(미수금)을 반환합니다.
+func (r *Reservation) UnpaidAmount() int {
+	return r.Price - r.PaymentAmount - r.Deposit
+}
+
+// ReceivableAgeDays는 미수금이 발생한 숙박 시작일부터 now까지 지난 일수입니다. 숙박 전이면 0입니다.
+func (r *Reservation) ReceivableAgeDays(now time.Time) int {
+	start := time.Date(r.StayStartAt.Year(), r.StayStartAt.Month(), r.StayStartAt.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if !today.After(start) {
+		return 0
+	}
+	return int(today.Sub(start).Hours() / 24)
+}
+
 func (r *Reservation) GetStayDays() int {
 	return int(r.StayEndAt.Sub(r.StayStartAt).Hours() / 24)
 }
 
+// DuplicateMatch는 두 예약을 같은 예약의 중복 입력으로 의심하는 근거입니다.
+type DuplicateMatch string
+
+const (
+	DuplicateMatchPhone DuplicateMatch = "PHONE"
+	DuplicateMatchName  DuplicateMatch = "NAME"
+)
+
+// DuplicateMatches는 other가 같은 예약을 한 번 더 입력한 것으로 의심되는 근거를 반환합니다.
+// 두 예약이 모두 객실을 점유하고(CountsAsOccupied) 숙박 기간이 겹칠 때, 정규화한 전화번호나 공백을 뺀 이름이 같으면 근거로 봅니다.
+func (r *Reservation) DuplicateMatches(other *Reservation) []DuplicateMatch {
+	if (r.ID != 0 && r.ID == other.ID) || !r.CountsAsOccupied() || !other.CountsAsOccupied() {
+		return nil
+	}
+	if !r.StayStartAt.Before(other.StayEndAt) || !other.StayStartAt.Before(r.StayEndAt) {
+		return nil
+	}
+
+	var matches []DuplicateMatch
+	if phone := NormalizePhone(r.Phone); phone != "" && phone == NormalizePhone(other.Phone) {
+		matches = append(matches, DuplicateMatchPhone)
+	}
+	if name := NormalizeName(r.Name); name != "" && strings.EqualFold(name, NormalizeName(other.Name)) {
+		matches = append(matches, DuplicateMatchName)
+	}
+	return matches
+}
+
+// NormalizeName은 이름에서 공백을 뺍니다. "홍 길동"과 "홍길동"은 같은 이름으로 봅니다.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(name), "")
+}
+
+// RoomIDs는 예약에 배정된 객실 ID 목록을 반환합니다. 숙박 중 옮긴 객실은 구간마다 한 번씩이 아니라 한 번만 포함합니다.
+func (r *Reservation) RoomIDs() []uint {
+	roomIDs := make([]uint, 0, len(r.Rooms))
+	seen := make(map[uint]bool, len(r.Rooms))
+	for _, rr := range r.Rooms {
+		if seen[rr.RoomID] {
+			continue
+		}
+		seen[rr.RoomID] = true
+		roomIDs = append(roomIDs, rr.RoomID)
+	}
+	return roomIDs
+}
+
+// HasRoomMoves는 숙박 중 객실을 옮겨 숙박 기간 일부만 사용하는 객실 구간이 있는지 확인합니다.
+func (r *Reservation) HasRoomMoves() bool {
+	stayStartAt, stayEndAt := truncateToDate(r.StayStartAt), truncateToDate(r.StayEndAt)
+	for i := range r.Rooms {
+		start, end := r.Rooms[i].StayPeriod(r)
+		if !start.Equal(stayStartAt) || !end.Equal(stayEndAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// MoveRoom은 moveDate 숙박일부터 fromRoomID 객실을 쓰던 구간을 toRoomID 객실로 옮기고 옮긴 구간을 반환합니다.
+// moveDate가 구간 시작일이면 구간의 객실만 바꾸고, 그렇지 않으면 구간을 moveDate에서 나눠 뒤쪽 구간을 새로 추가합니다.
+// 옮길 객실의 가용성은 확인하지 않으므로 호출하는 쪽에서 [moveDate, 반환한 구간의 StayEndAt) 기간을 확인해야 합니다.
+func (r *Reservation) MoveRoom(fromRoomID, toRoomID uint, moveDate time.Time) (*ReservationRoom, error) {
+	if fromRoomID == toRoomID {
+		return nil, ErrRoomMoveToSameRoom
+	}
+
+	moveDate = truncateToDate(moveDate)
+	for i := range r.Rooms {
+		segment := &r.Rooms[i]
+		if segment.RoomID != fromRoomID || !segment.Covers(r, moveDate) {
+			continue
+		}
+
+		start, end := segment.StayPeriod(r)
+		if start.Equal(moveDate) {
+			segment.StayStartAt, segment.StayEndAt = start, end
+			segment.RoomID = toRoomID
+			segment.Room = nil
+			return segment, nil
+		}
+
+		segment.StayStartAt, segment.StayEndAt = start, moveDate
+		r.Rooms = append(r.Rooms, ReservationRoom{
+			ReservationID: r.ID,
+			RoomID:        toRoomID,
+			StayStartAt:   moveDate,
+			StayEndAt:     end,
+		})
+		return &r.Rooms[len(r.Rooms)-1], nil
+	}
+	return nil, ErrRoomMoveOutOfStay
+}
+
+// RescheduleRooms는 숙박 기간이 previousStart~previousEnd에서 현재 StayStartAt~StayEndAt으로 바뀐 만큼 객실 구간을 조정합니다.
+// 숙박 시작일에 시작하던 구간은 새 시작일부터, 퇴실일에 끝나던 구간은 새 퇴실일까지 사용하며, 조정 후 비거나
+// 새 숙박 기간을 벗어나는 구간이 있으면 false를 반환합니다.
+func (r *Reservation) RescheduleRooms(previousStart, previousEnd time.Time) bool {
+	previousStart, previousEnd = truncateToDate(previousStart), truncateToDate(previousEnd)
+	stayStartAt, stayEndAt := truncateToDate(r.StayStartAt), truncateToDate(r.StayEndAt)
+
+	for i := range r.Rooms {
+		segment := &r.Rooms[i]
+		start, end := segment.StayStartAt, segment.StayEndAt
+		if start.IsZero() || truncateToDate(start).Equal(previousStart) {
+			start = stayStartAt
+		}
+		if end.IsZero() || truncateToDate(end).Equal(previousEnd) {
+			end = stayEndAt
+		}
+		start, end = truncateToDate(start), truncateToDate(end)
+		if !start.Before(end) || start.Before(stayStartAt) || end.After(stayEndAt) {
+			return false
+		}
+		segment.StayStartAt, segment.StayEndAt = start, end
+	}
+	return true
+}
+
+// Occupancy는 예약한 객실들의 기준 인원 합계와 최대 인원 합계입니다. Rooms.Room과 Rooms.Room.RoomGroup을 함께 조회해야 합니다.
+// 기준 인원이나 최대 인원을 정하지 않은 객실이 하나라도 있으면 해당 합계는 0(확인하지 않음)입니다.
+// 숙박 중 객실을 옮긴 예약은 숙박일마다 사용하는 객실의 합계를 구해, 인원을 정한 숙박일 중 가장 작은 값을 사용합니다.
+func (r *Reservation) Occupancy() (standard, max int) {
+	if !r.HasRoomMoves() {
+		return sumOccupancy(r.Rooms)
+	}
+
+	for date := truncateToDate(r.StayStartAt); date.Before(truncateToDate(r.StayEndAt)); date = date.AddDate(0, 0, 1) {
+		var rooms []ReservationRoom
+		for _, rr := range r.Rooms {
+			if rr.Covers(r, date) {
+				rooms = append(rooms, rr)
+			}
+		}
+		nightStandard, nightMax := sumOccupancy(rooms)
+		if nightStandard > 0 && (standard == 0 || nightStandard < standard) {
+			standard = nightStandard
+		}
+		if nightMax > 0 && (max == 0 || nightMax < max) {
+			max = nightMax
+		}
+	}
+	return standard, max
+}
+
+// sumOccupancy는 객실들의 기준 인원 합계와 최대 인원 합계이며, 정하지 않은 객실이 있으면 해당 합계는 0입니다.
+func sumOccupancy(reservationRooms []ReservationRoom) (standard, max int) {
+	standardLimited, maxLimited := len(reservationRooms) > 0, len(reservationRooms) > 0
+	for _, rr := range reservationRooms {
+		if rr.Room == nil {
+			return 0, 0
+		}
+		roomStandard, roomMax := rr.Room.Occupancy()
+		standardLimited = standardLimited && roomStandard > 0
+		maxLimited = maxLimited && roomMax > 0
+		standard += roomStandard
+		max += roomMax
+	}
+	if !standardLimited {
+		standard = 0
+	}
+	if !maxLimited {
+		max = 0
+	}
+	return standard, max
+}
+
 // GetAuditEntityType implements audit.Auditable interface
 func (r *Reservation) GetAuditEntityType() string {
 	return "reservation"
@@ -166,9 +394,12 @@ func formatTimePtr(t *time.Time) interface{} {
 func (r *Reservation) GetAuditFields() map[string]interface{} {
 	rooms := make([]map[string]interface{}, 0)
 	for _, rr := range r.Rooms {
+		stayStartAt, stayEndAt := rr.StayPeriod(r)
 		roomData := map[string]interface{}{
-			"id":     rr.RoomID,
-			"number": "",
+			"id":          rr.RoomID,
+			"number":      "",
+			"stayStartAt": stayStartAt.Format("2006-01-02"),
+			"stayEndAt":   stayEndAt.Format("2006-01-02"),
 		}
 		if rr.Room != nil {
 			roomData["number"] = rr.Room.Number
@@ -176,7 +407,10 @@ func (r *Reservation) GetAuditFields() map[string]interface{} {
 		rooms = append(rooms, roomData)
 	}
 	sort.Slice(rooms, func(i, j int) bool {
-		return rooms[i]["id"].(uint) < rooms[j]["id"].(uint)
+		if rooms[i]["id"].(uint) != rooms[j]["id"].(uint) {
+			return rooms[i]["id"].(uint) < rooms[j]["id"].(uint)
+		}
+		return rooms[i]["stayStartAt"].(string) < rooms[j]["stayStartAt"].(string)
 	})
 
 	paymentMethod := map[string]interface{}{
@@ -188,28 +422,31 @@ func (r *Reservation) GetAuditFields() map[string]interface{} {
 	}
 
 	return map[string]interface{}{
-		"id":            r.ID,
-		"rooms":         rooms,
-		"paymentMethod": paymentMethod,
-		"name":          r.Name,
-		"phone":         r.Phone,
-		"peopleCount":   r.PeopleCount,
-		"stayStartAt":   r.StayStartAt.Format("2006-01-02"),
-		"stayEndAt":     r.StayEndAt.Format("2006-01-02"),
-		"checkInAt":     formatTimePtr(r.CheckInAt),
-		"checkOutAt":    formatTimePtr(r.CheckOutAt),
-		"price":         r.Price,
-		"deposit":       r.Deposit,
-		"paymentAmount": r.PaymentAmount,
-		"refundAmount":  r.RefundAmount,
-		"brokerFee":     r.BrokerFee,
-		"note":          r.Note,
-		"canceledAt":    formatTimePtr(r.CanceledAt),
-		"status":        r.Status.String(),
-		"type":          r.Type.String(),
-		"createdBy":     r.CreatedBy,
-		"updatedBy":     r.UpdatedBy,
-		"createdAt":     r.CreatedAt,
-		"updatedAt":     r.UpdatedAt,
+		"id":                  r.ID,
+		"rooms":               rooms,
+		"paymentMethod":       paymentMethod,
+		"name":                r.Name,
+		"phone":               r.Phone,
+		"peopleCount":         r.PeopleCount,
+		"stayStartAt":         r.StayStartAt.Format("2006-01-02"),
+		"stayEndAt":           r.StayEndAt.Format("2006-01-02"),
+		"checkInAt":           formatTimePtr(r.CheckInAt),
+		"checkOutAt":          formatTimePtr(r.CheckOutAt),
+		"price":               r.Price,
+		"deposit":             r.Deposit,
+		"paymentAmount":       r.PaymentAmount,
+		"refundAmount":        r.RefundAmount,
+		"brokerFee":           r.BrokerFee,
+		"note":                r.Note,
+		"canceledAt":          formatTimePtr(r.CanceledAt),
+		"status":              r.Status.String(),
+		"type":                r.Type.String(),
+		"appliedPricingRules": r.AppliedPricingRules,
+		"roomGroupId":         r.RoomGroupID,
+		"guestId":             r.GuestID,
+		"createdBy":           r.CreatedBy,
+		"updatedBy":           r.UpdatedBy,
+		"createdAt":           r.CreatedAt,
+		"updatedAt":           r.UpdatedAt,
 	}
 }
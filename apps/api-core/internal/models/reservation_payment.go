@@ -0,0 +1,157 @@
+package models
+
+import (
+	"database/sql/driver"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ReservationPaymentType int8
+
+const (
+	ReservationPaymentTypeDeposit ReservationPaymentType = 1
+	ReservationPaymentTypePayment ReservationPaymentType = 2
+	ReservationPaymentTypeRefund  ReservationPaymentType = 3
+)
+
+func (t ReservationPaymentType) String() string {
+	switch t {
+	case ReservationPaymentTypeDeposit:
+		return "DEPOSIT"
+	case ReservationPaymentTypePayment:
+		return "PAYMENT"
+	case ReservationPaymentTypeRefund:
+		return "REFUND"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseReservationPaymentType은 문자열을 결제 내역 유형으로 변환합니다.
+func ParseReservationPaymentType(value string) (ReservationPaymentType, bool) {
+	for _, t := range []ReservationPaymentType{
+		ReservationPaymentTypeDeposit,
+		ReservationPaymentTypePayment,
+		ReservationPaymentTypeRefund,
+	} {
+		if t.String() == value {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+func (t ReservationPaymentType) Value() (driver.Value, error) {
+	return int64(t), nil
+}
+
+func (t *ReservationPaymentType) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*t = ReservationPaymentType(v)
+	case int8:
+		*t = ReservationPaymentType(v)
+	default:
+		*t = 0
+	}
+	return nil
+}
+
+// ReservationPayment는 예약의 입금/환불 내역 한 건입니다.
+// 예약의 Deposit, PaymentAmount, RefundAmount, BrokerFee는 이 내역의 합계로 계산됩니다.
+// BrokerFee는 기록 시점 결제 수단의 수수료율로 계산해 저장하며, 환불은 음수입니다.
+// 중개 수수료 정산에 포함되면 BrokerFeeSettlementID가 채워지고, 그 뒤로는 수정하거나 삭제할 수 없습니다.
+type ReservationPayment struct {
+	BaseMustAuditEntity
+	ReservationID   uint                   `gorm:"column:reservation_id;not null;index" json:"reservationId"`
+	PaymentMethodID uint                   `gorm:"column:payment_method_id;not null" json:"paymentMethodId"`
+	PaymentMethod   *PaymentMethod         `gorm:"foreignKey:PaymentMethodID" json:"paymentMethod,omitempty"`
+	Type            ReservationPaymentType `gorm:"type:tinyint;not null" json:"type"`
+	Amount          int                    `gorm:"not null" json:"amount"`
+	BrokerFee       int                    `gorm:"column:broker_fee;not null;default:0" json:"brokerFee"`
+	PaidAt          time.Time              `gorm:"column:paid_at;type:datetime;not null" json:"paidAt"`
+	Note            string                 `gorm:"type:varchar(200)" json:"note"`
+	// BrokerFeeSettlementID는 중개 수수료 정산에 포함된 결제 내역에만 채워집니다.
+	BrokerFeeSettlementID *uint `gorm:"column:broker_fee_settlement_id" json:"brokerFeeSettlementId,omitempty"`
+	CreatedByUser         *User `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
+}
+
+func (ReservationPayment) TableName() string {
+	return "reservation_payment"
+}
+
+func (p *ReservationPayment) BeforeCreate(tx *gorm.DB) error {
+	if err := p.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SettlementPeriodOffset은 중개 수수료 정산 기간을 나누는 입금일의 시간대(KST)입니다. PaidAt은 UTC로 저장됩니다.
+const SettlementPeriodOffset = 9 * time.Hour
+
+// SettlementDate는 결제 내역이 속하는 중개 수수료 정산 기간의 날짜(KST 입금일)를 반환합니다.
+func (p *ReservationPayment) SettlementDate() time.Time {
+	paidAt := p.PaidAt.UTC().Add(SettlementPeriodOffset)
+	return time.Date(paidAt.Year(), paidAt.Month(), paidAt.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsSettled는 중개 수수료 정산에 포함되어 금액이 확정된 결제 내역인지 반환합니다.
+func (p *ReservationPayment) IsSettled() bool {
+	return p.BrokerFeeSettlementID != nil
+}
+
+// CalculateBrokerFee는 결제 수단의 수수료율로 중개 수수료를 계산합니다. 환불은 수수료를 돌려받으므로 음수입니다.
+func (p *ReservationPayment) CalculateBrokerFee(commissionRate float64) {
+	fee := int(math.Round(float64(p.Amount) * commissionRate))
+	if p.Type == ReservationPaymentTypeRefund {
+		fee = -fee
+	}
+	p.BrokerFee = fee
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (p *ReservationPayment) GetAuditEntityType() string {
+	return "reservation_payment"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (p *ReservationPayment) GetAuditEntityID() uint {
+	return p.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (p *ReservationPayment) GetAuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"id":              p.ID,
+		"reservationId":   p.ReservationID,
+		"paymentMethodId": p.PaymentMethodID,
+		"type":            p.Type.String(),
+		"amount":          p.Amount,
+		"brokerFee":       p.BrokerFee,
+		"paidAt":          p.PaidAt,
+		"note":            p.Note,
+		"createdBy":       p.CreatedBy,
+		"updatedBy":       p.UpdatedBy,
+		"createdAt":       p.CreatedAt,
+		"updatedAt":       p.UpdatedAt,
+	}
+}
+
+// ApplyPayments는 결제 내역 합계로 예약의 예약금, 결제 금액, 환불 금액, 중개 수수료를 다시 계산합니다.
+func (r *Reservation) ApplyPayments(payments []ReservationPayment) {
+	r.Deposit, r.PaymentAmount, r.RefundAmount, r.BrokerFee = 0, 0, 0, 0
+	for _, payment := range payments {
+		switch payment.Type {
+		case ReservationPaymentTypeDeposit:
+			r.Deposit += payment.Amount
+		case ReservationPaymentTypePayment:
+			r.PaymentAmount += payment.Amount
+		case ReservationPaymentTypeRefund:
+			r.RefundAmount += payment.Amount
+		}
+		r.BrokerFee += payment.BrokerFee
+	}
+}
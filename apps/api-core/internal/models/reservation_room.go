@@ -1,15 +1,28 @@
 package models
 
 import (
+	"errors"
+	"time"
+
 	"gorm.io/gorm"
 )
 
+var (
+	ErrRoomMoveOutOfStay  = errors.New("옮길 날짜에 사용 중인 객실이 아닙니다")
+	ErrRoomMoveToSameRoom = errors.New("같은 객실로는 옮길 수 없습니다")
+)
+
+// ReservationRoom은 예약의 객실 사용 구간입니다. 숙박 중 객실을 옮기면 한 객실 배정이 옮기는 날짜를 기준으로
+// 두 구간으로 나뉘므로, 같은 예약에 같은 객실이 여러 구간으로 들어갈 수 있습니다.
 type ReservationRoom struct {
 	BaseMustAuditEntity
-	ReservationID uint         `gorm:"column:reservation_id;not null;uniqueIndex:uc_reservation_room_reservation_id_and_room_id,where:deleted_at = '1970-01-01 00:00:00'" json:"reservationId"`
+	ReservationID uint         `gorm:"column:reservation_id;not null" json:"reservationId"`
 	Reservation   *Reservation `gorm:"foreignKey:ReservationID" json:"reservation,omitempty"`
-	RoomID        uint         `gorm:"column:room_id;not null;uniqueIndex:uc_reservation_room_reservation_id_and_room_id,where:deleted_at = '1970-01-01 00:00:00'" json:"roomId"`
+	RoomID        uint         `gorm:"column:room_id;not null" json:"roomId"`
 	Room          *Room        `gorm:"foreignKey:RoomID" json:"room,omitempty"`
+	// StayStartAt, StayEndAt은 객실을 사용하는 구간 [StayStartAt, StayEndAt)이며, 객실을 옮기지 않았다면 예약의 숙박 기간과 같습니다.
+	StayStartAt time.Time `gorm:"column:stay_start_at;type:date;not null" json:"stayStartAt"`
+	StayEndAt   time.Time `gorm:"column:stay_end_at;type:date;not null" json:"stayEndAt"`
 }
 
 func (ReservationRoom) TableName() string {
@@ -19,3 +32,30 @@ func (ReservationRoom) TableName() string {
 func (rr *ReservationRoom) BeforeCreate(tx *gorm.DB) error {
 	return rr.BaseMustAuditEntity.BeforeCreate(tx)
 }
+
+// StayPeriod는 객실을 사용하는 구간입니다. 구간 날짜가 채워지지 않은 배정이면 reservation의 숙박 기간을 사용합니다.
+func (rr *ReservationRoom) StayPeriod(reservation *Reservation) (start, end time.Time) {
+	start, end = rr.StayStartAt, rr.StayEndAt
+	if reservation != nil {
+		if start.IsZero() {
+			start = reservation.StayStartAt
+		}
+		if end.IsZero() {
+			end = reservation.StayEndAt
+		}
+	}
+	return truncateToDate(start), truncateToDate(end)
+}
+
+// Covers는 date 숙박일에 이 구간에서 객실을 사용하는지 확인합니다. 구간의 마지막 날(퇴실일)은 사용하지 않는 것으로 봅니다.
+func (rr *ReservationRoom) Covers(reservation *Reservation, date time.Time) bool {
+	start, end := rr.StayPeriod(reservation)
+	date = truncateToDate(date)
+	return !start.After(date) && end.After(date)
+}
+
+// Overlaps는 이 구간이 [startDate, endDate) 기간과 겹치는지 확인합니다.
+func (rr *ReservationRoom) Overlaps(reservation *Reservation, startDate, endDate time.Time) bool {
+	start, end := rr.StayPeriod(reservation)
+	return start.Before(truncateToDate(endDate)) && end.After(truncateToDate(startDate))
+}
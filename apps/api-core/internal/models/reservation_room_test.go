@@ -0,0 +1,119 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func day(d int) time.Time {
+	return time.Date(2030, 5, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newStay(roomIDs ...uint) *models.Reservation {
+	reservation := &models.Reservation{StayStartAt: day(1), StayEndAt: day(5)}
+	for _, roomID := range roomIDs {
+		reservation.Rooms = append(reservation.Rooms, models.ReservationRoom{RoomID: roomID, StayStartAt: day(1), StayEndAt: day(5)})
+	}
+	return reservation
+}
+
+func TestReservationRoom_StayPeriod_구간_날짜가_없으면_예약_기간을_사용한다(t *testing.T) {
+	reservation := newStay()
+	rr := models.ReservationRoom{RoomID: 1}
+
+	start, end := rr.StayPeriod(reservation)
+	assert.Equal(t, day(1), start)
+	assert.Equal(t, day(5), end)
+	assert.True(t, rr.Covers(reservation, day(4)))
+	assert.False(t, rr.Covers(reservation, day(5)), "퇴실일은 사용하지 않는다")
+}
+
+func TestReservation_MoveRoom(t *testing.T) {
+	t.Run("숙박 중간에 옮기면 구간이 나뉜다", func(t *testing.T) {
+		reservation := newStay(10)
+
+		moved, err := reservation.MoveRoom(10, 20, day(3))
+
+		require.NoError(t, err)
+		require.Len(t, reservation.Rooms, 2)
+		assert.Equal(t, uint(20), moved.RoomID)
+		assert.Equal(t, day(3), moved.StayStartAt)
+		assert.Equal(t, day(5), moved.StayEndAt)
+		assert.Equal(t, day(3), reservation.Rooms[0].StayEndAt)
+		assert.True(t, reservation.HasRoomMoves())
+		assert.Equal(t, []uint{10, 20}, reservation.RoomIDs())
+	})
+
+	t.Run("구간 시작일에 옮기면 객실만 바꾼다", func(t *testing.T) {
+		reservation := newStay(10)
+
+		moved, err := reservation.MoveRoom(10, 20, day(1))
+
+		require.NoError(t, err)
+		require.Len(t, reservation.Rooms, 1)
+		assert.Equal(t, uint(20), moved.RoomID)
+		assert.False(t, reservation.HasRoomMoves())
+	})
+
+	t.Run("옮길 날짜에 사용하지 않는 객실이면 실패한다", func(t *testing.T) {
+		reservation := newStay(10)
+
+		_, err := reservation.MoveRoom(10, 20, day(5))
+		assert.ErrorIs(t, err, models.ErrRoomMoveOutOfStay)
+
+		_, err = reservation.MoveRoom(30, 20, day(2))
+		assert.ErrorIs(t, err, models.ErrRoomMoveOutOfStay)
+	})
+
+	t.Run("같은 객실로는 옮길 수 없다", func(t *testing.T) {
+		_, err := newStay(10).MoveRoom(10, 10, day(2))
+		assert.ErrorIs(t, err, models.ErrRoomMoveToSameRoom)
+	})
+}
+
+func TestReservation_RescheduleRooms(t *testing.T) {
+	t.Run("앞뒤 구간이 바뀐 숙박 기간을 따라간다", func(t *testing.T) {
+		reservation := newStay(10)
+		_, err := reservation.MoveRoom(10, 20, day(3))
+		require.NoError(t, err)
+
+		reservation.StayStartAt, reservation.StayEndAt = day(2), day(7)
+
+		assert.True(t, reservation.RescheduleRooms(day(1), day(5)))
+		assert.Equal(t, day(2), reservation.Rooms[0].StayStartAt)
+		assert.Equal(t, day(3), reservation.Rooms[0].StayEndAt)
+		assert.Equal(t, day(3), reservation.Rooms[1].StayStartAt)
+		assert.Equal(t, day(7), reservation.Rooms[1].StayEndAt)
+	})
+
+	t.Run("옮기는 날짜까지 줄이면 실패한다", func(t *testing.T) {
+		reservation := newStay(10)
+		_, err := reservation.MoveRoom(10, 20, day(3))
+		require.NoError(t, err)
+
+		reservation.StayEndAt = day(3)
+
+		assert.False(t, reservation.RescheduleRooms(day(1), day(5)))
+	})
+}
+
+func TestReservation_Occupancy_객실을_옮기면_숙박일별_최소값을_사용한다(t *testing.T) {
+	large := &models.Room{RoomGroup: &models.RoomGroup{StandardOccupancy: 4, MaxOccupancy: 6}}
+	small := &models.Room{RoomGroup: &models.RoomGroup{StandardOccupancy: 2, MaxOccupancy: 3}}
+	reservation := &models.Reservation{
+		StayStartAt: day(1),
+		StayEndAt:   day(5),
+		Rooms: []models.ReservationRoom{
+			{RoomID: 10, Room: large, StayStartAt: day(1), StayEndAt: day(3)},
+			{RoomID: 20, Room: small, StayStartAt: day(3), StayEndAt: day(5)},
+		},
+	}
+
+	standard, max := reservation.Occupancy()
+	assert.Equal(t, 2, standard)
+	assert.Equal(t, 3, max)
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidStatusTransition = errors.New("허용되지 않는 예약 상태 변경")
+	ErrRefundAmountRequired    = errors.New("환불 처리에는 환불 금액이 필요합니다")
+	ErrNoShowAfterCheckIn      = errors.New("체크인한 예약은 노쇼로 처리할 수 없습니다")
+	ErrNoShowBeforeStay        = errors.New("숙박 시작일 이전에는 노쇼로 처리할 수 없습니다")
+	ErrCompleteBeforeCheckIn   = errors.New("체크인하지 않은 예약은 이용 완료로 처리할 수 없습니다")
+)
+
+// StatusTransitionError는 상태 전이표에 없는 예약 상태 변경을 나타냅니다.
+// errors.Is(err, ErrInvalidStatusTransition)로 구분할 수 있습니다.
+type StatusTransitionError struct {
+	From ReservationStatus
+	To   ReservationStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrInvalidStatusTransition.Error(), e.From, e.To)
+}
+
+func (e *StatusTransitionError) Is(target error) bool {
+	return target == ErrInvalidStatusTransition
+}
+
+// reservationStatusTransitions는 예약 상태별로 변경 가능한 다음 상태 목록입니다.
+// 환불(REFUND)과 이용 완료(COMPLETED)는 종료 상태라 더 이상 변경할 수 없습니다.
+var reservationStatusTransitions = map[ReservationStatus][]ReservationStatus{
+	ReservationStatusPending: {ReservationStatusNormal, ReservationStatusCancel, ReservationStatusNoShow},
+	ReservationStatusNormal:  {ReservationStatusPending, ReservationStatusCancel, ReservationStatusRefund, ReservationStatusNoShow, ReservationStatusCompleted},
+	ReservationStatusCancel:  {ReservationStatusRefund},
+	ReservationStatusNoShow:  {ReservationStatusRefund},
+}
+
+// CanTransitionTo는 현재 상태에서 next 상태로 변경할 수 있는지 확인합니다. 같은 상태로의 변경은 항상 허용합니다.
+func (s ReservationStatus) CanTransitionTo(next ReservationStatus) bool {
+	if s == next {
+		return true
+	}
+	for _, allowed := range reservationStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo는 예약 상태를 next로 변경하고 상태별 부수 효과를 적용합니다.
+//   - CANCEL: 취소 시각 기록
+//   - REFUND: 환불 금액 필수, 취소 시각이 없으면 기록
+//   - NO_SHOW: 숙박 시작일 이후, 체크인하지 않은 예약만 가능
+//   - COMPLETED: 체크인한 예약만 가능, 체크아웃 시각이 없으면 기록
+func (r *Reservation) TransitionTo(next ReservationStatus, now time.Time) error {
+	if r.Status == next {
+		return nil
+	}
+	if !r.Status.CanTransitionTo(next) {
+		return &StatusTransitionError{From: r.Status, To: next}
+	}
+
+	switch next {
+	case ReservationStatusCancel:
+		r.CanceledAt = &now
+	case ReservationStatusRefund:
+		if r.RefundAmount <= 0 {
+			return ErrRefundAmountRequired
+		}
+		if r.CanceledAt == nil {
+			r.CanceledAt = &now
+		}
+	case ReservationStatusNoShow:
+		if r.CheckInAt != nil {
+			return ErrNoShowAfterCheckIn
+		}
+		if now.Format("2006-01-02") < r.StayStartAt.Format("2006-01-02") {
+			return ErrNoShowBeforeStay
+		}
+	case ReservationStatusCompleted:
+		if r.CheckInAt == nil {
+			return ErrCompleteBeforeCheckIn
+		}
+		if r.CheckOutAt == nil {
+			r.CheckOutAt = &now
+		}
+	}
+
+	r.Status = next
+	return nil
+}
@@ -98,3 +98,165 @@ func TestReservation_GetAuditFields_Room이_nil이면_number가_빈문자열(t *
 	rooms := fields["rooms"].([]map[string]interface{})
 	assert.Equal(t, "", rooms[0]["number"])
 }
+
+func TestReservationStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     models.ReservationStatus
+		to       models.ReservationStatus
+		expected bool
+	}{
+		{"대기 → 확정", models.ReservationStatusPending, models.ReservationStatusNormal, true},
+		{"확정 → 이용 완료", models.ReservationStatusNormal, models.ReservationStatusCompleted, true},
+		{"확정 → 노쇼", models.ReservationStatusNormal, models.ReservationStatusNoShow, true},
+		{"취소 → 환불", models.ReservationStatusCancel, models.ReservationStatusRefund, true},
+		{"같은 상태 유지", models.ReservationStatusRefund, models.ReservationStatusRefund, true},
+		{"환불 → 대기 불가", models.ReservationStatusRefund, models.ReservationStatusPending, false},
+		{"취소 → 확정 불가", models.ReservationStatusCancel, models.ReservationStatusNormal, false},
+		{"대기 → 이용 완료 불가", models.ReservationStatusPending, models.ReservationStatusCompleted, false},
+		{"이용 완료 → 취소 불가", models.ReservationStatusCompleted, models.ReservationStatusCancel, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestReservation_TransitionTo(t *testing.T) {
+	now := time.Date(2024, 3, 20, 10, 0, 0, 0, time.UTC)
+	newReservation := func(status models.ReservationStatus) *models.Reservation {
+		return &models.Reservation{
+			StayStartAt: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+			StayEndAt:   time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+			Status:      status,
+		}
+	}
+
+	t.Run("허용되지 않는 변경은 StatusTransitionError", func(t *testing.T) {
+		reservation := newReservation(models.ReservationStatusRefund)
+
+		err := reservation.TransitionTo(models.ReservationStatusPending, now)
+
+		var transitionErr *models.StatusTransitionError
+		assert.ErrorAs(t, err, &transitionErr)
+		assert.ErrorIs(t, err, models.ErrInvalidStatusTransition)
+		assert.Equal(t, models.ReservationStatusRefund, reservation.Status)
+	})
+
+	t.Run("취소하면 취소 시각이 기록된다", func(t *testing.T) {
+		reservation := newReservation(models.ReservationStatusNormal)
+
+		assert.NoError(t, reservation.TransitionTo(models.ReservationStatusCancel, now))
+		assert.Equal(t, models.ReservationStatusCancel, reservation.Status)
+		assert.Equal(t, &now, reservation.CanceledAt)
+	})
+
+	t.Run("환불 금액 없이 환불할 수 없다", func(t *testing.T) {
+		reservation := newReservation(models.ReservationStatusCancel)
+
+		assert.ErrorIs(t, reservation.TransitionTo(models.ReservationStatusRefund, now), models.ErrRefundAmountRequired)
+		assert.Equal(t, models.ReservationStatusCancel, reservation.Status)
+	})
+
+	t.Run("취소 후 환불하면 기존 취소 시각을 유지한다", func(t *testing.T) {
+		canceledAt := now.AddDate(0, 0, -1)
+		reservation := newReservation(models.ReservationStatusCancel)
+		reservation.CanceledAt = &canceledAt
+		reservation.RefundAmount = 50000
+
+		assert.NoError(t, reservation.TransitionTo(models.ReservationStatusRefund, now))
+		assert.Equal(t, &canceledAt, reservation.CanceledAt)
+	})
+
+	t.Run("체크인한 예약은 노쇼 처리할 수 없다", func(t *testing.T) {
+		reservation := newReservation(models.ReservationStatusNormal)
+		reservation.CheckInAt = &now
+
+		assert.ErrorIs(t, reservation.TransitionTo(models.ReservationStatusNoShow, now), models.ErrNoShowAfterCheckIn)
+	})
+
+	t.Run("숙박 시작일 이전에는 노쇼 처리할 수 없다", func(t *testing.T) {
+		reservation := newReservation(models.ReservationStatusNormal)
+
+		assert.ErrorIs(t, reservation.TransitionTo(models.ReservationStatusNoShow, now.AddDate(0, 0, -1)), models.ErrNoShowBeforeStay)
+	})
+
+	t.Run("체크인하지 않은 예약은 이용 완료 처리할 수 없다", func(t *testing.T) {
+		reservation := newReservation(models.ReservationStatusNormal)
+
+		assert.ErrorIs(t, reservation.TransitionTo(models.ReservationStatusCompleted, now), models.ErrCompleteBeforeCheckIn)
+	})
+
+	t.Run("이용 완료 처리하면 체크아웃 시각이 기록된다", func(t *testing.T) {
+		checkInAt := now.AddDate(0, 0, -2)
+		reservation := newReservation(models.ReservationStatusNormal)
+		reservation.CheckInAt = &checkInAt
+
+		assert.NoError(t, reservation.TransitionTo(models.ReservationStatusCompleted, now))
+		assert.Equal(t, &now, reservation.CheckOutAt)
+		assert.False(t, reservation.IsActive())
+		assert.True(t, reservation.CountsAsOccupied(), "이용 완료 예약도 객실을 점유한다")
+	})
+}
+
+func TestReservation_UnpaidAmount(t *testing.T) {
+	// Given - 판매 금액 300000원 중 예약금 50000원, 결제 금액 150000원을 받았을 때
+	reservation := &models.Reservation{Price: 300000, Deposit: 50000, PaymentAmount: 150000}
+
+	// Then - 미수금은 예약금과 결제 금액을 모두 뺀 금액이다
+	assert.Equal(t, 100000, reservation.UnpaidAmount())
+}
+
+func TestReservation_ReceivableAgeDays(t *testing.T) {
+	reservation := &models.Reservation{StayStartAt: time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("숙박 시작일부터 지난 일수를 반환한다", func(t *testing.T) {
+		assert.Equal(t, 5, reservation.ReceivableAgeDays(time.Date(2026, 7, 15, 23, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("숙박 시작 전이면 0", func(t *testing.T) {
+		assert.Equal(t, 0, reservation.ReceivableAgeDays(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestReservation_DuplicateMatches(t *testing.T) {
+	newReservation := func(id uint, name, phone string, start, end int, status models.ReservationStatus) *models.Reservation {
+		r := &models.Reservation{
+			Name:        name,
+			Phone:       phone,
+			StayStartAt: time.Date(2024, 3, start, 0, 0, 0, 0, time.UTC),
+			StayEndAt:   time.Date(2024, 3, end, 0, 0, 0, 0, time.UTC),
+			Status:      status,
+		}
+		r.ID = id
+		return r
+	}
+	base := newReservation(0, "홍길동", "010-1234-5678", 10, 12, models.ReservationStatusPending)
+
+	tests := []struct {
+		name     string
+		other    *models.Reservation
+		expected []models.DuplicateMatch
+	}{
+		{"형식이 다른 같은 전화번호", newReservation(1, "Hong", "01012345678", 11, 13, models.ReservationStatusNormal), []models.DuplicateMatch{models.DuplicateMatchPhone}},
+		{"공백만 다른 같은 이름", newReservation(2, "홍 길동", "010-9999-9999", 9, 11, models.ReservationStatusNormal), []models.DuplicateMatch{models.DuplicateMatchName}},
+		{"전화번호와 이름이 모두 같다", newReservation(3, "홍길동", "010-1234-5678", 10, 12, models.ReservationStatusNormal), []models.DuplicateMatch{models.DuplicateMatchPhone, models.DuplicateMatchName}},
+		{"퇴실일에 시작하는 예약은 겹치지 않는다", newReservation(4, "홍길동", "010-1234-5678", 12, 14, models.ReservationStatusNormal), nil},
+		{"이용 완료 예약도 본다", newReservation(8, "홍길동", "010-1234-5678", 9, 11, models.ReservationStatusCompleted), []models.DuplicateMatch{models.DuplicateMatchPhone, models.DuplicateMatchName}},
+		{"취소된 예약은 보지 않는다", newReservation(5, "홍길동", "010-1234-5678", 10, 12, models.ReservationStatusCancel), nil},
+		{"다른 고객", newReservation(6, "김철수", "010-5555-6666", 10, 12, models.ReservationStatusNormal), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, base.DuplicateMatches(tt.other))
+		})
+	}
+
+	t.Run("자기 자신은 중복이 아니다", func(t *testing.T) {
+		saved := newReservation(7, "홍길동", "010-1234-5678", 10, 12, models.ReservationStatusNormal)
+		assert.Nil(t, saved.DuplicateMatches(saved))
+	})
+}
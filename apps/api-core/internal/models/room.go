@@ -50,6 +50,63 @@ func (s *RoomStatus) Scan(value interface{}) error {
 	return nil
 }
 
+// HousekeepingStatus는 객실의 청소 상태입니다. 운영 상태(RoomStatus)와 별개로 관리되며,
+// 체크아웃하면 DIRTY가 되고 청소 작업을 시작/완료하면 CLEANING/CLEAN으로 바뀝니다.
+type HousekeepingStatus int8
+
+const (
+	HousekeepingStatusDirty     HousekeepingStatus = 1
+	HousekeepingStatusCleaning  HousekeepingStatus = 2
+	HousekeepingStatusClean     HousekeepingStatus = 3
+	HousekeepingStatusInspected HousekeepingStatus = 4
+)
+
+func (s HousekeepingStatus) String() string {
+	switch s {
+	case HousekeepingStatusDirty:
+		return "DIRTY"
+	case HousekeepingStatusCleaning:
+		return "CLEANING"
+	case HousekeepingStatusClean:
+		return "CLEAN"
+	case HousekeepingStatusInspected:
+		return "INSPECTED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseHousekeepingStatus는 문자열을 객실 청소 상태로 변환합니다.
+func ParseHousekeepingStatus(value string) (HousekeepingStatus, bool) {
+	for _, s := range []HousekeepingStatus{
+		HousekeepingStatusDirty,
+		HousekeepingStatusCleaning,
+		HousekeepingStatusClean,
+		HousekeepingStatusInspected,
+	} {
+		if s.String() == value {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func (s HousekeepingStatus) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+func (s *HousekeepingStatus) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		*s = HousekeepingStatus(v)
+	case int8:
+		*s = HousekeepingStatus(v)
+	default:
+		*s = HousekeepingStatusClean
+	}
+	return nil
+}
+
 type Room struct {
 	BaseMustAuditEntity
 	Number        string     `gorm:"type:varchar(10);not null;uniqueIndex:uc_room_number,where:deleted_at = '1970-01-01 00:00:00'" json:"number"`
@@ -59,6 +116,14 @@ type Room struct {
 	Status        RoomStatus `gorm:"type:tinyint;not null" json:"status"`
 	CreatedByUser *User      `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
 	UpdatedByUser *User      `gorm:"foreignKey:UpdatedBy" json:"updatedBy,omitempty"`
+
+	// HousekeepingStatus는 객실 청소 상태이며, 새 객실은 CLEAN으로 시작합니다.
+	HousekeepingStatus HousekeepingStatus `gorm:"column:housekeeping_status;type:tinyint;not null;default:3" json:"housekeepingStatus"`
+
+	// StandardOccupancy와 MaxOccupancy는 객실 그룹의 인원 기준을 이 객실만 다르게 정할 때 사용합니다. nil이면 객실 그룹 기준을 따릅니다.
+	StandardOccupancy *int      `gorm:"column:standard_occupancy" json:"standardOccupancy"`
+	MaxOccupancy      *int      `gorm:"column:max_occupancy" json:"maxOccupancy"`
+	Amenities         []Amenity `gorm:"many2many:room_amenity;joinForeignKey:RoomID;joinReferences:AmenityID" json:"amenities,omitempty"`
 }
 
 func (Room) TableName() string {
@@ -75,6 +140,9 @@ func (r *Room) BeforeCreate(tx *gorm.DB) error {
 	if r.Note == "" {
 		r.Note = ""
 	}
+	if r.HousekeepingStatus == 0 {
+		r.HousekeepingStatus = HousekeepingStatusClean
+	}
 	return nil
 }
 
@@ -82,6 +150,52 @@ func (r *Room) IsAvailable() bool {
 	return r.Status == RoomStatusNormal
 }
 
+// IsReadyForCheckIn은 객실 청소가 끝나 손님을 받을 수 있는 상태(CLEAN 또는 INSPECTED)인지 확인합니다.
+func (r *Room) IsReadyForCheckIn() bool {
+	return r.HousekeepingStatus == HousekeepingStatusClean || r.HousekeepingStatus == HousekeepingStatusInspected
+}
+
+// Occupancy는 객실 그룹의 인원 기준에 객실별 인원 기준을 덮어쓴 기준 인원과 최대 인원을 반환합니다.
+// RoomGroup을 함께 조회하지 않았으면 객실별 인원 기준만 반영합니다.
+func (r *Room) Occupancy() (standard, max int) {
+	if r.RoomGroup != nil {
+		standard, max = r.RoomGroup.StandardOccupancy, r.RoomGroup.MaxOccupancy
+	}
+	if r.StandardOccupancy != nil {
+		standard = *r.StandardOccupancy
+	}
+	if r.MaxOccupancy != nil {
+		max = *r.MaxOccupancy
+	}
+	return standard, max
+}
+
+// CanAccommodate는 peopleCount명이 객실 최대 인원을 넘지 않는지 확인합니다. 최대 인원을 정하지 않았으면 항상 true입니다.
+func (r *Room) CanAccommodate(peopleCount int) bool {
+	_, max := r.Occupancy()
+	return max == 0 || peopleCount <= max
+}
+
+// HasAmenities는 객실 또는 객실 그룹에 amenityIDs 편의시설이 모두 있는지 확인합니다.
+// Amenities와 RoomGroup.Amenities를 함께 조회해야 합니다.
+func (r *Room) HasAmenities(ids []uint) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	owned := amenityIDs(r.Amenities)
+	if r.RoomGroup != nil {
+		for id := range amenityIDs(r.RoomGroup.Amenities) {
+			owned[id] = true
+		}
+	}
+	for _, id := range ids {
+		if !owned[id] {
+			return false
+		}
+	}
+	return true
+}
+
 // GetAuditEntityType implements audit.Auditable interface
 func (r *Room) GetAuditEntityType() string {
 	return "room"
@@ -104,5 +218,9 @@ func (r *Room) GetAuditFields() map[string]interface{} {
 		"updatedBy":   r.UpdatedBy,
 		"createdAt":   r.CreatedAt,
 		"updatedAt":   r.UpdatedAt,
+
+		"housekeepingStatus": r.HousekeepingStatus.String(),
+		"standardOccupancy":  r.StandardOccupancy,
+		"maxOccupancy":       r.MaxOccupancy,
 	}
 }
@@ -13,6 +13,11 @@ type RoomGroup struct {
 	Rooms         []Room `gorm:"foreignKey:RoomGroupID" json:"rooms,omitempty"`
 	CreatedByUser *User  `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
 	UpdatedByUser *User  `gorm:"foreignKey:UpdatedBy" json:"updatedBy,omitempty"`
+
+	// StandardOccupancy는 기준 인원, MaxOccupancy는 최대 인원입니다. 0이면 정하지 않은 것으로 보고 인원을 확인하지 않습니다.
+	StandardOccupancy int       `gorm:"column:standard_occupancy;not null;default:0" json:"standardOccupancy"`
+	MaxOccupancy      int       `gorm:"column:max_occupancy;not null;default:0" json:"maxOccupancy"`
+	Amenities         []Amenity `gorm:"many2many:room_group_amenity;joinForeignKey:RoomGroupID;joinReferences:AmenityID" json:"amenities,omitempty"`
 }
 
 func (RoomGroup) TableName() string {
@@ -51,5 +56,8 @@ func (rg *RoomGroup) GetAuditFields() map[string]interface{} {
 		"updatedBy":    rg.UpdatedBy,
 		"createdAt":    rg.CreatedAt,
 		"updatedAt":    rg.UpdatedAt,
+
+		"standardOccupancy": rg.StandardOccupancy,
+		"maxOccupancy":      rg.MaxOccupancy,
 	}
 }
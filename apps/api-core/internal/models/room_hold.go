@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// RoomHold는 전화 상담 등으로 가격·결제를 협의하는 동안 객실을 잠시 선점해 두는 임시 홀드입니다.
+// DB 테이블이 아니라 Redis에 TTL과 함께 저장되며, 만료되면 자동으로 사라집니다.
+type RoomHold struct {
+	ID          uint      `json:"id"`
+	RoomIDs     []uint    `json:"roomIds"`
+	StayStartAt time.Time `json:"stayStartAt"`
+	StayEndAt   time.Time `json:"stayEndAt"`
+	HolderID    uint      `json:"holderId"`
+	Note        string    `json:"note"`
+	CreatedAt   time.Time `json:"createdAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// Overlaps는 홀드 기간이 주어진 기간과 겹치는지 확인합니다. 예약과 동일하게 체크아웃 당일 입실은 겹치지 않는 것으로 봅니다.
+func (h *RoomHold) Overlaps(startDate, endDate time.Time) bool {
+	return h.StayEndAt.After(startDate) && h.StayStartAt.Before(endDate)
+}
+
+// ContainsRoom은 홀드에 해당 객실이 포함되어 있는지 확인합니다.
+func (h *RoomHold) ContainsRoom(roomID uint) bool {
+	for _, id := range h.RoomIDs {
+		if id == roomID {
+			return true
+		}
+	}
+	return false
+}
+
+// RemainingTTL은 만료까지 남은 시간을 반환합니다.
+func (h *RoomHold) RemainingTTL(now time.Time) time.Duration {
+	return h.ExpiresAt.Sub(now)
+}
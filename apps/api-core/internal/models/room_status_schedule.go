@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+)
+
+// RoomStatusSchedule은 객실 상태를 미리 정해 둔 기간 동안 바꾸는 예약입니다. StartDate부터 EndDate까지(종료일 포함)
+// 객실은 Status 상태로 운영되며, 시작일이 되면 객실 상태가 Status로 바뀌고(AppliedAt) 종료일이 지나면
+// 바꾸기 전 상태(PreviousStatus)로 되돌아갑니다(RevertedAt).
+type RoomStatusSchedule struct {
+	BaseMustAuditEntity
+	RoomID         uint        `gorm:"column:room_id;not null" json:"roomId"`
+	Room           *Room       `gorm:"foreignKey:RoomID" json:"room,omitempty"`
+	Status         RoomStatus  `gorm:"type:tinyint;not null" json:"status"`
+	StartDate      time.Time   `gorm:"column:start_date;type:date;not null" json:"startDate"`
+	EndDate        time.Time   `gorm:"column:end_date;type:date;not null" json:"endDate"`
+	Note           string      `gorm:"type:varchar(200);not null" json:"note"`
+	PreviousStatus *RoomStatus `gorm:"column:previous_status;type:tinyint" json:"previousStatus,omitempty"`
+	AppliedAt      *time.Time  `gorm:"column:applied_at;type:datetime" json:"appliedAt,omitempty"`
+	RevertedAt     *time.Time  `gorm:"column:reverted_at;type:datetime" json:"revertedAt,omitempty"`
+}
+
+func (RoomStatusSchedule) TableName() string {
+	return "room_status_schedule"
+}
+
+// Covers는 date가 예약 기간(종료일 포함)에 속하는지 확인합니다.
+func (s *RoomStatusSchedule) Covers(date time.Time) bool {
+	return !date.Before(s.StartDate) && !date.After(s.EndDate)
+}
+
+// IsActive는 객실 상태를 바꿨고 아직 되돌리지 않은 예약인지 확인합니다.
+func (s *RoomStatusSchedule) IsActive() bool {
+	return s.AppliedAt != nil && s.RevertedAt == nil
+}
+
+// BaseStatus는 객실 상태 예약을 걷어 낸 객실의 평소 운영 상태입니다. 적용 중인 예약 때문에 지금 상태가 바뀌어 있으면
+// 그 예약 전 상태, 아니면 지금 상태입니다. schedules는 이 객실의 객실 상태 예약입니다.
+func (r *Room) BaseStatus(schedules []RoomStatusSchedule) RoomStatus {
+	for i := range schedules {
+		if schedules[i].IsActive() && schedules[i].Status == r.Status && schedules[i].PreviousStatus != nil {
+			return *schedules[i].PreviousStatus
+		}
+	}
+	return r.Status
+}
+
+// StatusOn은 date에 객실이 운영되는 상태입니다. date를 포함하는 되돌리지 않은 객실 상태 예약이 있으면 그 예약의 상태,
+// 없으면 BaseStatus입니다. 빈 객실 검색, 객실 배정, 점유 현황이 모두 이 기준으로 날짜별 객실 상태를 판단합니다.
+func (r *Room) StatusOn(schedules []RoomStatusSchedule, date time.Time) RoomStatus {
+	for i := range schedules {
+		if schedules[i].RevertedAt == nil && schedules[i].Covers(date) {
+			return schedules[i].Status
+		}
+	}
+	return r.BaseStatus(schedules)
+}
+
+// State는 today 기준 예약의 진행 상태입니다. 시작 전이면 SCHEDULED, 객실에 적용 중이면 ACTIVE, 끝났으면 COMPLETED입니다.
+func (s *RoomStatusSchedule) State(today time.Time) string {
+	switch {
+	case s.RevertedAt != nil || today.After(s.EndDate):
+		return "COMPLETED"
+	case s.AppliedAt != nil || !today.Before(s.StartDate):
+		return "ACTIVE"
+	default:
+		return "SCHEDULED"
+	}
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (s *RoomStatusSchedule) GetAuditEntityType() string {
+	return "room_status_schedule"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (s *RoomStatusSchedule) GetAuditEntityID() uint {
+	return s.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (s *RoomStatusSchedule) GetAuditFields() map[string]interface{} {
+	var previousStatus *string
+	if s.PreviousStatus != nil {
+		formatted := s.PreviousStatus.String()
+		previousStatus = &formatted
+	}
+
+	return map[string]interface{}{
+		"id":             s.ID,
+		"roomId":         s.RoomID,
+		"status":         s.Status.String(),
+		"startDate":      s.StartDate.Format("2006-01-02"),
+		"endDate":        s.EndDate.Format("2006-01-02"),
+		"note":           s.Note,
+		"previousStatus": previousStatus,
+		"appliedAt":      s.AppliedAt,
+		"revertedAt":     s.RevertedAt,
+		"createdBy":      s.CreatedBy,
+		"updatedBy":      s.UpdatedBy,
+		"createdAt":      s.CreatedAt,
+		"updatedAt":      s.UpdatedAt,
+	}
+}
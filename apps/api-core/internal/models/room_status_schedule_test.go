@@ -0,0 +1,28 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func TestRoom_StatusOn(t *testing.T) {
+	date := func(day int) time.Time { return time.Date(2026, 7, day, 0, 0, 0, 0, time.UTC) }
+	appliedAt := date(1)
+	normal := models.RoomStatusNormal
+
+	// 7/1 ~ 7/3 파손으로 바꿔 둔 예약과 7/10 ~ 7/12 공사 예약
+	room := models.Room{Status: models.RoomStatusDamaged}
+	schedules := []models.RoomStatusSchedule{
+		{Status: models.RoomStatusDamaged, StartDate: date(1), EndDate: date(3), PreviousStatus: &normal, AppliedAt: &appliedAt},
+		{Status: models.RoomStatusConstruction, StartDate: date(10), EndDate: date(12)},
+	}
+
+	assert.Equal(t, models.RoomStatusNormal, room.BaseStatus(schedules), "적용 중인 예약 전 상태가 평소 상태")
+	assert.Equal(t, models.RoomStatusDamaged, room.StatusOn(schedules, date(3)))
+	assert.Equal(t, models.RoomStatusNormal, room.StatusOn(schedules, date(4)), "예약이 끝난 뒤에는 평소 상태")
+	assert.Equal(t, models.RoomStatusConstruction, room.StatusOn(schedules, date(12)), "종료일 포함")
+	assert.Equal(t, models.RoomStatusDamaged, room.StatusOn(nil, date(20)), "예약이 없으면 지금 상태")
+}
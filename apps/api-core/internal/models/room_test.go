@@ -0,0 +1,76 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func amenity(id uint) models.Amenity {
+	var a models.Amenity
+	a.ID = id
+	return a
+}
+
+func TestRoom_Occupancy(t *testing.T) {
+	group := &models.RoomGroup{StandardOccupancy: 2, MaxOccupancy: 4}
+	three := 3
+
+	tests := []struct {
+		name             string
+		room             models.Room
+		expectedStandard int
+		expectedMax      int
+	}{
+		{"객실 그룹 인원을 따른다", models.Room{RoomGroup: group}, 2, 4},
+		{"객실 최대 인원이 그룹 값을 덮어쓴다", models.Room{RoomGroup: group, MaxOccupancy: &three}, 2, 3},
+		{"객실 그룹을 조회하지 않으면 객실 값만 사용한다", models.Room{StandardOccupancy: &three}, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard, max := tt.room.Occupancy()
+			assert.Equal(t, tt.expectedStandard, standard)
+			assert.Equal(t, tt.expectedMax, max)
+		})
+	}
+}
+
+func TestRoom_CanAccommodate(t *testing.T) {
+	limited := models.Room{RoomGroup: &models.RoomGroup{MaxOccupancy: 4}}
+	unlimited := models.Room{RoomGroup: &models.RoomGroup{}}
+
+	assert.True(t, limited.CanAccommodate(4))
+	assert.False(t, limited.CanAccommodate(5))
+	assert.True(t, unlimited.CanAccommodate(10), "최대 인원을 정하지 않으면 제한하지 않는다")
+}
+
+func TestRoom_HasAmenities(t *testing.T) {
+	room := models.Room{
+		Amenities: []models.Amenity{amenity(1)},
+		RoomGroup: &models.RoomGroup{Amenities: []models.Amenity{amenity(2)}},
+	}
+
+	assert.True(t, room.HasAmenities(nil))
+	assert.True(t, room.HasAmenities([]uint{1, 2}), "객실과 객실 그룹의 편의시설을 함께 본다")
+	assert.False(t, room.HasAmenities([]uint{1, 3}))
+}
+
+func TestReservation_Occupancy(t *testing.T) {
+	group := &models.RoomGroup{StandardOccupancy: 2, MaxOccupancy: 4}
+	unset := &models.RoomGroup{StandardOccupancy: 2}
+
+	reservation := models.Reservation{Rooms: []models.ReservationRoom{
+		{Room: &models.Room{RoomGroup: group}},
+		{Room: &models.Room{RoomGroup: group}},
+	}}
+	standard, max := reservation.Occupancy()
+	assert.Equal(t, 4, standard)
+	assert.Equal(t, 8, max)
+
+	reservation.Rooms = append(reservation.Rooms, models.ReservationRoom{Room: &models.Room{RoomGroup: unset}})
+	standard, max = reservation.Occupancy()
+	assert.Equal(t, 6, standard)
+	assert.Equal(t, 0, max, "최대 인원을 정하지 않은 객실이 있으면 확인하지 않는다")
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Season은 객실 그룹의 성수기 요금(PeekPrice)을 적용할 기간입니다.
+// 종료일을 포함하며, RecurringYearly가 true면 연도와 관계없이 매년 같은 월/일 구간에 적용됩니다.
+// RoomGroups가 비어 있으면 모든 객실 그룹에 적용됩니다.
+type Season struct {
+	BaseMustAuditEntity
+	Name            string      `gorm:"type:varchar(50);not null" json:"name"`
+	StartDate       time.Time   `gorm:"column:start_date;type:date;not null" json:"startDate"`
+	EndDate         time.Time   `gorm:"column:end_date;type:date;not null" json:"endDate"`
+	RecurringYearly bool        `gorm:"column:recurring_yearly;not null" json:"recurringYearly"`
+	RoomGroups      []RoomGroup `gorm:"many2many:season_room_group;joinForeignKey:SeasonID;joinReferences:RoomGroupID" json:"roomGroups,omitempty"`
+	CreatedByUser   *User       `gorm:"foreignKey:CreatedBy" json:"createdBy,omitempty"`
+	UpdatedByUser   *User       `gorm:"foreignKey:UpdatedBy" json:"updatedBy,omitempty"`
+}
+
+func (Season) TableName() string {
+	return "season"
+}
+
+func (s *Season) BeforeCreate(tx *gorm.DB) error {
+	if err := s.BaseMustAuditEntity.BeforeCreate(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AppliesToRoomGroup은 시즌이 해당 객실 그룹에 적용되는지 확인합니다.
+func (s *Season) AppliesToRoomGroup(roomGroupID uint) bool {
+	if len(s.RoomGroups) == 0 {
+		return true
+	}
+	for _, group := range s.RoomGroups {
+		if group.ID == roomGroupID {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers는 date(숙박일)가 시즌 기간에 포함되는지 확인합니다.
+// 매년 반복 시즌은 12/20 ~ 01/05처럼 연말을 넘어가는 구간도 지원합니다.
+func (s *Season) Covers(date time.Time) bool {
+	if !s.RecurringYearly {
+		day := date.Format("2006-01-02")
+		return day >= s.StartDate.Format("2006-01-02") && day <= s.EndDate.Format("2006-01-02")
+	}
+
+	day := date.Format("01-02")
+	start := s.StartDate.Format("01-02")
+	end := s.EndDate.Format("01-02")
+	if start <= end {
+		return day >= start && day <= end
+	}
+	return day >= start || day <= end
+}
+
+// GetAuditEntityType implements audit.Auditable interface
+func (s *Season) GetAuditEntityType() string {
+	return "season"
+}
+
+// GetAuditEntityID implements audit.Auditable interface
+func (s *Season) GetAuditEntityID() uint {
+	return s.ID
+}
+
+// GetAuditFields implements audit.Auditable interface
+func (s *Season) GetAuditFields() map[string]interface{} {
+	roomGroupIDs := make([]uint, len(s.RoomGroups))
+	for i, group := range s.RoomGroups {
+		roomGroupIDs[i] = group.ID
+	}
+
+	return map[string]interface{}{
+		"id":              s.ID,
+		"name":            s.Name,
+		"startDate":       s.StartDate.Format("2006-01-02"),
+		"endDate":         s.EndDate.Format("2006-01-02"),
+		"recurringYearly": s.RecurringYearly,
+		"roomGroupIds":    roomGroupIDs,
+		"createdBy":       s.CreatedBy,
+		"updatedBy":       s.UpdatedBy,
+		"createdAt":       s.CreatedAt,
+		"updatedAt":       s.UpdatedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSeason_Covers(t *testing.T) {
+	summer := models.Season{StartDate: date(2026, 7, 15), EndDate: date(2026, 8, 20)}
+	recurringSummer := models.Season{StartDate: date(2026, 7, 15), EndDate: date(2026, 8, 20), RecurringYearly: true}
+	yearEnd := models.Season{StartDate: date(2026, 12, 20), EndDate: date(2027, 1, 5), RecurringYearly: true}
+
+	tests := []struct {
+		name     string
+		season   models.Season
+		date     time.Time
+		expected bool
+	}{
+		{"시작일 포함", summer, date(2026, 7, 15), true},
+		{"종료일 포함", summer, date(2026, 8, 20), true},
+		{"종료일 다음날 제외", summer, date(2026, 8, 21), false},
+		{"반복하지 않으면 다음 해 제외", summer, date(2027, 7, 20), false},
+		{"매년 반복하면 다음 해 포함", recurringSummer, date(2027, 7, 20), true},
+		{"매년 반복해도 기간 밖은 제외", recurringSummer, date(2027, 9, 1), false},
+		{"연말을 넘는 반복 시즌 - 12월", yearEnd, date(2030, 12, 31), true},
+		{"연말을 넘는 반복 시즌 - 1월", yearEnd, date(2030, 1, 3), true},
+		{"연말을 넘는 반복 시즌 - 기간 밖", yearEnd, date(2030, 1, 10), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.season.Covers(tt.date))
+		})
+	}
+}
+
+func TestSeason_AppliesToRoomGroup(t *testing.T) {
+	group := models.RoomGroup{}
+	group.ID = 1
+
+	assert.True(t, (&models.Season{}).AppliesToRoomGroup(1), "객실 그룹을 지정하지 않으면 모든 그룹에 적용")
+	assert.True(t, (&models.Season{RoomGroups: []models.RoomGroup{group}}).AppliesToRoomGroup(1))
+	assert.False(t, (&models.Season{RoomGroups: []models.RoomGroup{group}}).AppliesToRoomGroup(2))
+}
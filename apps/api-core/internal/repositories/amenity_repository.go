@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type AmenityRepository interface {
+	Create(ctx context.Context, amenity *models.Amenity) (*models.Amenity, error)
+	Update(ctx context.Context, amenity *models.Amenity) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*models.Amenity, error)
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Amenity, error)
+	FindAll(ctx context.Context, category *models.AmenityCategory) ([]models.Amenity, error)
+	ExistsByCode(ctx context.Context, code string, excludeID *uint) (bool, error)
+}
+
+type amenityRepository struct {
+	db *gorm.DB
+}
+
+func NewAmenityRepository(db *gorm.DB) AmenityRepository {
+	return &amenityRepository{db: db}
+}
+
+func (r *amenityRepository) Create(ctx context.Context, amenity *models.Amenity) (*models.Amenity, error) {
+	err := dbFromContext(ctx, r.db).Create(amenity).Error
+	return amenity, err
+}
+
+func (r *amenityRepository) Update(ctx context.Context, amenity *models.Amenity) error {
+	return dbFromContext(ctx, r.db).Save(amenity).Error
+}
+
+// Delete는 편의시설을 삭제합니다. 객실 그룹과 객실 연결은 남지만 삭제된 편의시설은 조회되지 않습니다.
+func (r *amenityRepository) Delete(ctx context.Context, id uint) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"deleted_at": now,
+	}
+
+	if userID, ok := appContext.GetUserID(ctx); ok {
+		updates["updated_by"] = userID
+	}
+
+	return dbFromContext(ctx, r.db).Model(&models.Amenity{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (r *amenityRepository) FindByID(ctx context.Context, id uint) (*models.Amenity, error) {
+	var amenity models.Amenity
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&amenity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &amenity, nil
+}
+
+// FindByIDs는 ids에 해당하는 편의시설을 조회합니다. 없거나 삭제된 편의시설은 결과에서 빠집니다.
+func (r *amenityRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Amenity, error) {
+	amenities := []models.Amenity{}
+	if len(ids) == 0 {
+		return amenities, nil
+	}
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("id IN ? AND deleted_at = ?", ids, defaultDeletedAt).
+		Order("category, id").
+		Find(&amenities).Error
+	return amenities, err
+}
+
+func (r *amenityRepository) FindAll(ctx context.Context, category *models.AmenityCategory) ([]models.Amenity, error) {
+	var amenities []models.Amenity
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Where("deleted_at = ?", defaultDeletedAt)
+
+	if category != nil {
+		query = query.Where("category = ?", *category)
+	}
+
+	err := query.Order("category, id").Find(&amenities).Error
+	return amenities, err
+}
+
+func (r *amenityRepository) ExistsByCode(ctx context.Context, code string, excludeID *uint) (bool, error) {
+	var count int64
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Model(&models.Amenity{}).Where("code = ? AND deleted_at = ?", code, defaultDeletedAt)
+
+	if excludeID != nil {
+		query = query.Where("id != ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,248 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+// settlementReservationStatuses는 정산 대상 예약 상태입니다. 취소된 예약만 제외하며,
+// 환불 예약은 환불 금액과 돌려받을 수수료를 정산에 반영해야 하므로 포함합니다.
+var settlementReservationStatuses = []models.ReservationStatus{
+	models.ReservationStatusPending,
+	models.ReservationStatusNormal,
+	models.ReservationStatusCompleted,
+	models.ReservationStatusNoShow,
+	models.ReservationStatusRefund,
+}
+
+// settlementPaymentSelect는 결제 수단·기간·예약별로 결제 내역을 먼저 합산합니다.
+// 판매 금액은 예약마다 한 번만 더하기 위해 예약 단위로 묶은 뒤 settlementSummarySelect로 다시 합산합니다.
+const settlementPaymentSelect = `
+	reservation_payment.reservation_id as reservation_id,
+	reservation_payment.payment_method_id as payment_method_id,
+	MAX(reservation.price) as price,
+	COUNT(*) as payment_count,
+	SUM(CASE WHEN reservation_payment.broker_fee_settlement_id IS NOT NULL THEN 1 ELSE 0 END) as settled_count,
+	SUM(CASE WHEN reservation_payment.type = 3 THEN 0 ELSE reservation_payment.amount END) as paid_amount,
+	SUM(CASE WHEN reservation_payment.type = 3 THEN reservation_payment.amount ELSE 0 END) as refund_amount,
+	SUM(reservation_payment.broker_fee) as broker_fee`
+
+const settlementSummarySelect = `
+	COUNT(*) as reservation_count,
+	COALESCE(SUM(payments.payment_count), 0) as payment_count,
+	COALESCE(SUM(payments.settled_count), 0) as settled_count,
+	COALESCE(SUM(payments.price), 0) as gross_price,
+	COALESCE(SUM(payments.paid_amount), 0) as paid_amount,
+	COALESCE(SUM(payments.refund_amount), 0) as refund_amount,
+	COALESCE(SUM(payments.broker_fee), 0) as broker_fee`
+
+type BrokerFeeSettlementRepository interface {
+	Create(ctx context.Context, settlement *models.BrokerFeeSettlement) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*models.BrokerFeeSettlement, error)
+	FindAll(ctx context.Context, filter dto.BrokerFeeSettlementFilter, offset, limit int) ([]models.BrokerFeeSettlement, int64, error)
+	ExistsOverlapping(ctx context.Context, paymentMethodID uint, startDate, endDate time.Time) (bool, error)
+	Summarize(ctx context.Context, startDate, endDate time.Time, periodType string, paymentMethodID *uint) ([]models.BrokerFeeSettlementSummary, error)
+	SummarizeUnsettled(ctx context.Context, paymentMethodID uint, startDate, endDate time.Time) (*models.BrokerFeeSettlementSummary, error)
+	AssignPayments(ctx context.Context, settlementID, paymentMethodID uint, startDate, endDate time.Time) (int64, error)
+	ReleasePayments(ctx context.Context, settlementID uint) error
+}
+
+type brokerFeeSettlementRepository struct {
+	db *gorm.DB
+}
+
+func NewBrokerFeeSettlementRepository(db *gorm.DB) BrokerFeeSettlementRepository {
+	return &brokerFeeSettlementRepository{db: db}
+}
+
+func (r *brokerFeeSettlementRepository) Create(ctx context.Context, settlement *models.BrokerFeeSettlement) error {
+	return dbFromContext(ctx, r.db).Omit("PaymentMethod", "CreatedByUser").Create(settlement).Error
+}
+
+func (r *brokerFeeSettlementRepository) Delete(ctx context.Context, id uint) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now(),
+	}
+
+	if userID, ok := appContext.GetUserID(ctx); ok {
+		updates["updated_by"] = userID
+	}
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return dbFromContext(ctx, r.db).
+		Model(&models.BrokerFeeSettlement{}).
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		Updates(updates).Error
+}
+
+func (r *brokerFeeSettlementRepository) FindByID(ctx context.Context, id uint) (*models.BrokerFeeSettlement, error) {
+	var settlement models.BrokerFeeSettlement
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Preload("PaymentMethod").
+		Preload("CreatedByUser").
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		First(&settlement).Error
+	if err != nil {
+		return nil, err
+	}
+	return &settlement, nil
+}
+
+func (r *brokerFeeSettlementRepository) FindAll(ctx context.Context, filter dto.BrokerFeeSettlementFilter, offset, limit int) ([]models.BrokerFeeSettlement, int64, error) {
+	var settlements []models.BrokerFeeSettlement
+	var total int64
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Model(&models.BrokerFeeSettlement{}).Where("deleted_at = ?", defaultDeletedAt)
+
+	if filter.PaymentMethodID != nil {
+		query = query.Where("payment_method_id = ?", *filter.PaymentMethodID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("PaymentMethod").
+		Preload("CreatedByUser").
+		Order("period_start DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&settlements).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return settlements, total, nil
+}
+
+// ExistsOverlapping은 같은 결제 수단에 기간이 겹치는 정산이 있는지 확인합니다.
+func (r *brokerFeeSettlementRepository) ExistsOverlapping(ctx context.Context, paymentMethodID uint, startDate, endDate time.Time) (bool, error) {
+	var count int64
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Model(&models.BrokerFeeSettlement{}).
+		Where("payment_method_id = ? AND deleted_at = ?", paymentMethodID, defaultDeletedAt).
+		Where("period_start <= ? AND period_end >= ?", endDate, startDate).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Summarize는 입금일이 [startDate, endDate]에 속한 결제 내역을 결제 수단과 기간별로 합산합니다.
+func (r *brokerFeeSettlementRepository) Summarize(ctx context.Context, startDate, endDate time.Time, periodType string, paymentMethodID *uint) ([]models.BrokerFeeSettlementSummary, error) {
+	var summaries []models.BrokerFeeSettlementSummary
+
+	var dateFormat string
+	switch periodType {
+	case "DAILY":
+		dateFormat = "%Y-%m-%d"
+	case "YEARLY":
+		dateFormat = "%Y"
+	default:
+		dateFormat = "%Y-%m"
+	}
+
+	// 기간은 models.SettlementPeriodOffset과 같은 KST 입금일로 나눈다
+	payments := r.settlementPaymentQuery(ctx, startDate, endDate).
+		Select("DATE_FORMAT(CONVERT_TZ(reservation_payment.paid_at, '+00:00', '+09:00'), ?) as period,"+settlementPaymentSelect, dateFormat).
+		Group("period, reservation_payment.payment_method_id, reservation_payment.reservation_id")
+	if paymentMethodID != nil {
+		payments = payments.Where("reservation_payment.payment_method_id = ?", *paymentMethodID)
+	}
+
+	err := dbFromContext(ctx, r.db).
+		Table("(?) as payments", payments).
+		Select(`payments.period as period,
+			payments.payment_method_id as payment_method_id,
+			payment_method.name as payment_method_name,` + settlementSummarySelect).
+		Joins("JOIN payment_method ON payment_method.id = payments.payment_method_id").
+		Group("payments.period, payments.payment_method_id, payment_method.name").
+		Order("payments.period, payments.payment_method_id").
+		Scan(&summaries).Error
+
+	return summaries, err
+}
+
+// SummarizeUnsettled는 결제 수단의 입금일이 [startDate, endDate]인 결제 내역 중 아직 정산되지 않은 내역을 합산합니다.
+func (r *brokerFeeSettlementRepository) SummarizeUnsettled(ctx context.Context, paymentMethodID uint, startDate, endDate time.Time) (*models.BrokerFeeSettlementSummary, error) {
+	var summary models.BrokerFeeSettlementSummary
+
+	payments := r.settlementPaymentQuery(ctx, startDate, endDate).
+		Select(settlementPaymentSelect).
+		Where("reservation_payment.payment_method_id = ?", paymentMethodID).
+		Where("reservation_payment.broker_fee_settlement_id IS NULL").
+		Group("reservation_payment.payment_method_id, reservation_payment.reservation_id")
+
+	err := dbFromContext(ctx, r.db).
+		Table("(?) as payments", payments).
+		Select("? as payment_method_id,"+settlementSummarySelect, paymentMethodID).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// AssignPayments는 결제 수단의 입금일이 [startDate, endDate]인 결제 내역 중 정산되지 않은 내역을 정산에 연결하고,
+// 연결된 내역의 예약도 정산에 연결해 예약의 금액을 직접 수정할 수 없게 합니다.
+func (r *brokerFeeSettlementRepository) AssignPayments(ctx context.Context, settlementID, paymentMethodID uint, startDate, endDate time.Time) (int64, error) {
+	var paymentIDs []uint
+	err := r.settlementPaymentQuery(ctx, startDate, endDate).
+		Where("reservation_payment.payment_method_id = ?", paymentMethodID).
+		Where("reservation_payment.broker_fee_settlement_id IS NULL").
+		Pluck("reservation_payment.id", &paymentIDs).Error
+	if err != nil || len(paymentIDs) == 0 {
+		return 0, err
+	}
+
+	result := dbFromContext(ctx, r.db).
+		Model(&models.ReservationPayment{}).
+		Where("id IN ?", paymentIDs).
+		Update("broker_fee_settlement_id", settlementID)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	err = dbFromContext(ctx, r.db).
+		Model(&models.Reservation{}).
+		Where("id IN (SELECT reservation_id FROM reservation_payment WHERE broker_fee_settlement_id = ?)", settlementID).
+		Update("broker_fee_settlement_id", settlementID).Error
+	return result.RowsAffected, err
+}
+
+// ReleasePayments는 정산 취소 시 연결된 결제 내역의 정산 상태를 해제해 다시 수정할 수 있게 합니다.
+// 예약은 다른 정산에 포함된 결제 내역이 남아 있으면 그 정산에 연결된 채로 둡니다.
+func (r *brokerFeeSettlementRepository) ReleasePayments(ctx context.Context, settlementID uint) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(&models.ReservationPayment{}).
+		Where("broker_fee_settlement_id = ?", settlementID).
+		Update("broker_fee_settlement_id", nil).Error; err != nil {
+		return err
+	}
+
+	return dbFromContext(ctx, r.db).
+		Model(&models.Reservation{}).
+		Where("broker_fee_settlement_id = ?", settlementID).
+		Update("broker_fee_settlement_id", gorm.Expr(`(SELECT MAX(reservation_payment.broker_fee_settlement_id) FROM reservation_payment
+			WHERE reservation_payment.reservation_id = reservation.id AND reservation_payment.deleted_at = ?)`,
+			time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC))).Error
+}
+
+// settlementPaymentQuery는 입금일(KST)이 [startDate, endDate]인 정산 대상 예약의 결제 내역을 조회합니다.
+func (r *brokerFeeSettlementRepository) settlementPaymentQuery(ctx context.Context, startDate, endDate time.Time) *gorm.DB {
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return dbFromContext(ctx, r.db).
+		Model(&models.ReservationPayment{}).
+		Joins("JOIN reservation ON reservation.id = reservation_payment.reservation_id").
+		Where("reservation_payment.deleted_at = ? AND reservation.deleted_at = ?", defaultDeletedAt, defaultDeletedAt).
+		Where("reservation.status IN ?", settlementReservationStatuses).
+		Where("reservation_payment.paid_at >= ? AND reservation_payment.paid_at < ?",
+			startDate.Add(-models.SettlementPeriodOffset), endDate.AddDate(0, 0, 1).Add(-models.SettlementPeriodOffset))
+}
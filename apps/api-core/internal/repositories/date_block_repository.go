@@ -16,7 +16,38 @@ type DateBlockRepository interface {
 	Delete(ctx context.Context, id uint) error
 	FindByID(ctx context.Context, id uint) (*models.DateBlock, error)
 	FindAll(ctx context.Context, filter dto.DateBlockFilter, offset, limit int) ([]models.DateBlock, int64, error)
-	IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time) (bool, error)
+	IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time, roomIDs []uint) (bool, error)
+	FindConflictingReservations(ctx context.Context, dateBlock *models.DateBlock) ([]models.Reservation, error)
+	FindOverlapping(ctx context.Context, startDate, endDate time.Time) ([]models.DateBlock, error)
+}
+
+// dateBlockAppliesToAllRoomsExpr는 객실 그룹과 객실을 지정하지 않아 모든 객실을 차단하는 date_block 조건입니다.
+const dateBlockAppliesToAllRoomsExpr = "NOT EXISTS (SELECT 1 FROM date_block_room_group WHERE date_block_room_group.date_block_id = date_block.id)" +
+	" AND NOT EXISTS (SELECT 1 FROM date_block_room WHERE date_block_room.date_block_id = date_block.id)"
+
+// recurringDateBlockUntilExpr는 반복 날짜 차단의 마지막 회차가 주어진 날짜 이후까지 이어질 수 있는지 확인하는 조건입니다.
+const recurringDateBlockUntilExpr = "(date_block.recurrence_until IS NULL OR DATE_ADD(date_block.recurrence_until, INTERVAL DATEDIFF(date_block.end_date, date_block.start_date) DAY) >= ?)"
+
+// findRecurringDateBlocks는 query 조건에 맞는 반복 날짜 차단 중 [startDate, endDate) 기간에 회차가 있는 차단을 조회합니다.
+// 회차 계산은 SQL로 표현하기 어려우므로 후보를 조회한 뒤 DateBlock.Occurrences로 확인합니다.
+func findRecurringDateBlocks(query *gorm.DB, startDate, endDate time.Time) ([]models.DateBlock, error) {
+	var candidates []models.DateBlock
+	err := query.
+		Preload("Exceptions").
+		Where("date_block.recurrence <> ? AND date_block.start_date < ?", models.DateBlockRecurrenceNone, endDate).
+		Where(recurringDateBlockUntilExpr, startDate).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	dateBlocks := make([]models.DateBlock, 0, len(candidates))
+	for _, candidate := range candidates {
+		if len(candidate.Occurrences(startDate, endDate)) > 0 {
+			dateBlocks = append(dateBlocks, candidate)
+		}
+	}
+	return dateBlocks, nil
 }
 
 type dateBlockRepository struct {
@@ -28,13 +59,16 @@ func NewDateBlockRepository(db *gorm.DB) DateBlockRepository {
 }
 
 func (r *dateBlockRepository) Create(ctx context.Context, dateBlock *models.DateBlock) (*models.DateBlock, error) {
-	err := r.db.WithContext(ctx).Create(dateBlock).Error
+	err := dbFromContext(ctx, r.db).Omit("RoomGroups.*", "Rooms.*").Create(dateBlock).Error
 	if err != nil {
 		return nil, err
 	}
 
-	err = r.db.WithContext(ctx).
+	err = dbFromContext(ctx, r.db).
 		Preload("CreatedByUser").
+		Preload("RoomGroups").
+		Preload("Rooms").
+		Preload("Exceptions").
 		Where("id = ?", dateBlock.ID).
 		First(dateBlock).Error
 	if err != nil {
@@ -44,8 +78,32 @@ func (r *dateBlockRepository) Create(ctx context.Context, dateBlock *models.Date
 	return dateBlock, nil
 }
 
+// Update는 날짜 차단을 저장하고 차단 대상 객실 그룹과 객실 연결을 dateBlock.RoomGroups, dateBlock.Rooms로,
+// 제외 회차를 dateBlock.Exceptions로 교체합니다.
 func (r *dateBlockRepository) Update(ctx context.Context, dateBlock *models.DateBlock) error {
-	return r.db.WithContext(ctx).Save(dateBlock).Error
+	return runInTransaction(ctx, r.db, func(ctx context.Context) error {
+		db := dbFromContext(ctx, r.db)
+		if err := db.Omit("RoomGroups", "Rooms", "Exceptions").Save(dateBlock).Error; err != nil {
+			return err
+		}
+		if err := db.Model(dateBlock).Omit("RoomGroups.*").Association("RoomGroups").Replace(dateBlock.RoomGroups); err != nil {
+			return err
+		}
+		if err := db.Model(dateBlock).Omit("Rooms.*").Association("Rooms").Replace(dateBlock.Rooms); err != nil {
+			return err
+		}
+
+		if err := db.Where("date_block_id = ?", dateBlock.ID).Delete(&models.DateBlockException{}).Error; err != nil {
+			return err
+		}
+		for i := range dateBlock.Exceptions {
+			dateBlock.Exceptions[i].DateBlockID = dateBlock.ID
+		}
+		if len(dateBlock.Exceptions) == 0 {
+			return nil
+		}
+		return db.Create(&dateBlock.Exceptions).Error
+	})
 }
 
 func (r *dateBlockRepository) Delete(ctx context.Context, id uint) error {
@@ -59,7 +117,7 @@ func (r *dateBlockRepository) Delete(ctx context.Context, id uint) error {
 	}
 
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&models.DateBlock{}).
 		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
 		Updates(updates).Error
@@ -68,8 +126,11 @@ func (r *dateBlockRepository) Delete(ctx context.Context, id uint) error {
 func (r *dateBlockRepository) FindByID(ctx context.Context, id uint) (*models.DateBlock, error) {
 	var dateBlock models.DateBlock
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("CreatedByUser").
+		Preload("RoomGroups").
+		Preload("Rooms").
+		Preload("Exceptions").
 		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
 		First(&dateBlock).Error
 	if err != nil {
@@ -84,15 +145,23 @@ func (r *dateBlockRepository) FindAll(ctx context.Context, filter dto.DateBlockF
 	var total int64
 
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	query := r.db.WithContext(ctx).
+	query := dbFromContext(ctx, r.db).
 		Model(&models.DateBlock{}).
 		Where("deleted_at = ?", defaultDeletedAt).
-		Preload("CreatedByUser")
+		Preload("CreatedByUser").
+		Preload("RoomGroups").
+		Preload("Rooms").
+		Preload("Exceptions")
 
+	// 반복 날짜 차단은 첫 회차가 기간 종료 전에 시작하고 마지막 회차가 기간 시작 이후까지 이어질 수 있으면 포함합니다.
+	// 실제 회차 전개는 서비스에서 DateBlock.Occurrences로 합니다.
+	recurrenceNone := models.DateBlockRecurrenceNone
 	if filter.StartDate != "" && filter.EndDate != "" {
-		query = query.Where("NOT (end_date < ? OR start_date >= ?)", filter.StartDate, filter.EndDate)
+		query = query.Where("(recurrence = ? AND NOT (end_date < ? OR start_date >= ?)) OR (recurrence <> ? AND start_date < ? AND "+recurringDateBlockUntilExpr+")",
+			recurrenceNone, filter.StartDate, filter.EndDate, recurrenceNone, filter.EndDate, filter.StartDate)
 	} else if filter.StartDate != "" {
-		query = query.Where("end_date >= ?", filter.StartDate)
+		query = query.Where("(recurrence = ? AND end_date >= ?) OR (recurrence <> ? AND "+recurringDateBlockUntilExpr+")",
+			recurrenceNone, filter.StartDate, recurrenceNone, filter.StartDate)
 	} else if filter.EndDate != "" {
 		query = query.Where("start_date < ?", filter.EndDate)
 	}
@@ -109,17 +178,114 @@ func (r *dateBlockRepository) FindAll(ctx context.Context, filter dto.DateBlockF
 	return dateBlocks, total, nil
 }
 
-func (r *dateBlockRepository) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time) (bool, error) {
+// IsDateRangeBlocked는 [startDate, endDate) 기간이 roomIDs 객실 중 하나라도 차단하는 날짜 차단과 겹치는지 확인합니다.
+// 모든 객실에 적용되는 차단은 roomIDs와 관계없이 항상 확인하고, 반복 날짜 차단은 기간 안의 회차로 확인합니다.
+func (r *dateBlockRepository) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time, roomIDs []uint) (bool, error) {
 	var count int64
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	err := r.db.WithContext(ctx).
-		Model(&models.DateBlock{}).
-		Where("NOT (end_date < ? OR start_date >= ?) AND deleted_at = ?", startDate, endDate, defaultDeletedAt).
+	targetCondition := "(" + dateBlockAppliesToAllRoomsExpr + ")"
+	var targetArgs []interface{}
+	if len(roomIDs) > 0 {
+		targetCondition += " OR EXISTS (SELECT 1 FROM date_block_room WHERE date_block_room.date_block_id = date_block.id AND date_block_room.room_id IN ?)" +
+			" OR EXISTS (SELECT 1 FROM date_block_room_group JOIN room ON room.room_group_id = date_block_room_group.room_group_id" +
+			" WHERE date_block_room_group.date_block_id = date_block.id AND room.id IN ?)"
+		targetArgs = append(targetArgs, roomIDs, roomIDs)
+	}
+
+	targetQuery := func() *gorm.DB {
+		return dbFromContext(ctx, r.db).
+			Model(&models.DateBlock{}).
+			Where("date_block.deleted_at = ?", defaultDeletedAt).
+			Where("("+targetCondition+")", targetArgs...)
+	}
+
+	err := targetQuery().
+		Where("recurrence = ? AND NOT (end_date < ? OR start_date >= ?)", models.DateBlockRecurrenceNone, startDate, endDate).
 		Count(&count).Error
 	if err != nil {
 		return false, err
 	}
+	if count > 0 {
+		return true, nil
+	}
+
+	recurring, err := findRecurringDateBlocks(targetQuery(), startDate, endDate)
+	if err != nil {
+		return false, err
+	}
+
+	return len(recurring) > 0, nil
+}
+
+// FindConflictingReservations는 dateBlock의 회차와 겹치고 차단 대상 객실을 사용하는 정상/대기/이용 완료 예약을 숙박 시작일 순으로 조회합니다.
+// dateBlock은 저장 전 상태여도 되며, RoomGroups, Rooms, Exceptions가 채워져 있어야 합니다.
+func (r *dateBlockRepository) FindConflictingReservations(ctx context.Context, dateBlock *models.DateBlock) ([]models.Reservation, error) {
+	var candidates []models.Reservation
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := dbFromContext(ctx, r.db).
+		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
+		Preload("Rooms.Room").
+		Where("reservation.deleted_at = ?", defaultDeletedAt).
+		Where("reservation.status IN ?", models.OccupyingReservationStatuses).
+		Where("reservation.stay_end_at > ?", dateBlock.StartDate)
+
+	// 회차 계산은 DateBlock.Conflict로 하고, 여기서는 마지막 회차가 끝나기 전에 시작하는 예약으로 후보를 줄인다
+	span := dateBlock.EndDate.Sub(dateBlock.StartDate)
+	if !dateBlock.IsRecurring() {
+		query = query.Where("reservation.stay_start_at <= ?", dateBlock.EndDate)
+	} else if dateBlock.RecurrenceUntil != nil {
+		query = query.Where("reservation.stay_start_at <= ?", dateBlock.RecurrenceUntil.Add(span))
+	}
+
+	if !dateBlock.AppliesToAllRooms() {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM reservation_room JOIN room ON room.id = reservation_room.room_id"+
+				" WHERE reservation_room.reservation_id = reservation.id AND reservation_room.deleted_at = ?"+
+				" AND (room.id IN ? OR room.room_group_id IN ?))",
+			defaultDeletedAt, dateBlock.RoomIDs(), dateBlock.RoomGroupIDs(),
+		)
+	}
+
+	if err := query.Order("reservation.stay_start_at ASC, reservation.id ASC").Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	reservations := make([]models.Reservation, 0, len(candidates))
+	for i := range candidates {
+		if _, conflict := dateBlock.Conflict(&candidates[i]); conflict {
+			reservations = append(reservations, candidates[i])
+		}
+	}
+	return reservations, nil
+}
+
+// FindOverlapping은 [startDate, endDate) 기간에 회차가 있는 날짜 차단을 차단 대상과 제외 회차와 함께 조회합니다.
+// 반복하지 않는 차단을 시작일 순으로 먼저 반환하고, 반복 날짜 차단을 뒤에 붙입니다.
+func (r *dateBlockRepository) FindOverlapping(ctx context.Context, startDate, endDate time.Time) ([]models.DateBlock, error) {
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := func() *gorm.DB {
+		return dbFromContext(ctx, r.db).
+			Preload("RoomGroups").
+			Preload("Rooms").
+			Where("date_block.deleted_at = ?", defaultDeletedAt)
+	}
+
+	var dateBlocks []models.DateBlock
+	err := query().
+		Preload("Exceptions").
+		Where("date_block.recurrence = ? AND NOT (date_block.end_date < ? OR date_block.start_date >= ?)", models.DateBlockRecurrenceNone, startDate, endDate).
+		Order("date_block.start_date ASC, date_block.id ASC").
+		Find(&dateBlocks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	recurring, err := findRecurringDateBlocks(query().Order("date_block.start_date ASC, date_block.id ASC"), startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
 
-	return count > 0, nil
+	return append(dateBlocks, recurring...), nil
 }
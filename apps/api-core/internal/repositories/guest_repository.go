@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type GuestRepository interface {
+	Create(ctx context.Context, guest *models.Guest) (*models.Guest, error)
+	Update(ctx context.Context, guest *models.Guest) error
+	FindByID(ctx context.Context, id uint) (*models.Guest, error)
+	FindByPhone(ctx context.Context, phone string) (*models.Guest, error)
+	ExistsByPhone(ctx context.Context, phone string, excludeID *uint) (bool, error)
+	FindAll(ctx context.Context, filter dto.GuestRepositoryFilter, offset, limit int) ([]models.Guest, int64, error)
+	FindReservations(ctx context.Context, guestID uint) ([]models.Reservation, error)
+}
+
+type guestRepository struct {
+	db *gorm.DB
+}
+
+func NewGuestRepository(db *gorm.DB) GuestRepository {
+	return &guestRepository{db: db}
+}
+
+func (r *guestRepository) Create(ctx context.Context, guest *models.Guest) (*models.Guest, error) {
+	err := dbFromContext(ctx, r.db).Create(guest).Error
+	return guest, err
+}
+
+func (r *guestRepository) Update(ctx context.Context, guest *models.Guest) error {
+	return dbFromContext(ctx, r.db).Save(guest).Error
+}
+
+func (r *guestRepository) FindByID(ctx context.Context, id uint) (*models.Guest, error) {
+	var guest models.Guest
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&guest).Error
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+// FindByPhone은 정규화한 전화번호로 고객을 조회합니다. 같은 번호의 고객이 여럿이면 먼저 등록된 고객을 반환합니다.
+func (r *guestRepository) FindByPhone(ctx context.Context, phone string) (*models.Guest, error) {
+	var guest models.Guest
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("phone = ? AND deleted_at = ?", phone, defaultDeletedAt).
+		Order("id").
+		First(&guest).Error
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (r *guestRepository) ExistsByPhone(ctx context.Context, phone string, excludeID *uint) (bool, error) {
+	var count int64
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Model(&models.Guest{}).Where("phone = ? AND deleted_at = ?", phone, defaultDeletedAt)
+
+	if excludeID != nil {
+		query = query.Where("id != ?", *excludeID)
+	}
+
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
+func (r *guestRepository) FindAll(ctx context.Context, filter dto.GuestRepositoryFilter, offset, limit int) ([]models.Guest, int64, error) {
+	var guests []models.Guest
+	var total int64
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).
+		Model(&models.Guest{}).
+		Where("deleted_at = ?", defaultDeletedAt)
+
+	switch {
+	case filter.NameSearch != "" && filter.PhoneSearch != "":
+		query = query.Where("(name LIKE ? OR REPLACE(phone, '-', '') LIKE ?)", "%"+filter.NameSearch+"%", "%"+filter.PhoneSearch+"%")
+	case filter.NameSearch != "":
+		query = query.Where("name LIKE ?", "%"+filter.NameSearch+"%")
+	case filter.PhoneSearch != "":
+		query = query.Where("REPLACE(phone, '-', '') LIKE ?", "%"+filter.PhoneSearch+"%")
+	}
+	if filter.IsVIP != nil {
+		query = query.Where("is_vip = ?", *filter.IsVIP)
+	}
+	if filter.IsBlacklisted != nil {
+		query = query.Where("is_blacklisted = ?", *filter.IsBlacklisted)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&guests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return guests, total, nil
+}
+
+// FindReservations는 고객에 연결된 예약을 객실과 함께 숙박 시작일 순으로 조회합니다.
+func (r *guestRepository) FindReservations(ctx context.Context, guestID uint) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("guest_id = ? AND deleted_at = ?", guestID, defaultDeletedAt).
+		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
+		Preload("Rooms.Room", "deleted_at = ?", defaultDeletedAt).
+		Order("stay_start_at, id").
+		Find(&reservations).Error
+	return reservations, err
+}
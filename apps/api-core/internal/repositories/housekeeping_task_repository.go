@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type HousekeepingTaskRepository interface {
+	Create(ctx context.Context, task *models.HousekeepingTask) error
+	Update(ctx context.Context, task *models.HousekeepingTask) error
+	FindByID(ctx context.Context, id uint) (*models.HousekeepingTask, error)
+	FindAll(ctx context.Context, filter dto.HousekeepingTaskRepositoryFilter) ([]models.HousekeepingTask, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type housekeepingTaskRepository struct {
+	db *gorm.DB
+}
+
+func NewHousekeepingTaskRepository(db *gorm.DB) HousekeepingTaskRepository {
+	return &housekeepingTaskRepository{db: db}
+}
+
+func (r *housekeepingTaskRepository) Create(ctx context.Context, task *models.HousekeepingTask) error {
+	return dbFromContext(ctx, r.db).Omit("Room", "Assignee").Create(task).Error
+}
+
+func (r *housekeepingTaskRepository) Update(ctx context.Context, task *models.HousekeepingTask) error {
+	return dbFromContext(ctx, r.db).Omit("Room", "Assignee").Save(task).Error
+}
+
+func (r *housekeepingTaskRepository) FindByID(ctx context.Context, id uint) (*models.HousekeepingTask, error) {
+	var task models.HousekeepingTask
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Preload("Room").
+		Preload("Assignee").
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		First(&task).Error
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+// FindAll은 작업일의 청소 작업을 객실 번호 순으로 조회합니다.
+func (r *housekeepingTaskRepository) FindAll(ctx context.Context, filter dto.HousekeepingTaskRepositoryFilter) ([]models.HousekeepingTask, error) {
+	var tasks []models.HousekeepingTask
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).
+		Joins("JOIN room ON room.id = housekeeping_task.room_id").
+		Preload("Room").
+		Preload("Assignee").
+		Where("housekeeping_task.deleted_at = ?", defaultDeletedAt).
+		Where("housekeeping_task.task_date = ?", filter.TaskDate.Format("2006-01-02"))
+
+	if filter.AssigneeID != nil {
+		query = query.Where("housekeeping_task.assignee_id = ?", *filter.AssigneeID)
+	}
+	if filter.Status != nil {
+		query = query.Where("housekeeping_task.status = ?", *filter.Status)
+	}
+
+	err := query.Order("room.number ASC, housekeeping_task.id ASC").Find(&tasks).Error
+	return tasks, err
+}
+
+// Transaction은 fn 안에서 호출되는 리포지토리 작업을 하나의 DB 트랜잭션으로 묶습니다.
+func (r *housekeepingTaskRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runInTransaction(ctx, r.db, fn)
+}
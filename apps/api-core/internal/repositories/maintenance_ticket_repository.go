@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type MaintenanceTicketRepository interface {
+	Create(ctx context.Context, ticket *models.MaintenanceTicket) error
+	Update(ctx context.Context, ticket *models.MaintenanceTicket) error
+	FindByID(ctx context.Context, id uint) (*models.MaintenanceTicket, error)
+	FindAll(ctx context.Context, filter dto.MaintenanceTicketRepositoryFilter, offset, limit int) ([]models.MaintenanceTicket, int64, error)
+	CountOpenByRoomID(ctx context.Context, roomID uint) (int64, error)
+	FindAffectedReservations(ctx context.Context, roomID uint, from time.Time, until *time.Time) ([]models.Reservation, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type maintenanceTicketRepository struct {
+	db *gorm.DB
+}
+
+func NewMaintenanceTicketRepository(db *gorm.DB) MaintenanceTicketRepository {
+	return &maintenanceTicketRepository{db: db}
+}
+
+func (r *maintenanceTicketRepository) Create(ctx context.Context, ticket *models.MaintenanceTicket) error {
+	return dbFromContext(ctx, r.db).Omit("Room", "Assignee").Create(ticket).Error
+}
+
+func (r *maintenanceTicketRepository) Update(ctx context.Context, ticket *models.MaintenanceTicket) error {
+	return dbFromContext(ctx, r.db).Omit("Room", "Assignee").Save(ticket).Error
+}
+
+func (r *maintenanceTicketRepository) FindByID(ctx context.Context, id uint) (*models.MaintenanceTicket, error) {
+	var ticket models.MaintenanceTicket
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Preload("Room").
+		Preload("Assignee").
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		First(&ticket).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// FindAll은 유지보수 티켓을 열린 티켓, 높은 우선순위, 최근 등록 순으로 조회합니다.
+func (r *maintenanceTicketRepository) FindAll(ctx context.Context, filter dto.MaintenanceTicketRepositoryFilter, offset, limit int) ([]models.MaintenanceTicket, int64, error) {
+	var tickets []models.MaintenanceTicket
+	var total int64
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).
+		Model(&models.MaintenanceTicket{}).
+		Where("deleted_at = ?", defaultDeletedAt)
+
+	if filter.RoomID != nil {
+		query = query.Where("room_id = ?", *filter.RoomID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.Priority != nil {
+		query = query.Where("priority = ?", *filter.Priority)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Room").
+		Preload("Assignee").
+		Order("status ASC, priority DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&tickets).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tickets, total, nil
+}
+
+// CountOpenByRoomID는 객실의 열린 유지보수 티켓 수를 조회합니다.
+func (r *maintenanceTicketRepository) CountOpenByRoomID(ctx context.Context, roomID uint) (int64, error) {
+	var count int64
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Model(&models.MaintenanceTicket{}).
+		Where("room_id = ? AND status = ? AND deleted_at = ?", roomID, models.MaintenanceTicketStatusOpen, defaultDeletedAt).
+		Count(&count).Error
+	return count, err
+}
+
+// FindAffectedReservations는 [from, until) 기간에 객실을 사용하는 정상/대기 예약을 숙박 시작일 순으로 조회합니다.
+// until이 nil이면 from 이후의 모든 예약을 조회합니다. 숙박 중 객실을 옮긴 예약은 이 객실을 사용하는 구간으로 판단합니다.
+func (r *maintenanceTicketRepository) FindAffectedReservations(ctx context.Context, roomID uint, from time.Time, until *time.Time) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	segment := r.db.Model(&models.ReservationRoom{}).
+		Select("1").
+		Where("reservation_room.reservation_id = reservation.id").
+		Where("reservation_room.room_id = ? AND reservation_room.deleted_at = ?", roomID, defaultDeletedAt).
+		Where("reservation_room.stay_end_at > ?", from)
+	if until != nil {
+		segment = segment.Where("reservation_room.stay_start_at < ?", *until)
+	}
+
+	query := dbFromContext(ctx, r.db).
+		Where("reservation.deleted_at = ?", defaultDeletedAt).
+		Where("reservation.status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending}).
+		Where("EXISTS (?)", segment)
+
+	err := query.Order("reservation.stay_start_at ASC, reservation.id ASC").Find(&reservations).Error
+	return reservations, err
+}
+
+// Transaction은 fn 안에서 호출되는 리포지토리 작업을 하나의 DB 트랜잭션으로 묶습니다.
+func (r *maintenanceTicketRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runInTransaction(ctx, r.db, fn)
+}
@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type PricingRuleRepository interface {
+	Create(ctx context.Context, rule *models.PricingRule) (*models.PricingRule, error)
+	Update(ctx context.Context, rule *models.PricingRule) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*models.PricingRule, error)
+	FindAll(ctx context.Context) ([]models.PricingRule, error)
+	FindEnabled(ctx context.Context) ([]models.PricingRule, error)
+}
+
+type pricingRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewPricingRuleRepository(db *gorm.DB) PricingRuleRepository {
+	return &pricingRuleRepository{db: db}
+}
+
+func (r *pricingRuleRepository) Create(ctx context.Context, rule *models.PricingRule) (*models.PricingRule, error) {
+	err := dbFromContext(ctx, r.db).Create(rule).Error
+	return rule, err
+}
+
+func (r *pricingRuleRepository) Update(ctx context.Context, rule *models.PricingRule) error {
+	return dbFromContext(ctx, r.db).Save(rule).Error
+}
+
+func (r *pricingRuleRepository) Delete(ctx context.Context, id uint) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"deleted_at": now,
+	}
+
+	if userID, ok := appContext.GetUserID(ctx); ok {
+		updates["updated_by"] = userID
+	}
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return dbFromContext(ctx, r.db).
+		Model(&models.PricingRule{}).
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		Updates(updates).Error
+}
+
+func (r *pricingRuleRepository) FindByID(ctx context.Context, id uint) (*models.PricingRule, error) {
+	var rule models.PricingRule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&rule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *pricingRuleRepository) FindAll(ctx context.Context) ([]models.PricingRule, error) {
+	var rules []models.PricingRule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("deleted_at = ?", defaultDeletedAt).
+		Order("type ASC, id ASC").
+		Find(&rules).Error
+	return rules, err
+}
+
+// FindEnabled는 견적 계산에 사용할 활성 규칙을 조회합니다.
+func (r *pricingRuleRepository) FindEnabled(ctx context.Context) ([]models.PricingRule, error) {
+	var rules []models.PricingRule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("deleted_at = ? AND enabled = ?", defaultDeletedAt, true).
+		Order("type ASC, id ASC").
+		Find(&rules).Error
+	return rules, err
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type RentChargeRepository interface {
+	Update(ctx context.Context, charge *models.RentCharge) error
+	FindByID(ctx context.Context, id uint) (*models.RentCharge, error)
+	FindByReservationID(ctx context.Context, reservationID uint) ([]models.RentCharge, error)
+	FindOverdue(ctx context.Context, today time.Time, offset, limit int) ([]models.RentCharge, int64, error)
+}
+
+type rentChargeRepository struct {
+	db *gorm.DB
+}
+
+func NewRentChargeRepository(db *gorm.DB) RentChargeRepository {
+	return &rentChargeRepository{db: db}
+}
+
+func (r *rentChargeRepository) Update(ctx context.Context, charge *models.RentCharge) error {
+	return dbFromContext(ctx, r.db).Omit("Reservation").Save(charge).Error
+}
+
+func (r *rentChargeRepository) FindByID(ctx context.Context, id uint) (*models.RentCharge, error) {
+	var charge models.RentCharge
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&charge).Error
+	if err != nil {
+		return nil, err
+	}
+	return &charge, nil
+}
+
+func (r *rentChargeRepository) FindByReservationID(ctx context.Context, reservationID uint) ([]models.RentCharge, error) {
+	var charges []models.RentCharge
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("reservation_id = ? AND deleted_at = ?", reservationID, defaultDeletedAt).
+		Order("sequence").
+		Find(&charges).Error
+	return charges, err
+}
+
+// FindOverdue는 납부 기한이 today 이전인데 납부되지 않은 청구를 오래된 순으로 조회합니다.
+// 취소, 환불, 노쇼 처리된 예약의 청구는 제외합니다.
+func (r *rentChargeRepository) FindOverdue(ctx context.Context, today time.Time, offset, limit int) ([]models.RentCharge, int64, error) {
+	var charges []models.RentCharge
+	var total int64
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Model(&models.RentCharge{}).
+		Joins("JOIN reservation ON reservation.id = rent_charge.reservation_id").
+		Where("rent_charge.deleted_at = ? AND reservation.deleted_at = ?", defaultDeletedAt, defaultDeletedAt).
+		Where("reservation.status IN ?", []models.ReservationStatus{
+			models.ReservationStatusPending,
+			models.ReservationStatusNormal,
+			models.ReservationStatusCompleted,
+		}).
+		Where("rent_charge.paid_at IS NULL AND rent_charge.due_date < ?", today)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Reservation").
+		Order("rent_charge.due_date ASC, rent_charge.id ASC").
+		Offset(offset).Limit(limit).
+		Find(&charges).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return charges, total, nil
+}
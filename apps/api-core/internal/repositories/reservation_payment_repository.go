@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type ReservationPaymentRepository interface {
+	Create(ctx context.Context, payment *models.ReservationPayment) error
+	Update(ctx context.Context, payment *models.ReservationPayment) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*models.ReservationPayment, error)
+	FindByReservationID(ctx context.Context, reservationID uint) ([]models.ReservationPayment, error)
+}
+
+type reservationPaymentRepository struct {
+	db *gorm.DB
+}
+
+func NewReservationPaymentRepository(db *gorm.DB) ReservationPaymentRepository {
+	return &reservationPaymentRepository{db: db}
+}
+
+func (r *reservationPaymentRepository) Create(ctx context.Context, payment *models.ReservationPayment) error {
+	return dbFromContext(ctx, r.db).Omit("PaymentMethod", "CreatedByUser").Create(payment).Error
+}
+
+func (r *reservationPaymentRepository) Update(ctx context.Context, payment *models.ReservationPayment) error {
+	return dbFromContext(ctx, r.db).Omit("PaymentMethod", "CreatedByUser").Save(payment).Error
+}
+
+func (r *reservationPaymentRepository) Delete(ctx context.Context, id uint) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"deleted_at": now,
+	}
+
+	if userID, ok := appContext.GetUserID(ctx); ok {
+		updates["updated_by"] = userID
+	}
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return dbFromContext(ctx, r.db).
+		Model(&models.ReservationPayment{}).
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		Updates(updates).Error
+}
+
+func (r *reservationPaymentRepository) FindByID(ctx context.Context, id uint) (*models.ReservationPayment, error) {
+	var payment models.ReservationPayment
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Preload("PaymentMethod").
+		Preload("CreatedByUser").
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		First(&payment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// FindByReservationID는 예약의 결제 내역을 결제 일시 순으로 조회합니다.
+func (r *reservationPaymentRepository) FindByReservationID(ctx context.Context, reservationID uint) ([]models.ReservationPayment, error) {
+	var payments []models.ReservationPayment
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Preload("PaymentMethod").
+		Preload("CreatedByUser").
+		Where("reservation_id = ? AND deleted_at = ?", reservationID, defaultDeletedAt).
+		Order("paid_at ASC, id ASC").
+		Find(&payments).Error
+	return payments, err
+}
@@ -9,6 +9,7 @@ import (
 	"gitlab.bellsoft.net/rms/api-core/internal/dto"
 	"gitlab.bellsoft.net/rms/api-core/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ReservationRepository interface {
@@ -16,11 +17,16 @@ type ReservationRepository interface {
 	Update(ctx context.Context, reservation *models.Reservation) error
 	Delete(ctx context.Context, id uint) error
 	DeleteRooms(ctx context.Context, reservationID uint) error
+	DeleteUnpaidRentCharges(ctx context.Context, reservationID uint) error
+	LockRoomIDs(ctx context.Context, reservationID uint) ([]uint, error)
 	FindByID(ctx context.Context, id uint) (*models.Reservation, error)
 	FindByIDWithDetails(ctx context.Context, id uint) (*models.Reservation, error)
 	FindAll(ctx context.Context, filter dto.ReservationRepositoryFilter, offset, limit int, sort string) ([]models.Reservation, int64, error)
+	FindReceivables(ctx context.Context, filter dto.ReceivableFilter, offset, limit int, sort string) ([]models.Reservation, int64, error)
 	GetStatistics(ctx context.Context, startDate, endDate time.Time, periodType string) ([]ReservationStatistics, error)
 	FindLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error)
+	FindDuplicateCandidates(ctx context.Context, filter dto.DuplicateCandidateFilter) ([]models.Reservation, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type ReservationStatistics struct {
@@ -39,13 +45,18 @@ func NewReservationRepository(db *gorm.DB) ReservationRepository {
 	return &reservationRepository{db: db}
 }
 
+// Transaction은 fn 안에서 호출되는 리포지토리 작업을 하나의 DB 트랜잭션으로 묶습니다.
+func (r *reservationRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runInTransaction(ctx, r.db, fn)
+}
+
 func (r *reservationRepository) Create(ctx context.Context, reservation *models.Reservation) (*models.Reservation, error) {
-	err := r.db.WithContext(ctx).Create(reservation).Error
+	err := dbFromContext(ctx, r.db).Create(reservation).Error
 	return reservation, err
 }
 
 func (r *reservationRepository) Update(ctx context.Context, reservation *models.Reservation) error {
-	return r.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Save(reservation).Error
+	return dbFromContext(ctx, r.db).Session(&gorm.Session{FullSaveAssociations: true}).Save(reservation).Error
 }
 
 func (r *reservationRepository) Delete(ctx context.Context, id uint) error {
@@ -58,21 +69,43 @@ func (r *reservationRepository) Delete(ctx context.Context, id uint) error {
 		updates["updated_by"] = userID
 	}
 
-	return r.db.WithContext(ctx).Model(&models.Reservation{}).Where("id = ?", id).Updates(updates).Error
+	return dbFromContext(ctx, r.db).Model(&models.Reservation{}).Where("id = ?", id).Updates(updates).Error
 }
 
 func (r *reservationRepository) DeleteRooms(ctx context.Context, reservationID uint) error {
 	now := time.Now()
-	return r.db.WithContext(ctx).
+	return dbFromContext(ctx, r.db).
 		Model(&models.ReservationRoom{}).
 		Where("reservation_id = ? AND deleted_at = ?", reservationID, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
 		Update("deleted_at", now).Error
 }
 
+// LockRoomIDs는 예약이 사용하는 객실 ID를 잠금 조회(SELECT ... FOR UPDATE)로 읽습니다. 잠금 조회는 트랜잭션 스냅샷과
+// 상관없이 최신 커밋을 읽고 예약 객실 행을 트랜잭션이 끝날 때까지 잠그므로, 그 사이 다른 트랜잭션이 객실을 옮기거나 바꿀 수 없습니다.
+func (r *reservationRepository) LockRoomIDs(ctx context.Context, reservationID uint) ([]uint, error) {
+	var roomIDs []uint
+	err := dbFromContext(ctx, r.db).
+		Model(&models.ReservationRoom{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("reservation_id = ? AND deleted_at = ?", reservationID, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Order("room_id").
+		Pluck("room_id", &roomIDs).Error
+	return roomIDs, err
+}
+
+// DeleteUnpaidRentCharges는 달방 예약의 납부되지 않은 월별 청구를 삭제합니다. 납부된 청구는 결제 내역과 연결되어 있어 남겨둡니다.
+func (r *reservationRepository) DeleteUnpaidRentCharges(ctx context.Context, reservationID uint) error {
+	now := time.Now()
+	return dbFromContext(ctx, r.db).
+		Model(&models.RentCharge{}).
+		Where("reservation_id = ? AND paid_at IS NULL AND deleted_at = ?", reservationID, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Update("deleted_at", now).Error
+}
+
 func (r *reservationRepository) FindByID(ctx context.Context, id uint) (*models.Reservation, error) {
 	var reservation models.Reservation
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&reservation).Error
+	err := dbFromContext(ctx, r.db).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&reservation).Error
 	if err != nil {
 		return nil, err
 	}
@@ -82,11 +115,14 @@ func (r *reservationRepository) FindByID(ctx context.Context, id uint) (*models.
 func (r *reservationRepository) FindByIDWithDetails(ctx context.Context, id uint) (*models.Reservation, error) {
 	var reservation models.Reservation
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("PaymentMethod", "deleted_at = ?", defaultDeletedAt).
 		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
 		Preload("Rooms.Room", "deleted_at = ?", defaultDeletedAt).
 		Preload("Rooms.Room.RoomGroup", "deleted_at = ?", defaultDeletedAt).
+		Preload("RentCharges", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at = ?", defaultDeletedAt).Order("sequence")
+		}).
 		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
 		First(&reservation).Error
 	if err != nil {
@@ -100,7 +136,7 @@ func (r *reservationRepository) FindAll(ctx context.Context, filter dto.Reservat
 	var total int64
 
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	query := r.db.WithContext(ctx).Model(&models.Reservation{}).
+	query := dbFromContext(ctx, r.db).Model(&models.Reservation{}).
 		Where("deleted_at = ?", defaultDeletedAt).
 		Preload("PaymentMethod", "deleted_at = ?", defaultDeletedAt).
 		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
@@ -117,8 +153,9 @@ func (r *reservationRepository) FindAll(ctx context.Context, filter dto.Reservat
 
 	if filter.RoomID != nil {
 		defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-		query = query.Joins("JOIN reservation_room ON reservation_room.reservation_id = reservation.id").
-			Where("reservation_room.room_id = ? AND reservation_room.deleted_at = ?", *filter.RoomID, defaultDeletedAt)
+		// 숙박 중 옮긴 객실은 같은 예약에 구간이 여러 개라 JOIN하면 예약이 중복되므로 EXISTS로 확인한다
+		query = query.Where("EXISTS (SELECT 1 FROM reservation_room WHERE reservation_room.reservation_id = reservation.id"+
+			" AND reservation_room.room_id = ? AND reservation_room.deleted_at = ?)", *filter.RoomID, defaultDeletedAt)
 	}
 
 	if filter.StartDate != nil {
@@ -130,7 +167,17 @@ func (r *reservationRepository) FindAll(ctx context.Context, filter dto.Reservat
 
 	if filter.Search != "" {
 		searchPattern := "%" + filter.Search + "%"
-		query = query.Where("name LIKE ? OR phone LIKE ?", searchPattern, searchPattern)
+		// 전화번호처럼 보이는 검색어는 "010-1234-5678", "01012345678", "+82 10 1234 5678" 어느 형식으로 찾아도 숫자로 비교한다.
+		// 전화번호는 CanonicalPhone 형식(하이픈만 포함)으로 저장되므로 하이픈만 빼고 비교한다
+		if digits := models.PhoneSearch(filter.Search); digits != "" {
+			query = query.Where("(name LIKE ? OR REPLACE(phone, '-', '') LIKE ?)", searchPattern, "%"+digits+"%")
+		} else {
+			query = query.Where("name LIKE ?", searchPattern)
+		}
+	}
+
+	if filter.UnpaidOnly {
+		query = query.Where(unpaidAmountExpr + " > 0")
 	}
 
 	err := query.Count(&total).Error
@@ -152,6 +199,116 @@ func (r *reservationRepository) FindAll(ctx context.Context, filter dto.Reservat
 	return reservations, total, nil
 }
 
+// FindDuplicateCandidates는 [StartDate, EndDate) 기간과 숙박 기간이 겹치는 정상/대기/이용 완료 예약을 숙박 시작일 순으로 조회합니다.
+// Name이나 Phone을 지정하면 공백을 뺀 이름이 같거나 숫자만 남긴 전화번호가 같은 예약만 조회합니다.
+func (r *reservationRepository) FindDuplicateCandidates(ctx context.Context, filter dto.DuplicateCandidateFilter) ([]models.Reservation, error) {
+	var reservations []models.Reservation
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	query := dbFromContext(ctx, r.db).
+		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
+		Preload("Rooms.Room").
+		Where("reservation.deleted_at = ?", defaultDeletedAt).
+		Where("reservation.status IN ?", models.OccupyingReservationStatuses).
+		Where("reservation.stay_start_at < ? AND reservation.stay_end_at > ?", filter.EndDate, filter.StartDate)
+
+	switch {
+	case filter.Name != "" && filter.Phone != "":
+		query = query.Where("(REPLACE(reservation.name, ' ', '') = ? OR REGEXP_REPLACE(reservation.phone, '[^0-9]', '') = ?)", filter.Name, filter.Phone)
+	case filter.Name != "":
+		query = query.Where("REPLACE(reservation.name, ' ', '') = ?", filter.Name)
+	case filter.Phone != "":
+		query = query.Where("REGEXP_REPLACE(reservation.phone, '[^0-9]', '') = ?", filter.Phone)
+	}
+
+	err := query.Order("reservation.stay_start_at, reservation.id").Find(&reservations).Error
+	return reservations, err
+}
+
+// unpaidAmountExpr는 models.Reservation.UnpaidAmount와 같은 미수금 계산식입니다.
+const unpaidAmountExpr = "(reservation.price - reservation.payment_amount - reservation.deposit)"
+
+// FindReceivables는 미수금이 남은 예약(대기, 정상, 완료)을 조회합니다.
+// 정렬은 "unpaidAmount"(미수금)와 "ageDays"(숙박 시작일부터 지난 일수)를 지원하며, 기본은 오래된 순입니다.
+func (r *reservationRepository) FindReceivables(ctx context.Context, filter dto.ReceivableFilter, offset, limit int, sort string) ([]models.Reservation, int64, error) {
+	var reservations []models.Reservation
+	var total int64
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Model(&models.Reservation{}).
+		Where("reservation.deleted_at = ?", defaultDeletedAt).
+		Where("reservation.status IN ?", []models.ReservationStatus{
+			models.ReservationStatusPending,
+			models.ReservationStatusNormal,
+			models.ReservationStatusCompleted,
+		}).
+		Where(unpaidAmountExpr+" > 0").
+		Preload("PaymentMethod", "deleted_at = ?", defaultDeletedAt).
+		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
+		Preload("Rooms.Room", "deleted_at = ?", defaultDeletedAt)
+
+	if filter.PaymentMethodID != nil {
+		query = query.Where("reservation.payment_method_id = ?", *filter.PaymentMethodID)
+	}
+
+	if filter.RoomGroupID != nil {
+		query = query.Where(`EXISTS (SELECT 1 FROM reservation_room
+			JOIN room ON room.id = reservation_room.room_id
+			WHERE reservation_room.reservation_id = reservation.id
+			AND reservation_room.deleted_at = ? AND room.room_group_id = ?)`, defaultDeletedAt, *filter.RoomGroupID)
+	}
+
+	if filter.StartDate != nil {
+		query = query.Where("reservation.stay_end_at >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		query = query.Where("reservation.stay_start_at <= ?", *filter.EndDate)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	orderClause := r.parseReceivableSort(sort)
+	if orderClause == "" {
+		orderClause = "reservation.stay_start_at ASC, reservation.id ASC"
+	}
+
+	if err := query.Offset(offset).Limit(limit).Order(orderClause).Find(&reservations).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reservations, total, nil
+}
+
+// parseReceivableSort는 미수금 현황의 정렬 파라미터를 변환합니다.
+// ageDays는 숙박 시작일이 빠를수록 크므로 stay_start_at을 반대 방향으로 정렬합니다.
+func (r *reservationRepository) parseReceivableSort(sort string) string {
+	parts := strings.Split(sort, ",")
+
+	var orderClauses []string
+	for i := 0; i+1 < len(parts); i += 2 {
+		direction := strings.ToUpper(parts[i+1])
+		if direction != "ASC" && direction != "DESC" {
+			direction = "ASC"
+		}
+
+		switch parts[i] {
+		case "unpaidAmount":
+			orderClauses = append(orderClauses, unpaidAmountExpr+" "+direction)
+		case "ageDays":
+			if direction == "ASC" {
+				direction = "DESC"
+			} else {
+				direction = "ASC"
+			}
+			orderClauses = append(orderClauses, "reservation.stay_start_at "+direction)
+		}
+	}
+
+	return strings.Join(orderClauses, ", ")
+}
+
 // parseSort는 Spring Boot 형식의 정렬 파라미터를 GORM 형식으로 변환합니다.
 // 예: "price,desc" -> "price DESC"
 // 예: "price,desc,stayEndAt,asc" -> "price DESC, stay_end_at ASC"
@@ -232,7 +389,7 @@ func (r *reservationRepository) GetStatistics(ctx context.Context, startDate, en
 		dateFormat = "%Y-%m"
 	}
 
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Model(&models.Reservation{}).
 		Select(`
 			DATE_FORMAT(stay_start_at, ?) as period,
@@ -242,7 +399,8 @@ func (r *reservationRepository) GetStatistics(ctx context.Context, startDate, en
 			AVG(DATEDIFF(stay_end_at, stay_start_at)) as average_stay_days
 		`, dateFormat).
 		Where("stay_start_at >= ? AND stay_end_at <= ?", startDate, endDate).
-		Where("status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending}).
+		// 이용 완료(COMPLETED)된 예약도 실제 매출이므로 통계에 포함한다
+		Where("status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending, models.ReservationStatusCompleted}).
 		Where("deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
 		Group("period").
 		Order("period").
@@ -255,8 +413,8 @@ func (r *reservationRepository) FindLastReservationForRoom(ctx context.Context,
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
 	var reservation models.Reservation
 
-	// Find the last reservation for the room based on stay_end_at date
-	err := r.db.WithContext(ctx).
+	// Find the last reservation for the room based on the end date of the room's stay segment
+	err := dbFromContext(ctx, r.db).
 		Model(&models.Reservation{}).
 		Preload("PaymentMethod", "deleted_at = ?", defaultDeletedAt).
 		Preload("Rooms", "deleted_at = ?", defaultDeletedAt).
@@ -265,8 +423,8 @@ func (r *reservationRepository) FindLastReservationForRoom(ctx context.Context,
 		Joins("JOIN reservation_room ON reservation_room.reservation_id = reservation.id").
 		Where("reservation_room.room_id = ? AND reservation_room.deleted_at = ?", roomID, defaultDeletedAt).
 		Where("reservation.deleted_at = ?", defaultDeletedAt).
-		Where("reservation.status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending}).
-		Order("reservation.stay_end_at DESC").
+		Where("reservation.status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending, models.ReservationStatusCompleted}).
+		Order("reservation_room.stay_end_at DESC").
 		First(&reservation).Error
 
 	if err != nil {
@@ -0,0 +1,212 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type ReservationRepositoryTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	db       *gorm.DB
+	mock     sqlmock.Sqlmock
+	repo     repositories.ReservationRepository
+	roomRepo repositories.RoomRepository
+}
+
+func (suite *ReservationRepositoryTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(suite.T(), err)
+
+	suite.mock = mock
+
+	dialector := mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	})
+
+	suite.db, err = gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(suite.T(), err)
+
+	suite.repo = repositories.NewReservationRepository(suite.db)
+	suite.roomRepo = repositories.NewRoomRepository(suite.db)
+}
+
+func (suite *ReservationRepositoryTestSuite) TearDownTest() {
+	sqlDB, err := suite.db.DB()
+	if err == nil {
+		sqlDB.Close()
+	}
+}
+
+// =====================================================
+// Transaction Tests
+// =====================================================
+
+func (suite *ReservationRepositoryTestSuite) TestTransaction() {
+	suite.Run("트랜잭션 안의 리포지토리 호출은 같은 트랜잭션에서 실행되고 커밋된다", func() {
+		// Given
+		roomID := uint(1)
+		startDate := time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2026, 6, 12, 0, 0, 0, 0, time.UTC)
+
+		suite.mock.ExpectBegin()
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `room` WHERE id IN (?) ORDER BY id FOR UPDATE")).
+			WithArgs(roomID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(roomID))
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `reservation_room` JOIN reservation")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `room` WHERE room.id = ? AND EXISTS")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		suite.mock.ExpectCommit()
+
+		// When
+		var available bool
+		err := suite.repo.Transaction(suite.ctx, func(ctx context.Context) error {
+			if err := suite.roomRepo.LockRooms(ctx, []uint{roomID}); err != nil {
+				return err
+			}
+			var err error
+			available, err = suite.roomRepo.IsRoomAvailable(ctx, roomID, startDate, endDate, nil)
+			return err
+		})
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), available)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+
+	suite.Run("트랜잭션 함수가 에러를 반환하면 롤백된다", func() {
+		// Given
+		expectedErr := errors.New("객실 사용 불가")
+
+		suite.mock.ExpectBegin()
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `room` WHERE id IN (?) ORDER BY id FOR UPDATE")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		suite.mock.ExpectRollback()
+
+		// When
+		err := suite.repo.Transaction(suite.ctx, func(ctx context.Context) error {
+			if err := suite.roomRepo.LockRooms(ctx, []uint{1}); err != nil {
+				return err
+			}
+			return expectedErr
+		})
+
+		// Then
+		assert.ErrorIs(suite.T(), err, expectedErr)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+
+	suite.Run("이미 트랜잭션 안이라면 새 트랜잭션을 열지 않는다", func() {
+		// Given
+		suite.mock.ExpectBegin()
+		suite.mock.ExpectCommit()
+
+		// When
+		nestedCalled := false
+		err := suite.repo.Transaction(suite.ctx, func(ctx context.Context) error {
+			return suite.repo.Transaction(ctx, func(ctx context.Context) error {
+				nestedCalled = true
+				return nil
+			})
+		})
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), nestedCalled)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+}
+
+// =====================================================
+// FindAll Tests
+// =====================================================
+
+func (suite *ReservationRepositoryTestSuite) TestFindAll_Search() {
+	suite.Run("전화번호 검색어는 형식과 관계없이 숫자로 비교한다", func() {
+		// Given
+		searchQuery := regexp.QuoteMeta("(name LIKE ? OR REPLACE(phone, '-', '') LIKE ?)")
+		suite.mock.ExpectQuery("SELECT count\\(\\*\\) FROM `reservation` WHERE .*"+searchQuery).
+			WithArgs(sqlmock.AnyArg(), "%+82 10-1234%", "%0101234%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		suite.mock.ExpectQuery("SELECT \\* FROM `reservation` WHERE .*" + searchQuery).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// When
+		_, total, err := suite.repo.FindAll(suite.ctx, dto.ReservationRepositoryFilter{Search: "+82 10-1234"}, 0, 20, "")
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(0), total)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+
+	suite.Run("숫자가 섞인 이름 검색어는 전화번호로 찾지 않는다", func() {
+		// Given
+		suite.mock.ExpectQuery("SELECT count\\(\\*\\) FROM `reservation` WHERE deleted_at = \\? AND name LIKE \\?").
+			WithArgs(sqlmock.AnyArg(), "%102호 홍길동%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		suite.mock.ExpectQuery("SELECT \\* FROM `reservation`").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// When
+		_, _, err := suite.repo.FindAll(suite.ctx, dto.ReservationRepositoryFilter{Search: "102호 홍길동"}, 0, 20, "")
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+
+	suite.Run("숫자가 없는 검색어는 이름만 찾는다", func() {
+		// Given
+		suite.mock.ExpectQuery("SELECT count\\(\\*\\) FROM `reservation` WHERE deleted_at = \\? AND name LIKE \\?").
+			WithArgs(sqlmock.AnyArg(), "%홍길동%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		suite.mock.ExpectQuery("SELECT \\* FROM `reservation`").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// When
+		_, _, err := suite.repo.FindAll(suite.ctx, dto.ReservationRepositoryFilter{Search: "홍길동"}, 0, 20, "")
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+}
+
+func (suite *ReservationRepositoryTestSuite) TestLockRoomIDs() {
+	suite.Run("예약 객실 행을 SELECT ... FOR UPDATE로 읽는다", func() {
+		// Given
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT `room_id` FROM `reservation_room` WHERE reservation_id = ? AND deleted_at = ? ORDER BY room_id FOR UPDATE")).
+			WithArgs(1, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"room_id"}).AddRow(2).AddRow(5))
+
+		// When
+		roomIDs, err := suite.repo.LockRoomIDs(suite.ctx, 1)
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []uint{2, 5}, roomIDs)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+}
+
+func TestReservationRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationRepositoryTestSuite))
+}
@@ -13,6 +13,7 @@ type ReservationRoomRepository interface {
 	Create(ctx context.Context, reservationRoom *models.ReservationRoom) (*models.ReservationRoom, error)
 	Delete(ctx context.Context, id uint) error
 	FindByReservationID(ctx context.Context, reservationID uint) ([]models.ReservationRoom, error)
+	FindOccupying(ctx context.Context, startDate, endDate time.Time) ([]models.ReservationRoom, error)
 }
 
 type reservationRoomRepository struct {
@@ -24,7 +25,7 @@ func NewReservationRoomRepository(db *gorm.DB) ReservationRoomRepository {
 }
 
 func (r *reservationRoomRepository) Create(ctx context.Context, reservationRoom *models.ReservationRoom) (*models.ReservationRoom, error) {
-	err := r.db.WithContext(ctx).Create(reservationRoom).Error
+	err := dbFromContext(ctx, r.db).Create(reservationRoom).Error
 	return reservationRoom, err
 }
 
@@ -39,14 +40,30 @@ func (r *reservationRoomRepository) Delete(ctx context.Context, id uint) error {
 		updates["updated_by"] = userID
 	}
 
-	return r.db.WithContext(ctx).Model(&models.ReservationRoom{}).Where("id = ?", id).Updates(updates).Error
+	return dbFromContext(ctx, r.db).Model(&models.ReservationRoom{}).Where("id = ?", id).Updates(updates).Error
 }
 
 func (r *reservationRoomRepository) FindByReservationID(ctx context.Context, reservationID uint) ([]models.ReservationRoom, error) {
 	var reservationRooms []models.ReservationRoom
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("reservation_id = ? AND deleted_at = ?", reservationID, defaultDeletedAt).
 		Find(&reservationRooms).Error
 	return reservationRooms, err
 }
+
+// FindOccupying은 [startDate, endDate) 기간에 객실을 점유하는 정상/대기/이용 완료 예약의 객실 사용 구간을 예약과 함께
+// 구간 시작일 순으로 조회합니다. 숙박 중 객실을 옮긴 예약은 기간과 겹치는 구간만 조회됩니다.
+func (r *reservationRoomRepository) FindOccupying(ctx context.Context, startDate, endDate time.Time) ([]models.ReservationRoom, error) {
+	var reservationRooms []models.ReservationRoom
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Joins("JOIN reservation ON reservation.id = reservation_room.reservation_id").
+		Preload("Reservation").
+		Where("reservation_room.deleted_at = ? AND reservation.deleted_at = ?", defaultDeletedAt, defaultDeletedAt).
+		Where("reservation.status IN ?", models.OccupyingReservationStatuses).
+		Where("NOT (reservation_room.stay_end_at <= ? OR reservation_room.stay_start_at >= ?)", startDate, endDate).
+		Order("reservation_room.stay_start_at ASC, reservation_room.id ASC").
+		Find(&reservationRooms).Error
+	return reservationRooms, err
+}
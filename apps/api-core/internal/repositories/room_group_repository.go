@@ -40,12 +40,22 @@ func NewRoomGroupRepository(db *gorm.DB) RoomGroupRepository {
 }
 
 func (r *roomGroupRepository) Create(ctx context.Context, roomGroup *models.RoomGroup) (*models.RoomGroup, error) {
-	err := r.db.WithContext(ctx).Create(roomGroup).Error
+	err := r.db.WithContext(ctx).Omit("Amenities.*").Create(roomGroup).Error
 	return roomGroup, err
 }
 
+// Update는 객실 그룹을 저장합니다. roomGroup.Amenities가 nil이 아니면 편의시설 연결을 roomGroup.Amenities로 교체합니다.
 func (r *roomGroupRepository) Update(ctx context.Context, roomGroup *models.RoomGroup) error {
-	return r.db.WithContext(ctx).Save(roomGroup).Error
+	if roomGroup.Amenities == nil {
+		return r.db.WithContext(ctx).Save(roomGroup).Error
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Amenities").Save(roomGroup).Error; err != nil {
+			return err
+		}
+		return tx.Model(roomGroup).Omit("Amenities.*").Association("Amenities").Replace(roomGroup.Amenities)
+	})
 }
 
 func (r *roomGroupRepository) Delete(ctx context.Context, id uint) error {
@@ -114,8 +124,8 @@ func (r *roomGroupRepository) FindByIDWithFilteredRooms(ctx context.Context, id
 			Joins("JOIN reservation ON reservation.id = reservation_room.reservation_id").
 			Where("reservation_room.deleted_at = ?", defaultDeletedAt).
 			Where("reservation.deleted_at = ?", defaultDeletedAt).
-			Where("reservation.status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending}).
-			Where("NOT (reservation.stay_end_at <= ? OR reservation.stay_start_at >= ?)", *filter.StayStartAt, *filter.StayEndAt)
+			Where("reservation.status IN ?", models.OccupyingReservationStatuses).
+			Where("NOT (reservation_room.stay_end_at <= ? OR reservation_room.stay_start_at >= ?)", *filter.StayStartAt, *filter.StayEndAt)
 
 		if filter.ExcludeReservationID != nil {
 			reservedRoomIDs = reservedRoomIDs.Where("reservation.id != ?", *filter.ExcludeReservationID)
@@ -172,6 +182,7 @@ func (r *roomGroupRepository) FindByIDWithUsers(ctx context.Context, id uint) (*
 	err := r.db.WithContext(ctx).
 		Preload("CreatedByUser").
 		Preload("UpdatedByUser").
+		Preload("Amenities", "deleted_at = ?", defaultDeletedAt).
 		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
 		First(&roomGroup).Error
 	if err != nil {
@@ -202,6 +213,7 @@ func (r *roomGroupRepository) FindAllWithUsers(ctx context.Context, offset, limi
 	err = query.
 		Preload("CreatedByUser").
 		Preload("UpdatedByUser").
+		Preload("Amenities", "deleted_at = ?", defaultDeletedAt).
 		Offset(offset).Limit(limit).Find(&roomGroups).Error
 	if err != nil {
 		return nil, 0, err
@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+const (
+	roomHoldKeyPrefix = "room_hold:"
+	roomHoldSeqKey    = "room_hold:seq"
+	roomHoldIndexKey  = "room_hold:index"
+)
+
+// RoomHoldRepository는 Redis에 저장되는 임시 객실 홀드를 관리합니다.
+// 홀드 본문은 TTL이 걸린 개별 키에 저장되고, 조회를 위해 홀드 ID 목록을 별도 SET으로 유지합니다.
+// 만료된 홀드의 ID는 조회 시점에 SET에서 정리됩니다.
+type RoomHoldRepository interface {
+	Create(ctx context.Context, hold *models.RoomHold, ttl time.Duration) error
+	FindByID(ctx context.Context, id uint) (*models.RoomHold, error)
+	FindAll(ctx context.Context) ([]models.RoomHold, error)
+	FindOverlapping(ctx context.Context, startDate, endDate time.Time) ([]models.RoomHold, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type roomHoldRepository struct {
+	client *redis.Client
+}
+
+func NewRoomHoldRepository(client *redis.Client) RoomHoldRepository {
+	return &roomHoldRepository{client: client}
+}
+
+func roomHoldKey(id uint) string {
+	return roomHoldKeyPrefix + strconv.FormatUint(uint64(id), 10)
+}
+
+// Create는 홀드를 ttl 동안 저장합니다. ID가 비어 있으면 새 ID를 발급하고,
+// 이미 ID가 있으면 같은 ID로 다시 저장합니다 (홀드 복원용).
+func (r *roomHoldRepository) Create(ctx context.Context, hold *models.RoomHold, ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("room hold ttl must be positive: %s", ttl)
+	}
+
+	if hold.ID == 0 {
+		id, err := r.client.Incr(ctx, roomHoldSeqKey).Result()
+		if err != nil {
+			return err
+		}
+		hold.ID = uint(id)
+	}
+
+	now := time.Now()
+	if hold.CreatedAt.IsZero() {
+		hold.CreatedAt = now
+	}
+	hold.ExpiresAt = now.Add(ttl)
+
+	payload, err := json.Marshal(hold)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, roomHoldKey(hold.ID), payload, ttl)
+		pipe.SAdd(ctx, roomHoldIndexKey, hold.ID)
+		return nil
+	})
+	return err
+}
+
+// FindByID는 홀드를 조회합니다. 존재하지 않거나 만료된 경우 nil을 반환합니다.
+func (r *roomHoldRepository) FindByID(ctx context.Context, id uint) (*models.RoomHold, error) {
+	payload, err := r.client.Get(ctx, roomHoldKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var hold models.RoomHold
+	if err := json.Unmarshal(payload, &hold); err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+func (r *roomHoldRepository) FindAll(ctx context.Context) ([]models.RoomHold, error) {
+	members, err := r.client.SMembers(ctx, roomHoldIndexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return []models.RoomHold{}, nil
+	}
+
+	keys := make([]string, len(members))
+	for i, member := range members {
+		keys[i] = roomHoldKeyPrefix + member
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	holds := make([]models.RoomHold, 0, len(values))
+	var expired []interface{}
+	for i, value := range values {
+		payload, ok := value.(string)
+		if !ok {
+			expired = append(expired, members[i])
+			continue
+		}
+
+		var hold models.RoomHold
+		if err := json.Unmarshal([]byte(payload), &hold); err != nil {
+			return nil, err
+		}
+		holds = append(holds, hold)
+	}
+
+	if len(expired) > 0 {
+		if err := r.client.SRem(ctx, roomHoldIndexKey, expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return holds, nil
+}
+
+func (r *roomHoldRepository) FindOverlapping(ctx context.Context, startDate, endDate time.Time) ([]models.RoomHold, error) {
+	holds, err := r.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overlapping := make([]models.RoomHold, 0, len(holds))
+	for _, hold := range holds {
+		if hold.Overlaps(startDate, endDate) {
+			overlapping = append(overlapping, hold)
+		}
+	}
+	return overlapping, nil
+}
+
+func (r *roomHoldRepository) Delete(ctx context.Context, id uint) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, roomHoldKey(id))
+		pipe.SRem(ctx, roomHoldIndexKey, id)
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,209 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type RoomHoldRepositoryTestSuite struct {
+	suite.Suite
+	ctx       context.Context
+	miniRedis *miniredis.Miniredis
+	client    *redis.Client
+	repo      repositories.RoomHoldRepository
+}
+
+func (suite *RoomHoldRepositoryTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+
+	mr, err := miniredis.Run()
+	suite.Require().NoError(err)
+	suite.miniRedis = mr
+	suite.client = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	suite.repo = repositories.NewRoomHoldRepository(suite.client)
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TearDownTest() {
+	suite.client.Close()
+	suite.miniRedis.Close()
+}
+
+func (suite *RoomHoldRepositoryTestSuite) newHold(holderID uint, roomIDs ...uint) *models.RoomHold {
+	return &models.RoomHold{
+		RoomIDs:     roomIDs,
+		StayStartAt: time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC),
+		HolderID:    holderID,
+	}
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestCreate_ID를_발급하고_TTL과_함께_저장한다() {
+	// Given
+	hold := suite.newHold(1, 10, 11)
+
+	// When
+	err := suite.repo.Create(suite.ctx, hold, 10*time.Minute)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(uint(1), hold.ID)
+	suite.Equal(10*time.Minute, suite.miniRedis.TTL("room_hold:1"))
+
+	found, err := suite.repo.FindByID(suite.ctx, hold.ID)
+	suite.NoError(err)
+	suite.Require().NotNil(found)
+	suite.Equal([]uint{10, 11}, found.RoomIDs)
+	suite.Equal(uint(1), found.HolderID)
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestCreate_기존_ID로_복원한다() {
+	// Given - 이미 ID가 있는 홀드를 다시 저장하면
+	hold := suite.newHold(1, 10)
+	hold.ID = 42
+
+	// When
+	err := suite.repo.Create(suite.ctx, hold, time.Minute)
+
+	// Then - 같은 ID로 저장되고 시퀀스는 증가하지 않는다
+	suite.NoError(err)
+	suite.Equal(uint(42), hold.ID)
+	suite.False(suite.miniRedis.Exists("room_hold:seq"))
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestCreate_TTL이_0이하면_에러() {
+	err := suite.repo.Create(suite.ctx, suite.newHold(1, 10), 0)
+	suite.Error(err)
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestFindByID_만료되면_nil() {
+	// Given
+	hold := suite.newHold(1, 10)
+	suite.Require().NoError(suite.repo.Create(suite.ctx, hold, time.Minute))
+
+	// When - TTL이 지나면
+	suite.miniRedis.FastForward(2 * time.Minute)
+	found, err := suite.repo.FindByID(suite.ctx, hold.ID)
+
+	// Then
+	suite.NoError(err)
+	suite.Nil(found)
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestFindAll_만료된_홀드는_인덱스에서_정리된다() {
+	// Given
+	shortHold := suite.newHold(1, 10)
+	longHold := suite.newHold(2, 11)
+	suite.Require().NoError(suite.repo.Create(suite.ctx, shortHold, time.Minute))
+	suite.Require().NoError(suite.repo.Create(suite.ctx, longHold, 30*time.Minute))
+	suite.miniRedis.FastForward(5 * time.Minute)
+
+	// When
+	holds, err := suite.repo.FindAll(suite.ctx)
+
+	// Then
+	suite.NoError(err)
+	suite.Require().Len(holds, 1)
+	suite.Equal(longHold.ID, holds[0].ID)
+
+	members, err := suite.miniRedis.Members("room_hold:index")
+	suite.NoError(err)
+	suite.Equal([]string{"2"}, members)
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestFindOverlapping() {
+	// Given - 5/1~5/3 홀드
+	hold := suite.newHold(1, 10)
+	suite.Require().NoError(suite.repo.Create(suite.ctx, hold, time.Minute))
+
+	testCases := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected int
+	}{
+		{"기간이 겹치면 조회된다", time.Date(2030, 5, 2, 0, 0, 0, 0, time.UTC), time.Date(2030, 5, 4, 0, 0, 0, 0, time.UTC), 1},
+		{"체크아웃 당일 입실은 겹치지 않는다", time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC), time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC), 0},
+		{"이전 기간은 겹치지 않는다", time.Date(2030, 4, 28, 0, 0, 0, 0, time.UTC), time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC), 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			holds, err := suite.repo.FindOverlapping(suite.ctx, tc.start, tc.end)
+			suite.NoError(err)
+			suite.Len(holds, tc.expected)
+		})
+	}
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestDelete() {
+	// Given
+	hold := suite.newHold(1, 10)
+	suite.Require().NoError(suite.repo.Create(suite.ctx, hold, time.Minute))
+
+	// When
+	err := suite.repo.Delete(suite.ctx, hold.ID)
+
+	// Then
+	suite.NoError(err)
+	found, err := suite.repo.FindByID(suite.ctx, hold.ID)
+	suite.NoError(err)
+	suite.Nil(found)
+	suite.False(suite.miniRedis.Exists("room_hold:index"))
+}
+
+func (suite *RoomHoldRepositoryTestSuite) TestRoomRepository_IsRoomAvailable_다른_사용자의_홀드() {
+	// Given - 사용자 1이 10번 객실을 홀드한 상태
+	suite.Require().NoError(suite.repo.Create(suite.ctx, suite.newHold(1, 10), time.Minute))
+
+	sqlDB, mock, err := sqlmock.New()
+	suite.Require().NoError(err)
+	defer sqlDB.Close()
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	suite.Require().NoError(err)
+	roomRepo := repositories.NewRoomRepository(db, suite.repo)
+
+	start := time.Date(2030, 5, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2030, 5, 4, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected bool
+	}{
+		{"다른 사용자에게는 예약 불가능하다", appContext.WithUserID(suite.ctx, 2), false},
+		{"사용자 정보가 없으면 예약 불가능하다", suite.ctx, false},
+		{"홀드한 본인에게는 예약 가능하다", appContext.WithUserID(suite.ctx, 1), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			mock.ExpectQuery("SELECT count\\(\\*\\) FROM `reservation_room`").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			mock.ExpectQuery("SELECT count\\(\\*\\) FROM `room`").
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+			// When
+			available, err := roomRepo.IsRoomAvailable(tc.ctx, 10, start, end, nil)
+
+			// Then
+			suite.NoError(err)
+			suite.Equal(tc.expected, available)
+		})
+	}
+	suite.NoError(mock.ExpectationsWereMet())
+}
+
+func TestRoomHoldRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RoomHoldRepositoryTestSuite))
+}
@@ -9,6 +9,7 @@ import (
 	"gitlab.bellsoft.net/rms/api-core/internal/dto"
 	"gitlab.bellsoft.net/rms/api-core/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type RoomRepository interface {
@@ -23,23 +24,59 @@ type RoomRepository interface {
 	IsRoomAvailable(ctx context.Context, roomID uint, startDate, endDate time.Time, excludeReservationID *uint) (bool, error)
 	FindByNumber(ctx context.Context, number string) (*models.Room, error)
 	FindByStatus(ctx context.Context, status models.RoomStatus) ([]models.Room, error)
+	FindAllWithDetails(ctx context.Context) ([]models.Room, error)
+	LockRooms(ctx context.Context, roomIDs []uint) error
+	UpdateHousekeepingStatus(ctx context.Context, roomID uint, status models.HousekeepingStatus) error
 }
 
 type roomRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	holdRepo RoomHoldRepository
 }
 
-func NewRoomRepository(db *gorm.DB) RoomRepository {
-	return &roomRepository{db: db}
+// NewRoomRepository는 객실 리포지토리를 생성합니다.
+// holdRepo를 넘기면 다른 사용자가 임시 홀드 중인 객실을 예약 불가능한 객실로 취급합니다.
+func NewRoomRepository(db *gorm.DB, holdRepo ...RoomHoldRepository) RoomRepository {
+	var roomHoldRepository RoomHoldRepository
+	if len(holdRepo) > 0 {
+		roomHoldRepository = holdRepo[0]
+	}
+	return &roomRepository{db: db, holdRepo: roomHoldRepository}
+}
+
+// roomMaxOccupancyExpr는 객실별 최대 인원이 없으면 객실 그룹의 최대 인원을 쓰는 객실 최대 인원 식입니다.
+const roomMaxOccupancyExpr = "COALESCE(room.max_occupancy, (SELECT room_group.max_occupancy FROM room_group WHERE room_group.id = room.room_group_id))"
+
+// roomHasAmenityExpr는 객실 또는 객실의 그룹에 편의시설이 붙어 있는지 확인하는 조건입니다.
+const roomHasAmenityExpr = "(EXISTS (SELECT 1 FROM room_amenity WHERE room_amenity.room_id = room.id AND room_amenity.amenity_id = ?)" +
+	" OR EXISTS (SELECT 1 FROM room_group_amenity WHERE room_group_amenity.room_group_id = room.room_group_id AND room_group_amenity.amenity_id = ?))"
+
+// preloadRoomAmenities는 객실과 객실 그룹의 편의시설을 함께 조회합니다. RoomGroup preload 뒤에 사용해야 합니다.
+func preloadRoomAmenities(query *gorm.DB) *gorm.DB {
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return query.
+		Preload("Amenities", "deleted_at = ?", defaultDeletedAt).
+		Preload("RoomGroup.Amenities", "deleted_at = ?", defaultDeletedAt)
 }
 
 func (r *roomRepository) Create(ctx context.Context, room *models.Room) (*models.Room, error) {
-	err := r.db.WithContext(ctx).Create(room).Error
+	err := dbFromContext(ctx, r.db).Omit("Amenities.*").Create(room).Error
 	return room, err
 }
 
+// Update는 객실을 저장합니다. room.Amenities가 nil이 아니면 객실 편의시설 연결을 room.Amenities로 교체합니다.
 func (r *roomRepository) Update(ctx context.Context, room *models.Room) error {
-	return r.db.WithContext(ctx).Save(room).Error
+	if room.Amenities == nil {
+		return dbFromContext(ctx, r.db).Save(room).Error
+	}
+
+	return runInTransaction(ctx, r.db, func(ctx context.Context) error {
+		db := dbFromContext(ctx, r.db)
+		if err := db.Omit("Amenities").Save(room).Error; err != nil {
+			return err
+		}
+		return db.Model(room).Omit("Amenities.*").Association("Amenities").Replace(room.Amenities)
+	})
 }
 
 func (r *roomRepository) Delete(ctx context.Context, id uint) error {
@@ -53,13 +90,13 @@ func (r *roomRepository) Delete(ctx context.Context, id uint) error {
 		updates["updated_by"] = userID
 	}
 
-	return r.db.WithContext(ctx).Model(&models.Room{}).Where("id = ?", id).Updates(updates).Error
+	return dbFromContext(ctx, r.db).Model(&models.Room{}).Where("id = ?", id).Updates(updates).Error
 }
 
 func (r *roomRepository) FindByID(ctx context.Context, id uint) (*models.Room, error) {
 	var room models.Room
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&room).Error
+	err := dbFromContext(ctx, r.db).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&room).Error
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +106,8 @@ func (r *roomRepository) FindByID(ctx context.Context, id uint) (*models.Room, e
 func (r *roomRepository) FindByIDWithGroup(ctx context.Context, id uint) (*models.Room, error) {
 	var room models.Room
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&room).Error
+	query := dbFromContext(ctx, r.db).Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt)
+	err := preloadRoomAmenities(query).Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).First(&room).Error
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +119,7 @@ func (r *roomRepository) FindAll(ctx context.Context, filter dto.RoomRepositoryF
 	var total int64
 
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	query := r.db.WithContext(ctx).Model(&models.Room{}).Where("deleted_at = ?", defaultDeletedAt).Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt)
+	query := dbFromContext(ctx, r.db).Model(&models.Room{}).Where("deleted_at = ?", defaultDeletedAt).Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt)
 
 	if filter.RoomGroupID != nil {
 		query = query.Where("room_group_id = ?", *filter.RoomGroupID)
@@ -95,6 +133,15 @@ func (r *roomRepository) FindAll(ctx context.Context, filter dto.RoomRepositoryF
 		query = query.Where("number LIKE ?", "%"+filter.Search+"%")
 	}
 
+	// 최대 인원을 정하지 않은 객실은 인원 제한이 없는 것으로 봅니다
+	if filter.PeopleCount != nil {
+		query = query.Where("("+roomMaxOccupancyExpr+" = 0 OR "+roomMaxOccupancyExpr+" >= ?)", *filter.PeopleCount)
+	}
+
+	for _, amenityID := range filter.AmenityIDs {
+		query = query.Where(roomHasAmenityExpr, amenityID, amenityID)
+	}
+
 	err := query.Count(&total).Error
 	if err != nil {
 		return nil, 0, err
@@ -123,28 +170,83 @@ func (r *roomRepository) FindAvailableRooms(ctx context.Context, startDate, endD
 		Joins("JOIN reservation ON reservation.id = reservation_room.reservation_id").
 		Where("reservation_room.deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
 		Where("reservation.deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
-		Where("reservation.status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending}).
-		Where("NOT (reservation.stay_end_at <= ? OR reservation.stay_start_at >= ?)", startDate, endDate)
+		Where("reservation.status IN ?", models.OccupyingReservationStatuses).
+		Where("NOT (reservation_room.stay_end_at <= ? OR reservation_room.stay_start_at >= ?)", startDate, endDate)
 
 	if excludeReservationID != nil {
 		subQuery = subQuery.Where("reservation.id != ?", *excludeReservationID)
 	}
 
+	heldRoomIDs, err := r.findHeldRoomIDs(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).
+	// 지금 정상 상태가 아니어도 객실 상태 예약으로 바뀐 것이고 숙박 시작 전에 정상으로 돌아오면 포함하고,
+	// 숙박 기간에 걸리는 객실 상태 예약이 있으면 제외합니다.
+	query := dbFromContext(ctx, r.db).
 		Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt).
-		Where("status = ? AND deleted_at = ?", models.RoomStatusNormal, defaultDeletedAt).
-		Where("id NOT IN (?)", subQuery).
-		Order("room_group_id, number").
-		Find(&rooms).Error
+		Where("deleted_at = ?", defaultDeletedAt).
+		Where("(status = ? OR EXISTS (?))", models.RoomStatusNormal, r.returningToNormalSubQuery(startDate)).
+		Where("NOT EXISTS (?)", r.scheduledStatusSubQuery(startDate, endDate)).
+		Where("id NOT IN (?)", subQuery)
 
-	return rooms, err
+	if len(heldRoomIDs) > 0 {
+		query = query.Where("id NOT IN ?", heldRoomIDs)
+	}
+
+	// 기간과 겹치는 날짜 차단 중 모든 객실, 객실의 그룹 또는 객실 자체를 대상으로 하는 차단이 있으면 제외합니다.
+	blockedSubQuery := r.db.Model(&models.DateBlock{}).
+		Select("1").
+		Where("date_block.deleted_at = ?", defaultDeletedAt).
+		Where("date_block.recurrence = ?", models.DateBlockRecurrenceNone).
+		Where("NOT (date_block.end_date < ? OR date_block.start_date >= ?)", startDate, endDate).
+		Where("((" + dateBlockAppliesToAllRoomsExpr +
+			") OR EXISTS (SELECT 1 FROM date_block_room WHERE date_block_room.date_block_id = date_block.id AND date_block_room.room_id = room.id)" +
+			" OR EXISTS (SELECT 1 FROM date_block_room_group WHERE date_block_room_group.date_block_id = date_block.id AND date_block_room_group.room_group_id = room.room_group_id))")
+	query = query.Where("NOT EXISTS (?)", blockedSubQuery)
+
+	if err := preloadRoomAmenities(query).Order("room_group_id, number").Find(&rooms).Error; err != nil {
+		return nil, err
+	}
+
+	// 반복 날짜 차단은 회차를 계산해야 하므로 기간에 회차가 있는 차단을 조회한 뒤 대상 객실을 제외합니다.
+	recurringBlocks, err := findRecurringDateBlocks(
+		dbFromContext(ctx, r.db).
+			Preload("RoomGroups").
+			Preload("Rooms").
+			Where("date_block.deleted_at = ?", defaultDeletedAt),
+		startDate, endDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(recurringBlocks) == 0 {
+		return rooms, nil
+	}
+
+	availableRooms := make([]models.Room, 0, len(rooms))
+	for _, room := range rooms {
+		blocked := false
+		for i := range recurringBlocks {
+			if recurringBlocks[i].AppliesToRoom(room.ID, room.RoomGroupID) {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			availableRooms = append(availableRooms, room)
+		}
+	}
+
+	return availableRooms, nil
 }
 
 func (r *roomRepository) ExistsByNumber(ctx context.Context, number string, excludeID *uint) (bool, error) {
 	var count int64
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	query := r.db.WithContext(ctx).Model(&models.Room{}).Where("number = ? AND deleted_at = ?", number, defaultDeletedAt)
+	query := dbFromContext(ctx, r.db).Model(&models.Room{}).Where("number = ? AND deleted_at = ?", number, defaultDeletedAt)
 
 	if excludeID != nil {
 		query = query.Where("id != ?", *excludeID)
@@ -157,27 +259,99 @@ func (r *roomRepository) ExistsByNumber(ctx context.Context, number string, excl
 func (r *roomRepository) IsRoomAvailable(ctx context.Context, roomID uint, startDate, endDate time.Time, excludeReservationID *uint) (bool, error) {
 	var count int64
 
-	query := r.db.WithContext(ctx).
+	query := dbFromContext(ctx, r.db).
 		Model(&models.ReservationRoom{}).
 		Joins("JOIN reservation ON reservation.id = reservation_room.reservation_id").
 		Where("reservation_room.room_id = ?", roomID).
 		Where("reservation_room.deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
 		Where("reservation.deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
-		Where("reservation.status IN ?", []models.ReservationStatus{models.ReservationStatusNormal, models.ReservationStatusPending}).
-		Where("NOT (reservation.stay_end_at <= ? OR reservation.stay_start_at >= ?)", startDate, endDate)
+		Where("reservation.status IN ?", models.OccupyingReservationStatuses).
+		Where("NOT (reservation_room.stay_end_at <= ? OR reservation_room.stay_start_at >= ?)", startDate, endDate)
 
 	if excludeReservationID != nil {
 		query = query.Where("reservation.id != ?", *excludeReservationID)
 	}
 
-	err := query.Count(&count).Error
-	return count == 0, err
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	err := dbFromContext(ctx, r.db).
+		Table("room").
+		Where("room.id = ?", roomID).
+		Where("EXISTS (?)", r.scheduledStatusSubQuery(startDate, endDate)).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	heldRoomIDs, err := r.findHeldRoomIDs(ctx, startDate, endDate)
+	if err != nil {
+		return false, err
+	}
+	for _, heldRoomID := range heldRoomIDs {
+		if heldRoomID == roomID {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// scheduledStatusSubQuery는 [startDate, endDate) 숙박일 중 하루라도 room의 객실 상태 예약 기간(종료일 포함)에 걸리는지 확인하는 서브쿼리입니다.
+func (r *roomRepository) scheduledStatusSubQuery(startDate, endDate time.Time) *gorm.DB {
+	return r.db.Model(&models.RoomStatusSchedule{}).
+		Select("1").
+		Where("room_status_schedule.room_id = room.id").
+		Where("room_status_schedule.deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Where("room_status_schedule.reverted_at IS NULL").
+		Where("room_status_schedule.start_date < ? AND room_status_schedule.end_date >= ?", endDate, startDate)
+}
+
+// returningToNormalSubQuery는 room의 현재 상태가 적용 중인 객실 상태 예약 때문이고, 그 예약이 startDate 전에 끝나
+// 정상 상태로 돌아오는지 확인하는 서브쿼리입니다.
+func (r *roomRepository) returningToNormalSubQuery(startDate time.Time) *gorm.DB {
+	return r.db.Model(&models.RoomStatusSchedule{}).
+		Select("1").
+		Where("room_status_schedule.room_id = room.id AND room_status_schedule.status = room.status").
+		Where("room_status_schedule.deleted_at = ?", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Where("room_status_schedule.applied_at IS NOT NULL AND room_status_schedule.reverted_at IS NULL").
+		Where("room_status_schedule.previous_status = ? AND room_status_schedule.end_date < ?", models.RoomStatusNormal, startDate)
+}
+
+// findHeldRoomIDs는 기간이 겹치는 임시 홀드 중 현재 사용자가 아닌 다른 사용자가 잡아 둔 객실 ID를 반환합니다.
+// 홀드를 잡은 본인은 자신의 홀드로 예약을 진행할 수 있어야 하므로 제외합니다.
+func (r *roomRepository) findHeldRoomIDs(ctx context.Context, startDate, endDate time.Time) ([]uint, error) {
+	if r.holdRepo == nil {
+		return nil, nil
+	}
+
+	holds, err := r.holdRepo.FindOverlapping(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, hasUser := appContext.GetUserID(ctx)
+	var roomIDs []uint
+	for _, hold := range holds {
+		if hasUser && hold.HolderID == userID {
+			continue
+		}
+		roomIDs = append(roomIDs, hold.RoomIDs...)
+	}
+	return roomIDs, nil
 }
 
 func (r *roomRepository) FindByNumber(ctx context.Context, number string) (*models.Room, error) {
 	var room models.Room
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).Where("number = ? AND deleted_at = ?", number, defaultDeletedAt).First(&room).Error
+	err := dbFromContext(ctx, r.db).Where("number = ? AND deleted_at = ?", number, defaultDeletedAt).First(&room).Error
 	if err != nil {
 		return nil, err
 	}
@@ -187,14 +361,53 @@ func (r *roomRepository) FindByNumber(ctx context.Context, number string) (*mode
 func (r *roomRepository) FindByStatus(ctx context.Context, status models.RoomStatus) ([]models.Room, error) {
 	var rooms []models.Room
 	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-	err := r.db.WithContext(ctx).
-		Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt).
+	query := dbFromContext(ctx, r.db).Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt)
+	err := preloadRoomAmenities(query).
 		Where("status = ? AND deleted_at = ?", status, defaultDeletedAt).
 		Order("room_group_id, number").
 		Find(&rooms).Error
 	return rooms, err
 }
 
+// FindAllWithDetails는 삭제되지 않은 모든 객실을 상태와 관계없이 객실 그룹, 편의시설과 함께 조회합니다.
+// 객실 상태 예약으로 날짜마다 상태가 달라지는 객실을 다룰 때 사용하며, 날짜별 상태는 Room.StatusOn으로 판단합니다.
+func (r *roomRepository) FindAllWithDetails(ctx context.Context) ([]models.Room, error) {
+	var rooms []models.Room
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).Preload("RoomGroup", "deleted_at = ?", defaultDeletedAt)
+	err := preloadRoomAmenities(query).
+		Where("deleted_at = ?", defaultDeletedAt).
+		Order("room_group_id, number").
+		Find(&rooms).Error
+	return rooms, err
+}
+
+// LockRooms는 트랜잭션이 끝날 때까지 지정한 객실 행에 배타 잠금(SELECT ... FOR UPDATE)을 겁니다.
+// 동시에 같은 객실을 예약하려는 요청은 먼저 잠근 트랜잭션이 끝날 때까지 대기하게 되며,
+// 교착 상태를 피하기 위해 항상 ID 오름차순으로 잠급니다. 트랜잭션 밖에서 호출하면 효과가 없습니다.
+func (r *roomRepository) LockRooms(ctx context.Context, roomIDs []uint) error {
+	if len(roomIDs) == 0 {
+		return nil
+	}
+
+	var lockedIDs []uint
+	return dbFromContext(ctx, r.db).
+		Model(&models.Room{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", roomIDs).
+		Order("id").
+		Pluck("id", &lockedIDs).Error
+}
+
+// UpdateHousekeepingStatus는 객실의 청소 상태 컬럼만 바꿉니다. 객실 행 전체를 저장하면 그사이 바뀐 운영 상태를
+// 읽어 둔 값으로 덮어쓸 수 있으므로, 체크아웃이나 청소 작업처럼 청소 상태만 바꾸는 곳에서 사용합니다.
+func (r *roomRepository) UpdateHousekeepingStatus(ctx context.Context, roomID uint, status models.HousekeepingStatus) error {
+	return dbFromContext(ctx, r.db).
+		Model(&models.Room{}).
+		Where("id = ?", roomID).
+		UpdateColumn("housekeeping_status", status).Error
+}
+
 // parseSort는 Spring Boot 형식의 정렬 파라미터를 GORM 형식으로 변환합니다.
 // 예: "number,desc" -> "number DESC"
 // 예: "number,desc,roomGroupId,asc" -> "number DESC, room_group_id ASC"
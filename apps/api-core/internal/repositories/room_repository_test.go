@@ -80,6 +80,9 @@ func (suite *RoomRepositoryTestSuite) TestCreate() {
 				room.RoomGroupID,
 				room.Note,
 				room.Status,
+				models.HousekeepingStatusClean, // 새 객실은 청소 완료 상태
+				nil,                            // standard_occupancy (객실 그룹 기준)
+				nil,                            // max_occupancy (객실 그룹 기준)
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		suite.mock.ExpectCommit()
@@ -504,6 +507,9 @@ func (suite *RoomRepositoryTestSuite) TestUpdate() {
 				room.RoomGroupID,
 				room.Note,
 				room.Status,
+				room.HousekeepingStatus,
+				nil, // standard_occupancy
+				nil, // max_occupancy
 				room.ID,
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
@@ -721,6 +727,11 @@ func (suite *RoomRepositoryTestSuite) TestFindByStatus() {
 			WithArgs(status, defaultDeletedAt).
 			WillReturnRows(rows)
 
+		// Preload Amenities query (연결된 편의시설 없음)
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `room_amenity` WHERE `room_amenity`.`room_id` IN (?,?)")).
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"room_id", "amenity_id"}))
+
 		// Preload RoomGroup query
 		roomGroupRows := sqlmock.NewRows([]string{
 			"id", "name", "peek_price", "off_peek_price", "description",
@@ -731,6 +742,11 @@ func (suite *RoomRepositoryTestSuite) TestFindByStatus() {
 			WithArgs(1, defaultDeletedAt).
 			WillReturnRows(roomGroupRows)
 
+		// Preload RoomGroup.Amenities query (연결된 편의시설 없음)
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `room_group_amenity` WHERE `room_group_amenity`.`room_group_id` = ?")).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"room_group_id", "amenity_id"}))
+
 		// When
 		rooms, err := suite.repo.FindByStatus(suite.ctx, status)
 
@@ -744,6 +760,51 @@ func (suite *RoomRepositoryTestSuite) TestFindByStatus() {
 	})
 }
 
+func (suite *RoomRepositoryTestSuite) TestLockRooms() {
+	suite.Run("객실 잠금은 ID 오름차순으로 SELECT ... FOR UPDATE를 실행한다", func() {
+		// Given
+		roomIDs := []uint{3, 1}
+
+		suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `room` WHERE id IN (?,?) ORDER BY id FOR UPDATE")).
+			WithArgs(3, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3))
+
+		// When
+		err := suite.repo.LockRooms(suite.ctx, roomIDs)
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+
+	suite.Run("잠글 객실이 없으면 쿼리를 실행하지 않는다", func() {
+		// When
+		err := suite.repo.LockRooms(suite.ctx, nil)
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+}
+
+func (suite *RoomRepositoryTestSuite) TestUpdateHousekeepingStatus() {
+	suite.Run("청소 상태 컬럼만 바꾸고 운영 상태는 건드리지 않는다", func() {
+		// Given
+		suite.mock.ExpectBegin()
+		suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `room` SET `housekeeping_status`=? WHERE id = ?")).
+			WithArgs(models.HousekeepingStatusDirty, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		suite.mock.ExpectCommit()
+
+		// When
+		err := suite.repo.UpdateHousekeepingStatus(suite.ctx, 1, models.HousekeepingStatusDirty)
+
+		// Then
+		assert.NoError(suite.T(), err)
+		assert.NoError(suite.T(), suite.mock.ExpectationsWereMet())
+	})
+}
+
 // AnyTime is a custom matcher for time.Time values in sqlmock
 type AnyTime struct{}
 
@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type RoomStatusScheduleRepository interface {
+	Create(ctx context.Context, schedule *models.RoomStatusSchedule) error
+	Update(ctx context.Context, schedule *models.RoomStatusSchedule) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*models.RoomStatusSchedule, error)
+	FindByRoomID(ctx context.Context, roomID uint) ([]models.RoomStatusSchedule, error)
+	FindOverlapping(ctx context.Context, roomID uint, startDate, endDate time.Time) ([]models.RoomStatusSchedule, error)
+	FindToApply(ctx context.Context, today time.Time) ([]models.RoomStatusSchedule, error)
+	FindToRevert(ctx context.Context, today time.Time) ([]models.RoomStatusSchedule, error)
+	FindEffective(ctx context.Context, startDate, endDate time.Time) ([]models.RoomStatusSchedule, error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type roomStatusScheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewRoomStatusScheduleRepository(db *gorm.DB) RoomStatusScheduleRepository {
+	return &roomStatusScheduleRepository{db: db}
+}
+
+func (r *roomStatusScheduleRepository) Create(ctx context.Context, schedule *models.RoomStatusSchedule) error {
+	return dbFromContext(ctx, r.db).Omit("Room").Create(schedule).Error
+}
+
+func (r *roomStatusScheduleRepository) Update(ctx context.Context, schedule *models.RoomStatusSchedule) error {
+	return dbFromContext(ctx, r.db).Omit("Room").Save(schedule).Error
+}
+
+func (r *roomStatusScheduleRepository) Delete(ctx context.Context, id uint) error {
+	updates := map[string]interface{}{
+		"deleted_at": time.Now(),
+	}
+
+	if userID, ok := appContext.GetUserID(ctx); ok {
+		updates["updated_by"] = userID
+	}
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return dbFromContext(ctx, r.db).
+		Model(&models.RoomStatusSchedule{}).
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		Updates(updates).Error
+}
+
+func (r *roomStatusScheduleRepository) FindByID(ctx context.Context, id uint) (*models.RoomStatusSchedule, error) {
+	var schedule models.RoomStatusSchedule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		First(&schedule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// FindByRoomID는 객실의 객실 상태 예약을 시작일 순으로 조회합니다.
+func (r *roomStatusScheduleRepository) FindByRoomID(ctx context.Context, roomID uint) ([]models.RoomStatusSchedule, error) {
+	var schedules []models.RoomStatusSchedule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("room_id = ? AND deleted_at = ?", roomID, defaultDeletedAt).
+		Order("start_date ASC, id ASC").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// FindOverlapping은 객실의 객실 상태 예약 중 [startDate, endDate] 기간(종료일 포함)과 겹치는 예약을 조회합니다.
+func (r *roomStatusScheduleRepository) FindOverlapping(ctx context.Context, roomID uint, startDate, endDate time.Time) ([]models.RoomStatusSchedule, error) {
+	var schedules []models.RoomStatusSchedule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("room_id = ? AND deleted_at = ?", roomID, defaultDeletedAt).
+		Where("start_date <= ? AND end_date >= ?", endDate, startDate).
+		Order("start_date ASC, id ASC").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// FindToApply는 today가 기간에 속하지만 아직 객실에 적용하지 않은 객실 상태 예약을 조회합니다.
+func (r *roomStatusScheduleRepository) FindToApply(ctx context.Context, today time.Time) ([]models.RoomStatusSchedule, error) {
+	var schedules []models.RoomStatusSchedule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("deleted_at = ? AND applied_at IS NULL", defaultDeletedAt).
+		Where("start_date <= ? AND end_date >= ?", today, today).
+		Order("start_date ASC, id ASC").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// FindToRevert는 객실에 적용했고 today 전에 기간이 끝나 되돌려야 하는 객실 상태 예약을 조회합니다.
+func (r *roomStatusScheduleRepository) FindToRevert(ctx context.Context, today time.Time) ([]models.RoomStatusSchedule, error) {
+	var schedules []models.RoomStatusSchedule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("deleted_at = ? AND applied_at IS NOT NULL AND reverted_at IS NULL", defaultDeletedAt).
+		Where("end_date < ?", today).
+		Order("end_date ASC, id ASC").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// FindEffective는 모든 객실의 객실 상태 예약 중 [startDate, endDate] 기간(종료일 포함)의 객실 상태에 영향을 주는 예약,
+// 즉 되돌리지 않았고 기간과 겹치거나 지금 객실에 적용 중인 예약을 조회합니다. 적용 중인 예약은 기간이 끝난 뒤
+// 객실이 돌아갈 상태(PreviousStatus)를 알려 주므로 기간과 겹치지 않아도 함께 조회합니다.
+func (r *roomStatusScheduleRepository) FindEffective(ctx context.Context, startDate, endDate time.Time) ([]models.RoomStatusSchedule, error) {
+	var schedules []models.RoomStatusSchedule
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Where("deleted_at = ? AND reverted_at IS NULL", defaultDeletedAt).
+		Where("applied_at IS NOT NULL OR (start_date <= ? AND end_date >= ?)", endDate, startDate).
+		Order("room_id ASC, start_date ASC, id ASC").
+		Find(&schedules).Error
+	return schedules, err
+}
+
+// Transaction은 fn 안에서 호출되는 리포지토리 작업을 하나의 DB 트랜잭션으로 묶습니다.
+func (r *roomStatusScheduleRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runInTransaction(ctx, r.db, fn)
+}
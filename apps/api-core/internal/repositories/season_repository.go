@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gorm.io/gorm"
+)
+
+type SeasonRepository interface {
+	Create(ctx context.Context, season *models.Season) (*models.Season, error)
+	Update(ctx context.Context, season *models.Season) error
+	Delete(ctx context.Context, id uint) error
+	FindByID(ctx context.Context, id uint) (*models.Season, error)
+	FindAll(ctx context.Context, filter dto.SeasonFilter, offset, limit int) ([]models.Season, int64, error)
+	FindApplicable(ctx context.Context, startDate, endDate time.Time) ([]models.Season, error)
+}
+
+type seasonRepository struct {
+	db *gorm.DB
+}
+
+func NewSeasonRepository(db *gorm.DB) SeasonRepository {
+	return &seasonRepository{db: db}
+}
+
+func (r *seasonRepository) Create(ctx context.Context, season *models.Season) (*models.Season, error) {
+	err := dbFromContext(ctx, r.db).Omit("RoomGroups.*").Create(season).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindByID(ctx, season.ID)
+}
+
+// Update는 시즌을 저장하고 적용 객실 그룹 연결을 season.RoomGroups로 교체합니다.
+func (r *seasonRepository) Update(ctx context.Context, season *models.Season) error {
+	return runInTransaction(ctx, r.db, func(ctx context.Context) error {
+		db := dbFromContext(ctx, r.db)
+		if err := db.Omit("RoomGroups").Save(season).Error; err != nil {
+			return err
+		}
+		return db.Model(season).Omit("RoomGroups.*").Association("RoomGroups").Replace(season.RoomGroups)
+	})
+}
+
+func (r *seasonRepository) Delete(ctx context.Context, id uint) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"deleted_at": now,
+	}
+
+	if userID, ok := appContext.GetUserID(ctx); ok {
+		updates["updated_by"] = userID
+	}
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	return dbFromContext(ctx, r.db).
+		Model(&models.Season{}).
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		Updates(updates).Error
+}
+
+func (r *seasonRepository) FindByID(ctx context.Context, id uint) (*models.Season, error) {
+	var season models.Season
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := dbFromContext(ctx, r.db).
+		Preload("CreatedByUser").
+		Preload("RoomGroups").
+		Where("id = ? AND deleted_at = ?", id, defaultDeletedAt).
+		First(&season).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &season, nil
+}
+
+func (r *seasonRepository) FindAll(ctx context.Context, filter dto.SeasonFilter, offset, limit int) ([]models.Season, int64, error) {
+	var seasons []models.Season
+	var total int64
+
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := dbFromContext(ctx, r.db).
+		Model(&models.Season{}).
+		Where("deleted_at = ?", defaultDeletedAt).
+		Preload("CreatedByUser").
+		Preload("RoomGroups")
+
+	// 매년 반복 시즌은 기간 필터와 관계없이 항상 포함합니다.
+	if filter.StartDate != "" && filter.EndDate != "" {
+		query = query.Where("recurring_yearly = ? OR NOT (end_date < ? OR start_date >= ?)", true, filter.StartDate, filter.EndDate)
+	} else if filter.StartDate != "" {
+		query = query.Where("recurring_yearly = ? OR end_date >= ?", true, filter.StartDate)
+	} else if filter.EndDate != "" {
+		query = query.Where("recurring_yearly = ? OR start_date < ?", true, filter.EndDate)
+	}
+
+	// 객실 그룹 필터는 해당 그룹에 연결된 시즌과 모든 그룹에 적용되는 시즌을 조회합니다.
+	if filter.RoomGroupID != nil {
+		query = query.Where(
+			"id IN (SELECT season_id FROM season_room_group WHERE room_group_id = ?) OR NOT EXISTS (SELECT 1 FROM season_room_group WHERE season_id = season.id)",
+			*filter.RoomGroupID,
+		)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("start_date ASC, id ASC").Offset(offset).Limit(limit).Find(&seasons).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return seasons, total, nil
+}
+
+// FindApplicable은 [startDate, endDate) 숙박 기간에 적용될 수 있는 시즌을 조회합니다.
+// 매년 반복 시즌은 날짜와 관계없이 모두 반환하므로 날짜별 적용 여부는 Season.Covers로 확인해야 합니다.
+func (r *seasonRepository) FindApplicable(ctx context.Context, startDate, endDate time.Time) ([]models.Season, error) {
+	var seasons []models.Season
+	defaultDeletedAt := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := dbFromContext(ctx, r.db).
+		Preload("RoomGroups").
+		Where("deleted_at = ?", defaultDeletedAt).
+		Where("recurring_yearly = ? OR NOT (end_date < ? OR start_date >= ?)", true, startDate, endDate).
+		Order("start_date ASC, id ASC").
+		Find(&seasons).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return seasons, nil
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+// dbFromContext는 컨텍스트에 진행 중인 트랜잭션이 있으면 해당 트랜잭션을, 없으면 기본 DB를 반환합니다.
+// 같은 트랜잭션 안에서 호출되는 여러 리포지토리가 하나의 커넥션을 공유하도록 하기 위해 사용합니다.
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// runInTransaction은 fn을 하나의 DB 트랜잭션 안에서 실행합니다.
+// 이미 트랜잭션이 진행 중인 컨텍스트라면 새 트랜잭션을 열지 않고 기존 트랜잭션에 참여합니다.
+func runInTransaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
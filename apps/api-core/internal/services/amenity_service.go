@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrAmenityNotFound   = errors.New("존재하지 않는 편의시설")
+	ErrAmenityCodeExists = errors.New("이미 존재하는 편의시설 코드")
+)
+
+// AmenityService는 객실 그룹과 객실에 붙이는 편의시설/속성 카탈로그를 관리합니다.
+type AmenityService interface {
+	GetAll(ctx context.Context, category *string) ([]dto.AmenityResponse, error)
+	Create(ctx context.Context, req dto.CreateAmenityRequest) (*dto.AmenityResponse, error)
+	Update(ctx context.Context, id uint, req dto.UpdateAmenityRequest) (*dto.AmenityResponse, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type amenityService struct {
+	amenityRepo  repositories.AmenityRepository
+	auditService audit.AuditService
+}
+
+func NewAmenityService(amenityRepo repositories.AmenityRepository, auditService audit.AuditService) AmenityService {
+	return &amenityService{amenityRepo: amenityRepo, auditService: auditService}
+}
+
+func (s *amenityService) GetAll(ctx context.Context, category *string) ([]dto.AmenityResponse, error) {
+	var filter *models.AmenityCategory
+	if category != nil {
+		parsed, ok := models.ParseAmenityCategory(*category)
+		if !ok {
+			return nil, fmt.Errorf("unknown category %s", *category)
+		}
+		filter = &parsed
+	}
+
+	amenities, err := s.amenityRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToAmenityListResponse(amenities), nil
+}
+
+func (s *amenityService) Create(ctx context.Context, req dto.CreateAmenityRequest) (*dto.AmenityResponse, error) {
+	category, ok := models.ParseAmenityCategory(req.Category)
+	if !ok {
+		return nil, fmt.Errorf("unknown category %s", req.Category)
+	}
+
+	exists, err := s.amenityRepo.ExistsByCode(ctx, req.Code, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrAmenityCodeExists
+	}
+
+	amenity, err := s.amenityRepo.Create(ctx, &models.Amenity{Code: req.Code, Name: req.Name, Category: category})
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToAmenityResponse(amenity)
+	return &result, nil
+}
+
+func (s *amenityService) Update(ctx context.Context, id uint, req dto.UpdateAmenityRequest) (*dto.AmenityResponse, error) {
+	amenity, err := s.amenityRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrAmenityNotFound
+	}
+
+	if req.Code != nil && *req.Code != amenity.Code {
+		exists, err := s.amenityRepo.ExistsByCode(ctx, *req.Code, &id)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrAmenityCodeExists
+		}
+		amenity.Code = *req.Code
+	}
+	if req.Name != nil {
+		amenity.Name = *req.Name
+	}
+	if req.Category != nil {
+		category, ok := models.ParseAmenityCategory(*req.Category)
+		if !ok {
+			return nil, fmt.Errorf("unknown category %s", *req.Category)
+		}
+		amenity.Category = category
+	}
+
+	if err := s.amenityRepo.Update(ctx, amenity); err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToAmenityResponse(amenity)
+	return &result, nil
+}
+
+func (s *amenityService) Delete(ctx context.Context, id uint) error {
+	amenity, err := s.amenityRepo.FindByID(ctx, id)
+	if err != nil {
+		return ErrAmenityNotFound
+	}
+
+	if err := s.amenityRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// Log deletion in audit — manual call required because soft delete bypasses GORM delete hooks
+	if s.auditService != nil {
+		_ = s.auditService.LogDelete(ctx, amenity)
+	}
+
+	return nil
+}
+
+// findAmenities는 ids에 해당하는 편의시설을 조회합니다. 없거나 삭제된 편의시설이 있으면 ErrAmenityNotFound를 반환합니다.
+func findAmenities(ctx context.Context, amenityRepo repositories.AmenityRepository, ids []uint) ([]models.Amenity, error) {
+	unique := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		unique[id] = true
+	}
+
+	amenities, err := amenityRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(amenities) != len(unique) {
+		return nil, ErrAmenityNotFound
+	}
+	return amenities, nil
+}
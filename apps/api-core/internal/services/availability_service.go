@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var ErrAvailabilityRangeTooLong = errors.New("빈 객실은 최대 92박까지 조회할 수 있습니다")
+
+// maxAvailabilityNights는 빈 객실을 한 번에 조회할 수 있는 최대 박 수입니다.
+const maxAvailabilityNights = 92
+
+// AvailabilityService는 숙박 기간의 객실 그룹별 빈 객실 수와 기준 요금을 계산합니다.
+type AvailabilityService interface {
+	Search(ctx context.Context, req dto.AvailabilityRequest) (*dto.AvailabilityResponse, error)
+}
+
+type availabilityService struct {
+	roomRepo            repositories.RoomRepository
+	roomGroupRepo       repositories.RoomGroupRepository
+	reservationRoomRepo repositories.ReservationRoomRepository
+	dateBlockRepo       repositories.DateBlockRepository
+	roomHoldRepo        repositories.RoomHoldRepository
+	seasonRepo          repositories.SeasonRepository
+	scheduleRepo        repositories.RoomStatusScheduleRepository
+}
+
+func NewAvailabilityService(roomRepo repositories.RoomRepository, roomGroupRepo repositories.RoomGroupRepository,
+	reservationRoomRepo repositories.ReservationRoomRepository, dateBlockRepo repositories.DateBlockRepository,
+	roomHoldRepo repositories.RoomHoldRepository, seasonRepo repositories.SeasonRepository,
+	scheduleRepo repositories.RoomStatusScheduleRepository) AvailabilityService {
+	return &availabilityService{
+		roomRepo:            roomRepo,
+		roomGroupRepo:       roomGroupRepo,
+		reservationRoomRepo: reservationRoomRepo,
+		dateBlockRepo:       dateBlockRepo,
+		roomHoldRepo:        roomHoldRepo,
+		seasonRepo:          seasonRepo,
+		scheduleRepo:        scheduleRepo,
+	}
+}
+
+// Search는 [StayStartAt, StayEndAt) 숙박일마다 객실 그룹별로 비어 있는 정상(NORMAL) 객실 수를 셉니다.
+// 객실 상태는 객실 상태 예약을 반영한 그날의 상태(Room.StatusOn)로 판단하며, FindAvailableRooms와 같이
+// 객실을 점유하는 예약(정상/대기/이용 완료), 날짜 차단, 다른 사용자의 임시 홀드가 있는 객실은 빈 객실이 아닙니다.
+// 객실이 하나도 없는 객실 그룹도 빈 객실 0개로 반환하며, RoomCount를 지정하면 숙박 기간 내내 비어 있는 객실이
+// RoomCount보다 적은 객실 그룹은 제외합니다. 숙박 기간 중 하루도 정상 상태가 아니거나, PeopleCount를 수용할 수
+// 없거나 AmenityIDs 편의시설이 없는 객실은 객실 그룹의 객실 수에서도 빠집니다.
+func (s *availabilityService) Search(ctx context.Context, req dto.AvailabilityRequest) (*dto.AvailabilityResponse, error) {
+	startDate := truncateToDate(req.StayStartAt)
+	endDate := truncateToDate(req.StayEndAt)
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidDateRange
+	}
+
+	var dates []time.Time
+	for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+		dates = append(dates, date)
+	}
+	if len(dates) > maxAvailabilityNights {
+		return nil, ErrAvailabilityRangeTooLong
+	}
+
+	roomGroups, _, err := s.roomGroupRepo.FindAll(ctx, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.roomRepo.FindAllWithDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulesByRoom, err := findRoomStatusSchedules(ctx, s.scheduleRepo, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	reservationRooms, err := s.reservationRoomRepo.FindOccupying(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	segmentsByRoom := make(map[uint][]models.ReservationRoom)
+	for _, reservationRoom := range reservationRooms {
+		if reservationRoom.Reservation == nil || !reservationRoom.Reservation.CountsAsOccupied() {
+			continue
+		}
+		segmentsByRoom[reservationRoom.RoomID] = append(segmentsByRoom[reservationRoom.RoomID], reservationRoom)
+	}
+
+	dateBlocks, err := s.dateBlockRepo.FindOverlapping(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	occurrences := make([][]models.DateBlockOccurrence, len(dateBlocks))
+	for i := range dateBlocks {
+		occurrences[i] = dateBlocks[i].Occurrences(startDate, endDate)
+	}
+
+	holds, err := findOthersHolds(ctx, s.roomHoldRepo, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.seasonRepo.FindApplicable(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.AvailabilityResponse{
+		StayStartAt: dto.JSONDate{Time: startDate},
+		StayEndAt:   dto.JSONDate{Time: endDate},
+		Nights:      len(dates),
+		RoomGroups:  make([]dto.RoomGroupAvailabilityResponse, 0, len(roomGroups)),
+	}
+
+	for _, roomGroup := range roomGroups {
+		if req.RoomGroupID != nil && roomGroup.ID != *req.RoomGroupID {
+			continue
+		}
+
+		availability := dto.RoomGroupAvailabilityResponse{
+			RoomGroupID:       roomGroup.ID,
+			RoomGroupName:     roomGroup.Name,
+			StandardOccupancy: roomGroup.StandardOccupancy,
+			MaxOccupancy:      roomGroup.MaxOccupancy,
+			Nights:            make([]dto.NightlyAvailabilityResponse, len(dates)),
+		}
+		for i, date := range dates {
+			availability.Nights[i] = dto.NightlyAvailabilityResponse{Date: dto.JSONDate{Time: date}, Price: roomGroup.OffPeekPrice}
+			if findSeason(seasons, roomGroup.ID, date) != nil {
+				availability.Nights[i].Peak = true
+				availability.Nights[i].Price = roomGroup.PeekPrice
+			}
+			availability.Price += availability.Nights[i].Price
+		}
+
+		for i := range rooms {
+			room := &rooms[i]
+			if room.RoomGroupID != roomGroup.ID || !room.CanAccommodate(req.PeopleCount) || !room.HasAmenities(req.AmenityIDs) {
+				continue
+			}
+
+			inService := make([]bool, len(dates))
+			inServiceAnyNight := false
+			for j, date := range dates {
+				inService[j] = room.StatusOn(schedulesByRoom[room.ID], date) == models.RoomStatusNormal
+				inServiceAnyNight = inServiceAnyNight || inService[j]
+			}
+			if !inServiceAnyNight {
+				continue
+			}
+			availability.TotalCount++
+
+			freeAllStay := true
+			for j, date := range dates {
+				free := inService[j] &&
+					!isHeldRoom(holds, room.ID, date) &&
+					findOccupyingReservation(segmentsByRoom[room.ID], date) == nil &&
+					findBlockingDateBlock(dateBlocks, occurrences, room, date) == nil
+				if free {
+					availability.Nights[j].AvailableCount++
+				} else {
+					freeAllStay = false
+				}
+			}
+			if freeAllStay {
+				availability.AvailableCount++
+			}
+		}
+
+		availability.MinAvailableCount = availability.Nights[0].AvailableCount
+		for _, night := range availability.Nights[1:] {
+			if night.AvailableCount < availability.MinAvailableCount {
+				availability.MinAvailableCount = night.AvailableCount
+			}
+		}
+
+		if availability.AvailableCount < req.RoomCount {
+			continue
+		}
+		result.RoomGroups = append(result.RoomGroups, availability)
+	}
+
+	return result, nil
+}
+
+// findOthersHolds는 기간이 겹치는 임시 홀드 중 현재 사용자가 아닌 다른 사용자의 홀드를 반환합니다.
+func findOthersHolds(ctx context.Context, roomHoldRepo repositories.RoomHoldRepository, startDate, endDate time.Time) ([]models.RoomHold, error) {
+	if roomHoldRepo == nil {
+		return nil, nil
+	}
+
+	holds, err := roomHoldRepo.FindOverlapping(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, hasUser := appContext.GetUserID(ctx)
+	othersHolds := make([]models.RoomHold, 0, len(holds))
+	for _, hold := range holds {
+		if hasUser && hold.HolderID == userID {
+			continue
+		}
+		othersHolds = append(othersHolds, hold)
+	}
+	return othersHolds, nil
+}
+
+// isHeldRoom은 date 숙박일에 객실을 잡아 둔 홀드가 있는지 확인합니다.
+func isHeldRoom(holds []models.RoomHold, roomID uint, date time.Time) bool {
+	for i := range holds {
+		if holds[i].ContainsRoom(roomID) && holds[i].Overlaps(date, date.AddDate(0, 0, 1)) {
+			return true
+		}
+	}
+	return false
+}
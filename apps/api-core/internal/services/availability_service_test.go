@@ -0,0 +1,296 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type AvailabilityServiceTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockRoomRepo            *MockRoomRepository
+	mockRoomGroupRepo       *MockRoomGroupRepository
+	mockReservationRoomRepo *MockReservationRoomRepository
+	mockDateBlockRepo       *MockDateBlockRepository
+	mockRoomHoldRepo        *MockRoomHoldRepository
+	mockSeasonRepo          *MockSeasonRepository
+	mockScheduleRepo        *MockRoomStatusScheduleRepository
+	service                 services.AvailabilityService
+	start                   time.Time
+	end                     time.Time
+}
+
+func (suite *AvailabilityServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockRoomGroupRepo = new(MockRoomGroupRepository)
+	suite.mockReservationRoomRepo = new(MockReservationRoomRepository)
+	suite.mockDateBlockRepo = new(MockDateBlockRepository)
+	suite.mockRoomHoldRepo = new(MockRoomHoldRepository)
+	suite.mockSeasonRepo = new(MockSeasonRepository)
+	suite.mockScheduleRepo = new(MockRoomStatusScheduleRepository)
+	suite.mockScheduleRepo.On("FindEffective", mock.Anything, mock.Anything, mock.Anything).Return([]models.RoomStatusSchedule{}, nil).Maybe()
+	suite.service = services.NewAvailabilityService(suite.mockRoomRepo, suite.mockRoomGroupRepo, suite.mockReservationRoomRepo,
+		suite.mockDateBlockRepo, suite.mockRoomHoldRepo, suite.mockSeasonRepo, suite.mockScheduleRepo)
+	// 7/1 ~ 7/4, 3박
+	suite.start = time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	suite.end = time.Date(2026, 7, 4, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *AvailabilityServiceTestSuite) newRoomGroup(id uint, name string, peekPrice, offPeekPrice int) models.RoomGroup {
+	group := models.RoomGroup{Name: name, PeekPrice: peekPrice, OffPeekPrice: offPeekPrice}
+	group.ID = id
+	return group
+}
+
+func (suite *AvailabilityServiceTestSuite) newRoom(id, groupID uint) models.Room {
+	room := models.Room{RoomGroupID: groupID, Status: models.RoomStatusNormal}
+	room.ID = id
+	return room
+}
+
+// givenInventory는 패밀리(객실 1, 2, 3)와 스탠다드(객실 4) 그룹, 7/3 하루짜리 성수기 시즌을 준비합니다.
+func (suite *AvailabilityServiceTestSuite) givenInventory() {
+	suite.mockRoomGroupRepo.On("FindAll", suite.ctx, 0, -1).Return([]models.RoomGroup{
+		suite.newRoomGroup(1, "패밀리", 200000, 150000),
+		suite.newRoomGroup(2, "스탠다드", 100000, 80000),
+	}, int64(2), nil)
+	suite.mockRoomRepo.On("FindAllWithDetails", suite.ctx).Return([]models.Room{
+		suite.newRoom(1, 1), suite.newRoom(2, 1), suite.newRoom(3, 1), suite.newRoom(4, 2),
+	}, nil)
+
+	season := models.Season{
+		Name:      "여름 성수기",
+		StartDate: time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{season}, nil)
+}
+
+func nightlyAvailableCounts(availability dto.RoomGroupAvailabilityResponse) []int {
+	counts := make([]int, len(availability.Nights))
+	for i, night := range availability.Nights {
+		counts[i] = night.AvailableCount
+	}
+	return counts
+}
+
+func (suite *AvailabilityServiceTestSuite) TestSearch_객실_그룹별_빈_객실_수와_기준_요금() {
+	// Given - 패밀리 객실 1은 7/1~7/2 예약, 객실 2는 7/3 날짜 차단, 객실 3은 다른 사용자의 홀드가 7/2~7/3에 걸려 있으면
+	suite.givenInventory()
+
+	reservation := &models.Reservation{
+		StayStartAt: time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+		Status:      models.ReservationStatusNormal,
+	}
+	canceled := &models.Reservation{
+		StayStartAt: suite.start,
+		StayEndAt:   suite.end,
+		Status:      models.ReservationStatusCancel,
+	}
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{
+		{RoomID: 1, Reservation: reservation},
+		{RoomID: 4, Reservation: canceled},
+	}, nil)
+
+	room2 := models.Room{}
+	room2.ID = 2
+	dateBlock := models.DateBlock{
+		StartDate: time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+		Rooms:     []models.Room{room2},
+	}
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{dateBlock}, nil)
+
+	suite.mockRoomHoldRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.RoomHold{{
+		HolderID:    99,
+		RoomIDs:     []uint{3},
+		StayStartAt: time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}}, nil)
+
+	// When - 빈 객실을 조회하면
+	result, err := suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.end})
+
+	// Then - 박별로 빈 객실을 세고, 숙박 기간 내내 빈 객실은 없다
+	suite.NoError(err)
+	suite.Equal(3, result.Nights)
+	suite.Len(result.RoomGroups, 2)
+
+	family := result.RoomGroups[0]
+	suite.Equal("패밀리", family.RoomGroupName)
+	suite.Equal(3, family.TotalCount)
+	suite.Equal([]int{2, 2, 2}, nightlyAvailableCounts(family))
+	suite.Equal(0, family.AvailableCount)
+	suite.Equal(2, family.MinAvailableCount)
+	suite.Equal(150000+150000+200000, family.Price, "7/3은 성수기 요금")
+	suite.True(family.Nights[2].Peak)
+
+	standard := result.RoomGroups[1]
+	suite.Equal([]int{1, 1, 1}, nightlyAvailableCounts(standard), "취소된 예약은 객실을 점유하지 않음")
+	suite.Equal(1, standard.AvailableCount)
+}
+
+func (suite *AvailabilityServiceTestSuite) TestSearch_이용_완료_예약은_점유_현황과_같이_객실을_점유한다() {
+	// Given - 스탠다드 객실 4에 숙박 기간 내내 이용 완료(COMPLETED) 예약이 있으면
+	suite.givenInventory()
+	completed := &models.Reservation{
+		StayStartAt: suite.start,
+		StayEndAt:   suite.end,
+		Status:      models.ReservationStatusCompleted,
+	}
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{
+		{RoomID: 4, Reservation: completed},
+	}, nil)
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{}, nil)
+	suite.mockRoomHoldRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.RoomHold{}, nil)
+	suite.mockRoomRepo.On("FindAll", suite.ctx, dto.RoomRepositoryFilter{}, 0, -1, "roomGroupId,asc,number,asc").
+		Return([]models.Room{suite.newRoom(4, 2)}, int64(1), nil)
+	occupancyService := services.NewOccupancyService(suite.mockRoomRepo, suite.mockReservationRoomRepo, suite.mockDateBlockRepo, suite.mockScheduleRepo)
+
+	// When - 같은 기간의 점유 현황과 빈 객실을 조회하면
+	grid, err := occupancyService.GetGrid(suite.ctx, dto.OccupancyRequest{StartDate: suite.start, EndDate: suite.end})
+	suite.NoError(err)
+	result, err := suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.end})
+	suite.NoError(err)
+
+	// Then - 점유 현황에 점유로 표시되는 객실은 빈 객실로도 세지 않는다
+	suite.Equal([]string{"OCCUPIED", "OCCUPIED", "OCCUPIED"}, cellStates(grid.Rooms[0]))
+	standard := result.RoomGroups[1]
+	suite.Equal([]int{0, 0, 0}, nightlyAvailableCounts(standard), "이용 완료 예약도 객실을 점유함")
+	suite.Equal(0, standard.AvailableCount)
+}
+
+func (suite *AvailabilityServiceTestSuite) TestSearch_필요한_객실_수보다_빈_객실이_적은_그룹은_제외한다() {
+	// Given - 예약, 차단, 홀드가 없으면
+	suite.givenInventory()
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{}, nil)
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{}, nil)
+	suite.mockRoomHoldRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.RoomHold{}, nil)
+
+	// When - 객실 2개가 필요한 빈 객실을 조회하면
+	result, err := suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.end, RoomCount: 2})
+
+	// Then - 객실이 1개뿐인 스탠다드 그룹은 제외된다
+	suite.NoError(err)
+	suite.Len(result.RoomGroups, 1)
+	suite.Equal(uint(1), result.RoomGroups[0].RoomGroupID)
+	suite.Equal(3, result.RoomGroups[0].AvailableCount)
+}
+
+// givenRoomStatusSchedules는 기본으로 비워 둔 객실 상태 예약 조회 결과를 schedules로 바꿉니다.
+func (suite *AvailabilityServiceTestSuite) givenRoomStatusSchedules(schedules ...models.RoomStatusSchedule) {
+	suite.mockScheduleRepo.ExpectedCalls = nil
+	suite.mockScheduleRepo.On("FindEffective", suite.ctx, suite.start, time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC)).Return(schedules, nil)
+}
+
+func (suite *AvailabilityServiceTestSuite) TestSearch_객실_상태_예약을_날짜별로_반영한다() {
+	// Given - 객실 1은 7/2 하루 공사가 예약돼 있고, 객실 4는 지금 파손 상태지만 6/30에 정상으로 돌아오면
+	suite.mockRoomGroupRepo.On("FindAll", suite.ctx, 0, -1).Return([]models.RoomGroup{
+		suite.newRoomGroup(1, "패밀리", 200000, 150000),
+		suite.newRoomGroup(2, "스탠다드", 100000, 80000),
+	}, int64(2), nil)
+	damaged := suite.newRoom(4, 2)
+	damaged.Status = models.RoomStatusDamaged
+	suite.mockRoomRepo.On("FindAllWithDetails", suite.ctx).Return([]models.Room{suite.newRoom(1, 1), damaged}, nil)
+
+	appliedAt := time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC)
+	previousStatus := models.RoomStatusNormal
+	suite.givenRoomStatusSchedules(
+		models.RoomStatusSchedule{
+			RoomID:    1,
+			Status:    models.RoomStatusConstruction,
+			StartDate: time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+		},
+		models.RoomStatusSchedule{
+			RoomID:         4,
+			Status:         models.RoomStatusDamaged,
+			StartDate:      time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC),
+			EndDate:        time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC),
+			PreviousStatus: &previousStatus,
+			AppliedAt:      &appliedAt,
+		},
+	)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{}, nil)
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{}, nil)
+	suite.mockRoomHoldRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.RoomHold{}, nil)
+
+	// When - 7/1 ~ 7/4 빈 객실을 조회하면
+	result, err := suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.end})
+
+	// Then - 객실 1은 공사 날만 비어 있지 않고, 객실 4는 숙박 기간 내내 빈 객실로 센다
+	suite.NoError(err)
+	suite.Equal([]int{1, 0, 1}, nightlyAvailableCounts(result.RoomGroups[0]))
+	suite.Equal(1, result.RoomGroups[0].TotalCount)
+	suite.Equal(0, result.RoomGroups[0].AvailableCount)
+	suite.Equal([]int{1, 1, 1}, nightlyAvailableCounts(result.RoomGroups[1]))
+	suite.Equal(1, result.RoomGroups[1].AvailableCount)
+}
+
+func (suite *AvailabilityServiceTestSuite) TestSearch_인원과_편의시설로_객실을_거른다() {
+	// Given - 최대 4명인 패밀리 그룹에 바다 전망이 있고, 객실 2는 최대 2명으로 줄였고, 객실 3은 반려동물 동반이 가능하면
+	oceanView := models.Amenity{Code: "OCEAN_VIEW", Category: models.AmenityCategoryView}
+	oceanView.ID = 10
+	petFriendly := models.Amenity{Code: "PET_FRIENDLY", Category: models.AmenityCategoryPolicy}
+	petFriendly.ID = 20
+
+	family := suite.newRoomGroup(1, "패밀리", 200000, 150000)
+	family.StandardOccupancy = 2
+	family.MaxOccupancy = 4
+	family.Amenities = []models.Amenity{oceanView}
+	suite.mockRoomGroupRepo.On("FindAll", suite.ctx, 0, -1).Return([]models.RoomGroup{family}, int64(1), nil)
+
+	smallMax := 2
+	rooms := []models.Room{suite.newRoom(1, 1), suite.newRoom(2, 1), suite.newRoom(3, 1)}
+	for i := range rooms {
+		rooms[i].RoomGroup = &family
+	}
+	rooms[1].MaxOccupancy = &smallMax
+	rooms[2].Amenities = []models.Amenity{petFriendly}
+	suite.mockRoomRepo.On("FindAllWithDetails", suite.ctx).Return(rooms, nil)
+
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{}, nil)
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{}, nil)
+	suite.mockRoomHoldRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.RoomHold{}, nil)
+
+	// When - 3명이 묵을 객실을 조회하면
+	result, err := suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.end, PeopleCount: 3})
+
+	// Then - 최대 2명인 객실 2는 세지 않는다
+	suite.NoError(err)
+	suite.Len(result.RoomGroups, 1)
+	suite.Equal(2, result.RoomGroups[0].TotalCount)
+	suite.Equal(2, result.RoomGroups[0].StandardOccupancy)
+	suite.Equal(4, result.RoomGroups[0].MaxOccupancy)
+
+	// When - 바다 전망과 반려동물 동반이 모두 필요한 객실을 조회하면
+	result, err = suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.end, AmenityIDs: []uint{10, 20}})
+
+	// Then - 그룹의 바다 전망과 객실의 반려동물 동반을 함께 가진 객실 3만 센다
+	suite.NoError(err)
+	suite.Equal(1, result.RoomGroups[0].TotalCount)
+	suite.Equal(1, result.RoomGroups[0].AvailableCount)
+}
+
+func (suite *AvailabilityServiceTestSuite) TestSearch_기간이_잘못되면_에러() {
+	_, err := suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.start})
+	suite.ErrorIs(err, services.ErrInvalidDateRange)
+
+	_, err = suite.service.Search(suite.ctx, dto.AvailabilityRequest{StayStartAt: suite.start, StayEndAt: suite.start.AddDate(0, 0, 93)})
+	suite.ErrorIs(err, services.ErrAvailabilityRangeTooLong)
+}
+
+func TestAvailabilityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AvailabilityServiceTestSuite))
+}
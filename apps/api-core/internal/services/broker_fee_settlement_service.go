@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrBrokerFeeSettlementNotFound       = errors.New("존재하지 않는 정산")
+	ErrInvalidBrokerFeeSettlementRequest = errors.New("잘못된 정산 요청")
+	ErrBrokerFeeSettlementOverlap        = errors.New("이미 정산된 기간과 겹칩니다")
+	ErrNoPaymentsToSettle                = errors.New("정산할 결제 내역이 없습니다")
+)
+
+// BrokerFeeSettlementService는 결제 수단(예약 플랫폼)별 중개 수수료 정산 현황을 결제 내역의 입금일 기준으로 조회하고,
+// 기간을 정산 완료 처리합니다. 정산된 결제 내역과 그 예약의 금액은 수정할 수 없으며, 정산을 삭제하면 다시 수정할 수 있습니다.
+type BrokerFeeSettlementService interface {
+	GetReport(ctx context.Context, query dto.BrokerFeeSettlementReportQuery) ([]dto.BrokerFeeSettlementReportResponse, error)
+	GetAll(ctx context.Context, filter dto.BrokerFeeSettlementFilter, page, size int) ([]dto.BrokerFeeSettlementResponse, int64, error)
+	GetByID(ctx context.Context, id uint) (*dto.BrokerFeeSettlementResponse, error)
+	Create(ctx context.Context, req dto.CreateBrokerFeeSettlementRequest) (*dto.BrokerFeeSettlementResponse, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type brokerFeeSettlementService struct {
+	settlementRepo    repositories.BrokerFeeSettlementRepository
+	reservationRepo   repositories.ReservationRepository
+	paymentMethodRepo repositories.PaymentMethodRepository
+	auditService      audit.AuditService
+}
+
+func NewBrokerFeeSettlementService(settlementRepo repositories.BrokerFeeSettlementRepository, reservationRepo repositories.ReservationRepository,
+	paymentMethodRepo repositories.PaymentMethodRepository, auditService audit.AuditService) BrokerFeeSettlementService {
+	return &brokerFeeSettlementService{
+		settlementRepo:    settlementRepo,
+		reservationRepo:   reservationRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		auditService:      auditService,
+	}
+}
+
+func (s *brokerFeeSettlementService) GetReport(ctx context.Context, query dto.BrokerFeeSettlementReportQuery) ([]dto.BrokerFeeSettlementReportResponse, error) {
+	if query.StartDate.After(query.EndDate) {
+		return nil, fmt.Errorf("%w: startDate must be before or equal to endDate", ErrInvalidBrokerFeeSettlementRequest)
+	}
+
+	summaries, err := s.settlementRepo.Summarize(ctx, query.StartDate, query.EndDate, query.PeriodType, query.PaymentMethodID)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToBrokerFeeSettlementReportResponse(summaries), nil
+}
+
+func (s *brokerFeeSettlementService) GetAll(ctx context.Context, filter dto.BrokerFeeSettlementFilter, page, size int) ([]dto.BrokerFeeSettlementResponse, int64, error) {
+	settlements, total, err := s.settlementRepo.FindAll(ctx, filter, page*size, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	return mappers.ToBrokerFeeSettlementListResponse(settlements), total, nil
+}
+
+func (s *brokerFeeSettlementService) GetByID(ctx context.Context, id uint) (*dto.BrokerFeeSettlementResponse, error) {
+	settlement, err := s.settlementRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrBrokerFeeSettlementNotFound
+	}
+
+	result := mappers.ToBrokerFeeSettlementResponse(settlement)
+	return &result, nil
+}
+
+// Create는 결제 수단의 기간 내 정산되지 않은 결제 내역을 합산해 정산 기록을 만들고, 포함된 결제 내역과 예약의 금액을 확정합니다.
+func (s *brokerFeeSettlementService) Create(ctx context.Context, req dto.CreateBrokerFeeSettlementRequest) (*dto.BrokerFeeSettlementResponse, error) {
+	if req.PeriodStart.IsZero() || req.PeriodEnd.IsZero() {
+		return nil, fmt.Errorf("%w: periodStart and periodEnd are required", ErrInvalidBrokerFeeSettlementRequest)
+	}
+	if req.PeriodStart.After(req.PeriodEnd.Time) {
+		return nil, fmt.Errorf("%w: periodStart must be before or equal to periodEnd", ErrInvalidBrokerFeeSettlementRequest)
+	}
+
+	if _, err := s.paymentMethodRepo.FindByID(ctx, req.PaymentMethodID); err != nil {
+		return nil, ErrPaymentMethodNotFound
+	}
+
+	settlement := &models.BrokerFeeSettlement{
+		PaymentMethodID: req.PaymentMethodID,
+		PeriodStart:     req.PeriodStart.Time,
+		PeriodEnd:       req.PeriodEnd.Time,
+		Note:            req.Note,
+	}
+
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		overlapping, err := s.settlementRepo.ExistsOverlapping(ctx, settlement.PaymentMethodID, settlement.PeriodStart, settlement.PeriodEnd)
+		if err != nil {
+			return err
+		}
+		if overlapping {
+			return ErrBrokerFeeSettlementOverlap
+		}
+
+		summary, err := s.settlementRepo.SummarizeUnsettled(ctx, settlement.PaymentMethodID, settlement.PeriodStart, settlement.PeriodEnd)
+		if err != nil {
+			return err
+		}
+		if summary.PaymentCount == 0 {
+			return ErrNoPaymentsToSettle
+		}
+
+		settlement.ReservationCount = summary.ReservationCount
+		settlement.PaymentCount = summary.PaymentCount
+		settlement.GrossPrice = summary.GrossPrice
+		settlement.PaidAmount = summary.PaidAmount
+		settlement.RefundAmount = summary.RefundAmount
+		settlement.BrokerFee = summary.BrokerFee
+		settlement.NetPayout = summary.NetPayout()
+
+		if err := s.settlementRepo.Create(ctx, settlement); err != nil {
+			return err
+		}
+		_, err = s.settlementRepo.AssignPayments(ctx, settlement.ID, settlement.PaymentMethodID, settlement.PeriodStart, settlement.PeriodEnd)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, settlement.ID)
+}
+
+// Delete는 정산을 취소하고 포함된 결제 내역과 예약을 다시 수정할 수 있게 합니다.
+func (s *brokerFeeSettlementService) Delete(ctx context.Context, id uint) error {
+	settlement, err := s.settlementRepo.FindByID(ctx, id)
+	if err != nil {
+		return ErrBrokerFeeSettlementNotFound
+	}
+
+	err = s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.settlementRepo.ReleasePayments(ctx, id); err != nil {
+			return err
+		}
+		return s.settlementRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Log deletion in audit — manual call required because soft delete bypasses GORM delete hooks
+	_ = s.auditService.LogDelete(ctx, settlement)
+
+	return nil
+}
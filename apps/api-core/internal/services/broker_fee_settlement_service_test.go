@@ -0,0 +1,228 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockBrokerFeeSettlementRepository struct {
+	mock.Mock
+}
+
+func (m *MockBrokerFeeSettlementRepository) Create(ctx context.Context, settlement *models.BrokerFeeSettlement) error {
+	args := m.Called(ctx, settlement)
+	return args.Error(0)
+}
+
+func (m *MockBrokerFeeSettlementRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBrokerFeeSettlementRepository) FindByID(ctx context.Context, id uint) (*models.BrokerFeeSettlement, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BrokerFeeSettlement), args.Error(1)
+}
+
+func (m *MockBrokerFeeSettlementRepository) FindAll(ctx context.Context, filter dto.BrokerFeeSettlementFilter, offset, limit int) ([]models.BrokerFeeSettlement, int64, error) {
+	args := m.Called(ctx, filter, offset, limit)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.BrokerFeeSettlement), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBrokerFeeSettlementRepository) ExistsOverlapping(ctx context.Context, paymentMethodID uint, startDate, endDate time.Time) (bool, error) {
+	args := m.Called(ctx, paymentMethodID, startDate, endDate)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBrokerFeeSettlementRepository) Summarize(ctx context.Context, startDate, endDate time.Time, periodType string, paymentMethodID *uint) ([]models.BrokerFeeSettlementSummary, error) {
+	args := m.Called(ctx, startDate, endDate, periodType, paymentMethodID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.BrokerFeeSettlementSummary), args.Error(1)
+}
+
+func (m *MockBrokerFeeSettlementRepository) SummarizeUnsettled(ctx context.Context, paymentMethodID uint, startDate, endDate time.Time) (*models.BrokerFeeSettlementSummary, error) {
+	args := m.Called(ctx, paymentMethodID, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BrokerFeeSettlementSummary), args.Error(1)
+}
+
+func (m *MockBrokerFeeSettlementRepository) AssignPayments(ctx context.Context, settlementID, paymentMethodID uint, startDate, endDate time.Time) (int64, error) {
+	args := m.Called(ctx, settlementID, paymentMethodID, startDate, endDate)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBrokerFeeSettlementRepository) ReleasePayments(ctx context.Context, settlementID uint) error {
+	args := m.Called(ctx, settlementID)
+	return args.Error(0)
+}
+
+type BrokerFeeSettlementServiceTestSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	mockSettlementRepo    *MockBrokerFeeSettlementRepository
+	mockReservationRepo   *MockReservationRepository
+	mockPaymentMethodRepo *MockPaymentMethodRepository
+	mockAuditService      *MockAuditService
+	service               services.BrokerFeeSettlementService
+	periodStart           time.Time
+	periodEnd             time.Time
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockSettlementRepo = new(MockBrokerFeeSettlementRepository)
+	s.mockReservationRepo = new(MockReservationRepository)
+	s.mockPaymentMethodRepo = new(MockPaymentMethodRepository)
+	s.mockAuditService = new(MockAuditService)
+	s.service = services.NewBrokerFeeSettlementService(s.mockSettlementRepo, s.mockReservationRepo, s.mockPaymentMethodRepo, s.mockAuditService)
+
+	s.periodStart = time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	s.periodEnd = time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) createRequest() dto.CreateBrokerFeeSettlementRequest {
+	return dto.CreateBrokerFeeSettlementRequest{
+		PaymentMethodID: 1,
+		PeriodStart:     dto.JSONDate{Time: s.periodStart},
+		PeriodEnd:       dto.JSONDate{Time: s.periodEnd},
+	}
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) givenPaymentMethod() {
+	paymentMethod := &models.PaymentMethod{Name: "야놀자", CommissionRate: 0.1, Status: models.PaymentMethodStatusActive}
+	paymentMethod.ID = 1
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(1)).Return(paymentMethod, nil)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestGetReport_실수령액과_정산_여부를_계산한다() {
+	// Given - 7월 야놀자 결제 내역 4건이 모두 정산되었고, 8월 결제 내역 2건 중 1건만 정산되었을 때
+	query := dto.BrokerFeeSettlementReportQuery{StartDate: s.periodStart, EndDate: s.periodEnd.AddDate(0, 1, 0), PeriodType: "MONTHLY"}
+	s.mockSettlementRepo.On("Summarize", s.ctx, query.StartDate, query.EndDate, "MONTHLY", (*uint)(nil)).
+		Return([]models.BrokerFeeSettlementSummary{
+			{Period: "2026-07", PaymentMethodID: 1, PaymentMethodName: "야놀자", ReservationCount: 3, PaymentCount: 4, SettledCount: 4,
+				GrossPrice: 600000, PaidAmount: 600000, RefundAmount: 100000, BrokerFee: 50000},
+			{Period: "2026-08", PaymentMethodID: 1, PaymentMethodName: "야놀자", ReservationCount: 2, PaymentCount: 2, SettledCount: 1,
+				GrossPrice: 400000, PaidAmount: 300000, BrokerFee: 30000},
+		}, nil)
+
+	// When
+	report, err := s.service.GetReport(s.ctx, query)
+
+	// Then - 실수령액은 받은 금액에서 환불과 수수료를 뺀 금액이고, 결제 내역이 모두 정산된 기간만 정산 완료다
+	s.NoError(err)
+	s.Len(report, 2)
+	s.Equal(450000, report[0].NetPayout)
+	s.True(report[0].Settled)
+	s.Equal(270000, report[1].NetPayout)
+	s.False(report[1].Settled)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestGetReport_시작일이_종료일보다_늦으면_에러() {
+	query := dto.BrokerFeeSettlementReportQuery{StartDate: s.periodEnd, EndDate: s.periodStart}
+
+	_, err := s.service.GetReport(s.ctx, query)
+
+	s.ErrorIs(err, services.ErrInvalidBrokerFeeSettlementRequest)
+	s.mockSettlementRepo.AssertNotCalled(s.T(), "Summarize", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestCreate_정산되지_않은_결제_내역을_합산해_정산하고_결제_내역을_연결한다() {
+	// Given - 7월에 입금된 야놀자 결제 내역 중 정산되지 않은 내역이 예약 2건에 3건 있을 때
+	s.givenPaymentMethod()
+	s.mockSettlementRepo.On("ExistsOverlapping", s.ctx, uint(1), s.periodStart, s.periodEnd).Return(false, nil)
+	s.mockSettlementRepo.On("SummarizeUnsettled", s.ctx, uint(1), s.periodStart, s.periodEnd).
+		Return(&models.BrokerFeeSettlementSummary{PaymentMethodID: 1, ReservationCount: 2, PaymentCount: 3,
+			GrossPrice: 400000, PaidAmount: 400000, RefundAmount: 50000, BrokerFee: 35000}, nil)
+	s.mockSettlementRepo.On("Create", s.ctx, mock.MatchedBy(func(settlement *models.BrokerFeeSettlement) bool {
+		return settlement.ReservationCount == 2 && settlement.PaymentCount == 3 && settlement.BrokerFee == 35000 && settlement.NetPayout == 315000
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.BrokerFeeSettlement).ID = 5
+	}).Return(nil)
+	s.mockSettlementRepo.On("AssignPayments", s.ctx, uint(5), uint(1), s.periodStart, s.periodEnd).Return(int64(3), nil)
+
+	created := &models.BrokerFeeSettlement{PaymentMethodID: 1, PeriodStart: s.periodStart, PeriodEnd: s.periodEnd, ReservationCount: 2, NetPayout: 315000}
+	created.ID = 5
+	s.mockSettlementRepo.On("FindByID", s.ctx, uint(5)).Return(created, nil)
+
+	// When
+	result, err := s.service.Create(s.ctx, s.createRequest())
+
+	// Then - 정산 합계가 저장되고 결제 내역이 정산에 연결된다
+	s.NoError(err)
+	s.Equal(uint(5), result.ID)
+	s.Equal(315000, result.NetPayout)
+	s.mockSettlementRepo.AssertExpectations(s.T())
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestCreate_정산된_기간과_겹치면_에러() {
+	s.givenPaymentMethod()
+	s.mockSettlementRepo.On("ExistsOverlapping", s.ctx, uint(1), s.periodStart, s.periodEnd).Return(true, nil)
+
+	_, err := s.service.Create(s.ctx, s.createRequest())
+
+	s.ErrorIs(err, services.ErrBrokerFeeSettlementOverlap)
+	s.mockSettlementRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestCreate_정산할_결제_내역이_없으면_에러() {
+	s.givenPaymentMethod()
+	s.mockSettlementRepo.On("ExistsOverlapping", s.ctx, uint(1), s.periodStart, s.periodEnd).Return(false, nil)
+	s.mockSettlementRepo.On("SummarizeUnsettled", s.ctx, uint(1), s.periodStart, s.periodEnd).
+		Return(&models.BrokerFeeSettlementSummary{}, nil)
+
+	_, err := s.service.Create(s.ctx, s.createRequest())
+
+	s.ErrorIs(err, services.ErrNoPaymentsToSettle)
+	s.mockSettlementRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestCreate_기간을_생략하면_에러() {
+	_, err := s.service.Create(s.ctx, dto.CreateBrokerFeeSettlementRequest{PaymentMethodID: 1})
+
+	s.ErrorIs(err, services.ErrInvalidBrokerFeeSettlementRequest)
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestDelete_결제_내역의_정산을_해제하고_감사_로그를_남긴다() {
+	settlement := &models.BrokerFeeSettlement{PaymentMethodID: 1}
+	settlement.ID = 5
+	s.mockSettlementRepo.On("FindByID", s.ctx, uint(5)).Return(settlement, nil)
+	s.mockSettlementRepo.On("ReleasePayments", s.ctx, uint(5)).Return(nil)
+	s.mockSettlementRepo.On("Delete", s.ctx, uint(5)).Return(nil)
+	s.mockAuditService.On("LogDelete", s.ctx, settlement).Return(nil)
+
+	err := s.service.Delete(s.ctx, 5)
+
+	s.NoError(err)
+	s.mockSettlementRepo.AssertExpectations(s.T())
+	s.mockAuditService.AssertExpectations(s.T())
+}
+
+func (s *BrokerFeeSettlementServiceTestSuite) TestDelete_존재하지_않는_정산() {
+	s.mockSettlementRepo.On("FindByID", s.ctx, uint(5)).Return(nil, errors.New("record not found"))
+
+	err := s.service.Delete(s.ctx, 5)
+
+	s.ErrorIs(err, services.ErrBrokerFeeSettlementNotFound)
+}
+
+func TestBrokerFeeSettlementServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BrokerFeeSettlementServiceTestSuite))
+}
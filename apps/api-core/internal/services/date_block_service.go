@@ -16,24 +16,42 @@ import (
 var (
 	ErrDateBlockNotFound       = errors.New("존재하지 않는 날짜 차단")
 	ErrInvalidDateBlockRequest = errors.New("잘못된 날짜 차단 요청")
+	ErrDateBlockConflict       = errors.New("날짜 차단 기간에 예약이 있습니다")
 )
 
+// DateBlockConflictError는 날짜 차단과 겹치는 정상/대기/이용 완료 예약이 있어 저장하지 않았음을 나타냅니다.
+// errors.Is(err, ErrDateBlockConflict)로 구분할 수 있습니다.
+type DateBlockConflictError struct {
+	Conflicts []dto.DateBlockConflictResponse
+}
+
+func (e *DateBlockConflictError) Error() string {
+	return fmt.Sprintf("%s: %d건", ErrDateBlockConflict.Error(), len(e.Conflicts))
+}
+
+func (e *DateBlockConflictError) Is(target error) bool {
+	return target == ErrDateBlockConflict
+}
+
 type DateBlockService interface {
 	Create(ctx context.Context, req dto.CreateDateBlockRequest) (*dto.DateBlockResponse, error)
 	Delete(ctx context.Context, id uint) error
 	GetDateBlock(ctx context.Context, id uint) (*dto.DateBlockResponse, error)
 	GetAll(ctx context.Context, filter dto.DateBlockFilter, page, size int) ([]dto.DateBlockResponse, int64, error)
 	UpdateDateBlock(ctx context.Context, id uint, req dto.UpdateDateBlockRequest) (*dto.DateBlockResponse, error)
-	IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time) (bool, error)
+	IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time, roomIDs []uint) (bool, error)
+	GetConflicts(ctx context.Context, id uint) ([]dto.DateBlockConflictResponse, error)
 }
 
 type dateBlockService struct {
 	dateBlockRepo repositories.DateBlockRepository
+	roomGroupRepo repositories.RoomGroupRepository
+	roomRepo      repositories.RoomRepository
 	auditService  audit.AuditService
 }
 
-func NewDateBlockService(dateBlockRepo repositories.DateBlockRepository, auditService audit.AuditService) DateBlockService {
-	return &dateBlockService{dateBlockRepo: dateBlockRepo, auditService: auditService}
+func NewDateBlockService(dateBlockRepo repositories.DateBlockRepository, roomGroupRepo repositories.RoomGroupRepository, roomRepo repositories.RoomRepository, auditService audit.AuditService) DateBlockService {
+	return &dateBlockService{dateBlockRepo: dateBlockRepo, roomGroupRepo: roomGroupRepo, roomRepo: roomRepo, auditService: auditService}
 }
 
 func (s *dateBlockService) Create(ctx context.Context, req dto.CreateDateBlockRequest) (*dto.DateBlockResponse, error) {
@@ -51,10 +69,37 @@ func (s *dateBlockService) Create(ctx context.Context, req dto.CreateDateBlockRe
 		return nil, err
 	}
 
+	roomGroups, err := s.findRoomGroups(ctx, req.RoomGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.findRooms(ctx, req.RoomIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	dateBlock := &models.DateBlock{
-		StartDate: startDate,
-		EndDate:   endDate,
-		Reason:    req.Reason,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Reason:     req.Reason,
+		RoomGroups: roomGroups,
+		Rooms:      rooms,
+	}
+
+	if req.Recurrence != "" {
+		dateBlock.Recurrence, _ = models.ParseDateBlockRecurrence(req.Recurrence)
+	}
+	if req.RecurrenceUntil != nil {
+		until, _ := time.Parse("2006-01-02", *req.RecurrenceUntil)
+		dateBlock.RecurrenceUntil = &until
+	}
+	dateBlock.Exceptions = toDateBlockExceptions(req.ExceptionDates)
+
+	if !req.Force {
+		if err := s.checkConflicts(ctx, dateBlock, nil); err != nil {
+			return nil, err
+		}
 	}
 
 	created, err := s.dateBlockRepo.Create(ctx, dateBlock)
@@ -89,7 +134,18 @@ func (s *dateBlockService) GetAll(ctx context.Context, filter dto.DateBlockFilte
 		return nil, 0, err
 	}
 
-	return mappers.ToDateBlockListResponse(dateBlocks), total, nil
+	responses := mappers.ToDateBlockListResponse(dateBlocks)
+
+	// 조회 기간이 정해진 경우에만 기간 안의 회차를 전개한다. 종료일은 포함하지 않는다.
+	windowStart, startErr := time.Parse("2006-01-02", filter.StartDate)
+	windowEnd, endErr := time.Parse("2006-01-02", filter.EndDate)
+	if startErr == nil && endErr == nil {
+		for i := range dateBlocks {
+			responses[i].Occurrences = mappers.ToDateBlockOccurrenceResponses(dateBlocks[i].Occurrences(windowStart, windowEnd))
+		}
+	}
+
+	return responses, total, nil
 }
 
 func (s *dateBlockService) GetDateBlock(ctx context.Context, id uint) (*dto.DateBlockResponse, error) {
@@ -109,6 +165,7 @@ func (s *dateBlockService) UpdateDateBlock(ctx context.Context, id uint, req dto
 	}
 
 	oldValues := dateBlock.GetAuditFields()
+	previous := *dateBlock
 
 	if req.StartDate != nil {
 		startDate, parseErr := time.Parse("2006-01-02", *req.StartDate)
@@ -130,10 +187,77 @@ func (s *dateBlockService) UpdateDateBlock(ctx context.Context, id uint, req dto
 		dateBlock.Reason = *req.Reason
 	}
 
+	if req.RoomGroupIDs != nil {
+		roomGroups, err := s.findRoomGroups(ctx, *req.RoomGroupIDs)
+		if err != nil {
+			return nil, err
+		}
+		dateBlock.RoomGroups = roomGroups
+	}
+
+	if req.RoomIDs != nil {
+		rooms, err := s.findRooms(ctx, *req.RoomIDs)
+		if err != nil {
+			return nil, err
+		}
+		dateBlock.Rooms = rooms
+	}
+
+	if req.Recurrence != nil {
+		recurrence, ok := models.ParseDateBlockRecurrence(*req.Recurrence)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown recurrence %s", ErrInvalidDateBlockRequest, *req.Recurrence)
+		}
+		dateBlock.Recurrence = recurrence
+	}
+
+	if req.RecurrenceUntil != nil {
+		if *req.RecurrenceUntil == "" {
+			dateBlock.RecurrenceUntil = nil
+		} else {
+			until, parseErr := time.Parse("2006-01-02", *req.RecurrenceUntil)
+			if parseErr != nil {
+				return nil, fmt.Errorf("%w: invalid recurrenceUntil format, expected YYYY-MM-DD", ErrInvalidDateBlockRequest)
+			}
+			dateBlock.RecurrenceUntil = &until
+		}
+	}
+
+	if req.ExceptionDates != nil {
+		for _, exceptionDate := range *req.ExceptionDates {
+			if _, parseErr := time.Parse("2006-01-02", exceptionDate); parseErr != nil {
+				return nil, fmt.Errorf("%w: invalid exceptionDates format, expected YYYY-MM-DD", ErrInvalidDateBlockRequest)
+			}
+		}
+		dateBlock.Exceptions = toDateBlockExceptions(*req.ExceptionDates)
+	}
+
+	// 반복 규칙을 NONE으로 바꾸면서 종료일이나 제외 회차를 따로 지우지 않았다면 함께 지운다
+	if !dateBlock.IsRecurring() && req.Recurrence != nil {
+		if req.RecurrenceUntil == nil {
+			dateBlock.RecurrenceUntil = nil
+		}
+		if req.ExceptionDates == nil {
+			dateBlock.Exceptions = nil
+		}
+	}
+
 	if dateBlock.StartDate.After(dateBlock.EndDate) {
 		return nil, fmt.Errorf("%w: startDate must be before or equal to endDate", ErrInvalidDateBlockRequest)
 	}
 
+	if err := dto.ValidateDateBlockRecurrence(dateBlock.StartDate, dateBlock.EndDate, dateBlock.Recurrence.String(), dateBlock.RecurrenceUntil, len(dateBlock.Exceptions) > 0); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDateBlockRequest, err.Error())
+	}
+
+	scopeChanged := req.StartDate != nil || req.EndDate != nil || req.RoomGroupIDs != nil || req.RoomIDs != nil ||
+		req.Recurrence != nil || req.RecurrenceUntil != nil || req.ExceptionDates != nil
+	if scopeChanged && !req.Force {
+		if err := s.checkConflicts(ctx, dateBlock, &previous); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.dateBlockRepo.Update(ctx, dateBlock); err != nil {
 		return nil, err
 	}
@@ -146,6 +270,100 @@ func (s *dateBlockService) UpdateDateBlock(ctx context.Context, id uint, req dto
 	return &result, nil
 }
 
-func (s *dateBlockService) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time) (bool, error) {
-	return s.dateBlockRepo.IsDateRangeBlocked(ctx, startDate, endDate)
+func (s *dateBlockService) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time, roomIDs []uint) (bool, error) {
+	return s.dateBlockRepo.IsDateRangeBlocked(ctx, startDate, endDate, roomIDs)
+}
+
+func (s *dateBlockService) GetConflicts(ctx context.Context, id uint) ([]dto.DateBlockConflictResponse, error) {
+	dateBlock, err := s.dateBlockRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrDateBlockNotFound
+	}
+
+	reservations, err := s.dateBlockRepo.FindConflictingReservations(ctx, dateBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.ToDateBlockConflictListResponse(dateBlock, reservations), nil
+}
+
+// checkConflicts는 dateBlock과 겹치는 예약이 있으면 DateBlockConflictError를 반환합니다.
+// 수정 시에는 previous(수정 전 차단)와 이미 겹치던 예약은 제외해, 기간이나 대상을 넓혀 새로 겹치는 예약만 확인합니다.
+func (s *dateBlockService) checkConflicts(ctx context.Context, dateBlock *models.DateBlock, previous *models.DateBlock) error {
+	reservations, err := s.dateBlockRepo.FindConflictingReservations(ctx, dateBlock)
+	if err != nil {
+		return err
+	}
+
+	conflicts := make([]models.Reservation, 0, len(reservations))
+	for i := range reservations {
+		if previous != nil {
+			if _, alreadyConflicted := previous.Conflict(&reservations[i]); alreadyConflicted {
+				continue
+			}
+		}
+		conflicts = append(conflicts, reservations[i])
+	}
+
+	if len(conflicts) > 0 {
+		return &DateBlockConflictError{Conflicts: mappers.ToDateBlockConflictListResponse(dateBlock, conflicts)}
+	}
+	return nil
+}
+
+func toDateBlockExceptions(exceptionDates []string) []models.DateBlockException {
+	exceptions := make([]models.DateBlockException, 0, len(exceptionDates))
+	seen := make(map[string]bool, len(exceptionDates))
+	for _, exceptionDate := range exceptionDates {
+		if seen[exceptionDate] {
+			continue
+		}
+		seen[exceptionDate] = true
+
+		date, err := time.Parse("2006-01-02", exceptionDate)
+		if err != nil {
+			continue
+		}
+		exceptions = append(exceptions, models.DateBlockException{ExceptionDate: date})
+	}
+	return exceptions
+}
+
+// findRoomGroups는 날짜 차단 대상 객실 그룹을 조회합니다. 존재하지 않는 그룹이 있으면 요청 오류입니다.
+func (s *dateBlockService) findRoomGroups(ctx context.Context, roomGroupIDs []uint) ([]models.RoomGroup, error) {
+	roomGroups := make([]models.RoomGroup, 0, len(roomGroupIDs))
+	seen := make(map[uint]bool, len(roomGroupIDs))
+	for _, roomGroupID := range roomGroupIDs {
+		if seen[roomGroupID] {
+			continue
+		}
+		seen[roomGroupID] = true
+
+		roomGroup, err := s.roomGroupRepo.FindByID(ctx, roomGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s (id=%d)", ErrInvalidDateBlockRequest, ErrRoomGroupNotFound.Error(), roomGroupID)
+		}
+		roomGroups = append(roomGroups, *roomGroup)
+	}
+	return roomGroups, nil
+}
+
+// findRooms는 날짜 차단 대상 객실을 조회합니다. 존재하지 않는 객실이 있으면 요청 오류입니다.
+func (s *dateBlockService) findRooms(ctx context.Context, roomIDs []uint) ([]models.Room, error) {
+	rooms := make([]models.Room, 0, len(roomIDs))
+	seen := make(map[uint]bool, len(roomIDs))
+	for _, roomID := range roomIDs {
+		if seen[roomID] {
+			continue
+		}
+		seen[roomID] = true
+
+		room, err := s.roomRepo.FindByID(ctx, roomID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s (id=%d)", ErrInvalidDateBlockRequest, ErrRoomNotFound.Error(), roomID)
+		}
+		rooms = append(rooms, *room)
+	}
+	return rooms, nil
 }
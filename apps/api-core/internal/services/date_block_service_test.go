@@ -54,24 +54,44 @@ func (m *MockDateBlockRepository) FindAll(ctx context.Context, filter dto.DateBl
 	return args.Get(0).([]models.DateBlock), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockDateBlockRepository) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time) (bool, error) {
-	args := m.Called(ctx, startDate, endDate)
+func (m *MockDateBlockRepository) IsDateRangeBlocked(ctx context.Context, startDate, endDate time.Time, roomIDs []uint) (bool, error) {
+	args := m.Called(ctx, startDate, endDate, roomIDs)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockDateBlockRepository) FindConflictingReservations(ctx context.Context, dateBlock *models.DateBlock) ([]models.Reservation, error) {
+	args := m.Called(ctx, dateBlock)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Reservation), args.Error(1)
+}
+
+func (m *MockDateBlockRepository) FindOverlapping(ctx context.Context, startDate, endDate time.Time) ([]models.DateBlock, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.DateBlock), args.Error(1)
+}
+
 type DateBlockServiceTestSuite struct {
 	suite.Suite
-	ctx              context.Context
-	mockRepo         *MockDateBlockRepository
-	mockAuditService *MockAuditService
-	service          services.DateBlockService
+	ctx               context.Context
+	mockRepo          *MockDateBlockRepository
+	mockRoomGroupRepo *MockRoomGroupRepository
+	mockRoomRepo      *MockRoomRepository
+	mockAuditService  *MockAuditService
+	service           services.DateBlockService
 }
 
 func (s *DateBlockServiceTestSuite) SetupTest() {
 	s.ctx = context.Background()
 	s.mockRepo = new(MockDateBlockRepository)
+	s.mockRoomGroupRepo = new(MockRoomGroupRepository)
+	s.mockRoomRepo = new(MockRoomRepository)
 	s.mockAuditService = new(MockAuditService)
-	s.service = services.NewDateBlockService(s.mockRepo, s.mockAuditService)
+	s.service = services.NewDateBlockService(s.mockRepo, s.mockRoomGroupRepo, s.mockRoomRepo, s.mockAuditService)
 }
 
 func (s *DateBlockServiceTestSuite) TestCreate_날짜_차단을_생성하면_저장된_차단을_반환한다() {
@@ -89,6 +109,7 @@ func (s *DateBlockServiceTestSuite) TestCreate_날짜_차단을_생성하면_저
 	}
 	created.ID = 10
 
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
 	s.mockRepo.On("Create", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
 		return block.Reason == req.Reason &&
 			block.StartDate.Equal(created.StartDate) &&
@@ -108,6 +129,106 @@ func (s *DateBlockServiceTestSuite) TestCreate_날짜_차단을_생성하면_저
 	s.mockRepo.AssertExpectations(s.T())
 }
 
+func (s *DateBlockServiceTestSuite) TestCreate_객실_그룹과_객실을_지정하면_차단_대상으로_저장한다() {
+	// Given - 객실 그룹 1과 객실 7(중복 포함)을 대상으로 하는 생성 요청이 주어지면
+	req := dto.CreateDateBlockRequest{
+		StartDate:    "2026-03-01",
+		EndDate:      "2026-03-03",
+		Reason:       "별관 공사",
+		RoomGroupIDs: []uint{1},
+		RoomIDs:      []uint{7, 7},
+	}
+	roomGroup := &models.RoomGroup{Name: "별관"}
+	roomGroup.ID = 1
+	room := &models.Room{Number: "701"}
+	room.ID = 7
+	created := &models.DateBlock{Reason: req.Reason, RoomGroups: []models.RoomGroup{*roomGroup}, Rooms: []models.Room{*room}}
+	created.ID = 11
+
+	s.mockRoomGroupRepo.On("FindByID", s.ctx, uint(1)).Return(roomGroup, nil)
+	s.mockRoomRepo.On("FindByID", s.ctx, uint(7)).Return(room, nil).Once()
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
+	s.mockRepo.On("Create", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
+		return len(block.RoomGroups) == 1 && block.RoomGroups[0].ID == 1 &&
+			len(block.Rooms) == 1 && block.Rooms[0].ID == 7
+	})).Return(created, nil)
+
+	// When - 날짜 차단을 생성하면
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - 응답에 차단 대상 객실 그룹과 객실이 포함된다
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []uint{1}, result.RoomGroupIDs)
+	assert.Equal(s.T(), []uint{7}, result.RoomIDs)
+	s.mockRepo.AssertExpectations(s.T())
+	s.mockRoomRepo.AssertExpectations(s.T())
+}
+
+func (s *DateBlockServiceTestSuite) TestCreate_존재하지_않는_객실을_지정하면_요청_에러를_반환한다() {
+	// Given - 존재하지 않는 객실을 대상으로 하는 생성 요청이 주어지면
+	req := dto.CreateDateBlockRequest{
+		StartDate: "2026-03-01",
+		EndDate:   "2026-03-03",
+		Reason:    "객실 점검",
+		RoomIDs:   []uint{99},
+	}
+	s.mockRoomRepo.On("FindByID", s.ctx, uint(99)).Return(nil, errors.New("record not found"))
+
+	// When - 날짜 차단 생성을 시도하면
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - ErrInvalidDateBlockRequest를 반환하고 저장하지 않는다
+	assert.ErrorIs(s.T(), err, services.ErrInvalidDateBlockRequest)
+	assert.Nil(s.T(), result)
+	s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *DateBlockServiceTestSuite) TestCreate_반복_주기보다_긴_회차는_에러를_반환한다() {
+	// Given - 7일 이상인 기간을 매주 반복하는 요청이 주어지면
+	req := dto.CreateDateBlockRequest{
+		StartDate:  "2026-03-01",
+		EndDate:    "2026-03-08",
+		Reason:     "장기 휴무",
+		Recurrence: "WEEKLY",
+	}
+
+	// When - 날짜 차단 생성을 시도하면
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - 회차가 겹치므로 검증 에러를 반환한다
+	assert.EqualError(s.T(), err, "recurring date block must be shorter than its recurrence period")
+	assert.Nil(s.T(), result)
+	s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *DateBlockServiceTestSuite) TestCreate_반복_규칙과_종료일_제외_회차를_저장한다() {
+	// Given - 매년 반복하고 종료일과 제외 회차가 있는 요청이 주어지면
+	until := "2030-12-31"
+	req := dto.CreateDateBlockRequest{
+		StartDate:       "2026-01-01",
+		EndDate:         "2026-01-01",
+		Reason:          "신정 휴무",
+		Recurrence:      "YEARLY",
+		RecurrenceUntil: &until,
+		ExceptionDates:  []string{"2028-01-01", "2028-01-01"},
+	}
+
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
+	s.mockRepo.On("Create", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
+		return block.Recurrence == models.DateBlockRecurrenceYearly &&
+			block.RecurrenceUntil != nil && block.RecurrenceUntil.Equal(time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)) &&
+			len(block.Exceptions) == 1 && block.Exceptions[0].ExceptionDate.Equal(time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC))
+	})).Return(&models.DateBlock{Recurrence: models.DateBlockRecurrenceYearly}, nil)
+
+	// When - 날짜 차단을 생성하면
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - 반복 규칙이 저장된다
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "YEARLY", result.Recurrence)
+	s.mockRepo.AssertExpectations(s.T())
+}
+
 func (s *DateBlockServiceTestSuite) TestCreate_시작일이_종료일보다_이후이면_에러를_반환한다() {
 	// Given - 시작일이 종료일보다 이후인 요청이 주어지면
 	req := dto.CreateDateBlockRequest{
@@ -141,6 +262,7 @@ func (s *DateBlockServiceTestSuite) TestCreate_단일_날짜_차단시작일_종
 	}
 	created.ID = 11
 
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
 	s.mockRepo.On("Create", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
 		return block.StartDate.Equal(block.EndDate)
 	})).Return(created, nil)
@@ -244,6 +366,33 @@ func (s *DateBlockServiceTestSuite) TestGetAll_날짜_범위로_차단_목록을
 	s.mockRepo.AssertExpectations(s.T())
 }
 
+func (s *DateBlockServiceTestSuite) TestGetAll_반복_차단은_조회_기간_안의_회차를_전개한다() {
+	// Given - 매주 화요일 반복 차단과 2026년 3월 조회 기간이 주어지면
+	filter := dto.DateBlockFilter{StartDate: "2026-03-01", EndDate: "2026-03-15"}
+	list := []models.DateBlock{{
+		StartDate:  time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC),
+		Reason:     "비수기 화요일 휴무",
+		Recurrence: models.DateBlockRecurrenceWeekly,
+		Exceptions: []models.DateBlockException{{ExceptionDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)}},
+	}}
+	list[0].ID = 5
+
+	s.mockRepo.On("FindAll", s.ctx, filter, 0, 20).Return(list, int64(1), nil)
+
+	// When - 목록을 조회하면
+	result, _, err := s.service.GetAll(s.ctx, filter, 0, 20)
+
+	// Then - 제외 회차를 뺀 기간 안의 회차가 함께 반환된다
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), result, 1)
+	assert.Equal(s.T(), "WEEKLY", result[0].Recurrence)
+	assert.Equal(s.T(), []string{"2026-03-10"}, result[0].ExceptionDates)
+	assert.Len(s.T(), result[0].Occurrences, 1)
+	assert.True(s.T(), result[0].Occurrences[0].StartDate.Time.Equal(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)))
+	s.mockRepo.AssertExpectations(s.T())
+}
+
 func (s *DateBlockServiceTestSuite) TestGetDateBlock_날짜_차단_단건_조회_정상_조회() {
 	// Given - 존재하는 날짜 차단 ID가 주어지면
 	dateBlock := &models.DateBlock{
@@ -329,6 +478,7 @@ func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_날짜_차단_수정_날
 	req := dto.UpdateDateBlockRequest{StartDate: &newStart, EndDate: &newEnd}
 
 	s.mockRepo.On("FindByID", s.ctx, uint(602)).Return(dateBlock, nil)
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
 	s.mockRepo.On("Update", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
 		return block.ID == 602 &&
 			block.Reason == "시설 점검" &&
@@ -350,6 +500,74 @@ func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_날짜_차단_수정_날
 	s.mockAuditService.AssertExpectations(s.T())
 }
 
+func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_날짜_차단_수정_대상_객실을_비우면_모든_객실_차단으로_바뀐다() {
+	// Given - 객실 하나만 차단하던 날짜 차단과 대상 객실을 비우는 요청이 주어지면
+	room := models.Room{Number: "101"}
+	room.ID = 1
+	dateBlock := &models.DateBlock{
+		StartDate: time.Date(2026, 9, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 9, 12, 0, 0, 0, 0, time.UTC),
+		Reason:    "객실 수리",
+		Rooms:     []models.Room{room},
+	}
+	dateBlock.ID = 604
+
+	emptyRoomIDs := []uint{}
+	req := dto.UpdateDateBlockRequest{RoomIDs: &emptyRoomIDs}
+
+	s.mockRepo.On("FindByID", s.ctx, uint(604)).Return(dateBlock, nil)
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
+	s.mockRepo.On("Update", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
+		return block.AppliesToAllRooms()
+	})).Return(nil)
+	s.mockAuditService.On("LogUpdate", s.ctx, dateBlock, mock.MatchedBy(func(oldValues map[string]interface{}) bool {
+		return assert.ObjectsAreEqual([]uint{1}, oldValues["roomIds"])
+	})).Return(nil)
+
+	// When - 날짜 차단 수정을 실행하면
+	result, err := s.service.UpdateDateBlock(s.ctx, 604, req)
+
+	// Then - 대상 객실이 비워지고 이전 대상은 감사 로그에 남는다
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), result.RoomIDs)
+	s.mockRepo.AssertExpectations(s.T())
+	s.mockAuditService.AssertExpectations(s.T())
+}
+
+func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_반복을_해제하면_종료일과_제외_회차도_지운다() {
+	// Given - 종료일과 제외 회차가 있는 매주 반복 차단과 반복 해제 요청이 주어지면
+	until := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	dateBlock := &models.DateBlock{
+		StartDate:       time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Reason:          "정기 휴무",
+		Recurrence:      models.DateBlockRecurrenceWeekly,
+		RecurrenceUntil: &until,
+		Exceptions:      []models.DateBlockException{{DateBlockID: 605, ExceptionDate: time.Date(2026, 9, 8, 0, 0, 0, 0, time.UTC)}},
+	}
+	dateBlock.ID = 605
+
+	none := "NONE"
+	req := dto.UpdateDateBlockRequest{Recurrence: &none}
+
+	s.mockRepo.On("FindByID", s.ctx, uint(605)).Return(dateBlock, nil)
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
+	s.mockRepo.On("Update", s.ctx, mock.MatchedBy(func(block *models.DateBlock) bool {
+		return !block.IsRecurring() && block.RecurrenceUntil == nil && len(block.Exceptions) == 0
+	})).Return(nil)
+	s.mockAuditService.On("LogUpdate", s.ctx, dateBlock, mock.Anything).Return(nil)
+
+	// When - 날짜 차단 수정을 실행하면
+	result, err := s.service.UpdateDateBlock(s.ctx, 605, req)
+
+	// Then - 반복하지 않는 차단이 된다
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "NONE", result.Recurrence)
+	assert.Nil(s.T(), result.RecurrenceUntil)
+	assert.Empty(s.T(), result.ExceptionDates)
+	s.mockRepo.AssertExpectations(s.T())
+}
+
 func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_날짜_차단_수정_존재하지_않는_ID() {
 	// Given - 존재하지 않는 날짜 차단 ID가 주어지면
 	newReason := "수정 시도"
@@ -458,6 +676,139 @@ func (s *DateBlockServiceTestSuite) TestGetDateBlockHistory_날짜_차단_이력
 	s.mockAuditService.AssertExpectations(s.T())
 }
 
+func conflictingReservation(id uint, name string, start, end time.Time, room *models.Room) models.Reservation {
+	reservation := models.Reservation{
+		Name:        name,
+		StayStartAt: start,
+		StayEndAt:   end,
+		Status:      models.ReservationStatusNormal,
+		Rooms:       []models.ReservationRoom{{RoomID: room.ID, Room: room}},
+	}
+	reservation.ID = id
+	return reservation
+}
+
+func (s *DateBlockServiceTestSuite) TestCreate_겹치는_예약이_있으면_충돌_목록과_함께_거절한다() {
+	// Given - 차단 기간에 정상 예약이 있는 생성 요청이 주어지면
+	req := dto.CreateDateBlockRequest{StartDate: "2026-04-10", EndDate: "2026-04-12", Reason: "보일러 교체"}
+	room := &models.Room{Number: "201"}
+	room.ID = 3
+	reservations := []models.Reservation{
+		conflictingReservation(70, "홍길동", time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 13, 0, 0, 0, 0, time.UTC), room),
+	}
+	s.mockRepo.On("FindConflictingReservations", s.ctx, mock.AnythingOfType("*models.DateBlock")).Return(reservations, nil)
+
+	// When - 날짜 차단 생성을 시도하면
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - 충돌 예약과 차단 대상 객실을 담은 에러를 반환하고 저장하지 않는다
+	assert.Nil(s.T(), result)
+	assert.ErrorIs(s.T(), err, services.ErrDateBlockConflict)
+	var conflictErr *services.DateBlockConflictError
+	assert.True(s.T(), errors.As(err, &conflictErr))
+	assert.Len(s.T(), conflictErr.Conflicts, 1)
+	assert.Equal(s.T(), uint(70), conflictErr.Conflicts[0].ReservationID)
+	assert.Equal(s.T(), []dto.DateBlockConflictRoomResponse{{ID: 3, Number: "201"}}, conflictErr.Conflicts[0].Rooms)
+	s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *DateBlockServiceTestSuite) TestCreate_force면_겹치는_예약이_있어도_생성한다() {
+	// Given - force 옵션을 켠 생성 요청이 주어지면
+	req := dto.CreateDateBlockRequest{StartDate: "2026-04-10", EndDate: "2026-04-12", Reason: "긴급 공사", Force: true}
+	created := &models.DateBlock{Reason: req.Reason}
+	created.ID = 12
+	s.mockRepo.On("Create", s.ctx, mock.AnythingOfType("*models.DateBlock")).Return(created, nil)
+
+	// When - 날짜 차단을 생성하면
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - 충돌 확인 없이 저장된다
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint(12), result.ID)
+	s.mockRepo.AssertNotCalled(s.T(), "FindConflictingReservations", mock.Anything, mock.Anything)
+}
+
+func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_기간을_늘리면_새로_겹치는_예약만_충돌로_본다() {
+	// Given - 이미 예약 하나와 겹치도록 강제 생성된 차단을 뒤로 늘리는 요청이 주어지면
+	room := &models.Room{Number: "101"}
+	room.ID = 1
+	dateBlock := &models.DateBlock{
+		StartDate: time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC),
+		Reason:    "공사",
+	}
+	dateBlock.ID = 606
+	alreadyConflicted := conflictingReservation(80, "기존 충돌", time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC), room)
+	newlyConflicted := conflictingReservation(81, "새 충돌", time.Date(2026, 4, 14, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 16, 0, 0, 0, 0, time.UTC), room)
+
+	newEnd := "2026-04-15"
+	req := dto.UpdateDateBlockRequest{EndDate: &newEnd}
+
+	s.mockRepo.On("FindByID", s.ctx, uint(606)).Return(dateBlock, nil)
+	s.mockRepo.On("FindConflictingReservations", s.ctx, dateBlock).Return([]models.Reservation{alreadyConflicted, newlyConflicted}, nil)
+
+	// When - 날짜 차단 수정을 실행하면
+	result, err := s.service.UpdateDateBlock(s.ctx, 606, req)
+
+	// Then - 기간을 늘려 새로 겹친 예약만 충돌로 반환한다
+	assert.Nil(s.T(), result)
+	var conflictErr *services.DateBlockConflictError
+	assert.True(s.T(), errors.As(err, &conflictErr))
+	assert.Len(s.T(), conflictErr.Conflicts, 1)
+	assert.Equal(s.T(), uint(81), conflictErr.Conflicts[0].ReservationID)
+	s.mockRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *DateBlockServiceTestSuite) TestUpdateDateBlock_사유만_바꾸면_충돌을_확인하지_않는다() {
+	// Given - 기간과 대상을 바꾸지 않는 수정 요청이 주어지면
+	dateBlock := &models.DateBlock{
+		StartDate: time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC),
+		Reason:    "공사",
+	}
+	dateBlock.ID = 607
+	reason := "보수 공사"
+
+	s.mockRepo.On("FindByID", s.ctx, uint(607)).Return(dateBlock, nil)
+	s.mockRepo.On("Update", s.ctx, dateBlock).Return(nil)
+	s.mockAuditService.On("LogUpdate", s.ctx, dateBlock, mock.Anything).Return(nil)
+
+	// When - 날짜 차단 수정을 실행하면
+	_, err := s.service.UpdateDateBlock(s.ctx, 607, dto.UpdateDateBlockRequest{Reason: &reason})
+
+	// Then - 충돌 확인 없이 수정된다
+	assert.NoError(s.T(), err)
+	s.mockRepo.AssertNotCalled(s.T(), "FindConflictingReservations", mock.Anything, mock.Anything)
+}
+
+func (s *DateBlockServiceTestSuite) TestGetConflicts_날짜_차단과_겹치는_예약을_조회한다() {
+	// Given - 예약과 겹치는 날짜 차단이 주어지면
+	room := &models.Room{Number: "301"}
+	room.ID = 9
+	dateBlock := &models.DateBlock{
+		StartDate: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		Rooms:     []models.Room{*room},
+	}
+	dateBlock.ID = 608
+	reservations := []models.Reservation{
+		conflictingReservation(90, "김철수", time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), room),
+	}
+
+	s.mockRepo.On("FindByID", s.ctx, uint(608)).Return(dateBlock, nil)
+	s.mockRepo.On("FindConflictingReservations", s.ctx, dateBlock).Return(reservations, nil)
+
+	// When - 충돌 예약을 조회하면
+	result, err := s.service.GetConflicts(s.ctx, 608)
+
+	// Then - 예약 정보와 차단 대상 객실이 반환된다
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), result, 1)
+	assert.Equal(s.T(), "김철수", result[0].Name)
+	assert.Equal(s.T(), "NORMAL", result[0].Status)
+	assert.Equal(s.T(), []dto.DateBlockConflictRoomResponse{{ID: 9, Number: "301"}}, result[0].Rooms)
+}
+
 func TestDateBlockService(t *testing.T) {
 	suite.Run(t, new(DateBlockServiceTestSuite))
 }
@@ -36,8 +36,11 @@ func (s *developmentService) resetData() error {
 	logrus.Info("=== resetData called ===")
 
 	tables := []string{
+		"rent_charge",
+		"reservation_payment",
 		"reservation_room",
 		"reservation",
+		"broker_fee_settlement",
 		"room",
 		"room_group",
 		"payment_method",
@@ -1079,6 +1082,8 @@ func (s *developmentService) generateReservationData_OLD() (map[string]interface
 			},
 			ReservationID: reservation.ID,
 			RoomID:        availableRooms[i].ID,
+			StayStartAt:   reservation.StayStartAt,
+			StayEndAt:     reservation.StayEndAt,
 		}
 
 		if err := db.Session(&gorm.Session{SkipHooks: true}).Omit("CreatedByUser", "UpdatedByUser", "Reservation", "Room").Create(&reservationRoom).Error; err != nil {
@@ -1138,6 +1143,8 @@ func (s *developmentService) generateReservationData_OLD() (map[string]interface
 			},
 			ReservationID: reservation.ID,
 			RoomID:        availableRooms[roomIndex].ID,
+			StayStartAt:   reservation.StayStartAt,
+			StayEndAt:     reservation.StayEndAt,
 		}
 
 		if err := db.Session(&gorm.Session{SkipHooks: true}).Omit("CreatedByUser", "UpdatedByUser", "Reservation", "Room").Create(&reservationRoom).Error; err != nil {
@@ -1207,6 +1214,8 @@ func (s *developmentService) generateReservationData_OLD() (map[string]interface
 			},
 			ReservationID: reservation.ID,
 			RoomID:        availableRooms[roomIndex].ID,
+			StayStartAt:   reservation.StayStartAt,
+			StayEndAt:     reservation.StayEndAt,
 		}
 
 		if err := db.Session(&gorm.Session{SkipHooks: true}).Omit("CreatedByUser", "UpdatedByUser", "Reservation", "Room").Create(&reservationRoom).Error; err != nil {
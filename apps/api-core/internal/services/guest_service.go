@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrGuestNotFound    = errors.New("존재하지 않는 고객")
+	ErrGuestPhoneExists = errors.New("같은 전화번호의 고객이 이미 있습니다")
+	ErrInvalidPhone     = errors.New("올바른 전화번호 형식이 아닙니다")
+)
+
+// GuestService는 고객(CRM) 정보와 고객별 숙박 이력을 관리합니다. 예약과 고객의 연결은 reservationService가 예약 생성 시 합니다.
+type GuestService interface {
+	GetGuests(ctx context.Context, filter dto.GuestFilter, offset, limit int) ([]models.Guest, int64, error)
+	GetGuest(ctx context.Context, id uint) (*models.Guest, error)
+	Create(ctx context.Context, req dto.CreateGuestRequest) (*models.Guest, error)
+	Update(ctx context.Context, id uint, req dto.UpdateGuestRequest) (*models.Guest, error)
+	GetHistory(ctx context.Context, id uint, today time.Time) (*dto.GuestHistoryResponse, error)
+}
+
+type guestService struct {
+	guestRepo repositories.GuestRepository
+}
+
+func NewGuestService(guestRepo repositories.GuestRepository) GuestService {
+	return &guestService{guestRepo: guestRepo}
+}
+
+func (s *guestService) GetGuests(ctx context.Context, filter dto.GuestFilter, offset, limit int) ([]models.Guest, int64, error) {
+	return s.guestRepo.FindAll(ctx, dto.GuestRepositoryFilter{
+		NameSearch:    filter.Search,
+		PhoneSearch:   models.NormalizePhone(filter.Search),
+		IsVIP:         filter.IsVIP,
+		IsBlacklisted: filter.IsBlacklisted,
+	}, offset, limit)
+}
+
+func (s *guestService) GetGuest(ctx context.Context, id uint) (*models.Guest, error) {
+	guest, err := s.guestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrGuestNotFound
+	}
+	return guest, nil
+}
+
+func (s *guestService) Create(ctx context.Context, req dto.CreateGuestRequest) (*models.Guest, error) {
+	phone, err := s.checkPhone(ctx, req.Phone, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	guest := &models.Guest{
+		Name:          req.Name,
+		Phone:         phone,
+		Email:         req.Email,
+		Note:          req.Note,
+		IsVIP:         req.IsVIP,
+		IsBlacklisted: req.IsBlacklisted,
+	}
+	guest.SetMarketingConsent(req.MarketingConsent, time.Now())
+
+	return s.guestRepo.Create(ctx, guest)
+}
+
+func (s *guestService) Update(ctx context.Context, id uint, req dto.UpdateGuestRequest) (*models.Guest, error) {
+	guest, err := s.guestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrGuestNotFound
+	}
+
+	if req.Phone != nil {
+		phone, err := s.checkPhone(ctx, *req.Phone, &id)
+		if err != nil {
+			return nil, err
+		}
+		guest.Phone = phone
+	}
+	if req.Name != nil {
+		guest.Name = *req.Name
+	}
+	if req.Email != nil {
+		guest.Email = req.Email
+		if *req.Email == "" {
+			guest.Email = nil
+		}
+	}
+	if req.Note != nil {
+		guest.Note = *req.Note
+	}
+	if req.IsVIP != nil {
+		guest.IsVIP = *req.IsVIP
+	}
+	if req.IsBlacklisted != nil {
+		guest.IsBlacklisted = *req.IsBlacklisted
+	}
+	if req.MarketingConsent != nil {
+		guest.SetMarketingConsent(*req.MarketingConsent, time.Now())
+	}
+
+	if err := s.guestRepo.Update(ctx, guest); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
+
+// GetHistory는 고객의 예약을 today 기준 지난 숙박과 예정된(투숙 중 포함) 숙박으로 나누고, 누적 결제 금액과 노쇼 횟수를 계산합니다.
+// 취소/환불 예약은 숙박 목록에서 빠지고, 노쇼는 횟수만 셉니다.
+func (s *guestService) GetHistory(ctx context.Context, id uint, today time.Time) (*dto.GuestHistoryResponse, error) {
+	guest, err := s.guestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrGuestNotFound
+	}
+
+	reservations, err := s.guestRepo.FindReservations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	today = truncateToDate(today)
+	history := &dto.GuestHistoryResponse{
+		Guest:         mappers.ToGuestResponse(guest),
+		PastStays:     []dto.GuestStayResponse{},
+		UpcomingStays: []dto.GuestStayResponse{},
+	}
+	for i := range reservations {
+		reservation := &reservations[i]
+		history.LifetimeSpend += reservation.Deposit + reservation.PaymentAmount - reservation.RefundAmount
+
+		switch {
+		case reservation.Status == models.ReservationStatusNoShow:
+			history.NoShowCount++
+		case reservation.IsCanceled():
+		case reservation.IsActive() && truncateToDate(reservation.StayEndAt).After(today):
+			history.UpcomingStays = append(history.UpcomingStays, mappers.ToGuestStayResponse(reservation))
+		default:
+			history.PastStays = append(history.PastStays, mappers.ToGuestStayResponse(reservation))
+		}
+	}
+	history.StayCount = len(history.PastStays)
+
+	return history, nil
+}
+
+// checkPhone은 전화번호를 저장 형식으로 바꾸고 같은 번호의 다른 고객이 없는지 확인합니다.
+func (s *guestService) checkPhone(ctx context.Context, phone string, excludeID *uint) (string, error) {
+	phone, ok := models.CanonicalPhone(phone)
+	if !ok {
+		return "", ErrInvalidPhone
+	}
+
+	exists, err := s.guestRepo.ExistsByPhone(ctx, phone, excludeID)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return "", ErrGuestPhoneExists
+	}
+	return phone, nil
+}
@@ -0,0 +1,265 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/gorm"
+)
+
+type MockGuestRepository struct {
+	mock.Mock
+}
+
+func (m *MockGuestRepository) Create(ctx context.Context, guest *models.Guest) (*models.Guest, error) {
+	args := m.Called(ctx, guest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Guest), args.Error(1)
+}
+
+func (m *MockGuestRepository) Update(ctx context.Context, guest *models.Guest) error {
+	args := m.Called(ctx, guest)
+	return args.Error(0)
+}
+
+func (m *MockGuestRepository) FindByID(ctx context.Context, id uint) (*models.Guest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Guest), args.Error(1)
+}
+
+func (m *MockGuestRepository) FindByPhone(ctx context.Context, phone string) (*models.Guest, error) {
+	args := m.Called(ctx, phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Guest), args.Error(1)
+}
+
+func (m *MockGuestRepository) ExistsByPhone(ctx context.Context, phone string, excludeID *uint) (bool, error) {
+	args := m.Called(ctx, phone, excludeID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGuestRepository) FindAll(ctx context.Context, filter dto.GuestRepositoryFilter, offset, limit int) ([]models.Guest, int64, error) {
+	args := m.Called(ctx, filter, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Guest), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockGuestRepository) FindReservations(ctx context.Context, guestID uint) ([]models.Reservation, error) {
+	args := m.Called(ctx, guestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Reservation), args.Error(1)
+}
+
+type GuestServiceTestSuite struct {
+	suite.Suite
+	ctx           context.Context
+	mockGuestRepo *MockGuestRepository
+	service       services.GuestService
+}
+
+func (suite *GuestServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockGuestRepo = new(MockGuestRepository)
+	suite.service = services.NewGuestService(suite.mockGuestRepo)
+}
+
+func (suite *GuestServiceTestSuite) newGuest() *models.Guest {
+	guest := &models.Guest{Name: "홍길동", Phone: "010-1234-5678"}
+	guest.ID = 1
+	return guest
+}
+
+func (suite *GuestServiceTestSuite) Test_전화번호를_정규화해_고객을_만든다() {
+	// Given - 같은 번호의 고객이 없으면
+	suite.mockGuestRepo.On("ExistsByPhone", suite.ctx, "010-1234-5678", (*uint)(nil)).Return(false, nil)
+	suite.mockGuestRepo.On("Create", suite.ctx, mock.AnythingOfType("*models.Guest")).Return(suite.newGuest(), nil)
+
+	// When - 국가 번호를 붙인 번호로 마케팅 수신에 동의한 고객을 만들면
+	_, err := suite.service.Create(suite.ctx, dto.CreateGuestRequest{
+		Name:             "홍길동",
+		Phone:            "010-1234 5678",
+		MarketingConsent: true,
+	})
+
+	// Then - 국내 번호 형식으로 바꾼 번호와 동의 시각이 저장된다
+	suite.NoError(err)
+	created := suite.mockGuestRepo.Calls[1].Arguments.Get(1).(*models.Guest)
+	suite.Equal("010-1234-5678", created.Phone)
+	suite.True(created.MarketingConsent)
+	suite.NotNil(created.MarketingConsentAt)
+}
+
+func (suite *GuestServiceTestSuite) Test_같은_전화번호의_고객이_있으면_만들지_않는다() {
+	// Given - 같은 번호의 고객이 이미 있으면
+	suite.mockGuestRepo.On("ExistsByPhone", suite.ctx, "010-1234-5678", (*uint)(nil)).Return(true, nil)
+
+	// When - 고객을 만들면
+	_, err := suite.service.Create(suite.ctx, dto.CreateGuestRequest{Name: "홍길동", Phone: "010-1234-5678"})
+
+	// Then - 중복 오류가 반환된다
+	suite.ErrorIs(err, services.ErrGuestPhoneExists)
+	suite.mockGuestRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *GuestServiceTestSuite) Test_형식에_맞지_않는_전화번호는_거부한다() {
+	// When - 자릿수가 맞지 않는 번호로 고객을 만들면
+	_, err := suite.service.Create(suite.ctx, dto.CreateGuestRequest{Name: "홍길동", Phone: "010-123-456"})
+
+	// Then - 잘못된 전화번호 오류가 반환된다
+	suite.ErrorIs(err, services.ErrInvalidPhone)
+}
+
+func (suite *GuestServiceTestSuite) Test_숙박_이력을_지난_숙박과_예정된_숙박으로_나눈다() {
+	// Given - 지난 숙박, 예정된 숙박, 취소, 노쇼 예약이 있는 고객
+	today := time.Date(2024, 5, 10, 15, 0, 0, 0, time.UTC)
+	reservation := func(id uint, status models.ReservationStatus, start, end time.Time, paid int) models.Reservation {
+		r := models.Reservation{Status: status, StayStartAt: start, StayEndAt: end, PaymentAmount: paid}
+		r.ID = id
+		return r
+	}
+	reservations := []models.Reservation{
+		reservation(1, models.ReservationStatusNormal, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), 200000),
+		reservation(2, models.ReservationStatusNoShow, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC), 50000),
+		reservation(3, models.ReservationStatusCancel, time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 6, 0, 0, 0, 0, time.UTC), 0),
+		reservation(4, models.ReservationStatusNormal, time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC), 180000),
+		reservation(5, models.ReservationStatusPending, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC), 0),
+	}
+	suite.mockGuestRepo.On("FindByID", suite.ctx, uint(1)).Return(suite.newGuest(), nil)
+	suite.mockGuestRepo.On("FindReservations", suite.ctx, uint(1)).Return(reservations, nil)
+
+	// When - 숙박 이력을 조회하면
+	history, err := suite.service.GetHistory(suite.ctx, 1, today)
+
+	// Then - 투숙 중인 예약은 예정된 숙박에 포함되고, 취소는 빠지고, 노쇼는 횟수만 센다
+	suite.NoError(err)
+	suite.Require().Len(history.PastStays, 1)
+	suite.Equal(uint(1), history.PastStays[0].ReservationID)
+	suite.Require().Len(history.UpcomingStays, 2)
+	suite.Equal(uint(4), history.UpcomingStays[0].ReservationID)
+	suite.Equal(uint(5), history.UpcomingStays[1].ReservationID)
+	suite.Equal(1, history.StayCount)
+	suite.Equal(1, history.NoShowCount)
+	suite.Equal(430000, history.LifetimeSpend)
+}
+
+func (suite *GuestServiceTestSuite) Test_없는_고객의_이력은_조회할_수_없다() {
+	suite.mockGuestRepo.On("FindByID", suite.ctx, uint(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.service.GetHistory(suite.ctx, 9, time.Now())
+
+	suite.ErrorIs(err, services.ErrGuestNotFound)
+}
+
+func TestGuestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(GuestServiceTestSuite))
+}
+
+func newReservationServiceWithGuests(guestRepo *MockGuestRepository) (services.ReservationService, *MockReservationRepository, *MockRoomRepository) {
+	reservationRepo := new(MockReservationRepository)
+	roomRepo := new(MockRoomRepository)
+	paymentMethodRepo := new(MockPaymentMethodRepository)
+
+	paymentMethod := &models.PaymentMethod{Name: "현금", Status: models.PaymentMethodStatusActive}
+	paymentMethod.ID = 1
+	paymentMethodRepo.On("FindByID", mock.Anything, uint(1)).Return(paymentMethod, nil)
+	roomRepo.On("LockRooms", mock.Anything, []uint{1}).Return(nil)
+	roomRepo.On("IsRoomAvailable", mock.Anything, uint(1), mock.Anything, mock.Anything, (*uint)(nil)).Return(true, nil)
+	roomRepo.On("FindByIDWithGroup", mock.Anything, uint(1)).Return(&models.Room{Number: "101"}, nil)
+	reservationRepo.On("FindDuplicateCandidates", mock.Anything, mock.Anything).Return([]models.Reservation{}, nil)
+
+	service := services.NewReservationService(reservationRepo, roomRepo, paymentMethodRepo, guestRepo, nil)
+	return service, reservationRepo, roomRepo
+}
+
+func newGuestReservation() *models.Reservation {
+	return &models.Reservation{
+		Name:            "홍길동",
+		Phone:           "010-1234-5678",
+		PeopleCount:     2,
+		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+		Status:          models.ReservationStatusNormal,
+		Type:            models.ReservationTypeStay,
+		PaymentMethodID: 1,
+	}
+}
+
+func TestReservationService_Create_전화번호가_같은_고객에_연결한다(t *testing.T) {
+	// Given - 같은 전화번호의 고객이 있으면
+	guestRepo := new(MockGuestRepository)
+	service, reservationRepo, _ := newReservationServiceWithGuests(guestRepo)
+	guest := &models.Guest{Name: "홍길동", Phone: "010-1234-5678"}
+	guest.ID = 7
+	guestRepo.On("FindByPhone", mock.Anything, "010-1234-5678").Return(guest, nil)
+	reservationRepo.On("Create", mock.Anything, mock.Anything).Return(&models.Reservation{}, nil)
+
+	// When - 예약을 만들면
+	reservation := newGuestReservation()
+	err := service.Create(context.Background(), reservation, []uint{1})
+
+	// Then - 기존 고객에 연결되고 새 고객은 만들지 않는다
+	assert.NoError(t, err)
+	if assert.NotNil(t, reservation.GuestID) {
+		assert.Equal(t, uint(7), *reservation.GuestID)
+	}
+	guestRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestReservationService_Create_처음_보는_전화번호면_고객을_만든다(t *testing.T) {
+	// Given - 같은 전화번호의 고객이 없으면
+	guestRepo := new(MockGuestRepository)
+	service, reservationRepo, _ := newReservationServiceWithGuests(guestRepo)
+	created := &models.Guest{Name: "홍길동", Phone: "010-1234-5678"}
+	created.ID = 8
+	guestRepo.On("FindByPhone", mock.Anything, "010-1234-5678").Return(nil, gorm.ErrRecordNotFound)
+	guestRepo.On("Create", mock.Anything, mock.MatchedBy(func(g *models.Guest) bool {
+		return g.Name == "홍길동" && g.Phone == "010-1234-5678"
+	})).Return(created, nil)
+	reservationRepo.On("Create", mock.Anything, mock.Anything).Return(&models.Reservation{}, nil)
+
+	// When - 예약을 만들면
+	reservation := newGuestReservation()
+	err := service.Create(context.Background(), reservation, []uint{1})
+
+	// Then - 예약자 정보로 새 고객을 만들어 연결한다
+	assert.NoError(t, err)
+	if assert.NotNil(t, reservation.GuestID) {
+		assert.Equal(t, uint(8), *reservation.GuestID)
+	}
+	guestRepo.AssertExpectations(t)
+}
+
+func TestReservationService_Create_지정한_고객이_없으면_실패한다(t *testing.T) {
+	// Given - 없는 고객을 지정하면
+	guestRepo := new(MockGuestRepository)
+	service, reservationRepo, _ := newReservationServiceWithGuests(guestRepo)
+	guestRepo.On("FindByID", mock.Anything, uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+	// When - 예약을 만들면
+	reservation := newGuestReservation()
+	guestID := uint(99)
+	reservation.GuestID = &guestID
+	err := service.Create(context.Background(), reservation, []uint{1})
+
+	// Then - 고객 없음 오류로 예약이 만들어지지 않는다
+	assert.ErrorIs(t, err, services.ErrGuestNotFound)
+	reservationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
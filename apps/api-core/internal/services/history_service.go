@@ -13,6 +13,9 @@ type HistoryService interface {
 	GetRoomHistory(ctx context.Context, roomID uint, page, size int) ([]dto.RoomRevisionResponse, int64, error)
 	GetReservationHistory(ctx context.Context, reservationID uint, page, size int) ([]dto.ReservationRevisionResponse, int64, error)
 	GetDateBlockHistory(ctx context.Context, dateBlockID uint, page, size int) ([]dto.DateBlockRevisionResponse, int64, error)
+	GetSeasonHistory(ctx context.Context, seasonID uint, page, size int) ([]dto.SeasonRevisionResponse, int64, error)
+	GetHousekeepingTaskHistory(ctx context.Context, taskID uint, page, size int) ([]dto.HousekeepingTaskRevisionResponse, int64, error)
+	GetMaintenanceTicketHistory(ctx context.Context, ticketID uint, page, size int) ([]dto.MaintenanceTicketRevisionResponse, int64, error)
 }
 
 type historyService struct {
@@ -69,6 +72,48 @@ func (s *historyService) GetDateBlockHistory(ctx context.Context, dateBlockID ui
 	return revisions, total, nil
 }
 
+func (s *historyService) GetSeasonHistory(ctx context.Context, seasonID uint, page, size int) ([]dto.SeasonRevisionResponse, int64, error) {
+	logs, total, err := s.auditService.GetHistory(ctx, "season", seasonID, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	revisions := make([]dto.SeasonRevisionResponse, len(logs))
+	for i, log := range logs {
+		revisions[i] = s.convertToSeasonRevision(ctx, &log)
+	}
+
+	return revisions, total, nil
+}
+
+func (s *historyService) GetHousekeepingTaskHistory(ctx context.Context, taskID uint, page, size int) ([]dto.HousekeepingTaskRevisionResponse, int64, error) {
+	logs, total, err := s.auditService.GetHistory(ctx, "housekeeping_task", taskID, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	revisions := make([]dto.HousekeepingTaskRevisionResponse, len(logs))
+	for i, log := range logs {
+		revisions[i] = s.convertToHousekeepingTaskRevision(ctx, &log)
+	}
+
+	return revisions, total, nil
+}
+
+func (s *historyService) GetMaintenanceTicketHistory(ctx context.Context, ticketID uint, page, size int) ([]dto.MaintenanceTicketRevisionResponse, int64, error) {
+	logs, total, err := s.auditService.GetHistory(ctx, "maintenance_ticket", ticketID, page, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	revisions := make([]dto.MaintenanceTicketRevisionResponse, len(logs))
+	for i, log := range logs {
+		revisions[i] = s.convertToMaintenanceTicketRevision(ctx, &log)
+	}
+
+	return revisions, total, nil
+}
+
 func (s *historyService) convertToRoomRevision(ctx context.Context, log *audit.AuditLog) dto.RoomRevisionResponse {
 	var roomEntity dto.RoomResponse
 
@@ -88,6 +133,8 @@ func (s *historyService) convertToRoomRevision(ctx context.Context, log *audit.A
 				Status:      snapshot.Status,
 				CreatedBy:   s.getUserSummary(ctx, snapshot.CreatedBy),
 				UpdatedBy:   s.getUserSummary(ctx, snapshot.UpdatedBy),
+
+				HousekeepingStatus: snapshot.HousekeepingStatus,
 			}
 		}
 	}
@@ -193,11 +240,24 @@ func (s *historyService) convertToDateBlockRevision(ctx context.Context, log *au
 			startDate, _ := time.Parse("2006-01-02", snapshot.StartDate)
 			endDate, _ := time.Parse("2006-01-02", snapshot.EndDate)
 			dateBlockEntity = dto.DateBlockResponse{
-				ID:        snapshot.ID,
-				StartDate: dto.JSONDate{Time: startDate},
-				EndDate:   dto.JSONDate{Time: endDate},
-				Reason:    snapshot.Reason,
-				CreatedBy: s.getUserSummary(ctx, snapshot.CreatedBy),
+				ID:           snapshot.ID,
+				StartDate:    dto.JSONDate{Time: startDate},
+				EndDate:      dto.JSONDate{Time: endDate},
+				Reason:       snapshot.Reason,
+				RoomGroupIDs: snapshot.RoomGroupIDs,
+				RoomIDs:      snapshot.RoomIDs,
+				CreatedBy:    s.getUserSummary(ctx, snapshot.CreatedBy),
+				// 반복 규칙이 추가되기 전 이력은 recurrence가 없으므로 NONE으로 본다
+				Recurrence:     "NONE",
+				ExceptionDates: snapshot.ExceptionDates,
+			}
+			if snapshot.Recurrence != "" {
+				dateBlockEntity.Recurrence = snapshot.Recurrence
+			}
+			if snapshot.RecurrenceUntil != nil {
+				if until, parseErr := time.Parse("2006-01-02", *snapshot.RecurrenceUntil); parseErr == nil {
+					dateBlockEntity.RecurrenceUntil = &dto.JSONDate{Time: until}
+				}
 			}
 		}
 	}
@@ -211,6 +271,125 @@ func (s *historyService) convertToDateBlockRevision(ctx context.Context, log *au
 	}
 }
 
+func (s *historyService) convertToSeasonRevision(ctx context.Context, log *audit.AuditLog) dto.SeasonRevisionResponse {
+	var seasonEntity dto.SeasonResponse
+
+	valuesJSON := log.NewValues
+	if log.Action == audit.ActionDelete {
+		valuesJSON = log.OldValues
+	}
+
+	if valuesJSON != nil && len(valuesJSON) > 0 {
+		var snapshot dto.SeasonHistorySnapshot
+		if err := json.Unmarshal(valuesJSON, &snapshot); err == nil {
+			startDate, _ := time.Parse("2006-01-02", snapshot.StartDate)
+			endDate, _ := time.Parse("2006-01-02", snapshot.EndDate)
+			seasonEntity = dto.SeasonResponse{
+				ID:              snapshot.ID,
+				Name:            snapshot.Name,
+				StartDate:       dto.JSONDate{Time: startDate},
+				EndDate:         dto.JSONDate{Time: endDate},
+				RecurringYearly: snapshot.RecurringYearly,
+				RoomGroupIDs:    snapshot.RoomGroupIDs,
+				CreatedBy:       s.getUserSummary(ctx, snapshot.CreatedBy),
+			}
+		}
+	}
+
+	return dto.SeasonRevisionResponse{
+		Entity:           seasonEntity,
+		HistoryType:      dto.ActionToHistoryType(string(log.Action)),
+		HistoryCreatedAt: dto.CustomTime{Time: log.CreatedAt},
+		UpdatedFields:    dto.ParseChangedFields(log.ChangedFields),
+		HistoryUsername:  log.Username,
+	}
+}
+
+func (s *historyService) convertToHousekeepingTaskRevision(ctx context.Context, log *audit.AuditLog) dto.HousekeepingTaskRevisionResponse {
+	var taskEntity dto.HousekeepingTaskResponse
+
+	valuesJSON := log.NewValues
+	if log.Action == audit.ActionDelete {
+		valuesJSON = log.OldValues
+	}
+
+	if valuesJSON != nil && len(valuesJSON) > 0 {
+		var snapshot dto.HousekeepingTaskHistorySnapshot
+		if err := json.Unmarshal(valuesJSON, &snapshot); err == nil {
+			taskDate, _ := time.Parse("2006-01-02", snapshot.TaskDate)
+			taskEntity = dto.HousekeepingTaskResponse{
+				ID:            snapshot.ID,
+				RoomID:        snapshot.RoomID,
+				ReservationID: snapshot.ReservationID,
+				TaskDate:      dto.JSONDate{Time: taskDate},
+				Type:          snapshot.Type,
+				Status:        snapshot.Status,
+				Note:          snapshot.Note,
+			}
+			if snapshot.AssigneeID != nil {
+				taskEntity.Assignee = s.getUserSummary(ctx, *snapshot.AssigneeID)
+			}
+			if snapshot.CompletedAt != nil {
+				if completedAt, err := time.Parse(time.RFC3339Nano, *snapshot.CompletedAt); err == nil {
+					taskEntity.CompletedAt = &dto.CustomTime{Time: completedAt}
+				}
+			}
+		}
+	}
+
+	return dto.HousekeepingTaskRevisionResponse{
+		Entity:           taskEntity,
+		HistoryType:      dto.ActionToHistoryType(string(log.Action)),
+		HistoryCreatedAt: dto.CustomTime{Time: log.CreatedAt},
+		UpdatedFields:    dto.ParseChangedFields(log.ChangedFields),
+		HistoryUsername:  log.Username,
+	}
+}
+
+func (s *historyService) convertToMaintenanceTicketRevision(ctx context.Context, log *audit.AuditLog) dto.MaintenanceTicketRevisionResponse {
+	var ticketEntity dto.MaintenanceTicketResponse
+
+	valuesJSON := log.NewValues
+	if log.Action == audit.ActionDelete {
+		valuesJSON = log.OldValues
+	}
+
+	if valuesJSON != nil && len(valuesJSON) > 0 {
+		var snapshot dto.MaintenanceTicketHistorySnapshot
+		if err := json.Unmarshal(valuesJSON, &snapshot); err == nil {
+			ticketEntity = dto.MaintenanceTicketResponse{
+				ID:          snapshot.ID,
+				RoomID:      snapshot.RoomID,
+				Description: snapshot.Description,
+				Priority:    snapshot.Priority,
+				Status:      snapshot.Status,
+				Resolution:  snapshot.Resolution,
+			}
+			if snapshot.AssigneeID != nil {
+				ticketEntity.Assignee = s.getUserSummary(ctx, *snapshot.AssigneeID)
+			}
+			if snapshot.ExpectedReturnDate != nil {
+				if expectedReturnDate, err := time.Parse("2006-01-02", *snapshot.ExpectedReturnDate); err == nil {
+					ticketEntity.ExpectedReturnDate = &dto.JSONDate{Time: expectedReturnDate}
+				}
+			}
+			if snapshot.ResolvedAt != nil {
+				if resolvedAt, err := time.Parse(time.RFC3339Nano, *snapshot.ResolvedAt); err == nil {
+					ticketEntity.ResolvedAt = &dto.CustomTime{Time: resolvedAt}
+				}
+			}
+		}
+	}
+
+	return dto.MaintenanceTicketRevisionResponse{
+		Entity:           ticketEntity,
+		HistoryType:      dto.ActionToHistoryType(string(log.Action)),
+		HistoryCreatedAt: dto.CustomTime{Time: log.CreatedAt},
+		UpdatedFields:    dto.ParseChangedFields(log.ChangedFields),
+		HistoryUsername:  log.Username,
+	}
+}
+
 func (s *historyService) getUserSummary(ctx context.Context, userID uint) *dto.UserSummaryResponse {
 	if userID == 0 {
 		return nil
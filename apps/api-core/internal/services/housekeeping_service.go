@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrHousekeepingTaskNotFound = errors.New("청소 작업을 찾을 수 없습니다")
+	ErrAssigneeNotFound         = errors.New("담당자를 찾을 수 없습니다")
+)
+
+// HousekeepingService는 객실 청소 상태와 일별 청소 작업을 관리합니다.
+type HousekeepingService interface {
+	GetRooms(ctx context.Context, filter dto.HousekeepingRoomFilter) ([]models.Room, error)
+	UpdateRoomStatus(ctx context.Context, roomID uint, status models.HousekeepingStatus) (*models.Room, error)
+	GetTasks(ctx context.Context, filter dto.HousekeepingTaskRepositoryFilter) ([]models.HousekeepingTask, error)
+	GetTask(ctx context.Context, id uint) (*models.HousekeepingTask, error)
+	GenerateTasks(ctx context.Context, date time.Time) ([]models.HousekeepingTask, error)
+	UpdateTask(ctx context.Context, id uint, req dto.UpdateHousekeepingTaskRequest) (*models.HousekeepingTask, error)
+}
+
+type housekeepingService struct {
+	taskRepo            repositories.HousekeepingTaskRepository
+	roomRepo            repositories.RoomRepository
+	reservationRoomRepo repositories.ReservationRoomRepository
+	userRepo            repositories.UserRepository
+}
+
+func NewHousekeepingService(taskRepo repositories.HousekeepingTaskRepository, roomRepo repositories.RoomRepository,
+	reservationRoomRepo repositories.ReservationRoomRepository, userRepo repositories.UserRepository) HousekeepingService {
+	return &housekeepingService{
+		taskRepo:            taskRepo,
+		roomRepo:            roomRepo,
+		reservationRoomRepo: reservationRoomRepo,
+		userRepo:            userRepo,
+	}
+}
+
+// GetRooms는 객실별 청소 상태를 객실 번호 순으로 조회합니다. 사용하지 않는(INACTIVE) 객실은 제외합니다.
+func (s *housekeepingService) GetRooms(ctx context.Context, filter dto.HousekeepingRoomFilter) ([]models.Room, error) {
+	rooms, _, err := s.roomRepo.FindAll(ctx, dto.RoomRepositoryFilter{RoomGroupID: filter.RoomGroupID}, 0, -1, "number,asc")
+	if err != nil {
+		return nil, err
+	}
+
+	status, hasStatus := models.ParseHousekeepingStatus(filter.HousekeepingStatus)
+	result := make([]models.Room, 0, len(rooms))
+	for _, room := range rooms {
+		if room.Status == models.RoomStatusInactive {
+			continue
+		}
+		if hasStatus && room.HousekeepingStatus != status {
+			continue
+		}
+		result = append(result, room)
+	}
+	return result, nil
+}
+
+// UpdateRoomStatus는 객실 청소 상태를 직접 변경합니다. 점검을 마친 객실을 INSPECTED로 바꿀 때 주로 사용합니다.
+func (s *housekeepingService) UpdateRoomStatus(ctx context.Context, roomID uint, status models.HousekeepingStatus) (*models.Room, error) {
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, ErrRoomNotFound
+	}
+
+	if err := s.roomRepo.UpdateHousekeepingStatus(ctx, room.ID, status); err != nil {
+		return nil, err
+	}
+	room.HousekeepingStatus = status
+	return room, nil
+}
+
+func (s *housekeepingService) GetTasks(ctx context.Context, filter dto.HousekeepingTaskRepositoryFilter) ([]models.HousekeepingTask, error) {
+	filter.TaskDate = truncateToDate(filter.TaskDate)
+	return s.taskRepo.FindAll(ctx, filter)
+}
+
+func (s *housekeepingService) GetTask(ctx context.Context, id uint) (*models.HousekeepingTask, error) {
+	task, err := s.taskRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrHousekeepingTaskNotFound
+	}
+	return task, nil
+}
+
+// GenerateTasks는 date에 퇴실하는 객실의 퇴실 청소와 연박 중인 객실의 중간 청소 작업을 생성합니다.
+// 이미 작업이 있는 객실은 건너뛰므로 여러 번 호출해도 같은 날 같은 객실에 작업이 중복되지 않으며, 새로 만든 작업만 반환합니다.
+func (s *housekeepingService) GenerateTasks(ctx context.Context, date time.Time) ([]models.HousekeepingTask, error) {
+	date = truncateToDate(date)
+
+	reservationRooms, err := s.reservationRoomRepo.FindOccupying(ctx, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	// 같은 객실에 퇴실과 연박이 겹치면 퇴실 청소를 우선한다
+	planned := make(map[uint]*models.HousekeepingTask)
+	var order []uint
+	for _, reservationRoom := range reservationRooms {
+		reservation := reservationRoom.Reservation
+		if reservation == nil {
+			continue
+		}
+
+		// 숙박 중 객실을 옮긴 날은 옮기기 전 객실의 퇴실 청소 날이다
+		var taskType models.HousekeepingTaskType
+		stayStartAt, stayEndAt := reservationRoom.StayPeriod(reservation)
+		switch {
+		case stayEndAt.Equal(date):
+			taskType = models.HousekeepingTaskTypeDeparture
+		case stayStartAt.Before(date) && stayEndAt.After(date):
+			taskType = models.HousekeepingTaskTypeStayOver
+		default:
+			continue
+		}
+
+		if existing, ok := planned[reservationRoom.RoomID]; ok {
+			if existing.Type == models.HousekeepingTaskTypeDeparture {
+				continue
+			}
+		} else {
+			order = append(order, reservationRoom.RoomID)
+		}
+		reservationID := reservation.ID
+		planned[reservationRoom.RoomID] = &models.HousekeepingTask{
+			RoomID:        reservationRoom.RoomID,
+			ReservationID: &reservationID,
+			TaskDate:      date,
+			Type:          taskType,
+			Status:        models.HousekeepingTaskStatusPending,
+		}
+	}
+
+	var created []models.HousekeepingTask
+	err = s.taskRepo.Transaction(ctx, func(ctx context.Context) error {
+		existing, err := s.taskRepo.FindAll(ctx, dto.HousekeepingTaskRepositoryFilter{TaskDate: date})
+		if err != nil {
+			return err
+		}
+		hasTask := make(map[uint]bool, len(existing))
+		for _, task := range existing {
+			hasTask[task.RoomID] = true
+		}
+
+		for _, roomID := range order {
+			if hasTask[roomID] {
+				continue
+			}
+			task := planned[roomID]
+			if err := s.taskRepo.Create(ctx, task); err != nil {
+				return err
+			}
+			created = append(created, *task)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateTask는 청소 작업의 담당자, 진행 상태, 메모를 변경합니다. 작업을 시작하면 객실이 CLEANING,
+// 완료하면 CLEAN이 되며, 완료한 작업을 되돌리면 완료 시각을 지웁니다.
+func (s *housekeepingService) UpdateTask(ctx context.Context, id uint, req dto.UpdateHousekeepingTaskRequest) (*models.HousekeepingTask, error) {
+	err := s.taskRepo.Transaction(ctx, func(ctx context.Context) error {
+		task, err := s.taskRepo.FindByID(ctx, id)
+		if err != nil {
+			return ErrHousekeepingTaskNotFound
+		}
+
+		if req.AssigneeID != nil {
+			if *req.AssigneeID == 0 {
+				task.AssigneeID = nil
+			} else {
+				if _, err := s.userRepo.FindByID(ctx, *req.AssigneeID); err != nil {
+					return ErrAssigneeNotFound
+				}
+				assigneeID := *req.AssigneeID
+				task.AssigneeID = &assigneeID
+			}
+		}
+		if req.Note != nil {
+			task.Note = *req.Note
+		}
+
+		var roomStatus models.HousekeepingStatus
+		if req.Status != nil {
+			status, _ := models.ParseHousekeepingTaskStatus(*req.Status)
+			if status != task.Status {
+				switch status {
+				case models.HousekeepingTaskStatusInProgress:
+					roomStatus = models.HousekeepingStatusCleaning
+					task.CompletedAt = nil
+				case models.HousekeepingTaskStatusDone:
+					roomStatus = models.HousekeepingStatusClean
+					now := time.Now()
+					task.CompletedAt = &now
+				default:
+					task.CompletedAt = nil
+				}
+				task.Status = status
+			}
+		}
+
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			return err
+		}
+
+		if roomStatus != 0 {
+			if _, err := s.roomRepo.FindByID(ctx, task.RoomID); err != nil {
+				return ErrRoomNotFound
+			}
+			return s.roomRepo.UpdateHousekeepingStatus(ctx, task.RoomID, roomStatus)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.taskRepo.FindByID(ctx, id)
+}
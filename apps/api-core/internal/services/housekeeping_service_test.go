@@ -0,0 +1,188 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockHousekeepingTaskRepository struct {
+	mock.Mock
+}
+
+func (m *MockHousekeepingTaskRepository) Create(ctx context.Context, task *models.HousekeepingTask) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockHousekeepingTaskRepository) Update(ctx context.Context, task *models.HousekeepingTask) error {
+	args := m.Called(ctx, task)
+	return args.Error(0)
+}
+
+func (m *MockHousekeepingTaskRepository) FindByID(ctx context.Context, id uint) (*models.HousekeepingTask, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.HousekeepingTask), args.Error(1)
+}
+
+func (m *MockHousekeepingTaskRepository) FindAll(ctx context.Context, filter dto.HousekeepingTaskRepositoryFilter) ([]models.HousekeepingTask, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.HousekeepingTask), args.Error(1)
+}
+
+func (m *MockHousekeepingTaskRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type HousekeepingServiceTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockTaskRepo            *MockHousekeepingTaskRepository
+	mockRoomRepo            *MockRoomRepository
+	mockReservationRoomRepo *MockReservationRoomRepository
+	mockUserRepo            *MockUserRepository
+	service                 services.HousekeepingService
+	date                    time.Time
+}
+
+func (suite *HousekeepingServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockTaskRepo = new(MockHousekeepingTaskRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockReservationRoomRepo = new(MockReservationRoomRepository)
+	suite.mockUserRepo = new(MockUserRepository)
+	suite.service = services.NewHousekeepingService(suite.mockTaskRepo, suite.mockRoomRepo, suite.mockReservationRoomRepo, suite.mockUserRepo)
+	suite.date = time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *HousekeepingServiceTestSuite) occupying(reservationID, roomID uint, stayStartAt, stayEndAt time.Time) models.ReservationRoom {
+	reservation := &models.Reservation{StayStartAt: stayStartAt, StayEndAt: stayEndAt, Status: models.ReservationStatusNormal}
+	reservation.ID = reservationID
+	return models.ReservationRoom{ReservationID: reservationID, RoomID: roomID, Reservation: reservation}
+}
+
+func (suite *HousekeepingServiceTestSuite) TestGenerateTasks_퇴실_객실과_연박_객실에_작업을_만든다() {
+	// Given - 101호는 당일 퇴실, 102호는 연박 중, 103호는 당일 입실, 104호는 퇴실 후 같은 날 입실이 있으면
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 0, 0, 0, 0, time.UTC) }
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, day(9), day(11)).Return([]models.ReservationRoom{
+		suite.occupying(1, 101, day(8), day(10)),
+		suite.occupying(2, 102, day(9), day(12)),
+		suite.occupying(3, 103, day(10), day(12)),
+		suite.occupying(4, 104, day(10), day(11)),
+		suite.occupying(5, 104, day(7), day(10)),
+	}, nil)
+	suite.mockTaskRepo.On("FindAll", suite.ctx, dto.HousekeepingTaskRepositoryFilter{TaskDate: suite.date}).Return([]models.HousekeepingTask{}, nil)
+	suite.mockTaskRepo.On("Create", suite.ctx, mock.AnythingOfType("*models.HousekeepingTask")).Return(nil)
+
+	// When - 작업을 생성하면
+	tasks, err := suite.service.GenerateTasks(suite.ctx, suite.date.Add(9*time.Hour))
+
+	// Then - 입실만 있는 객실은 건너뛰고, 퇴실이 겹치는 객실은 퇴실 청소로 만든다
+	suite.NoError(err)
+	suite.Len(tasks, 3)
+	suite.Equal(uint(101), tasks[0].RoomID)
+	suite.Equal(models.HousekeepingTaskTypeDeparture, tasks[0].Type)
+	suite.Equal(uint(102), tasks[1].RoomID)
+	suite.Equal(models.HousekeepingTaskTypeStayOver, tasks[1].Type)
+	suite.Equal(uint(104), tasks[2].RoomID)
+	suite.Equal(models.HousekeepingTaskTypeDeparture, tasks[2].Type)
+	suite.Equal(uint(5), *tasks[2].ReservationID)
+	for _, task := range tasks {
+		suite.Equal(suite.date, task.TaskDate)
+		suite.Equal(models.HousekeepingTaskStatusPending, task.Status)
+	}
+}
+
+func (suite *HousekeepingServiceTestSuite) TestGenerateTasks_이미_작업이_있는_객실은_건너뛴다() {
+	// Given - 101호에 이미 작업이 있으면
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 0, 0, 0, 0, time.UTC) }
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, day(9), day(11)).Return([]models.ReservationRoom{
+		suite.occupying(1, 101, day(8), day(10)),
+		suite.occupying(2, 102, day(8), day(10)),
+	}, nil)
+	suite.mockTaskRepo.On("FindAll", suite.ctx, dto.HousekeepingTaskRepositoryFilter{TaskDate: suite.date}).
+		Return([]models.HousekeepingTask{{RoomID: 101, TaskDate: suite.date}}, nil)
+	suite.mockTaskRepo.On("Create", suite.ctx, mock.MatchedBy(func(task *models.HousekeepingTask) bool {
+		return task.RoomID == 102
+	})).Return(nil).Once()
+
+	// When - 다시 작업을 생성하면
+	tasks, err := suite.service.GenerateTasks(suite.ctx, suite.date)
+
+	// Then - 새로 만든 작업만 반환한다
+	suite.NoError(err)
+	suite.Len(tasks, 1)
+	suite.Equal(uint(102), tasks[0].RoomID)
+	suite.mockTaskRepo.AssertExpectations(suite.T())
+}
+
+func (suite *HousekeepingServiceTestSuite) TestUpdateTask_작업_상태에_따라_객실_청소_상태가_바뀐다() {
+	tests := []struct {
+		status             string
+		expectedRoomStatus models.HousekeepingStatus
+		expectCompletedAt  bool
+	}{
+		{"IN_PROGRESS", models.HousekeepingStatusCleaning, false},
+		{"DONE", models.HousekeepingStatusClean, true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.status, func() {
+			suite.SetupTest()
+
+			// Given - 대기 중인 청소 작업이 있으면
+			task := &models.HousekeepingTask{RoomID: 101, TaskDate: suite.date, Type: models.HousekeepingTaskTypeDeparture, Status: models.HousekeepingTaskStatusPending}
+			task.ID = 1
+			room := &models.Room{Number: "101", HousekeepingStatus: models.HousekeepingStatusDirty}
+			room.ID = 101
+			suite.mockTaskRepo.On("FindByID", suite.ctx, uint(1)).Return(task, nil)
+			suite.mockTaskRepo.On("Update", suite.ctx, task).Return(nil)
+			suite.mockRoomRepo.On("FindByID", suite.ctx, uint(101)).Return(room, nil)
+			suite.mockRoomRepo.On("UpdateHousekeepingStatus", suite.ctx, uint(101), tt.expectedRoomStatus).Return(nil)
+
+			// When - 작업 상태를 바꾸면
+			status := tt.status
+			updated, err := suite.service.UpdateTask(suite.ctx, 1, dto.UpdateHousekeepingTaskRequest{Status: &status})
+
+			// Then - 객실 청소 상태가 함께 바뀐다
+			suite.NoError(err)
+			suite.Equal(status, updated.Status.String())
+			suite.Equal(tt.expectCompletedAt, updated.CompletedAt != nil)
+			suite.mockRoomRepo.AssertExpectations(suite.T())
+			suite.mockRoomRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (suite *HousekeepingServiceTestSuite) TestUpdateTask_존재하지_않는_담당자면_에러() {
+	// Given - 담당자가 존재하지 않으면
+	task := &models.HousekeepingTask{RoomID: 101, Status: models.HousekeepingTaskStatusPending}
+	task.ID = 1
+	suite.mockTaskRepo.On("FindByID", suite.ctx, uint(1)).Return(task, nil)
+	suite.mockUserRepo.On("FindByID", suite.ctx, uint(99)).Return(nil, errors.New("record not found"))
+
+	// When - 담당자를 지정하면
+	assigneeID := uint(99)
+	_, err := suite.service.UpdateTask(suite.ctx, 1, dto.UpdateHousekeepingTaskRequest{AssigneeID: &assigneeID})
+
+	// Then
+	suite.ErrorIs(err, services.ErrAssigneeNotFound)
+	suite.mockTaskRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func TestHousekeepingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(HousekeepingServiceTestSuite))
+}
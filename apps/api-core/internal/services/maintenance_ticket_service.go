@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrMaintenanceTicketNotFound = errors.New("유지보수 티켓을 찾을 수 없습니다")
+	ErrMaintenanceTicketResolved = errors.New("이미 해결된 유지보수 티켓입니다")
+	ErrInvalidExpectedReturnDate = errors.New("잘못된 예상 복구일입니다")
+)
+
+// MaintenanceTicketService는 객실 유지보수 티켓과 그에 따른 객실 상태 변경을 관리합니다.
+type MaintenanceTicketService interface {
+	GetTickets(ctx context.Context, filter dto.MaintenanceTicketRepositoryFilter, offset, limit int) ([]models.MaintenanceTicket, int64, error)
+	GetTicket(ctx context.Context, id uint) (*models.MaintenanceTicket, error)
+	GetAffectedReservations(ctx context.Context, ticket *models.MaintenanceTicket) ([]models.Reservation, error)
+	CreateTicket(ctx context.Context, ticket *models.MaintenanceTicket, roomStatus *models.RoomStatus) (*models.MaintenanceTicket, error)
+	UpdateTicket(ctx context.Context, id uint, req dto.UpdateMaintenanceTicketRequest) (*models.MaintenanceTicket, error)
+	ResolveTicket(ctx context.Context, id uint, resolution string) (*models.MaintenanceTicket, error)
+}
+
+type maintenanceTicketService struct {
+	ticketRepo   repositories.MaintenanceTicketRepository
+	roomRepo     repositories.RoomRepository
+	userRepo     repositories.UserRepository
+	scheduleRepo repositories.RoomStatusScheduleRepository
+}
+
+func NewMaintenanceTicketService(ticketRepo repositories.MaintenanceTicketRepository, roomRepo repositories.RoomRepository,
+	userRepo repositories.UserRepository, scheduleRepo repositories.RoomStatusScheduleRepository) MaintenanceTicketService {
+	return &maintenanceTicketService{
+		ticketRepo:   ticketRepo,
+		roomRepo:     roomRepo,
+		userRepo:     userRepo,
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+func (s *maintenanceTicketService) GetTickets(ctx context.Context, filter dto.MaintenanceTicketRepositoryFilter, offset, limit int) ([]models.MaintenanceTicket, int64, error) {
+	return s.ticketRepo.FindAll(ctx, filter, offset, limit)
+}
+
+func (s *maintenanceTicketService) GetTicket(ctx context.Context, id uint) (*models.MaintenanceTicket, error) {
+	ticket, err := s.ticketRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrMaintenanceTicketNotFound
+	}
+	return ticket, nil
+}
+
+// GetAffectedReservations는 열린 티켓의 예상 고장 기간(오늘부터 예상 복구일 전날까지)에 객실을 쓰는 정상/대기 예약을 조회합니다.
+// 예상 복구일이 없으면 오늘 이후의 모든 예약을, 해결된 티켓이면 빈 목록을 반환합니다.
+func (s *maintenanceTicketService) GetAffectedReservations(ctx context.Context, ticket *models.MaintenanceTicket) ([]models.Reservation, error) {
+	if !ticket.IsOpen() {
+		return nil, nil
+	}
+	return s.ticketRepo.FindAffectedReservations(ctx, ticket.RoomID, truncateToDate(time.Now()), ticket.ExpectedReturnDate)
+}
+
+// CreateTicket은 유지보수 티켓을 열고, roomStatus를 지정하면 객실 상태를 함께 바꿉니다.
+func (s *maintenanceTicketService) CreateTicket(ctx context.Context, ticket *models.MaintenanceTicket, roomStatus *models.RoomStatus) (*models.MaintenanceTicket, error) {
+	room, err := s.roomRepo.FindByID(ctx, ticket.RoomID)
+	if err != nil {
+		return nil, ErrRoomNotFound
+	}
+	if ticket.AssigneeID != nil {
+		if _, err := s.userRepo.FindByID(ctx, *ticket.AssigneeID); err != nil {
+			return nil, ErrAssigneeNotFound
+		}
+	}
+
+	err = s.ticketRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.ticketRepo.Create(ctx, ticket); err != nil {
+			return err
+		}
+		if roomStatus == nil || room.Status == *roomStatus {
+			return nil
+		}
+		room.Status = *roomStatus
+		return s.roomRepo.Update(ctx, room)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ticketRepo.FindByID(ctx, ticket.ID)
+}
+
+// UpdateTicket은 열린 티켓의 설명, 우선순위, 담당자, 예상 복구일을 수정합니다.
+func (s *maintenanceTicketService) UpdateTicket(ctx context.Context, id uint, req dto.UpdateMaintenanceTicketRequest) (*models.MaintenanceTicket, error) {
+	ticket, err := s.ticketRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrMaintenanceTicketNotFound
+	}
+	if !ticket.IsOpen() {
+		return nil, ErrMaintenanceTicketResolved
+	}
+
+	if req.Description != nil {
+		ticket.Description = *req.Description
+	}
+	if req.Priority != nil {
+		ticket.Priority, _ = models.ParseMaintenanceTicketPriority(*req.Priority)
+	}
+	if req.AssigneeID != nil {
+		if *req.AssigneeID == 0 {
+			ticket.AssigneeID = nil
+		} else {
+			if _, err := s.userRepo.FindByID(ctx, *req.AssigneeID); err != nil {
+				return nil, ErrAssigneeNotFound
+			}
+			assigneeID := *req.AssigneeID
+			ticket.AssigneeID = &assigneeID
+		}
+	}
+	if req.ExpectedReturnDate != nil {
+		expectedReturnDate, err := dto.ParseExpectedReturnDate(*req.ExpectedReturnDate)
+		if err != nil {
+			return nil, ErrInvalidExpectedReturnDate
+		}
+		ticket.ExpectedReturnDate = expectedReturnDate
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, err
+	}
+
+	return s.ticketRepo.FindByID(ctx, id)
+}
+
+// ResolveTicket은 티켓을 해결 처리합니다. 객실에 남은 열린 티켓이 없고 객실이 고장(DAMAGED)이나
+// 공사(CONSTRUCTION) 상태면 NORMAL로 되돌립니다. 오늘을 포함하는 객실 상태 예약이 적용 중이면 NORMAL 대신
+// 그 예약의 상태로 두며, 예약 기간이 끝나면 객실 상태 예약이 원래 상태로 되돌립니다. 사용하지 않는(INACTIVE) 객실은 그대로 둡니다.
+func (s *maintenanceTicketService) ResolveTicket(ctx context.Context, id uint, resolution string) (*models.MaintenanceTicket, error) {
+	err := s.ticketRepo.Transaction(ctx, func(ctx context.Context) error {
+		ticket, err := s.ticketRepo.FindByID(ctx, id)
+		if err != nil {
+			return ErrMaintenanceTicketNotFound
+		}
+		if !ticket.IsOpen() {
+			return ErrMaintenanceTicketResolved
+		}
+
+		now := time.Now()
+		ticket.Status = models.MaintenanceTicketStatusResolved
+		ticket.Resolution = resolution
+		ticket.ResolvedAt = &now
+		if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+			return err
+		}
+
+		openCount, err := s.ticketRepo.CountOpenByRoomID(ctx, ticket.RoomID)
+		if err != nil {
+			return err
+		}
+		if openCount > 0 {
+			return nil
+		}
+
+		room, err := s.roomRepo.FindByID(ctx, ticket.RoomID)
+		if err != nil {
+			return ErrRoomNotFound
+		}
+		if room.Status != models.RoomStatusDamaged && room.Status != models.RoomStatusConstruction {
+			return nil
+		}
+
+		today := truncateToDate(now)
+		schedules, err := s.scheduleRepo.FindOverlapping(ctx, room.ID, today, today)
+		if err != nil {
+			return err
+		}
+		status := models.RoomStatusNormal
+		for i := range schedules {
+			if schedules[i].IsActive() {
+				status = schedules[i].Status
+				break
+			}
+		}
+		if room.Status == status {
+			return nil
+		}
+		room.Status = status
+		return s.roomRepo.Update(ctx, room)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ticketRepo.FindByID(ctx, id)
+}
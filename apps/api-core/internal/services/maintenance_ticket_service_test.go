@@ -0,0 +1,267 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/gorm"
+)
+
+type MockMaintenanceTicketRepository struct {
+	mock.Mock
+}
+
+func (m *MockMaintenanceTicketRepository) Create(ctx context.Context, ticket *models.MaintenanceTicket) error {
+	args := m.Called(ctx, ticket)
+	return args.Error(0)
+}
+
+func (m *MockMaintenanceTicketRepository) Update(ctx context.Context, ticket *models.MaintenanceTicket) error {
+	args := m.Called(ctx, ticket)
+	return args.Error(0)
+}
+
+func (m *MockMaintenanceTicketRepository) FindByID(ctx context.Context, id uint) (*models.MaintenanceTicket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepository) FindAll(ctx context.Context, filter dto.MaintenanceTicketRepositoryFilter, offset, limit int) ([]models.MaintenanceTicket, int64, error) {
+	args := m.Called(ctx, filter, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.MaintenanceTicket), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockMaintenanceTicketRepository) CountOpenByRoomID(ctx context.Context, roomID uint) (int64, error) {
+	args := m.Called(ctx, roomID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepository) FindAffectedReservations(ctx context.Context, roomID uint, from time.Time, until *time.Time) ([]models.Reservation, error) {
+	args := m.Called(ctx, roomID, from, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Reservation), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type MaintenanceTicketServiceTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	mockTicketRepo   *MockMaintenanceTicketRepository
+	mockRoomRepo     *MockRoomRepository
+	mockUserRepo     *MockUserRepository
+	mockScheduleRepo *MockRoomStatusScheduleRepository
+	service          services.MaintenanceTicketService
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockTicketRepo = new(MockMaintenanceTicketRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockUserRepo = new(MockUserRepository)
+	suite.mockScheduleRepo = new(MockRoomStatusScheduleRepository)
+	suite.service = services.NewMaintenanceTicketService(suite.mockTicketRepo, suite.mockRoomRepo, suite.mockUserRepo, suite.mockScheduleRepo)
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) newRoom(status models.RoomStatus) *models.Room {
+	room := &models.Room{Number: "101", Status: status}
+	room.ID = 10
+	return room
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) newOpenTicket() *models.MaintenanceTicket {
+	ticket := &models.MaintenanceTicket{
+		RoomID:      10,
+		Description: "에어컨 고장",
+		Priority:    models.MaintenanceTicketPriorityHigh,
+		Status:      models.MaintenanceTicketStatusOpen,
+	}
+	ticket.ID = 1
+	return ticket
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestCreateTicket_객실_상태를_함께_바꾼다() {
+	// Given - 정상 객실에
+	room := suite.newRoom(models.RoomStatusNormal)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockTicketRepo.On("Create", suite.ctx, mock.AnythingOfType("*models.MaintenanceTicket")).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.MaintenanceTicket).ID = 1
+	})
+	suite.mockRoomRepo.On("Update", suite.ctx, room).Return(nil)
+	suite.mockTicketRepo.On("FindByID", suite.ctx, uint(1)).Return(suite.newOpenTicket(), nil)
+
+	// When - 객실을 고장 상태로 바꾸며 티켓을 열면
+	damaged := models.RoomStatusDamaged
+	ticket := &models.MaintenanceTicket{RoomID: 10, Description: "에어컨 고장"}
+	_, err := suite.service.CreateTicket(suite.ctx, ticket, &damaged)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(models.RoomStatusDamaged, room.Status)
+	suite.mockRoomRepo.AssertExpectations(suite.T())
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestCreateTicket_객실_상태를_지정하지_않으면_그대로_둔다() {
+	room := suite.newRoom(models.RoomStatusNormal)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockTicketRepo.On("Create", suite.ctx, mock.AnythingOfType("*models.MaintenanceTicket")).Return(nil)
+	suite.mockTicketRepo.On("FindByID", suite.ctx, mock.Anything).Return(suite.newOpenTicket(), nil)
+
+	_, err := suite.service.CreateTicket(suite.ctx, &models.MaintenanceTicket{RoomID: 10, Description: "전등 교체"}, nil)
+
+	suite.NoError(err)
+	suite.Equal(models.RoomStatusNormal, room.Status)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestCreateTicket_존재하지_않는_객실() {
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.service.CreateTicket(suite.ctx, &models.MaintenanceTicket{RoomID: 10}, nil)
+
+	suite.ErrorIs(err, services.ErrRoomNotFound)
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestResolveTicket_마지막_열린_티켓이면_객실이_정상으로_돌아온다() {
+	tests := []struct {
+		name           string
+		openCount      int64
+		roomStatus     models.RoomStatus
+		expectedStatus models.RoomStatus
+	}{
+		{"마지막 티켓이고 고장 상태면 정상으로", 0, models.RoomStatusDamaged, models.RoomStatusNormal},
+		{"마지막 티켓이고 공사 상태면 정상으로", 0, models.RoomStatusConstruction, models.RoomStatusNormal},
+		{"다른 열린 티켓이 남아 있으면 그대로", 1, models.RoomStatusDamaged, models.RoomStatusDamaged},
+		{"사용하지 않는 객실은 그대로", 0, models.RoomStatusInactive, models.RoomStatusInactive},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+
+			// Given
+			ticket := suite.newOpenTicket()
+			room := suite.newRoom(tt.roomStatus)
+			suite.mockTicketRepo.On("FindByID", suite.ctx, uint(1)).Return(ticket, nil)
+			suite.mockTicketRepo.On("Update", suite.ctx, ticket).Return(nil)
+			suite.mockTicketRepo.On("CountOpenByRoomID", suite.ctx, uint(10)).Return(tt.openCount, nil)
+			suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+			suite.mockRoomRepo.On("Update", suite.ctx, room).Return(nil)
+			suite.mockScheduleRepo.On("FindOverlapping", suite.ctx, uint(10), today(), today()).Return([]models.RoomStatusSchedule{}, nil)
+
+			// When
+			resolved, err := suite.service.ResolveTicket(suite.ctx, 1, "컴프레서 교체")
+
+			// Then
+			suite.NoError(err)
+			suite.Equal(models.MaintenanceTicketStatusResolved, resolved.Status)
+			suite.Equal("컴프레서 교체", resolved.Resolution)
+			suite.NotNil(resolved.ResolvedAt)
+			suite.Equal(tt.expectedStatus, room.Status)
+		})
+	}
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestResolveTicket_적용_중인_객실_상태_예약이_있으면_예약_상태로_둔다() {
+	// Given - 공사 예약이 적용 중인 객실에서 고장 티켓을 해결하면
+	ticket := suite.newOpenTicket()
+	room := suite.newRoom(models.RoomStatusDamaged)
+	appliedAt := today()
+	previousStatus := models.RoomStatusNormal
+	suite.mockTicketRepo.On("FindByID", suite.ctx, uint(1)).Return(ticket, nil)
+	suite.mockTicketRepo.On("Update", suite.ctx, ticket).Return(nil)
+	suite.mockTicketRepo.On("CountOpenByRoomID", suite.ctx, uint(10)).Return(int64(0), nil)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockRoomRepo.On("Update", suite.ctx, room).Return(nil)
+	suite.mockScheduleRepo.On("FindOverlapping", suite.ctx, uint(10), today(), today()).Return([]models.RoomStatusSchedule{{
+		RoomID:         10,
+		Status:         models.RoomStatusConstruction,
+		StartDate:      today().AddDate(0, 0, -1),
+		EndDate:        today().AddDate(0, 0, 3),
+		PreviousStatus: &previousStatus,
+		AppliedAt:      &appliedAt,
+	}}, nil)
+
+	// When
+	_, err := suite.service.ResolveTicket(suite.ctx, 1, "유리 교체")
+
+	// Then - 정상이 아니라 공사 상태로 돌아간다
+	suite.NoError(err)
+	suite.Equal(models.RoomStatusConstruction, room.Status)
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestResolveTicket_이미_해결된_티켓() {
+	ticket := suite.newOpenTicket()
+	ticket.Status = models.MaintenanceTicketStatusResolved
+	suite.mockTicketRepo.On("FindByID", suite.ctx, uint(1)).Return(ticket, nil)
+
+	_, err := suite.service.ResolveTicket(suite.ctx, 1, "재처리")
+
+	suite.ErrorIs(err, services.ErrMaintenanceTicketResolved)
+	suite.mockTicketRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestUpdateTicket_예상_복구일을_바꾸고_지운다() {
+	ticket := suite.newOpenTicket()
+	suite.mockTicketRepo.On("FindByID", suite.ctx, uint(1)).Return(ticket, nil)
+	suite.mockTicketRepo.On("Update", suite.ctx, ticket).Return(nil)
+
+	expectedReturnDate := "2026-06-01"
+	updated, err := suite.service.UpdateTicket(suite.ctx, 1, dto.UpdateMaintenanceTicketRequest{ExpectedReturnDate: &expectedReturnDate})
+	suite.NoError(err)
+	suite.Equal(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), *updated.ExpectedReturnDate)
+
+	cleared := ""
+	updated, err = suite.service.UpdateTicket(suite.ctx, 1, dto.UpdateMaintenanceTicketRequest{ExpectedReturnDate: &cleared})
+	suite.NoError(err)
+	suite.Nil(updated.ExpectedReturnDate)
+
+	invalid := "06/01"
+	_, err = suite.service.UpdateTicket(suite.ctx, 1, dto.UpdateMaintenanceTicketRequest{ExpectedReturnDate: &invalid})
+	suite.ErrorIs(err, services.ErrInvalidExpectedReturnDate)
+}
+
+func (suite *MaintenanceTicketServiceTestSuite) TestGetAffectedReservations_오늘부터_예상_복구일까지의_예약을_조회한다() {
+	// Given - 예상 복구일이 있는 열린 티켓이면
+	ticket := suite.newOpenTicket()
+	expectedReturnDate := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	ticket.ExpectedReturnDate = &expectedReturnDate
+	reservation := models.Reservation{Name: "홍길동", Status: models.ReservationStatusNormal}
+	reservation.ID = 50
+	suite.mockTicketRepo.On("FindAffectedReservations", suite.ctx, uint(10), today(), &expectedReturnDate).
+		Return([]models.Reservation{reservation}, nil)
+
+	// When
+	reservations, err := suite.service.GetAffectedReservations(suite.ctx, ticket)
+
+	// Then
+	suite.NoError(err)
+	suite.Len(reservations, 1)
+
+	// 해결된 티켓은 조회하지 않는다
+	ticket.Status = models.MaintenanceTicketStatusResolved
+	reservations, err = suite.service.GetAffectedReservations(suite.ctx, ticket)
+	suite.NoError(err)
+	suite.Empty(reservations)
+	suite.mockTicketRepo.AssertNumberOfCalls(suite.T(), "FindAffectedReservations", 1)
+}
+
+func TestMaintenanceTicketServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MaintenanceTicketServiceTestSuite))
+}
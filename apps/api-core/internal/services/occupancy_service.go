@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var ErrOccupancyRangeTooLong = errors.New("점유 현황은 최대 92일까지 조회할 수 있습니다")
+
+// maxOccupancyDays는 점유 현황을 한 번에 조회할 수 있는 최대 일수입니다.
+const maxOccupancyDays = 92
+
+const (
+	occupancyStateOccupied     = "OCCUPIED"
+	occupancyStateOutOfService = "OUT_OF_SERVICE"
+	occupancyStateBlocked      = "BLOCKED"
+	occupancyStateFree         = "FREE"
+)
+
+// OccupancyService는 프런트 데스크용 객실 × 날짜 점유 현황(테이프 차트)을 만듭니다.
+type OccupancyService interface {
+	GetGrid(ctx context.Context, req dto.OccupancyRequest) (*dto.OccupancyGridResponse, error)
+}
+
+type occupancyService struct {
+	roomRepo            repositories.RoomRepository
+	reservationRoomRepo repositories.ReservationRoomRepository
+	dateBlockRepo       repositories.DateBlockRepository
+	scheduleRepo        repositories.RoomStatusScheduleRepository
+}
+
+func NewOccupancyService(roomRepo repositories.RoomRepository, reservationRoomRepo repositories.ReservationRoomRepository,
+	dateBlockRepo repositories.DateBlockRepository, scheduleRepo repositories.RoomStatusScheduleRepository) OccupancyService {
+	return &occupancyService{roomRepo: roomRepo, reservationRoomRepo: reservationRoomRepo, dateBlockRepo: dateBlockRepo, scheduleRepo: scheduleRepo}
+}
+
+// GetGrid는 [StartDate, EndDate) 기간의 객실별, 날짜별 상태를 반환합니다.
+// 객실, 기간과 겹치는 예약 객실, 날짜 차단, 객실 상태 예약을 각각 한 번씩 조회한 뒤 메모리에서 칸을 채우며,
+// 객실 상태 예약을 반영한 그날의 객실 상태가 정상이 아니면 OUT_OF_SERVICE입니다. 평소 사용하지 않는 객실(INACTIVE)은 제외합니다.
+func (s *occupancyService) GetGrid(ctx context.Context, req dto.OccupancyRequest) (*dto.OccupancyGridResponse, error) {
+	startDate := truncateToDate(req.StartDate)
+	endDate := truncateToDate(req.EndDate)
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidDateRange
+	}
+
+	var dates []time.Time
+	for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+		dates = append(dates, date)
+	}
+	if len(dates) > maxOccupancyDays {
+		return nil, ErrOccupancyRangeTooLong
+	}
+
+	rooms, _, err := s.roomRepo.FindAll(ctx, dto.RoomRepositoryFilter{RoomGroupID: req.RoomGroupID}, 0, -1, "roomGroupId,asc,number,asc")
+	if err != nil {
+		return nil, err
+	}
+
+	reservationRooms, err := s.reservationRoomRepo.FindOccupying(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	segmentsByRoom := make(map[uint][]models.ReservationRoom)
+	for _, reservationRoom := range reservationRooms {
+		if reservationRoom.Reservation == nil {
+			continue
+		}
+		segmentsByRoom[reservationRoom.RoomID] = append(segmentsByRoom[reservationRoom.RoomID], reservationRoom)
+	}
+
+	dateBlocks, err := s.dateBlockRepo.FindOverlapping(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	occurrences := make([][]models.DateBlockOccurrence, len(dateBlocks))
+	for i := range dateBlocks {
+		occurrences[i] = dateBlocks[i].Occurrences(startDate, endDate)
+	}
+
+	schedulesByRoom, err := findRoomStatusSchedules(ctx, s.scheduleRepo, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	grid := &dto.OccupancyGridResponse{
+		StartDate: dto.JSONDate{Time: startDate},
+		EndDate:   dto.JSONDate{Time: endDate},
+		Dates:     make([]dto.JSONDate, len(dates)),
+		Rooms:     make([]dto.OccupancyRoomResponse, 0, len(rooms)),
+	}
+	for i, date := range dates {
+		grid.Dates[i] = dto.JSONDate{Time: date}
+	}
+
+	for _, room := range rooms {
+		schedules := schedulesByRoom[room.ID]
+		if room.BaseStatus(schedules) == models.RoomStatusInactive {
+			continue
+		}
+
+		roomResponse := dto.OccupancyRoomResponse{
+			RoomID:      room.ID,
+			RoomNumber:  room.Number,
+			RoomGroupID: room.RoomGroupID,
+			Status:      room.Status.String(),
+			Cells:       make([]dto.OccupancyCellResponse, len(dates)),
+		}
+		if room.RoomGroup != nil {
+			roomResponse.RoomGroupName = room.RoomGroup.Name
+		}
+
+		for i, date := range dates {
+			cell := dto.OccupancyCellResponse{Date: dto.JSONDate{Time: date}, State: occupancyStateFree}
+
+			if dateBlock := findBlockingDateBlock(dateBlocks, occurrences, &room, date); dateBlock != nil {
+				cell.State = occupancyStateBlocked
+				cell.DateBlock = &dto.OccupancyDateBlockResponse{ID: dateBlock.ID, Reason: dateBlock.Reason}
+			}
+			if room.StatusOn(schedules, date) != models.RoomStatusNormal {
+				cell.State = occupancyStateOutOfService
+			}
+			if reservation := findOccupyingReservation(segmentsByRoom[room.ID], date); reservation != nil {
+				cell.State = occupancyStateOccupied
+				cell.Reservation = &dto.OccupancyReservationResponse{
+					ID:           reservation.ID,
+					Name:         reservation.Name,
+					Status:       reservation.Status.String(),
+					CheckInState: checkInState(reservation),
+				}
+			}
+
+			roomResponse.Cells[i] = cell
+		}
+
+		grid.Rooms = append(grid.Rooms, roomResponse)
+	}
+
+	return grid, nil
+}
+
+// findOccupyingReservation은 객실 사용 구간 중 date 숙박일에 객실을 사용하는 첫 번째 예약을 반환합니다.
+// 구간의 마지막 날(퇴실일 또는 객실을 옮긴 날)은 사용하지 않는 것으로 봅니다.
+func findOccupyingReservation(segments []models.ReservationRoom, date time.Time) *models.Reservation {
+	for i := range segments {
+		if segments[i].Covers(segments[i].Reservation, date) {
+			return segments[i].Reservation
+		}
+	}
+	return nil
+}
+
+// findBlockingDateBlock은 date에 객실을 차단하는 첫 번째 날짜 차단을 반환합니다. occurrences는 dateBlocks와 같은 순서의 회차 목록입니다.
+func findBlockingDateBlock(dateBlocks []models.DateBlock, occurrences [][]models.DateBlockOccurrence, room *models.Room, date time.Time) *models.DateBlock {
+	for i := range dateBlocks {
+		if !dateBlocks[i].AppliesToRoom(room.ID, room.RoomGroupID) {
+			continue
+		}
+		for _, occurrence := range occurrences[i] {
+			if !occurrence.StartDate.After(date) && !occurrence.EndDate.Before(date) {
+				return &dateBlocks[i]
+			}
+		}
+	}
+	return nil
+}
+
+func checkInState(reservation *models.Reservation) string {
+	switch {
+	case reservation.CheckOutAt != nil:
+		return "CHECKED_OUT"
+	case reservation.CheckInAt != nil:
+		return "CHECKED_IN"
+	default:
+		return "NOT_CHECKED_IN"
+	}
+}
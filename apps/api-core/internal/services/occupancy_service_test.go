@@ -0,0 +1,216 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockReservationRoomRepository struct {
+	mock.Mock
+}
+
+func (m *MockReservationRoomRepository) Create(ctx context.Context, reservationRoom *models.ReservationRoom) (*models.ReservationRoom, error) {
+	args := m.Called(ctx, reservationRoom)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ReservationRoom), args.Error(1)
+}
+
+func (m *MockReservationRoomRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockReservationRoomRepository) FindByReservationID(ctx context.Context, reservationID uint) ([]models.ReservationRoom, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ReservationRoom), args.Error(1)
+}
+
+func (m *MockReservationRoomRepository) FindOccupying(ctx context.Context, startDate, endDate time.Time) ([]models.ReservationRoom, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ReservationRoom), args.Error(1)
+}
+
+type OccupancyServiceTestSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	mockRoomRepo            *MockRoomRepository
+	mockReservationRoomRepo *MockReservationRoomRepository
+	mockDateBlockRepo       *MockDateBlockRepository
+	mockScheduleRepo        *MockRoomStatusScheduleRepository
+	service                 services.OccupancyService
+	start                   time.Time
+	end                     time.Time
+}
+
+func (suite *OccupancyServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockReservationRoomRepo = new(MockReservationRoomRepository)
+	suite.mockDateBlockRepo = new(MockDateBlockRepository)
+	suite.mockScheduleRepo = new(MockRoomStatusScheduleRepository)
+	suite.mockScheduleRepo.On("FindEffective", mock.Anything, mock.Anything, mock.Anything).Return([]models.RoomStatusSchedule{}, nil).Maybe()
+	suite.service = services.NewOccupancyService(suite.mockRoomRepo, suite.mockReservationRoomRepo, suite.mockDateBlockRepo, suite.mockScheduleRepo)
+	// 5/1 ~ 5/4, 3일
+	suite.start = time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	suite.end = time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *OccupancyServiceTestSuite) newRoom(id, groupID uint, number string, status models.RoomStatus) models.Room {
+	group := &models.RoomGroup{Name: "디럭스"}
+	group.ID = groupID
+	room := models.Room{Number: number, RoomGroupID: groupID, RoomGroup: group, Status: status}
+	room.ID = id
+	return room
+}
+
+func (suite *OccupancyServiceTestSuite) givenRooms(rooms ...models.Room) {
+	suite.mockRoomRepo.On("FindAll", suite.ctx, dto.RoomRepositoryFilter{}, 0, -1, "roomGroupId,asc,number,asc").
+		Return(rooms, int64(len(rooms)), nil)
+}
+
+func cellStates(room dto.OccupancyRoomResponse) []string {
+	states := make([]string, len(room.Cells))
+	for i, cell := range room.Cells {
+		states[i] = cell.State
+	}
+	return states
+}
+
+func (suite *OccupancyServiceTestSuite) TestGetGrid_예약_차단_사용불가_객실을_날짜별로_표시한다() {
+	// Given - 예약이 있는 객실, 날짜 차단 대상 객실, 공사 중인 객실, 사용하지 않는 객실이 있으면
+	suite.givenRooms(
+		suite.newRoom(1, 1, "101", models.RoomStatusNormal),
+		suite.newRoom(2, 1, "102", models.RoomStatusNormal),
+		suite.newRoom(3, 1, "103", models.RoomStatusConstruction),
+		suite.newRoom(4, 1, "104", models.RoomStatusInactive),
+	)
+
+	checkInAt := time.Date(2026, 4, 30, 15, 0, 0, 0, time.UTC)
+	reservation := &models.Reservation{
+		Name:        "홍길동",
+		StayStartAt: time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		CheckInAt:   &checkInAt,
+		Status:      models.ReservationStatusNormal,
+	}
+	reservation.ID = 50
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).
+		Return([]models.ReservationRoom{{ReservationID: 50, RoomID: 1, Reservation: reservation}}, nil)
+
+	room102 := models.Room{}
+	room102.ID = 2
+	dateBlock := models.DateBlock{
+		StartDate: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC),
+		Reason:    "도배",
+		Rooms:     []models.Room{room102},
+	}
+	dateBlock.ID = 7
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{dateBlock}, nil)
+
+	// When - 점유 현황을 조회하면
+	grid, err := suite.service.GetGrid(suite.ctx, dto.OccupancyRequest{StartDate: suite.start, EndDate: suite.end})
+
+	// Then - 사용하지 않는 객실은 빠지고, 객실별로 날짜마다 상태가 채워진다
+	suite.NoError(err)
+	suite.Len(grid.Dates, 3)
+	suite.Len(grid.Rooms, 3)
+
+	suite.Equal([]string{"OCCUPIED", "FREE", "FREE"}, cellStates(grid.Rooms[0]), "퇴실일(5/2)은 비어 있음")
+	suite.Equal(&dto.OccupancyReservationResponse{ID: 50, Name: "홍길동", Status: "NORMAL", CheckInState: "CHECKED_IN"}, grid.Rooms[0].Cells[0].Reservation)
+
+	suite.Equal([]string{"FREE", "BLOCKED", "BLOCKED"}, cellStates(grid.Rooms[1]), "날짜 차단은 종료일 포함")
+	suite.Equal(&dto.OccupancyDateBlockResponse{ID: 7, Reason: "도배"}, grid.Rooms[1].Cells[1].DateBlock)
+
+	suite.Equal([]string{"OUT_OF_SERVICE", "OUT_OF_SERVICE", "OUT_OF_SERVICE"}, cellStates(grid.Rooms[2]))
+	suite.Equal("디럭스", grid.Rooms[2].RoomGroupName)
+}
+
+func (suite *OccupancyServiceTestSuite) TestGetGrid_반복_날짜_차단은_회차만_차단한다() {
+	// Given - 매주 토요일 모든 객실을 차단하는 반복 날짜 차단이 있으면
+	suite.givenRooms(suite.newRoom(1, 1, "101", models.RoomStatusNormal))
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{}, nil)
+	dateBlock := models.DateBlock{
+		StartDate:  time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2026, 4, 25, 0, 0, 0, 0, time.UTC),
+		Reason:     "정기 점검",
+		Recurrence: models.DateBlockRecurrenceWeekly,
+	}
+	dateBlock.ID = 8
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{dateBlock}, nil)
+
+	// When - 점유 현황을 조회하면
+	grid, err := suite.service.GetGrid(suite.ctx, dto.OccupancyRequest{StartDate: suite.start, EndDate: suite.end})
+
+	// Then - 5/2(토)만 차단된다
+	suite.NoError(err)
+	suite.Equal([]string{"FREE", "BLOCKED", "FREE"}, cellStates(grid.Rooms[0]))
+}
+
+func (suite *OccupancyServiceTestSuite) TestGetGrid_객실_상태_예약을_날짜별로_반영한다() {
+	// Given - 101호는 5/3부터 공사가 예약돼 있고, 102호는 지금 파손 상태지만 5/1까지만 파손이면
+	suite.givenRooms(
+		suite.newRoom(1, 1, "101", models.RoomStatusNormal),
+		suite.newRoom(2, 1, "102", models.RoomStatusDamaged),
+	)
+	appliedAt := time.Date(2026, 4, 28, 0, 0, 0, 0, time.UTC)
+	previousStatus := models.RoomStatusNormal
+	suite.mockScheduleRepo.ExpectedCalls = nil
+	suite.mockScheduleRepo.On("FindEffective", suite.ctx, suite.start, time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC)).Return([]models.RoomStatusSchedule{
+		{
+			RoomID:    1,
+			Status:    models.RoomStatusConstruction,
+			StartDate: time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			RoomID:         2,
+			Status:         models.RoomStatusDamaged,
+			StartDate:      time.Date(2026, 4, 28, 0, 0, 0, 0, time.UTC),
+			EndDate:        time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			PreviousStatus: &previousStatus,
+			AppliedAt:      &appliedAt,
+		},
+	}, nil)
+	suite.mockReservationRoomRepo.On("FindOccupying", suite.ctx, suite.start, suite.end).Return([]models.ReservationRoom{}, nil)
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, suite.start, suite.end).Return([]models.DateBlock{}, nil)
+
+	// When - 점유 현황을 조회하면
+	grid, err := suite.service.GetGrid(suite.ctx, dto.OccupancyRequest{StartDate: suite.start, EndDate: suite.end})
+
+	// Then - 오늘 상태가 아니라 날짜별 객실 상태로 칸을 채운다
+	suite.NoError(err)
+	suite.Equal([]string{"FREE", "FREE", "OUT_OF_SERVICE"}, cellStates(grid.Rooms[0]))
+	suite.Equal([]string{"OUT_OF_SERVICE", "FREE", "FREE"}, cellStates(grid.Rooms[1]))
+}
+
+func (suite *OccupancyServiceTestSuite) TestGetGrid_기간이_잘못되면_에러() {
+	// 종료일이 시작일 이전
+	_, err := suite.service.GetGrid(suite.ctx, dto.OccupancyRequest{StartDate: suite.end, EndDate: suite.start})
+	suite.ErrorIs(err, services.ErrInvalidDateRange)
+
+	// 92일 초과
+	_, err = suite.service.GetGrid(suite.ctx, dto.OccupancyRequest{StartDate: suite.start, EndDate: suite.start.AddDate(0, 0, 93)})
+	suite.ErrorIs(err, services.ErrOccupancyRangeTooLong)
+
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "FindAll", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOccupancyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OccupancyServiceTestSuite))
+}
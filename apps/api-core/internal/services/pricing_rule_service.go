@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrPricingRuleNotFound       = errors.New("존재하지 않는 요금 규칙")
+	ErrInvalidPricingRuleRequest = errors.New("잘못된 요금 규칙 요청")
+)
+
+type PricingRuleService interface {
+	Create(ctx context.Context, req dto.CreatePricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Update(ctx context.Context, id uint, req dto.UpdatePricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Delete(ctx context.Context, id uint) error
+	GetPricingRule(ctx context.Context, id uint) (*dto.PricingRuleResponse, error)
+	GetAll(ctx context.Context) ([]dto.PricingRuleResponse, error)
+}
+
+type pricingRuleService struct {
+	pricingRuleRepo repositories.PricingRuleRepository
+	roomGroupRepo   repositories.RoomGroupRepository
+	auditService    audit.AuditService
+}
+
+func NewPricingRuleService(pricingRuleRepo repositories.PricingRuleRepository, roomGroupRepo repositories.RoomGroupRepository,
+	auditService audit.AuditService) PricingRuleService {
+	return &pricingRuleService{pricingRuleRepo: pricingRuleRepo, roomGroupRepo: roomGroupRepo, auditService: auditService}
+}
+
+func (s *pricingRuleService) Create(ctx context.Context, req dto.CreatePricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	ruleType, ok := models.ParsePricingRuleType(req.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidPricingRuleRequest, req.Type)
+	}
+
+	rule := &models.PricingRule{
+		Name:        req.Name,
+		Type:        ruleType,
+		RoomGroupID: req.RoomGroupID,
+		Amount:      req.Amount,
+		Percent:     req.Percent,
+		MinNights:   req.MinNights,
+		Enabled:     req.Enabled == nil || *req.Enabled,
+	}
+	if err := s.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	created, err := s.pricingRuleRepo.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToPricingRuleResponse(created)
+	return &result, nil
+}
+
+func (s *pricingRuleService) Update(ctx context.Context, id uint, req dto.UpdatePricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	rule, err := s.pricingRuleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrPricingRuleNotFound
+	}
+
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.ClearRoomGroup {
+		rule.RoomGroupID = nil
+	} else if req.RoomGroupID != nil {
+		rule.RoomGroupID = req.RoomGroupID
+	}
+	if req.Amount != nil {
+		rule.Amount = *req.Amount
+	}
+	if req.Percent != nil {
+		rule.Percent = *req.Percent
+	}
+	if req.MinNights != nil {
+		rule.MinNights = *req.MinNights
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+
+	if err := s.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.pricingRuleRepo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToPricingRuleResponse(rule)
+	return &result, nil
+}
+
+func (s *pricingRuleService) Delete(ctx context.Context, id uint) error {
+	rule, err := s.pricingRuleRepo.FindByID(ctx, id)
+	if err != nil {
+		return ErrPricingRuleNotFound
+	}
+
+	if err := s.pricingRuleRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// Log deletion in audit — manual call required because soft delete bypasses GORM delete hooks
+	_ = s.auditService.LogDelete(ctx, rule)
+
+	return nil
+}
+
+func (s *pricingRuleService) GetPricingRule(ctx context.Context, id uint) (*dto.PricingRuleResponse, error) {
+	rule, err := s.pricingRuleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrPricingRuleNotFound
+	}
+
+	result := mappers.ToPricingRuleResponse(rule)
+	return &result, nil
+}
+
+func (s *pricingRuleService) GetAll(ctx context.Context) ([]dto.PricingRuleResponse, error) {
+	rules, err := s.pricingRuleRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToPricingRuleListResponse(rules), nil
+}
+
+// validate는 규칙 유형별 필수 값과 객실 그룹 존재 여부를 확인합니다.
+func (s *pricingRuleService) validate(ctx context.Context, rule *models.PricingRule) error {
+	switch rule.Type {
+	case models.PricingRuleTypeWeekendSurcharge, models.PricingRuleTypeLongStayDiscount:
+		if rule.Amount == 0 && rule.Percent == 0 {
+			return fmt.Errorf("%w: amount or percent is required", ErrInvalidPricingRuleRequest)
+		}
+	case models.PricingRuleTypeExtraPersonFee, models.PricingRuleTypeMonthlyRent:
+		if rule.Amount == 0 {
+			return fmt.Errorf("%w: amount is required", ErrInvalidPricingRuleRequest)
+		}
+	}
+
+	if rule.RoomGroupID != nil {
+		if _, err := s.roomGroupRepo.FindByID(ctx, *rule.RoomGroupID); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPricingRuleRequest, ErrRoomGroupNotFound.Error())
+		}
+	}
+	return nil
+}
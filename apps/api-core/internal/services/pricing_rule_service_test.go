@@ -0,0 +1,169 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/gorm"
+)
+
+type MockPricingRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockPricingRuleRepository) Create(ctx context.Context, rule *models.PricingRule) (*models.PricingRule, error) {
+	args := m.Called(ctx, rule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PricingRule), args.Error(1)
+}
+
+func (m *MockPricingRuleRepository) Update(ctx context.Context, rule *models.PricingRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockPricingRuleRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPricingRuleRepository) FindByID(ctx context.Context, id uint) (*models.PricingRule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PricingRule), args.Error(1)
+}
+
+func (m *MockPricingRuleRepository) FindAll(ctx context.Context) ([]models.PricingRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PricingRule), args.Error(1)
+}
+
+func (m *MockPricingRuleRepository) FindEnabled(ctx context.Context) ([]models.PricingRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PricingRule), args.Error(1)
+}
+
+type PricingRuleServiceTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	mockRepo          *MockPricingRuleRepository
+	mockRoomGroupRepo *MockRoomGroupRepository
+	mockAuditService  *MockAuditService
+	service           services.PricingRuleService
+}
+
+func (s *PricingRuleServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockRepo = new(MockPricingRuleRepository)
+	s.mockRoomGroupRepo = new(MockRoomGroupRepository)
+	s.mockAuditService = new(MockAuditService)
+	s.service = services.NewPricingRuleService(s.mockRepo, s.mockRoomGroupRepo, s.mockAuditService)
+}
+
+func (s *PricingRuleServiceTestSuite) TestCreate_사용_여부를_생략하면_사용으로_생성한다() {
+	// Given - 사용 여부 없이 주말 할증 규칙을 요청하면
+	req := dto.CreatePricingRuleRequest{Name: "주말 할증", Type: "WEEKEND_SURCHARGE", Amount: 20000}
+	created := &models.PricingRule{Name: req.Name, Type: models.PricingRuleTypeWeekendSurcharge, Amount: 20000, Enabled: true}
+	created.ID = 1
+	s.mockRepo.On("Create", s.ctx, mock.MatchedBy(func(rule *models.PricingRule) bool {
+		return rule.Type == models.PricingRuleTypeWeekendSurcharge && rule.Enabled
+	})).Return(created, nil)
+
+	// When
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then
+	s.NoError(err)
+	s.Equal("WEEKEND_SURCHARGE", result.Type)
+	s.True(result.Enabled)
+}
+
+func (s *PricingRuleServiceTestSuite) TestCreate_유형별_필수_값_검증() {
+	testCases := []struct {
+		name string
+		req  dto.CreatePricingRuleRequest
+	}{
+		{"금액과 비율이 모두 없는 장기 숙박 할인", dto.CreatePricingRuleRequest{Name: "장기", Type: "LONG_STAY_DISCOUNT", MinNights: 7}},
+		{"금액이 없는 추가 인원 요금", dto.CreatePricingRuleRequest{Name: "추가 인원", Type: "EXTRA_PERSON_FEE"}},
+		{"금액이 없는 달방 정액", dto.CreatePricingRuleRequest{Name: "달방", Type: "MONTHLY_RENT"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			_, err := s.service.Create(s.ctx, tc.req)
+
+			s.ErrorIs(err, services.ErrInvalidPricingRuleRequest)
+			s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (s *PricingRuleServiceTestSuite) TestCreate_존재하지_않는_객실_그룹이면_에러() {
+	roomGroupID := uint(99)
+	req := dto.CreatePricingRuleRequest{Name: "주말 할증", Type: "WEEKEND_SURCHARGE", Percent: 10, RoomGroupID: &roomGroupID}
+	s.mockRoomGroupRepo.On("FindByID", s.ctx, uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := s.service.Create(s.ctx, req)
+
+	s.ErrorIs(err, services.ErrInvalidPricingRuleRequest)
+}
+
+func (s *PricingRuleServiceTestSuite) TestUpdate_객실_그룹_지정을_해제한다() {
+	// Given - 객실 그룹 1번에만 적용되던 규칙을
+	roomGroupID := uint(1)
+	rule := &models.PricingRule{Name: "주말 할증", Type: models.PricingRuleTypeWeekendSurcharge, Amount: 20000, RoomGroupID: &roomGroupID}
+	rule.ID = 3
+	s.mockRepo.On("FindByID", s.ctx, uint(3)).Return(rule, nil)
+	s.mockRepo.On("Update", s.ctx, rule).Return(nil)
+
+	// When - 객실 그룹 해제로 수정하면
+	result, err := s.service.Update(s.ctx, 3, dto.UpdatePricingRuleRequest{ClearRoomGroup: true})
+
+	// Then - 전체 객실 그룹에 적용된다
+	s.NoError(err)
+	s.Nil(result.RoomGroupID)
+	s.mockRoomGroupRepo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything)
+}
+
+func (s *PricingRuleServiceTestSuite) TestDelete_삭제_후_감사_로그를_남긴다() {
+	rule := &models.PricingRule{Name: "주말 할증"}
+	rule.ID = 3
+	s.mockRepo.On("FindByID", s.ctx, uint(3)).Return(rule, nil)
+	s.mockRepo.On("Delete", s.ctx, uint(3)).Return(nil)
+	s.mockAuditService.On("LogDelete", s.ctx, rule).Return(nil)
+
+	err := s.service.Delete(s.ctx, 3)
+
+	s.NoError(err)
+	s.mockAuditService.AssertExpectations(s.T())
+}
+
+func (s *PricingRuleServiceTestSuite) TestDelete_존재하지_않는_규칙() {
+	s.mockRepo.On("FindByID", s.ctx, uint(3)).Return(nil, errors.New("record not found"))
+
+	err := s.service.Delete(s.ctx, 3)
+
+	s.ErrorIs(err, services.ErrPricingRuleNotFound)
+}
+
+func TestPricingRuleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PricingRuleServiceTestSuite))
+}
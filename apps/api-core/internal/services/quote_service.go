@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var ErrInvalidQuoteRequest = errors.New("잘못된 견적 요청")
+
+// monthlyRentNights는 달방 정액 요금 1개월에 해당하는 박 수입니다.
+const monthlyRentNights = 30
+
+// QuoteService는 객실 그룹의 성수기/비성수기 요금, 시즌 달력과 요금 규칙으로 숙박 요금을 계산합니다.
+type QuoteService interface {
+	Quote(ctx context.Context, req dto.QuoteRequest) (*dto.QuoteResponse, error)
+	// Price는 견적 합계와 적용된 규칙을 예약에 저장할 수 있는 형태로 반환합니다.
+	Price(ctx context.Context, req dto.QuoteRequest) (int, models.AppliedPricingRules, error)
+}
+
+type quoteService struct {
+	roomRepo        repositories.RoomRepository
+	seasonRepo      repositories.SeasonRepository
+	pricingRuleRepo repositories.PricingRuleRepository
+}
+
+func NewQuoteService(roomRepo repositories.RoomRepository, seasonRepo repositories.SeasonRepository,
+	pricingRuleRepo repositories.PricingRuleRepository) QuoteService {
+	return &quoteService{roomRepo: roomRepo, seasonRepo: seasonRepo, pricingRuleRepo: pricingRuleRepo}
+}
+
+func (s *quoteService) Quote(ctx context.Context, req dto.QuoteRequest) (*dto.QuoteResponse, error) {
+	quote, _, err := s.calculate(ctx, req)
+	return quote, err
+}
+
+func (s *quoteService) Price(ctx context.Context, req dto.QuoteRequest) (int, models.AppliedPricingRules, error) {
+	quote, appliedRules, err := s.calculate(ctx, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return quote.TotalPrice, appliedRules, nil
+}
+
+// calculate는 [StayStartAt, StayEndAt) 기간의 객실별, 박별 요금 내역과 합계를 계산합니다.
+//  1. 숙박일이 객실 그룹에 적용되는 시즌에 포함되면 PeekPrice, 아니면 OffPeekPrice
+//  2. 달방(MONTHLY_RENT) 예약이고 MONTHLY_RENT 규칙이 있으면 객실 요금을 정액으로 대체
+//  3. 그 외에는 객실별 주말 할증, 장기 숙박 할인을 차례로 적용
+//  4. 예약 전체 인원이 객실 기준 인원(Room.Occupancy) 합계를 넘으면 초과 인원을 배정한 객실별로 추가 인원 요금 적용
+//
+// 같은 유형의 규칙이 여러 개면 객실 그룹을 지정한 규칙이 전체 그룹 규칙보다 우선합니다.
+func (s *quoteService) calculate(ctx context.Context, req dto.QuoteRequest) (*dto.QuoteResponse, models.AppliedPricingRules, error) {
+	if len(req.RoomIDs) == 0 || req.PeopleCount < 0 {
+		return nil, nil, ErrInvalidQuoteRequest
+	}
+
+	startDate := truncateToDate(req.StayStartAt)
+	endDate := truncateToDate(req.StayEndAt)
+	if !startDate.Before(endDate) {
+		return nil, nil, ErrInvalidDateRange
+	}
+
+	seasons, err := s.seasonRepo.FindApplicable(ctx, startDate, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := s.pricingRuleRepo.FindEnabled(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quote := &dto.QuoteResponse{
+		StayStartAt: dto.JSONDate{Time: startDate},
+		StayEndAt:   dto.JSONDate{Time: endDate},
+		PeopleCount: req.PeopleCount,
+		Type:        req.Type.String(),
+		Rooms:       make([]dto.RoomQuoteResponse, 0, len(req.RoomIDs)),
+	}
+	var appliedRules models.AppliedPricingRules
+	var rooms []*models.Room
+
+	for _, roomID := range req.RoomIDs {
+		room, err := s.roomRepo.FindByIDWithGroup(ctx, roomID)
+		if err != nil || room.RoomGroup == nil {
+			return nil, nil, ErrRoomNotFound
+		}
+		rooms = append(rooms, room)
+
+		roomQuote := dto.RoomQuoteResponse{
+			RoomID:        room.ID,
+			RoomNumber:    room.Number,
+			RoomGroupID:   room.RoomGroupID,
+			RoomGroupName: room.RoomGroup.Name,
+		}
+
+		nightlyTotal := 0
+		for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+			night := dto.NightlyPriceResponse{
+				Date:  dto.JSONDate{Time: date},
+				Price: room.RoomGroup.OffPeekPrice,
+			}
+			if season := findSeason(seasons, room.RoomGroupID, date); season != nil {
+				seasonID := season.ID
+				night.Peak = true
+				night.SeasonID = &seasonID
+				night.SeasonName = season.Name
+				night.Price = room.RoomGroup.PeekPrice
+			}
+
+			roomQuote.Nights = append(roomQuote.Nights, night)
+			nightlyTotal += night.Price
+		}
+
+		roomRules := s.applyRoomRules(rules, req.Type, room, roomQuote.Nights, nightlyTotal)
+		roomQuote.Subtotal = nightlyTotal
+		for _, applied := range roomRules {
+			roomQuote.Subtotal += applied.Amount
+		}
+		appliedRules = append(appliedRules, roomRules...)
+
+		quote.Rooms = append(quote.Rooms, roomQuote)
+		quote.TotalPrice += roomQuote.Subtotal
+	}
+	quote.Nights = len(quote.Rooms[0].Nights)
+
+	if req.Type != models.ReservationTypeMonthlyRent {
+		for _, applied := range applyExtraPersonFees(rules, rooms, req.PeopleCount, quote.Nights) {
+			appliedRules = append(appliedRules, applied)
+			quote.TotalPrice += applied.Amount
+		}
+	}
+
+	quote.AppliedRules = mappers.ToAppliedPricingRuleResponses(appliedRules)
+	if quote.AppliedRules == nil {
+		quote.AppliedRules = []dto.AppliedPricingRuleResponse{}
+	}
+
+	return quote, appliedRules, nil
+}
+
+// applyRoomRules는 객실 하나에 적용되는 규칙을 계산합니다. nights의 Surcharge에는 주말 할증을 기록합니다.
+func (s *quoteService) applyRoomRules(rules []models.PricingRule, reservationType models.ReservationType,
+	room *models.Room, nights []dto.NightlyPriceResponse, nightlyTotal int) models.AppliedPricingRules {
+	var applied models.AppliedPricingRules
+	nightCount := len(nights)
+
+	if reservationType == models.ReservationTypeMonthlyRent {
+		if rule := selectPricingRule(rules, models.PricingRuleTypeMonthlyRent, room.RoomGroupID, nil); rule != nil {
+			flatPrice := rule.Amount*(nightCount/monthlyRentNights) + rule.Amount*(nightCount%monthlyRentNights)/monthlyRentNights
+			applied = append(applied, newAppliedPricingRule(rule, room.ID, nightCount, flatPrice-nightlyTotal))
+			return applied
+		}
+	}
+
+	subtotal := nightlyTotal
+	if rule := selectPricingRule(rules, models.PricingRuleTypeWeekendSurcharge, room.RoomGroupID, nil); rule != nil {
+		surchargeTotal, weekendNights := 0, 0
+		for i := range nights {
+			if !isWeekendNight(nights[i].Date.Time) {
+				continue
+			}
+			nights[i].Surcharge = rule.Amount + nights[i].Price*rule.Percent/100
+			surchargeTotal += nights[i].Surcharge
+			weekendNights++
+		}
+		if weekendNights > 0 {
+			applied = append(applied, newAppliedPricingRule(rule, room.ID, weekendNights, surchargeTotal))
+			subtotal += surchargeTotal
+		}
+	}
+
+	longStay := func(rule *models.PricingRule) bool { return nightCount >= rule.MinNights }
+	if rule := selectPricingRule(rules, models.PricingRuleTypeLongStayDiscount, room.RoomGroupID, longStay); rule != nil {
+		discount := subtotal*rule.Percent/100 + rule.Amount
+		if discount > subtotal {
+			discount = subtotal
+		}
+		if discount > 0 {
+			applied = append(applied, newAppliedPricingRule(rule, room.ID, nightCount, -discount))
+		}
+	}
+
+	return applied
+}
+
+// applyExtraPersonFees는 예약 인원이 객실 기준 인원(Room.Occupancy) 합계를 넘을 때 객실별 추가 인원 요금을 계산합니다.
+// 기준 인원을 정하지 않은 객실이 하나라도 있으면 초과 인원을 알 수 없으므로 적용하지 않습니다.
+// 초과 인원은 요청한 객실 순서대로 객실 최대 인원까지 채우고 남는 인원은 마지막 객실에 배정하며, 객실마다 그 객실 그룹의
+// EXTRA_PERSON_FEE 규칙 요금으로 계산합니다. 규칙이 없는 객실에 배정된 인원은 추가 요금이 없습니다.
+func applyExtraPersonFees(rules []models.PricingRule, rooms []*models.Room, peopleCount, nights int) models.AppliedPricingRules {
+	capacity := 0
+	for _, room := range rooms {
+		standard, _ := room.Occupancy()
+		if standard == 0 {
+			return nil
+		}
+		capacity += standard
+	}
+
+	extraPeople := peopleCount - capacity
+	if extraPeople <= 0 {
+		return nil
+	}
+
+	var applied models.AppliedPricingRules
+	for i, room := range rooms {
+		standard, maxOccupancy := room.Occupancy()
+		roomExtraPeople := extraPeople
+		if i < len(rooms)-1 && maxOccupancy > 0 && roomExtraPeople > maxOccupancy-standard {
+			roomExtraPeople = maxOccupancy - standard
+		}
+		if roomExtraPeople <= 0 {
+			continue
+		}
+		extraPeople -= roomExtraPeople
+
+		if rule := selectPricingRule(rules, models.PricingRuleTypeExtraPersonFee, room.RoomGroupID, nil); rule != nil {
+			applied = append(applied, newAppliedPricingRule(rule, room.ID, nights, roomExtraPeople*rule.Amount*nights))
+		}
+	}
+	return applied
+}
+
+// selectPricingRule은 객실 그룹에 적용할 유형별 규칙을 고릅니다. 객실 그룹을 지정한 규칙을 우선하고,
+// 장기 숙박 할인처럼 조건이 있는 규칙은 조건을 만족하는 것 중 최소 박 수가 가장 큰 규칙을 고릅니다.
+func selectPricingRule(rules []models.PricingRule, ruleType models.PricingRuleType, roomGroupID uint,
+	matches func(rule *models.PricingRule) bool) *models.PricingRule {
+	var selected *models.PricingRule
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Type != ruleType || !rule.AppliesToRoomGroup(roomGroupID) {
+			continue
+		}
+		if matches != nil && !matches(rule) {
+			continue
+		}
+		if selected == nil || isPreferredPricingRule(rule, selected) {
+			selected = rule
+		}
+	}
+	return selected
+}
+
+func isPreferredPricingRule(candidate, current *models.PricingRule) bool {
+	if (candidate.RoomGroupID != nil) != (current.RoomGroupID != nil) {
+		return candidate.RoomGroupID != nil
+	}
+	return candidate.MinNights > current.MinNights
+}
+
+func newAppliedPricingRule(rule *models.PricingRule, roomID uint, nights, amount int) models.AppliedPricingRule {
+	return models.AppliedPricingRule{
+		RuleID: rule.ID,
+		Type:   rule.Type.String(),
+		Name:   rule.Name,
+		RoomID: roomID,
+		Nights: nights,
+		Amount: amount,
+	}
+}
+
+// isWeekendNight는 금요일, 토요일 밤 숙박인지 확인합니다.
+func isWeekendNight(date time.Time) bool {
+	return date.Weekday() == time.Friday || date.Weekday() == time.Saturday
+}
+
+// findSeason은 객실 그룹과 숙박일에 적용되는 첫 번째 시즌을 반환합니다.
+func findSeason(seasons []models.Season, roomGroupID uint, date time.Time) *models.Season {
+	for i := range seasons {
+		if seasons[i].AppliesToRoomGroup(roomGroupID) && seasons[i].Covers(date) {
+			return &seasons[i]
+		}
+	}
+	return nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,319 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/gorm"
+)
+
+type QuoteServiceTestSuite struct {
+	suite.Suite
+	ctx            context.Context
+	mockRoomRepo   *MockRoomRepository
+	mockSeasonRepo *MockSeasonRepository
+	mockRuleRepo   *MockPricingRuleRepository
+	service        services.QuoteService
+	start          time.Time
+	end            time.Time
+}
+
+func (suite *QuoteServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockSeasonRepo = new(MockSeasonRepository)
+	suite.mockRuleRepo = new(MockPricingRuleRepository)
+	suite.service = services.NewQuoteService(suite.mockRoomRepo, suite.mockSeasonRepo, suite.mockRuleRepo)
+	// 7/30(목) ~ 8/2, 3박 - 7/31(금), 8/1(토)은 주말 숙박
+	suite.start = time.Date(2026, 7, 30, 0, 0, 0, 0, time.UTC)
+	suite.end = time.Date(2026, 8, 2, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *QuoteServiceTestSuite) newRoom(id, groupID uint, peekPrice, offPeekPrice int) *models.Room {
+	group := &models.RoomGroup{Name: "그룹", PeekPrice: peekPrice, OffPeekPrice: offPeekPrice}
+	group.ID = groupID
+	room := &models.Room{Number: "101", RoomGroupID: groupID, RoomGroup: group}
+	room.ID = id
+	return room
+}
+
+func (suite *QuoteServiceTestSuite) request(roomIDs ...uint) dto.QuoteRequest {
+	return dto.QuoteRequest{RoomIDs: roomIDs, StayStartAt: suite.start, StayEndAt: suite.end, Type: models.ReservationTypeStay}
+}
+
+func (suite *QuoteServiceTestSuite) givenRules(rules ...models.PricingRule) {
+	suite.mockRuleRepo.On("FindEnabled", suite.ctx).Return(rules, nil)
+}
+
+func newPricingRule(id uint, ruleType models.PricingRuleType, roomGroupID *uint) models.PricingRule {
+	rule := models.PricingRule{Name: ruleType.String(), Type: ruleType, RoomGroupID: roomGroupID, Enabled: true}
+	rule.ID = id
+	return rule
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_시즌에_포함된_날만_성수기_요금() {
+	// Given - 8/1부터 시작하는 성수기 시즌이면
+	season := models.Season{
+		Name:      "여름 성수기",
+		StartDate: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 8, 20, 0, 0, 0, 0, time.UTC),
+	}
+	season.ID = 3
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{season}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoom(1, 10, 150000, 100000), nil)
+	suite.givenRules()
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, suite.request(1))
+
+	// Then - 7/30, 7/31은 비성수기, 8/1은 성수기 요금이다
+	suite.NoError(err)
+	suite.Equal(3, quote.Nights)
+	suite.Require().Len(quote.Rooms, 1)
+	nights := quote.Rooms[0].Nights
+	suite.Require().Len(nights, 3)
+	suite.False(nights[0].Peak)
+	suite.Equal(100000, nights[1].Price)
+	suite.True(nights[2].Peak)
+	suite.Equal(uint(3), *nights[2].SeasonID)
+	suite.Equal(150000, nights[2].Price)
+	suite.Equal(350000, quote.Rooms[0].Subtotal)
+	suite.Equal(350000, quote.TotalPrice)
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_다른_객실_그룹_시즌은_적용되지_않는다() {
+	// Given - 20번 그룹에만 적용되는 매년 반복 시즌이면
+	otherGroup := models.RoomGroup{}
+	otherGroup.ID = 20
+	season := models.Season{
+		Name:            "여름 성수기",
+		StartDate:       time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC),
+		RecurringYearly: true,
+		RoomGroups:      []models.RoomGroup{otherGroup},
+	}
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{season}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoom(1, 10, 150000, 100000), nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(2)).Return(suite.newRoom(2, 20, 200000, 120000), nil)
+	suite.givenRules()
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, suite.request(1, 2))
+
+	// Then - 10번 그룹 객실은 비성수기, 20번 그룹 객실은 성수기 요금이다
+	suite.NoError(err)
+	suite.Equal(300000, quote.Rooms[0].Subtotal)
+	suite.Equal(600000, quote.Rooms[1].Subtotal)
+	suite.Equal(900000, quote.TotalPrice)
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_잘못된_요청() {
+	_, err := suite.service.Quote(suite.ctx, suite.request())
+	suite.ErrorIs(err, services.ErrInvalidQuoteRequest)
+
+	req := suite.request(1)
+	req.PeopleCount = -1
+	_, err = suite.service.Quote(suite.ctx, req)
+	suite.ErrorIs(err, services.ErrInvalidQuoteRequest)
+
+	req = suite.request(1)
+	req.StayStartAt, req.StayEndAt = suite.end, suite.start
+	_, err = suite.service.Quote(suite.ctx, req)
+	suite.ErrorIs(err, services.ErrInvalidDateRange)
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_존재하지_않는_객실() {
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(nil, gorm.ErrRecordNotFound)
+	suite.givenRules()
+
+	_, err := suite.service.Quote(suite.ctx, suite.request(1, 2))
+
+	suite.ErrorIs(err, services.ErrRoomNotFound)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "FindByIDWithGroup", mock.Anything, uint(2))
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_주말_숙박에_할증을_적용한다() {
+	// Given - 1박당 10,000원 + 10% 주말 할증 규칙이면
+	rule := newPricingRule(1, models.PricingRuleTypeWeekendSurcharge, nil)
+	rule.Amount = 10000
+	rule.Percent = 10
+	suite.givenRules(rule)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoom(1, 10, 150000, 100000), nil)
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, suite.request(1))
+
+	// Then - 금요일, 토요일 밤에만 20,000원씩 할증된다
+	suite.NoError(err)
+	nights := quote.Rooms[0].Nights
+	suite.Equal(0, nights[0].Surcharge)
+	suite.Equal(20000, nights[1].Surcharge)
+	suite.Equal(20000, nights[2].Surcharge)
+	suite.Equal(340000, quote.Rooms[0].Subtotal)
+	suite.Equal(340000, quote.TotalPrice)
+	suite.Require().Len(quote.AppliedRules, 1)
+	suite.Equal(dto.AppliedPricingRuleResponse{RuleID: 1, Type: "WEEKEND_SURCHARGE", Name: "WEEKEND_SURCHARGE", RoomID: 1, Nights: 2, Amount: 40000}, quote.AppliedRules[0])
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_장기_숙박은_조건을_만족하는_가장_긴_규칙으로_할인한다() {
+	// Given - 3박 이상 5%, 7박 이상 10% 할인 규칙과 8/3(월) ~ 8/10 7박 요청이면
+	threeNights := newPricingRule(1, models.PricingRuleTypeLongStayDiscount, nil)
+	threeNights.MinNights = 3
+	threeNights.Percent = 5
+	sevenNights := newPricingRule(2, models.PricingRuleTypeLongStayDiscount, nil)
+	sevenNights.MinNights = 7
+	sevenNights.Percent = 10
+	suite.givenRules(threeNights, sevenNights)
+
+	req := suite.request(1)
+	req.StayStartAt = time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC)
+	req.StayEndAt = time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, req.StayStartAt, req.StayEndAt).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoom(1, 10, 150000, 100000), nil)
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, req)
+
+	// Then - 700,000원에서 10%가 할인된다
+	suite.NoError(err)
+	suite.Equal(630000, quote.TotalPrice)
+	suite.Require().Len(quote.AppliedRules, 1)
+	suite.Equal(uint(2), quote.AppliedRules[0].RuleID)
+	suite.Equal(-70000, quote.AppliedRules[0].Amount)
+}
+
+func (suite *QuoteServiceTestSuite) newRoomWithOccupancy(id, groupID uint, standard, max int) *models.Room {
+	room := suite.newRoom(id, groupID, 150000, 100000)
+	room.RoomGroup.StandardOccupancy = standard
+	room.RoomGroup.MaxOccupancy = max
+	return room
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_기준_인원을_넘으면_추가_인원_요금을_적용한다() {
+	// Given - 기준 2명, 최대 4명인 객실 2개와 1인 1박 20,000원 규칙에 5명 요청이면
+	rule := newPricingRule(1, models.PricingRuleTypeExtraPersonFee, nil)
+	rule.Amount = 20000
+	suite.givenRules(rule)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoomWithOccupancy(1, 10, 2, 4), nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(2)).Return(suite.newRoomWithOccupancy(2, 10, 2, 4), nil)
+	req := suite.request(1, 2)
+	req.PeopleCount = 5
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, req)
+
+	// Then - 객실 기준 인원 합계 4명을 넘는 1명 x 3박 요금이 첫 번째 객실에 적용된다
+	suite.NoError(err)
+	suite.Equal(600000+60000, quote.TotalPrice)
+	suite.Require().Len(quote.AppliedRules, 1)
+	suite.Equal(uint(1), quote.AppliedRules[0].RoomID)
+	suite.Equal(60000, quote.AppliedRules[0].Amount)
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_추가_인원_요금은_객실마다_그_객실_그룹_요금으로_계산한다() {
+	// Given - 10번 그룹(기준 2명, 최대 3명)은 1인 1박 20,000원, 20번 그룹(기준 2명, 최대 4명)은 30,000원이고 7명 요청이면
+	groupID := uint(20)
+	global := newPricingRule(1, models.PricingRuleTypeExtraPersonFee, nil)
+	global.Amount = 20000
+	grouped := newPricingRule(2, models.PricingRuleTypeExtraPersonFee, &groupID)
+	grouped.Amount = 30000
+	suite.givenRules(global, grouped)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoomWithOccupancy(1, 10, 2, 3), nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(2)).Return(suite.newRoomWithOccupancy(2, 20, 2, 4), nil)
+	req := suite.request(1, 2)
+	req.PeopleCount = 7
+
+	// When
+	_, appliedRules, err := suite.service.Price(suite.ctx, req)
+
+	// Then - 초과 3명 중 1명은 최대 인원까지 채운 10번 그룹 객실, 나머지 2명은 20번 그룹 객실 요금으로 계산한다
+	suite.NoError(err)
+	suite.Require().Len(appliedRules, 2)
+	suite.Equal(models.AppliedPricingRule{RuleID: 1, Type: "EXTRA_PERSON_FEE", Name: "EXTRA_PERSON_FEE", RoomID: 1, Nights: 3, Amount: 1 * 20000 * 3}, appliedRules[0])
+	suite.Equal(models.AppliedPricingRule{RuleID: 2, Type: "EXTRA_PERSON_FEE", Name: "EXTRA_PERSON_FEE", RoomID: 2, Nights: 3, Amount: 2 * 30000 * 3}, appliedRules[1])
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_기준_인원을_정하지_않은_객실이_있으면_추가_인원_요금을_적용하지_않는다() {
+	// Given
+	rule := newPricingRule(1, models.PricingRuleTypeExtraPersonFee, nil)
+	rule.Amount = 20000
+	suite.givenRules(rule)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoomWithOccupancy(1, 10, 0, 0), nil)
+	req := suite.request(1)
+	req.PeopleCount = 5
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, req)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(300000, quote.TotalPrice)
+	suite.Empty(quote.AppliedRules)
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_달방은_정액_요금으로_대체한다() {
+	// Given - 30박당 1,500,000원 달방 규칙과 30박 달방 요청이면
+	monthly := newPricingRule(1, models.PricingRuleTypeMonthlyRent, nil)
+	monthly.Amount = 1500000
+	weekend := newPricingRule(2, models.PricingRuleTypeWeekendSurcharge, nil)
+	weekend.Amount = 10000
+	extra := newPricingRule(3, models.PricingRuleTypeExtraPersonFee, nil)
+	extra.Amount = 20000
+	suite.givenRules(monthly, weekend, extra)
+
+	req := suite.request(1)
+	req.StayEndAt = suite.start.AddDate(0, 0, 30)
+	req.PeopleCount = 2
+	req.Type = models.ReservationTypeMonthlyRent
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, req.StayStartAt, req.StayEndAt).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoom(1, 10, 100000, 100000), nil)
+
+	// When
+	price, appliedRules, err := suite.service.Price(suite.ctx, req)
+
+	// Then - 주말 할증, 추가 인원 요금 없이 정액만 적용된다
+	suite.NoError(err)
+	suite.Equal(1500000, price)
+	suite.Require().Len(appliedRules, 1)
+	suite.Equal("MONTHLY_RENT", appliedRules[0].Type)
+	suite.Equal(1500000-3000000, appliedRules[0].Amount)
+}
+
+func (suite *QuoteServiceTestSuite) TestQuote_객실_그룹을_지정한_규칙이_우선한다() {
+	// Given - 전체 그룹 10,000원, 10번 그룹 30,000원 주말 할증 규칙이면
+	groupID := uint(10)
+	global := newPricingRule(1, models.PricingRuleTypeWeekendSurcharge, nil)
+	global.Amount = 10000
+	grouped := newPricingRule(2, models.PricingRuleTypeWeekendSurcharge, &groupID)
+	grouped.Amount = 30000
+	suite.givenRules(global, grouped)
+	suite.mockSeasonRepo.On("FindApplicable", suite.ctx, suite.start, suite.end).Return([]models.Season{}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(suite.newRoom(1, 10, 150000, 100000), nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(2)).Return(suite.newRoom(2, 20, 150000, 100000), nil)
+
+	// When
+	quote, err := suite.service.Quote(suite.ctx, suite.request(1, 2))
+
+	// Then - 10번 그룹 객실은 그룹 규칙, 20번 그룹 객실은 전체 규칙이 적용된다
+	suite.NoError(err)
+	suite.Equal(360000, quote.Rooms[0].Subtotal)
+	suite.Equal(320000, quote.Rooms[1].Subtotal)
+	suite.Require().Len(quote.AppliedRules, 2)
+	suite.Equal(uint(2), quote.AppliedRules[0].RuleID)
+	suite.Equal(uint(1), quote.AppliedRules[1].RuleID)
+}
+
+func TestQuoteServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(QuoteServiceTestSuite))
+}
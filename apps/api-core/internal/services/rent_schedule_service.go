@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrRentChargeNotFound    = errors.New("존재하지 않는 월세 청구")
+	ErrNotMonthlyRent        = errors.New("달방 예약이 아닙니다")
+	ErrRentChargeAlreadyPaid = errors.New("이미 납부된 월세 청구")
+	ErrRentChargeNotPaid     = errors.New("납부되지 않은 월세 청구")
+)
+
+// RentScheduleService는 달방(MONTHLY_RENT) 예약의 월별 청구를 조회하고 납부 처리합니다.
+// 청구는 예약 생성과 숙박 기간 변경 시 reservationService가 만들며, 납부 처리는 결제 내역을 함께 기록합니다.
+type RentScheduleService interface {
+	GetSchedule(ctx context.Context, reservationID uint) (*dto.RentScheduleResponse, error)
+	Pay(ctx context.Context, reservationID, chargeID uint, req dto.PayRentChargeRequest) (*dto.RentChargeResponse, error)
+	CancelPayment(ctx context.Context, reservationID, chargeID uint) (*dto.RentChargeResponse, error)
+	GetOverdue(ctx context.Context, page, size int) ([]dto.OverdueRentChargeResponse, int64, error)
+}
+
+type rentScheduleService struct {
+	rentChargeRepo  repositories.RentChargeRepository
+	reservationRepo repositories.ReservationRepository
+	paymentService  ReservationPaymentService
+}
+
+func NewRentScheduleService(rentChargeRepo repositories.RentChargeRepository, reservationRepo repositories.ReservationRepository,
+	paymentService ReservationPaymentService) RentScheduleService {
+	return &rentScheduleService{
+		rentChargeRepo:  rentChargeRepo,
+		reservationRepo: reservationRepo,
+		paymentService:  paymentService,
+	}
+}
+
+// GetSchedule은 예약의 월별 청구를 조회합니다. 청구는 예약 생성과 수정 때만 만들며, 조회에서는 만들지 않습니다.
+func (s *rentScheduleService) GetSchedule(ctx context.Context, reservationID uint) (*dto.RentScheduleResponse, error) {
+	reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+	if err != nil {
+		return nil, ErrReservationNotFound
+	}
+	if reservation.Type != models.ReservationTypeMonthlyRent {
+		return nil, ErrNotMonthlyRent
+	}
+
+	charges, err := s.rentChargeRepo.FindByReservationID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToRentScheduleResponse(reservationID, charges, time.Now())
+	return &result, nil
+}
+
+// Pay는 청구를 납부 처리하고 청구 금액만큼 결제(PAYMENT) 내역을 기록합니다.
+func (s *rentScheduleService) Pay(ctx context.Context, reservationID, chargeID uint, req dto.PayRentChargeRequest) (*dto.RentChargeResponse, error) {
+	var charge *models.RentCharge
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		charge, err = s.findCharge(ctx, reservationID, chargeID)
+		if err != nil {
+			return err
+		}
+		if charge.IsPaid() {
+			return ErrRentChargeAlreadyPaid
+		}
+
+		note := req.Note
+		if note == "" {
+			note = fmt.Sprintf("월세 %d회차", charge.Sequence)
+		}
+		payment, err := s.paymentService.Create(ctx, reservationID, dto.CreateReservationPaymentRequest{
+			PaymentMethodID: req.PaymentMethodID,
+			Type:            models.ReservationPaymentTypePayment.String(),
+			Amount:          charge.Amount,
+			PaidAt:          req.PaidAt,
+			Note:            note,
+		})
+		if err != nil {
+			return err
+		}
+
+		paidAt := payment.PaidAt.Time
+		charge.PaidAt = &paidAt
+		charge.ReservationPaymentID = &payment.ID
+		return s.rentChargeRepo.Update(ctx, charge)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToRentChargeResponse(charge, time.Now())
+	return &result, nil
+}
+
+// CancelPayment는 납부 처리를 취소하고 함께 기록한 결제 내역을 삭제합니다.
+// 결제 내역을 이미 직접 삭제했다면 청구만 미납으로 되돌립니다.
+func (s *rentScheduleService) CancelPayment(ctx context.Context, reservationID, chargeID uint) (*dto.RentChargeResponse, error) {
+	var charge *models.RentCharge
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		charge, err = s.findCharge(ctx, reservationID, chargeID)
+		if err != nil {
+			return err
+		}
+		if !charge.IsPaid() {
+			return ErrRentChargeNotPaid
+		}
+
+		if charge.ReservationPaymentID != nil {
+			err := s.paymentService.Delete(ctx, reservationID, *charge.ReservationPaymentID)
+			if err != nil && !errors.Is(err, ErrReservationPaymentNotFound) {
+				return err
+			}
+		}
+
+		charge.PaidAt = nil
+		charge.ReservationPaymentID = nil
+		return s.rentChargeRepo.Update(ctx, charge)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToRentChargeResponse(charge, time.Now())
+	return &result, nil
+}
+
+func (s *rentScheduleService) GetOverdue(ctx context.Context, page, size int) ([]dto.OverdueRentChargeResponse, int64, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	charges, total, err := s.rentChargeRepo.FindOverdue(ctx, today, page*size, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	return mappers.ToOverdueRentChargeListResponse(charges, now), total, nil
+}
+
+func (s *rentScheduleService) findCharge(ctx context.Context, reservationID, chargeID uint) (*models.RentCharge, error) {
+	charge, err := s.rentChargeRepo.FindByID(ctx, chargeID)
+	if err != nil || charge.ReservationID != reservationID {
+		return nil, ErrRentChargeNotFound
+	}
+	return charge, nil
+}
@@ -0,0 +1,207 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockRentChargeRepository struct {
+	mock.Mock
+}
+
+func (m *MockRentChargeRepository) Update(ctx context.Context, charge *models.RentCharge) error {
+	args := m.Called(ctx, charge)
+	return args.Error(0)
+}
+
+func (m *MockRentChargeRepository) FindByID(ctx context.Context, id uint) (*models.RentCharge, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RentCharge), args.Error(1)
+}
+
+func (m *MockRentChargeRepository) FindByReservationID(ctx context.Context, reservationID uint) ([]models.RentCharge, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RentCharge), args.Error(1)
+}
+
+func (m *MockRentChargeRepository) FindOverdue(ctx context.Context, today time.Time, offset, limit int) ([]models.RentCharge, int64, error) {
+	args := m.Called(ctx, today, offset, limit)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.RentCharge), args.Get(1).(int64), args.Error(2)
+}
+
+type MockReservationPaymentService struct {
+	mock.Mock
+}
+
+func (m *MockReservationPaymentService) GetPayments(ctx context.Context, reservationID uint) ([]dto.ReservationPaymentResponse, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.ReservationPaymentResponse), args.Error(1)
+}
+
+func (m *MockReservationPaymentService) Create(ctx context.Context, reservationID uint, req dto.CreateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error) {
+	args := m.Called(ctx, reservationID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReservationPaymentResponse), args.Error(1)
+}
+
+func (m *MockReservationPaymentService) Update(ctx context.Context, reservationID, paymentID uint, req dto.UpdateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error) {
+	args := m.Called(ctx, reservationID, paymentID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReservationPaymentResponse), args.Error(1)
+}
+
+func (m *MockReservationPaymentService) Delete(ctx context.Context, reservationID, paymentID uint) error {
+	args := m.Called(ctx, reservationID, paymentID)
+	return args.Error(0)
+}
+
+type RentScheduleServiceTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	mockRentChargeRepo  *MockRentChargeRepository
+	mockReservationRepo *MockReservationRepository
+	mockPaymentService  *MockReservationPaymentService
+	service             services.RentScheduleService
+	reservation         *models.Reservation
+}
+
+func (s *RentScheduleServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockRentChargeRepo = new(MockRentChargeRepository)
+	s.mockReservationRepo = new(MockReservationRepository)
+	s.mockPaymentService = new(MockReservationPaymentService)
+	s.service = services.NewRentScheduleService(s.mockRentChargeRepo, s.mockReservationRepo, s.mockPaymentService)
+
+	s.reservation = &models.Reservation{
+		Type:        models.ReservationTypeMonthlyRent,
+		Price:       900000,
+		StayStartAt: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC),
+	}
+	s.reservation.ID = 10
+}
+
+func (s *RentScheduleServiceTestSuite) newCharge(id uint, sequence int) *models.RentCharge {
+	charge := &models.RentCharge{
+		ReservationID: s.reservation.ID,
+		Sequence:      sequence,
+		PeriodStart:   s.reservation.StayStartAt.AddDate(0, sequence-1, 0),
+		PeriodEnd:     s.reservation.StayStartAt.AddDate(0, sequence, 0),
+		DueDate:       s.reservation.StayStartAt.AddDate(0, sequence-1, 0),
+		Amount:        300000,
+	}
+	charge.ID = id
+	return charge
+}
+
+func (s *RentScheduleServiceTestSuite) TestGetSchedule_저장된_청구를_조회만_한다() {
+	// Given - 3개월 청구가 저장된 달방 예약에서
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockRentChargeRepo.On("FindByReservationID", s.ctx, uint(10)).
+		Return([]models.RentCharge{*s.newCharge(1, 1), *s.newCharge(2, 2), *s.newCharge(3, 3)}, nil)
+
+	// When
+	schedule, err := s.service.GetSchedule(s.ctx, 10)
+
+	// Then - 저장된 청구를 그대로 반환하고 청구를 고치지 않는다
+	s.NoError(err)
+	s.Len(schedule.Charges, 3)
+	s.Equal(900000, schedule.TotalAmount)
+	s.mockRentChargeRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *RentScheduleServiceTestSuite) TestGetSchedule_달방이_아니면_에러() {
+	s.reservation.Type = models.ReservationTypeStay
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+
+	_, err := s.service.GetSchedule(s.ctx, 10)
+
+	s.ErrorIs(err, services.ErrNotMonthlyRent)
+}
+
+func (s *RentScheduleServiceTestSuite) TestPay_청구_금액만큼_결제_내역을_기록하고_연결한다() {
+	// Given - 2회차 청구를 납부 처리하면
+	charge := s.newCharge(2, 2)
+	s.mockRentChargeRepo.On("FindByID", s.ctx, uint(2)).Return(charge, nil)
+	paidAt := time.Date(2026, 4, 10, 9, 0, 0, 0, time.UTC)
+	payment := &dto.ReservationPaymentResponse{ID: 7, PaidAt: dto.CustomTime{Time: paidAt}}
+	s.mockPaymentService.On("Create", s.ctx, uint(10), mock.MatchedBy(func(req dto.CreateReservationPaymentRequest) bool {
+		return req.Type == "PAYMENT" && req.Amount == 300000 && req.Note == "월세 2회차"
+	})).Return(payment, nil)
+	s.mockRentChargeRepo.On("Update", s.ctx, charge).Return(nil)
+
+	// When
+	result, err := s.service.Pay(s.ctx, 10, 2, dto.PayRentChargeRequest{})
+
+	// Then - 청구가 납부 상태가 되고 결제 내역과 연결된다
+	s.NoError(err)
+	s.Equal(models.RentChargeStatusPaid, result.Status)
+	s.Equal(uint(7), *result.ReservationPaymentID)
+	s.Equal(paidAt, *charge.PaidAt)
+}
+
+func (s *RentScheduleServiceTestSuite) TestPay_이미_납부된_청구면_에러() {
+	charge := s.newCharge(2, 2)
+	paidAt := time.Now()
+	charge.PaidAt = &paidAt
+	s.mockRentChargeRepo.On("FindByID", s.ctx, uint(2)).Return(charge, nil)
+
+	_, err := s.service.Pay(s.ctx, 10, 2, dto.PayRentChargeRequest{})
+
+	s.ErrorIs(err, services.ErrRentChargeAlreadyPaid)
+	s.mockPaymentService.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *RentScheduleServiceTestSuite) TestPay_다른_예약의_청구면_에러() {
+	charge := s.newCharge(2, 2)
+	charge.ReservationID = 99
+	s.mockRentChargeRepo.On("FindByID", s.ctx, uint(2)).Return(charge, nil)
+
+	_, err := s.service.Pay(s.ctx, 10, 2, dto.PayRentChargeRequest{})
+
+	s.ErrorIs(err, services.ErrRentChargeNotFound)
+}
+
+func (s *RentScheduleServiceTestSuite) TestCancelPayment_결제_내역을_삭제하고_미납으로_되돌린다() {
+	charge := s.newCharge(2, 2)
+	paidAt := time.Now()
+	paymentID := uint(7)
+	charge.PaidAt, charge.ReservationPaymentID = &paidAt, &paymentID
+	s.mockRentChargeRepo.On("FindByID", s.ctx, uint(2)).Return(charge, nil)
+	s.mockPaymentService.On("Delete", s.ctx, uint(10), uint(7)).Return(nil)
+	s.mockRentChargeRepo.On("Update", s.ctx, charge).Return(nil)
+
+	result, err := s.service.CancelPayment(s.ctx, 10, 2)
+
+	s.NoError(err)
+	s.Nil(charge.PaidAt)
+	s.Nil(result.ReservationPaymentID)
+	s.mockPaymentService.AssertExpectations(s.T())
+}
+
+func TestRentScheduleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RentScheduleServiceTestSuite))
+}
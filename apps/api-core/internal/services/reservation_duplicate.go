@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+)
+
+var ErrDuplicateReservation = errors.New("같은 예약자의 숙박 기간이 겹치는 예약이 있습니다")
+
+// DuplicateReservationError는 같은 예약을 두 번 입력한 것으로 의심되는 예약이 있어 예약을 만들지 않았음을 나타냅니다.
+// errors.Is(err, ErrDuplicateReservation)로 구분할 수 있습니다.
+type DuplicateReservationError struct {
+	Duplicates []dto.DuplicateReservationResponse
+}
+
+func (e *DuplicateReservationError) Error() string {
+	return fmt.Sprintf("%s: %d건", ErrDuplicateReservation.Error(), len(e.Duplicates))
+}
+
+func (e *DuplicateReservationError) Is(target error) bool {
+	return target == ErrDuplicateReservation
+}
+
+type duplicateConfirmedKey struct{}
+
+// WithDuplicateConfirmed는 중복 의심 예약이 있어도 예약을 만들도록 확인했음을 ctx에 표시합니다.
+// 객실 홀드 전환처럼 reservationService.Create를 거치는 모든 경로에 같은 방식으로 전달됩니다.
+func WithDuplicateConfirmed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, duplicateConfirmedKey{}, true)
+}
+
+func isDuplicateConfirmed(ctx context.Context) bool {
+	confirmed, _ := ctx.Value(duplicateConfirmedKey{}).(bool)
+	return confirmed
+}
+
+// checkDuplicates는 중복 확인을 하지 않은 예약 생성에서 중복 의심 예약이 있으면 DuplicateReservationError를 반환합니다.
+func (s *reservationService) checkDuplicates(ctx context.Context, reservation *models.Reservation) error {
+	if isDuplicateConfirmed(ctx) {
+		return nil
+	}
+
+	duplicates, err := s.FindDuplicates(ctx, reservation)
+	if err != nil {
+		return err
+	}
+	if len(duplicates) > 0 {
+		return &DuplicateReservationError{Duplicates: duplicates}
+	}
+	return nil
+}
+
+// FindDuplicates는 reservation과 숙박 기간이 겹치고 전화번호나 이름이 같은 예약 중 객실을 점유하는(정상/대기/이용 완료) 예약을 찾습니다.
+// 저장한 예약을 넘기면 자기 자신은 제외합니다.
+func (s *reservationService) FindDuplicates(ctx context.Context, reservation *models.Reservation) ([]dto.DuplicateReservationResponse, error) {
+	filter := dto.DuplicateCandidateFilter{
+		StartDate: reservation.StayStartAt,
+		EndDate:   reservation.StayEndAt,
+		Name:      models.NormalizeName(reservation.Name),
+		Phone:     models.NormalizePhone(reservation.Phone),
+	}
+	if !reservation.CountsAsOccupied() || (filter.Name == "" && filter.Phone == "") {
+		return nil, nil
+	}
+
+	candidates, err := s.reservationRepo.FindDuplicateCandidates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []dto.DuplicateReservationResponse
+	for i := range candidates {
+		if matches := reservation.DuplicateMatches(&candidates[i]); len(matches) > 0 {
+			duplicates = append(duplicates, mappers.ToDuplicateReservationResponse(&candidates[i], matches))
+		}
+	}
+	return duplicates, nil
+}
+
+// GetDuplicateReport는 [startDate, endDate) 기간과 겹치는 정상/대기/이용 완료 예약 중 서로 중복으로 의심되는 예약 쌍을 숙박 시작일 순으로 조회합니다.
+func (s *reservationService) GetDuplicateReport(ctx context.Context, startDate, endDate time.Time) ([]dto.DuplicateReservationPairResponse, error) {
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidDateRange
+	}
+
+	candidates, err := s.reservationRepo.FindDuplicateCandidates(ctx, dto.DuplicateCandidateFilter{StartDate: startDate, EndDate: endDate})
+	if err != nil {
+		return nil, err
+	}
+
+	// 후보는 숙박 시작일 순이므로 뒤의 예약이 앞 예약의 퇴실일 이후에 시작하면 더 볼 필요가 없다
+	pairs := []dto.DuplicateReservationPairResponse{}
+	for i := range candidates {
+		for j := i + 1; j < len(candidates) && candidates[j].StayStartAt.Before(candidates[i].StayEndAt); j++ {
+			if matches := candidates[i].DuplicateMatches(&candidates[j]); len(matches) > 0 {
+				pairs = append(pairs, mappers.ToDuplicateReservationPairResponse(&candidates[i], &candidates[j], matches))
+			}
+		}
+	}
+	return pairs, nil
+}
@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrReservationPaymentNotFound       = errors.New("존재하지 않는 결제 내역")
+	ErrInvalidReservationPaymentRequest = errors.New("잘못된 결제 내역 요청")
+	ErrRefundExceedsPaidAmount          = errors.New("환불 금액이 입금된 금액보다 클 수 없습니다")
+	ErrReservationPaymentSettled        = errors.New("중개 수수료 정산에 포함된 결제 내역은 수정할 수 없습니다")
+	ErrPaymentInSettledPeriod           = errors.New("중개 수수료 정산이 끝난 기간의 결제 내역은 기록할 수 없습니다")
+)
+
+// ReservationPaymentService는 예약의 입금/환불 내역을 관리합니다. 내역이 바뀔 때마다 예약의 예약금,
+// 결제 금액, 환불 금액, 중개 수수료를 내역 합계로 다시 계산합니다. 정산에 포함된 내역은 바꾸거나 지울 수 없고,
+// 결제 수단의 정산이 끝난 기간에는 내역을 새로 기록하거나 옮길 수 없습니다.
+type ReservationPaymentService interface {
+	GetPayments(ctx context.Context, reservationID uint) ([]dto.ReservationPaymentResponse, error)
+	Create(ctx context.Context, reservationID uint, req dto.CreateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error)
+	Update(ctx context.Context, reservationID, paymentID uint, req dto.UpdateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error)
+	Delete(ctx context.Context, reservationID, paymentID uint) error
+}
+
+type reservationPaymentService struct {
+	paymentRepo       repositories.ReservationPaymentRepository
+	reservationRepo   repositories.ReservationRepository
+	paymentMethodRepo repositories.PaymentMethodRepository
+	settlementRepo    repositories.BrokerFeeSettlementRepository
+	auditService      audit.AuditService
+}
+
+func NewReservationPaymentService(paymentRepo repositories.ReservationPaymentRepository, reservationRepo repositories.ReservationRepository,
+	paymentMethodRepo repositories.PaymentMethodRepository, settlementRepo repositories.BrokerFeeSettlementRepository,
+	auditService audit.AuditService) ReservationPaymentService {
+	return &reservationPaymentService{
+		paymentRepo:       paymentRepo,
+		reservationRepo:   reservationRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		settlementRepo:    settlementRepo,
+		auditService:      auditService,
+	}
+}
+
+func (s *reservationPaymentService) GetPayments(ctx context.Context, reservationID uint) ([]dto.ReservationPaymentResponse, error) {
+	if _, err := s.reservationRepo.FindByID(ctx, reservationID); err != nil {
+		return nil, ErrReservationNotFound
+	}
+
+	payments, err := s.paymentRepo.FindByReservationID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.ToReservationPaymentListResponse(payments), nil
+}
+
+func (s *reservationPaymentService) Create(ctx context.Context, reservationID uint, req dto.CreateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error) {
+	paymentType, ok := models.ParseReservationPaymentType(req.Type)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidReservationPaymentRequest, req.Type)
+	}
+
+	payment := &models.ReservationPayment{
+		ReservationID: reservationID,
+		Type:          paymentType,
+		Amount:        req.Amount,
+		PaidAt:        time.Now(),
+		Note:          req.Note,
+	}
+	if req.PaidAt != nil {
+		payment.PaidAt = req.PaidAt.Time
+	}
+
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+
+		paymentMethodID := reservation.PaymentMethodID
+		if req.PaymentMethodID != nil {
+			paymentMethodID = *req.PaymentMethodID
+		}
+		paymentMethod, err := s.findActivePaymentMethod(ctx, paymentMethodID)
+		if err != nil {
+			return err
+		}
+		payment.PaymentMethodID = paymentMethod.ID
+		payment.CalculateBrokerFee(paymentMethod.CommissionRate)
+		if err := s.ensureUnsettledPeriod(ctx, payment); err != nil {
+			return err
+		}
+
+		if err := s.paymentRepo.Create(ctx, payment); err != nil {
+			return err
+		}
+		return s.recalculate(ctx, reservation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findPaymentResponse(ctx, payment.ID)
+}
+
+func (s *reservationPaymentService) Update(ctx context.Context, reservationID, paymentID uint, req dto.UpdateReservationPaymentRequest) (*dto.ReservationPaymentResponse, error) {
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+
+		payment, err := s.findPayment(ctx, reservationID, paymentID)
+		if err != nil {
+			return err
+		}
+		if payment.IsSettled() {
+			return ErrReservationPaymentSettled
+		}
+
+		if req.Type != nil {
+			paymentType, ok := models.ParseReservationPaymentType(*req.Type)
+			if !ok {
+				return fmt.Errorf("%w: unknown type %s", ErrInvalidReservationPaymentRequest, *req.Type)
+			}
+			payment.Type = paymentType
+		}
+		if req.Amount != nil {
+			payment.Amount = *req.Amount
+		}
+		if req.PaidAt != nil {
+			payment.PaidAt = req.PaidAt.Time
+		}
+		if req.Note != nil {
+			payment.Note = *req.Note
+		}
+
+		// 결제 수단을 바꾸면 새 결제 수단의 수수료율로, 아니면 기존 결제 수단의 수수료율로 수수료를 다시 계산한다
+		paymentMethod := payment.PaymentMethod
+		if req.PaymentMethodID != nil && *req.PaymentMethodID != payment.PaymentMethodID {
+			paymentMethod, err = s.findActivePaymentMethod(ctx, *req.PaymentMethodID)
+			if err != nil {
+				return err
+			}
+			payment.PaymentMethodID = paymentMethod.ID
+		}
+		if paymentMethod != nil {
+			payment.CalculateBrokerFee(paymentMethod.CommissionRate)
+		}
+		payment.PaymentMethod = nil
+		if err := s.ensureUnsettledPeriod(ctx, payment); err != nil {
+			return err
+		}
+
+		if err := s.paymentRepo.Update(ctx, payment); err != nil {
+			return err
+		}
+		return s.recalculate(ctx, reservation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findPaymentResponse(ctx, paymentID)
+}
+
+func (s *reservationPaymentService) Delete(ctx context.Context, reservationID, paymentID uint) error {
+	var deleted *models.ReservationPayment
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+
+		payment, err := s.findPayment(ctx, reservationID, paymentID)
+		if err != nil {
+			return err
+		}
+		if payment.IsSettled() {
+			return ErrReservationPaymentSettled
+		}
+
+		if err := s.paymentRepo.Delete(ctx, paymentID); err != nil {
+			return err
+		}
+		deleted = payment
+		return s.recalculate(ctx, reservation)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Log deletion in audit — manual call required because soft delete bypasses GORM delete hooks
+	_ = s.auditService.LogDelete(ctx, deleted)
+
+	return nil
+}
+
+// recalculate는 예약의 결제 내역 합계로 금액 필드를 다시 계산해 저장합니다.
+func (s *reservationPaymentService) recalculate(ctx context.Context, reservation *models.Reservation) error {
+	payments, err := s.paymentRepo.FindByReservationID(ctx, reservation.ID)
+	if err != nil {
+		return err
+	}
+
+	reservation.ApplyPayments(payments)
+	if reservation.RefundAmount > reservation.Deposit+reservation.PaymentAmount {
+		return ErrRefundExceedsPaidAmount
+	}
+	return s.reservationRepo.Update(ctx, reservation)
+}
+
+// ensureUnsettledPeriod는 결제 내역의 결제 수단과 입금일이 이미 정산된 기간에 들어가면 ErrPaymentInSettledPeriod를 반환합니다.
+// 정산된 기간에 들어간 내역은 다시 정산할 수 없어 정산에서 빠지기 때문입니다.
+func (s *reservationPaymentService) ensureUnsettledPeriod(ctx context.Context, payment *models.ReservationPayment) error {
+	settlementDate := payment.SettlementDate()
+	settled, err := s.settlementRepo.ExistsOverlapping(ctx, payment.PaymentMethodID, settlementDate, settlementDate)
+	if err != nil {
+		return err
+	}
+	if settled {
+		return ErrPaymentInSettledPeriod
+	}
+	return nil
+}
+
+func (s *reservationPaymentService) findPayment(ctx context.Context, reservationID, paymentID uint) (*models.ReservationPayment, error) {
+	payment, err := s.paymentRepo.FindByID(ctx, paymentID)
+	if err != nil || payment.ReservationID != reservationID {
+		return nil, ErrReservationPaymentNotFound
+	}
+	return payment, nil
+}
+
+func (s *reservationPaymentService) findActivePaymentMethod(ctx context.Context, id uint) (*models.PaymentMethod, error) {
+	paymentMethod, err := s.paymentMethodRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrPaymentMethodNotFound
+	}
+	if !paymentMethod.IsActive() {
+		return nil, ErrPaymentMethodInactive
+	}
+	return paymentMethod, nil
+}
+
+func (s *reservationPaymentService) findPaymentResponse(ctx context.Context, id uint) (*dto.ReservationPaymentResponse, error) {
+	payment, err := s.paymentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToReservationPaymentResponse(payment)
+	return &result, nil
+}
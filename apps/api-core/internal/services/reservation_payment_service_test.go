@@ -0,0 +1,308 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/gorm"
+)
+
+type MockReservationPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockReservationPaymentRepository) Create(ctx context.Context, payment *models.ReservationPayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockReservationPaymentRepository) Update(ctx context.Context, payment *models.ReservationPayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockReservationPaymentRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockReservationPaymentRepository) FindByID(ctx context.Context, id uint) (*models.ReservationPayment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ReservationPayment), args.Error(1)
+}
+
+func (m *MockReservationPaymentRepository) FindByReservationID(ctx context.Context, reservationID uint) ([]models.ReservationPayment, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ReservationPayment), args.Error(1)
+}
+
+type ReservationPaymentServiceTestSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	mockPaymentRepo       *MockReservationPaymentRepository
+	mockReservationRepo   *MockReservationRepository
+	mockPaymentMethodRepo *MockPaymentMethodRepository
+	mockSettlementRepo    *MockBrokerFeeSettlementRepository
+	mockAuditService      *MockAuditService
+	service               services.ReservationPaymentService
+	reservation           *models.Reservation
+	card                  *models.PaymentMethod
+}
+
+func (s *ReservationPaymentServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockPaymentRepo = new(MockReservationPaymentRepository)
+	s.mockReservationRepo = new(MockReservationRepository)
+	s.mockPaymentMethodRepo = new(MockPaymentMethodRepository)
+	s.mockSettlementRepo = new(MockBrokerFeeSettlementRepository)
+	s.mockSettlementRepo.On("ExistsOverlapping", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	s.mockAuditService = new(MockAuditService)
+	s.service = services.NewReservationPaymentService(s.mockPaymentRepo, s.mockReservationRepo, s.mockPaymentMethodRepo,
+		s.mockSettlementRepo, s.mockAuditService)
+
+	s.reservation = &models.Reservation{Price: 200000, PaymentMethodID: 1}
+	s.reservation.ID = 10
+	s.card = &models.PaymentMethod{Name: "신용카드", CommissionRate: 0.1, Status: models.PaymentMethodStatusActive}
+	s.card.ID = 1
+}
+
+func (s *ReservationPaymentServiceTestSuite) newPayment(id uint, paymentType models.ReservationPaymentType, amount, brokerFee int) models.ReservationPayment {
+	payment := models.ReservationPayment{
+		ReservationID:   s.reservation.ID,
+		PaymentMethodID: s.card.ID,
+		PaymentMethod:   s.card,
+		Type:            paymentType,
+		Amount:          amount,
+		BrokerFee:       brokerFee,
+	}
+	payment.ID = id
+	return payment
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestCreate_결제_내역을_추가하면_예약_금액을_다시_계산한다() {
+	// Given - 예약금 50,000원이 입금된 예약에 다른 결제 수단으로 잔금 150,000원을 기록하면
+	cash := &models.PaymentMethod{Name: "현금", CommissionRate: 0, Status: models.PaymentMethodStatusActive}
+	cash.ID = 2
+	cashID := uint(2)
+	req := dto.CreateReservationPaymentRequest{PaymentMethodID: &cashID, Type: "PAYMENT", Amount: 150000, Note: "잔금"}
+
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(2)).Return(cash, nil)
+	s.mockPaymentRepo.On("Create", s.ctx, mock.MatchedBy(func(p *models.ReservationPayment) bool {
+		return p.PaymentMethodID == 2 && p.Type == models.ReservationPaymentTypePayment && p.BrokerFee == 0
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.ReservationPayment).ID = 2
+	}).Return(nil)
+
+	deposit := s.newPayment(1, models.ReservationPaymentTypeDeposit, 50000, 5000)
+	balance := s.newPayment(2, models.ReservationPaymentTypePayment, 150000, 0)
+	balance.PaymentMethodID, balance.PaymentMethod = 2, cash
+	s.mockPaymentRepo.On("FindByReservationID", s.ctx, uint(10)).Return([]models.ReservationPayment{deposit, balance}, nil)
+	s.mockReservationRepo.On("Update", s.ctx, s.reservation).Return(nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, uint(2)).Return(&balance, nil)
+
+	// When
+	result, err := s.service.Create(s.ctx, 10, req)
+
+	// Then - 결제 금액과 중개 수수료가 결제 내역 합계로 바뀐다
+	s.NoError(err)
+	s.Equal("PAYMENT", result.Type)
+	s.Equal("현금", result.PaymentMethod.Name)
+	s.Equal(50000, s.reservation.Deposit)
+	s.Equal(150000, s.reservation.PaymentAmount)
+	s.Equal(5000, s.reservation.BrokerFee)
+	s.mockReservationRepo.AssertExpectations(s.T())
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestCreate_결제_수단을_생략하면_예약의_결제_수단으로_수수료를_계산한다() {
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(1)).Return(s.card, nil)
+	s.mockPaymentRepo.On("Create", s.ctx, mock.MatchedBy(func(p *models.ReservationPayment) bool {
+		return p.PaymentMethodID == 1 && p.BrokerFee == 5000 && !p.PaidAt.IsZero()
+	})).Return(nil)
+	s.mockPaymentRepo.On("FindByReservationID", s.ctx, uint(10)).
+		Return([]models.ReservationPayment{s.newPayment(1, models.ReservationPaymentTypeDeposit, 50000, 5000)}, nil)
+	s.mockReservationRepo.On("Update", s.ctx, s.reservation).Return(nil)
+	payment := s.newPayment(1, models.ReservationPaymentTypeDeposit, 50000, 5000)
+	s.mockPaymentRepo.On("FindByID", s.ctx, mock.Anything).Return(&payment, nil)
+
+	_, err := s.service.Create(s.ctx, 10, dto.CreateReservationPaymentRequest{Type: "DEPOSIT", Amount: 50000})
+
+	s.NoError(err)
+	s.Equal(50000, s.reservation.Deposit)
+	s.mockPaymentRepo.AssertExpectations(s.T())
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestCreate_입금액보다_많이_환불하면_에러() {
+	// Given - 50,000원만 입금된 예약에 80,000원 환불을 기록하면
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(1)).Return(s.card, nil)
+	s.mockPaymentRepo.On("Create", s.ctx, mock.Anything).Return(nil)
+	s.mockPaymentRepo.On("FindByReservationID", s.ctx, uint(10)).Return([]models.ReservationPayment{
+		s.newPayment(1, models.ReservationPaymentTypeDeposit, 50000, 5000),
+		s.newPayment(2, models.ReservationPaymentTypeRefund, 80000, -8000),
+	}, nil)
+
+	// When
+	_, err := s.service.Create(s.ctx, 10, dto.CreateReservationPaymentRequest{Type: "REFUND", Amount: 80000})
+
+	// Then - 트랜잭션이 롤백되도록 에러를 반환하고 예약은 저장하지 않는다
+	s.ErrorIs(err, services.ErrRefundExceedsPaidAmount)
+	s.mockReservationRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestCreate_비활성_결제_수단이면_에러() {
+	inactive := &models.PaymentMethod{Status: models.PaymentMethodStatusInactive}
+	inactive.ID = 3
+	inactiveID := uint(3)
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(3)).Return(inactive, nil)
+
+	_, err := s.service.Create(s.ctx, 10, dto.CreateReservationPaymentRequest{PaymentMethodID: &inactiveID, Type: "PAYMENT", Amount: 10000})
+
+	s.ErrorIs(err, services.ErrPaymentMethodInactive)
+	s.mockPaymentRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestCreate_결제_수단의_정산이_끝난_기간에_입금하면_에러() {
+	// Given - 신용카드의 7월 정산이 끝났을 때 7/1 00:30(KST) 입금을 기록하면
+	s.mockSettlementRepo.ExpectedCalls = nil
+	julyFirst := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	s.mockSettlementRepo.On("ExistsOverlapping", s.ctx, uint(1), julyFirst, julyFirst).Return(true, nil)
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(1)).Return(s.card, nil)
+	paidAt := dto.JSONTime{Time: time.Date(2026, 6, 30, 15, 30, 0, 0, time.UTC)}
+
+	// When
+	_, err := s.service.Create(s.ctx, 10, dto.CreateReservationPaymentRequest{Type: "PAYMENT", Amount: 10000, PaidAt: &paidAt})
+
+	// Then - 정산에서 빠지게 되므로 기록할 수 없다
+	s.ErrorIs(err, services.ErrPaymentInSettledPeriod)
+	s.mockPaymentRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestCreate_정산된_결제_내역이_있는_예약에도_잔금을_기록한다() {
+	// Given - 예약금이 정산에 포함된 예약에 정산되지 않은 기간의 잔금을 기록하면
+	settlementID := uint(3)
+	s.reservation.BrokerFeeSettlementID = &settlementID
+	deposit := s.newPayment(1, models.ReservationPaymentTypeDeposit, 50000, 5000)
+	deposit.BrokerFeeSettlementID = &settlementID
+	balance := s.newPayment(2, models.ReservationPaymentTypePayment, 150000, 15000)
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(1)).Return(s.card, nil)
+	s.mockPaymentRepo.On("Create", s.ctx, mock.Anything).Return(nil)
+	s.mockPaymentRepo.On("FindByReservationID", s.ctx, uint(10)).Return([]models.ReservationPayment{deposit, balance}, nil)
+	s.mockReservationRepo.On("Update", s.ctx, s.reservation).Return(nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, mock.Anything).Return(&balance, nil)
+
+	// When
+	_, err := s.service.Create(s.ctx, 10, dto.CreateReservationPaymentRequest{Type: "PAYMENT", Amount: 150000})
+
+	// Then - 정산은 결제 내역 단위이므로 새 내역은 기록된다
+	s.NoError(err)
+	s.Equal(150000, s.reservation.PaymentAmount)
+	s.Equal(20000, s.reservation.BrokerFee)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestUpdate_금액을_바꾸면_기존_결제_수단_수수료율로_다시_계산한다() {
+	payment := s.newPayment(1, models.ReservationPaymentTypePayment, 100000, 10000)
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, uint(1)).Return(&payment, nil)
+	s.mockPaymentRepo.On("Update", s.ctx, &payment).Return(nil)
+	s.mockPaymentRepo.On("FindByReservationID", s.ctx, uint(10)).
+		Return([]models.ReservationPayment{s.newPayment(1, models.ReservationPaymentTypePayment, 120000, 12000)}, nil)
+	s.mockReservationRepo.On("Update", s.ctx, s.reservation).Return(nil)
+
+	amount := 120000
+	_, err := s.service.Update(s.ctx, 10, 1, dto.UpdateReservationPaymentRequest{Amount: &amount})
+
+	s.NoError(err)
+	s.Equal(12000, payment.BrokerFee)
+	s.Equal(12000, s.reservation.BrokerFee)
+	s.mockPaymentMethodRepo.AssertNotCalled(s.T(), "FindByID", mock.Anything, mock.Anything)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestUpdate_정산에_포함된_결제_내역이면_에러() {
+	settlementID := uint(3)
+	payment := s.newPayment(1, models.ReservationPaymentTypePayment, 100000, 10000)
+	payment.BrokerFeeSettlementID = &settlementID
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, uint(1)).Return(&payment, nil)
+
+	amount := 120000
+	_, err := s.service.Update(s.ctx, 10, 1, dto.UpdateReservationPaymentRequest{Amount: &amount})
+
+	s.ErrorIs(err, services.ErrReservationPaymentSettled)
+	s.mockPaymentRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestUpdate_다른_예약의_결제_내역이면_에러() {
+	payment := s.newPayment(1, models.ReservationPaymentTypePayment, 100000, 10000)
+	payment.ReservationID = 99
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, uint(1)).Return(&payment, nil)
+
+	amount := 120000
+	_, err := s.service.Update(s.ctx, 10, 1, dto.UpdateReservationPaymentRequest{Amount: &amount})
+
+	s.ErrorIs(err, services.ErrReservationPaymentNotFound)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestDelete_삭제_후_예약_금액을_다시_계산하고_감사_로그를_남긴다() {
+	// Given - 예약금과 잔금이 기록된 예약에서 잔금 내역을 삭제하면
+	s.reservation.Deposit, s.reservation.PaymentAmount, s.reservation.BrokerFee = 50000, 150000, 20000
+	payment := s.newPayment(2, models.ReservationPaymentTypePayment, 150000, 15000)
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, uint(2)).Return(&payment, nil)
+	s.mockPaymentRepo.On("Delete", s.ctx, uint(2)).Return(nil)
+	s.mockPaymentRepo.On("FindByReservationID", s.ctx, uint(10)).
+		Return([]models.ReservationPayment{s.newPayment(1, models.ReservationPaymentTypeDeposit, 50000, 5000)}, nil)
+	s.mockReservationRepo.On("Update", s.ctx, s.reservation).Return(nil)
+	s.mockAuditService.On("LogDelete", s.ctx, &payment).Return(nil)
+
+	// When
+	err := s.service.Delete(s.ctx, 10, 2)
+
+	// Then
+	s.NoError(err)
+	s.Equal(0, s.reservation.PaymentAmount)
+	s.Equal(5000, s.reservation.BrokerFee)
+	s.mockAuditService.AssertExpectations(s.T())
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestDelete_정산에_포함된_결제_내역이면_에러() {
+	settlementID := uint(3)
+	payment := s.newPayment(2, models.ReservationPaymentTypePayment, 150000, 15000)
+	payment.BrokerFeeSettlementID = &settlementID
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(s.reservation, nil)
+	s.mockPaymentRepo.On("FindByID", s.ctx, uint(2)).Return(&payment, nil)
+
+	err := s.service.Delete(s.ctx, 10, 2)
+
+	s.ErrorIs(err, services.ErrReservationPaymentSettled)
+	s.mockPaymentRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *ReservationPaymentServiceTestSuite) TestGetPayments_존재하지_않는_예약() {
+	s.mockReservationRepo.On("FindByID", s.ctx, uint(10)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := s.service.GetPayments(s.ctx, 10)
+
+	s.ErrorIs(err, services.ErrReservationNotFound)
+}
+
+func TestReservationPaymentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationPaymentServiceTestSuite))
+}
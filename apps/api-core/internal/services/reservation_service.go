@@ -3,12 +3,14 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"gitlab.bellsoft.net/rms/api-core/internal/audit"
 	"gitlab.bellsoft.net/rms/api-core/internal/dto"
 	"gitlab.bellsoft.net/rms/api-core/internal/models"
 	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+	"gorm.io/gorm"
 )
 
 var (
@@ -17,30 +19,50 @@ var (
 	ErrInvalidDateRange      = errors.New("잘못된 날짜 범위")
 	ErrPaymentMethodInactive = errors.New("비활성 상태의 결제 수단")
 	ErrDateRangeBlocked      = errors.New("차단된 날짜 범위에는 예약할 수 없습니다")
+	ErrCheckInBeforeStay     = errors.New("숙박 시작일 이전에는 체크인할 수 없습니다")
+	ErrCheckInCanceled       = errors.New("취소되었거나 종료된 예약은 체크인할 수 없습니다")
+	ErrAlreadyCheckedIn      = errors.New("이미 체크인한 예약")
+	ErrNotCheckedIn          = errors.New("체크인하지 않은 예약은 체크아웃할 수 없습니다")
+	ErrAlreadyCheckedOut     = errors.New("이미 체크아웃한 예약")
+	ErrUnpaidAmountRemaining = errors.New("미수금이 남아 있어 체크아웃할 수 없습니다")
+	ErrPaymentAmountDecrease = errors.New("금액을 줄이려면 결제 내역을 수정하거나 삭제해야 합니다")
+	ErrReservationSettled    = errors.New("중개 수수료 정산이 끝난 예약은 금액을 수정할 수 없습니다")
+	ErrPeopleCountExceeded   = errors.New("예약 인원이 객실 최대 인원을 초과합니다")
+	ErrRoomMoveInactive      = errors.New("취소되었거나 종료된 예약은 객실을 옮길 수 없습니다")
+	ErrRoomMoveOutOfStay     = errors.New("숙박 중 객실을 옮기는 구간이 바뀐 숙박 기간을 벗어납니다")
 )
 
 type ReservationService interface {
 	GetByID(ctx context.Context, id uint) (*models.Reservation, error)
 	GetByIDWithDetails(ctx context.Context, id uint) (*models.Reservation, error)
 	GetAll(ctx context.Context, filter dto.ReservationRepositoryFilter, page, size int, sort string) ([]models.Reservation, int64, error)
+	GetReceivables(ctx context.Context, filter dto.ReceivableFilter, page, size int, sort string) ([]models.Reservation, int64, error)
 	GetStatistics(ctx context.Context, startDate, endDate time.Time, periodType string) ([]repositories.ReservationStatistics, error)
 	Create(ctx context.Context, reservation *models.Reservation, roomIDs []uint) error
 	Update(ctx context.Context, id uint, updates map[string]interface{}, roomIDs []uint, hasRoomsUpdate bool) (*models.Reservation, error)
 	Delete(ctx context.Context, id uint) error
+	CheckIn(ctx context.Context, id uint) (*models.Reservation, error)
+	CheckOut(ctx context.Context, id uint) (*models.Reservation, error)
+	MoveRoom(ctx context.Context, id uint, fromRoomID, toRoomID uint, moveDate time.Time) (*models.Reservation, error)
 	GetAvailableRooms(ctx context.Context, startDate, endDate time.Time, excludeReservationID *uint) ([]models.Room, error)
+	SelectRooms(ctx context.Context, roomGroupID uint, count int, startDate, endDate time.Time) ([]uint, error)
 	GetLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error)
+	FindDuplicates(ctx context.Context, reservation *models.Reservation) ([]dto.DuplicateReservationResponse, error)
+	GetDuplicateReport(ctx context.Context, startDate, endDate time.Time) ([]dto.DuplicateReservationPairResponse, error)
 }
 
 type reservationService struct {
 	reservationRepo   repositories.ReservationRepository
 	roomRepo          repositories.RoomRepository
 	paymentMethodRepo repositories.PaymentMethodRepository
+	guestRepo         repositories.GuestRepository
 	auditService      audit.AuditService
 	dateBlockRepo     repositories.DateBlockRepository
 }
 
+// guestRepo가 nil이면 예약 생성 시 고객을 연결하지 않습니다.
 func NewReservationService(reservationRepo repositories.ReservationRepository, roomRepo repositories.RoomRepository,
-	paymentMethodRepo repositories.PaymentMethodRepository, auditService audit.AuditService,
+	paymentMethodRepo repositories.PaymentMethodRepository, guestRepo repositories.GuestRepository, auditService audit.AuditService,
 	dateBlockRepo ...repositories.DateBlockRepository) ReservationService {
 	var dateBlockRepository repositories.DateBlockRepository
 	if len(dateBlockRepo) > 0 {
@@ -51,6 +73,7 @@ func NewReservationService(reservationRepo repositories.ReservationRepository, r
 		reservationRepo:   reservationRepo,
 		roomRepo:          roomRepo,
 		paymentMethodRepo: paymentMethodRepo,
+		guestRepo:         guestRepo,
 		auditService:      auditService,
 		dateBlockRepo:     dateBlockRepository,
 	}
@@ -77,6 +100,11 @@ func (s *reservationService) GetAll(ctx context.Context, filter dto.ReservationR
 	return s.reservationRepo.FindAll(ctx, filter, offset, size, sort)
 }
 
+func (s *reservationService) GetReceivables(ctx context.Context, filter dto.ReceivableFilter, page, size int, sort string) ([]models.Reservation, int64, error) {
+	offset := page * size
+	return s.reservationRepo.FindReceivables(ctx, filter, offset, size, sort)
+}
+
 func (s *reservationService) GetStatistics(ctx context.Context, startDate, endDate time.Time, periodType string) ([]repositories.ReservationStatistics, error) {
 	if periodType == "" {
 		periodType = "MONTHLY"
@@ -89,6 +117,12 @@ func (s *reservationService) Create(ctx context.Context, reservation *models.Res
 		return ErrInvalidDateRange
 	}
 
+	phone, err := canonicalPhone(reservation.Phone)
+	if err != nil {
+		return err
+	}
+	reservation.Phone = phone
+
 	paymentMethod, err := s.paymentMethodRepo.FindByID(ctx, reservation.PaymentMethodID)
 	if err != nil {
 		return ErrPaymentMethodNotFound
@@ -98,174 +132,553 @@ func (s *reservationService) Create(ctx context.Context, reservation *models.Res
 		return ErrPaymentMethodInactive
 	}
 
-	if s.dateBlockRepo != nil {
-		blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, reservation.StayStartAt, reservation.StayEndAt)
-		if err != nil {
+	// 요청의 예약금과 결제 금액은 초기 결제 내역으로 기록하고, 금액 합계와 중개 수수료는 내역에서 계산한다
+	now := time.Now()
+	reservation.Payments = nil
+	appendAdjustmentPayment(reservation, paymentMethod, models.ReservationPaymentTypeDeposit, reservation.Deposit, now)
+	appendAdjustmentPayment(reservation, paymentMethod, models.ReservationPaymentTypePayment, reservation.PaymentAmount, now)
+	reservation.ApplyPayments(reservation.Payments)
+
+	if reservation.Type == models.ReservationTypeMonthlyRent {
+		reservation.RentCharges = models.BuildRentCharges(reservation, nil)
+	}
+
+	// 가용성 검증부터 저장까지 하나의 트랜잭션에서 객실을 잠근 채 수행해야
+	// 동시에 들어온 같은 객실 예약이 모두 검증을 통과해 중복 예약되는 것을 막을 수 있다.
+	return s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, roomIDs); err != nil {
 			return err
 		}
-		if blocked {
-			return ErrDateRangeBlocked
+
+		if s.dateBlockRepo != nil {
+			blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, reservation.StayStartAt, reservation.StayEndAt, roomIDs)
+			if err != nil {
+				return err
+			}
+			if blocked {
+				return ErrDateRangeBlocked
+			}
+		}
+
+		for _, roomID := range roomIDs {
+			available, err := s.roomRepo.IsRoomAvailable(ctx, roomID, reservation.StayStartAt, reservation.StayEndAt, nil)
+			if err != nil {
+				return err
+			}
+			if !available {
+				return ErrRoomNotAvailable
+			}
+		}
+
+		reservation.Rooms = make([]models.ReservationRoom, len(roomIDs))
+		for i, roomID := range roomIDs {
+			room, err := s.roomRepo.FindByIDWithGroup(ctx, roomID)
+			if err != nil {
+				return ErrRoomNotFound
+			}
+			reservation.Rooms[i] = models.ReservationRoom{
+				RoomID:      roomID,
+				Room:        room, // 추가: audit 로깅용
+				StayStartAt: reservation.StayStartAt,
+				StayEndAt:   reservation.StayEndAt,
+			}
+		}
+
+		if err := checkPeopleCount(reservation); err != nil {
+			return err
 		}
-	}
 
-	for _, roomID := range roomIDs {
-		available, err := s.roomRepo.IsRoomAvailable(ctx, roomID, reservation.StayStartAt, reservation.StayEndAt, nil)
+		if err := s.checkDuplicates(ctx, reservation); err != nil {
+			return err
+		}
+
+		if err := s.linkGuest(ctx, reservation); err != nil {
+			return err
+		}
+
+		_, err := s.reservationRepo.Create(ctx, reservation)
+		return err
+	})
+}
+
+func (s *reservationService) Update(ctx context.Context, id uint, updates map[string]interface{}, roomIDs []uint, hasRoomsUpdate bool) (*models.Reservation, error) {
+	_, startChanged := updates["stayStartAt"]
+	_, endChanged := updates["stayEndAt"]
+
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		// 객실 잠금은 트랜잭션의 첫 일반 조회보다 먼저 걸어야 한다. MySQL(REPEATABLE READ)은 첫 일반 조회 시점의
+		// 스냅샷을 트랜잭션 끝까지 사용하므로, 잠금 대기 후에 다른 트랜잭션이 커밋한 예약을 보지 못할 수 있다.
+		// 객실은 그대로 두고 숙박 기간만 바꾸면 지금 객실을 잠금 조회로 읽어 잠근다. 잠금 조회는 스냅샷을 만들지 않고
+		// 예약 객실 행도 잠그므로, 그 사이 객실 이동이나 배정이 끼어들어 다른 객실을 잠그는 일이 없다.
+		lockRoomIDs := roomIDs
+		if !hasRoomsUpdate && (startChanged || endChanged) {
+			currentRoomIDs, err := s.reservationRepo.LockRoomIDs(ctx, id)
+			if err != nil {
+				return err
+			}
+			lockRoomIDs = currentRoomIDs
+		}
+		if len(lockRoomIDs) > 0 {
+			if err := s.roomRepo.LockRooms(ctx, lockRoomIDs); err != nil {
+				return err
+			}
+		}
+
+		reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, id)
 		if err != nil {
+			return ErrReservationNotFound
+		}
+
+		if reservation.IsSettled() && changesSettledFields(reservation, updates) {
+			return ErrReservationSettled
+		}
+
+		if name, ok := updates["name"].(string); ok {
+			reservation.Name = name
+		}
+
+		if phone, ok := updates["phone"].(string); ok {
+			canonical, err := canonicalPhone(phone)
+			if err != nil {
+				return err
+			}
+			reservation.Phone = canonical
+		}
+
+		if peopleCount, ok := updates["peopleCount"].(int); ok {
+			reservation.PeopleCount = peopleCount
+		}
+
+		previousStartAt, previousEndAt := reservation.StayStartAt, reservation.StayEndAt
+		if stayStartAt, ok := updates["stayStartAt"].(time.Time); ok {
+			reservation.StayStartAt = stayStartAt
+		}
+
+		if stayEndAt, ok := updates["stayEndAt"].(time.Time); ok {
+			reservation.StayEndAt = stayEndAt
+		}
+
+		if reservation.StayStartAt.After(reservation.StayEndAt) || reservation.StayStartAt.Equal(reservation.StayEndAt) {
+			return ErrInvalidDateRange
+		}
+
+		// 객실을 통째로 바꾸지 않으면 숙박 중 옮기는 구간은 유지하고 바뀐 시작일/퇴실일에 맞춰 앞뒤 구간만 늘리거나 줄인다.
+		// 늘어난 숙박일은 다른 예약과 겹칠 수 있으므로 객실이 비어 있는지 다시 확인한다
+		if (startChanged || endChanged) && !hasRoomsUpdate {
+			previousRooms := append([]models.ReservationRoom(nil), reservation.Rooms...)
+			if !reservation.RescheduleRooms(previousStartAt, previousEndAt) {
+				return ErrRoomMoveOutOfStay
+			}
+			if err := checkAddedNights(ctx, s.roomRepo, reservation, previousStartAt, previousEndAt, previousRooms); err != nil {
+				return err
+			}
+		}
+
+		// 객실 단위 차단이 있으므로 날짜를 그대로 두고 객실만 바꾸는 경우에도 차단 여부를 확인한다
+		if (startChanged || endChanged || hasRoomsUpdate) && s.dateBlockRepo != nil {
+			if err := s.checkDateBlocks(ctx, reservation, roomIDs, hasRoomsUpdate); err != nil {
+				return err
+			}
+		}
+
+		if checkInAt, ok := updates["checkInAt"].(*time.Time); ok {
+			reservation.CheckInAt = checkInAt
+		}
+
+		if checkOutAt, ok := updates["checkOutAt"].(*time.Time); ok {
+			reservation.CheckOutAt = checkOutAt
+		}
+
+		if price, ok := updates["price"].(int); ok {
+			reservation.Price = price
+		}
+
+		// 예약의 결제 수단은 새 결제 내역의 기본값이다. 이미 기록된 결제 내역과 중개 수수료는 그대로 두고,
+		// 이 요청에서 늘린 금액부터 새 결제 수단으로 기록한다
+		if paymentMethodID, ok := updates["paymentMethodId"].(uint); ok {
+			if paymentMethodID != reservation.PaymentMethodID {
+				paymentMethod, err := s.paymentMethodRepo.FindByID(ctx, paymentMethodID)
+				if err != nil {
+					return ErrPaymentMethodNotFound
+				}
+				if !paymentMethod.IsActive() {
+					return ErrPaymentMethodInactive
+				}
+				reservation.PaymentMethod = nil // GORM Save 충돌 방지: Preload된 association을 nil로 설정
+				reservation.PaymentMethodID = paymentMethodID
+			}
+		}
+
+		if err := s.applyAmountUpdates(ctx, reservation, updates); err != nil {
 			return err
 		}
-		if !available {
-			return ErrRoomNotAvailable
+
+		if note, ok := updates["note"].(string); ok {
+			reservation.Note = note
+		}
+
+		if status, ok := updates["status"].(models.ReservationStatus); ok {
+			if err := reservation.TransitionTo(status, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		if type_, ok := updates["type"].(models.ReservationType); ok {
+			reservation.Type = type_
+		}
+
+		_, priceChanged := updates["price"]
+		_, typeChanged := updates["type"]
+		if startChanged || endChanged || priceChanged || typeChanged {
+			if err := rescheduleRent(ctx, s.reservationRepo, reservation); err != nil {
+				return err
+			}
+		}
+
+		if hasRoomsUpdate {
+			for _, roomID := range roomIDs {
+				available, err := s.roomRepo.IsRoomAvailable(ctx, roomID, reservation.StayStartAt, reservation.StayEndAt, &id)
+				if err != nil {
+					return err
+				}
+				if !available {
+					return ErrRoomNotAvailable
+				}
+			}
+
+			if err := s.reservationRepo.DeleteRooms(ctx, id); err != nil {
+				return err
+			}
+
+			reservation.Rooms = make([]models.ReservationRoom, len(roomIDs))
+			for i, roomID := range roomIDs {
+				room, err := s.roomRepo.FindByIDWithGroup(ctx, roomID)
+				if err != nil {
+					return ErrRoomNotFound
+				}
+				reservation.Rooms[i] = models.ReservationRoom{
+					RoomID:      roomID,
+					Room:        room,
+					StayStartAt: reservation.StayStartAt,
+					StayEndAt:   reservation.StayEndAt,
+				}
+			}
+		}
+
+		if _, peopleChanged := updates["peopleCount"]; peopleChanged || hasRoomsUpdate {
+			if err := checkPeopleCount(reservation); err != nil {
+				return err
+			}
 		}
+
+		return s.reservationRepo.Update(ctx, reservation)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	reservation.Rooms = make([]models.ReservationRoom, len(roomIDs))
-	for i, roomID := range roomIDs {
-		room, err := s.roomRepo.FindByID(ctx, roomID)
+	return s.reservationRepo.FindByIDWithDetails(ctx, id)
+}
+
+// MoveRoom은 moveDate 숙박일부터 fromRoomID 객실을 쓰던 손님을 toRoomID 객실로 옮깁니다.
+// 옮길 객실은 [moveDate, 구간 끝) 기간에 비어 있고 차단되지 않아야 하며, 감사 로그에는 ROOM_MOVE로 기록됩니다.
+func (s *reservationService) MoveRoom(ctx context.Context, id uint, fromRoomID, toRoomID uint, moveDate time.Time) (*models.Reservation, error) {
+	ctx = audit.WithAction(ctx, audit.ActionRoomMove)
+
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, []uint{fromRoomID, toRoomID}); err != nil {
+			return err
+		}
+
+		reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, id)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+		if !reservation.IsActive() {
+			return ErrRoomMoveInactive
+		}
+
+		toRoom, err := s.roomRepo.FindByIDWithGroup(ctx, toRoomID)
 		if err != nil {
 			return ErrRoomNotFound
 		}
-		reservation.Rooms[i] = models.ReservationRoom{
-			RoomID: roomID,
-			Room:   room, // 추가: audit 로깅용
+
+		moved, err := reservation.MoveRoom(fromRoomID, toRoomID, moveDate)
+		if err != nil {
+			return err
+		}
+		moved.Room = toRoom
+		startDate, endDate := moved.StayPeriod(reservation)
+
+		// 같은 예약의 다른 구간이 이미 쓰고 있는 객실로는 옮길 수 없다
+		for i := range reservation.Rooms {
+			segment := &reservation.Rooms[i]
+			if segment != moved && segment.RoomID == toRoomID && segment.Overlaps(reservation, startDate, endDate) {
+				return ErrRoomNotAvailable
+			}
 		}
-	}
 
-	reservation.BrokerFee = int(float64(reservation.Price) * paymentMethod.CommissionRate)
+		available, err := s.roomRepo.IsRoomAvailable(ctx, toRoomID, startDate, endDate, &id)
+		if err != nil {
+			return err
+		}
+		if !available {
+			return ErrRoomNotAvailable
+		}
 
-	_, err = s.reservationRepo.Create(ctx, reservation)
-	return err
-}
+		if s.dateBlockRepo != nil {
+			blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, startDate, endDate, []uint{toRoomID})
+			if err != nil {
+				return err
+			}
+			if blocked {
+				return ErrDateRangeBlocked
+			}
+		}
 
-func (s *reservationService) Update(ctx context.Context, id uint, updates map[string]interface{}, roomIDs []uint, hasRoomsUpdate bool) (*models.Reservation, error) {
-	reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, id)
+		if err := checkPeopleCount(reservation); err != nil {
+			return err
+		}
+
+		return s.reservationRepo.Update(ctx, reservation)
+	})
 	if err != nil {
-		return nil, ErrReservationNotFound
+		return nil, err
 	}
 
-	if name, ok := updates["name"].(string); ok {
-		reservation.Name = name
-	}
+	return s.reservationRepo.FindByIDWithDetails(ctx, id)
+}
 
-	if phone, ok := updates["phone"].(string); ok {
-		reservation.Phone = phone
+// checkDateBlocks는 예약 객실이 날짜 차단에 걸리는지 확인합니다. 객실을 통째로 바꾸면 roomIDs를 숙박 기간 전체로 확인하고,
+// 숙박 중 객실을 옮기는 예약은 객실마다 그 객실을 사용하는 구간만 확인합니다.
+func (s *reservationService) checkDateBlocks(ctx context.Context, reservation *models.Reservation, roomIDs []uint, hasRoomsUpdate bool) error {
+	if hasRoomsUpdate || !reservation.HasRoomMoves() {
+		blockedRoomIDs := roomIDs
+		if !hasRoomsUpdate {
+			blockedRoomIDs = reservation.RoomIDs()
+		}
+		blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, reservation.StayStartAt, reservation.StayEndAt, blockedRoomIDs)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrDateRangeBlocked
+		}
+		return nil
 	}
 
-	if peopleCount, ok := updates["peopleCount"].(int); ok {
-		reservation.PeopleCount = peopleCount
+	for i := range reservation.Rooms {
+		startDate, endDate := reservation.Rooms[i].StayPeriod(reservation)
+		blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, startDate, endDate, []uint{reservation.Rooms[i].RoomID})
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrDateRangeBlocked
+		}
 	}
+	return nil
+}
 
-	if stayStartAt, ok := updates["stayStartAt"].(time.Time); ok {
-		reservation.StayStartAt = stayStartAt
+// checkAddedNights는 숙박 기간 변경으로 객실 구간마다 새로 사용하게 된 숙박일에 객실이 비어 있는지 확인합니다.
+// previousRooms는 구간을 조정하기 전 reservation.Rooms의 복사본이며, 이미 사용하던 숙박일은 다시 확인하지 않습니다.
+func checkAddedNights(ctx context.Context, roomRepo repositories.RoomRepository, reservation *models.Reservation,
+	previousStartAt, previousEndAt time.Time, previousRooms []models.ReservationRoom) error {
+	previous := &models.Reservation{StayStartAt: previousStartAt, StayEndAt: previousEndAt}
+	for i := range reservation.Rooms {
+		start, end := reservation.Rooms[i].StayPeriod(reservation)
+		previousStart, previousEnd := previousRooms[i].StayPeriod(previous)
+
+		var added [][2]time.Time
+		if start.Before(previousStart) {
+			added = append(added, [2]time.Time{start, minTime(previousStart, end)})
+		}
+		if end.After(previousEnd) {
+			added = append(added, [2]time.Time{maxTime(previousEnd, start), end})
+		}
+		for _, period := range added {
+			available, err := roomRepo.IsRoomAvailable(ctx, reservation.Rooms[i].RoomID, period[0], period[1], &reservation.ID)
+			if err != nil {
+				return err
+			}
+			if !available {
+				return ErrRoomNotAvailable
+			}
+		}
 	}
+	return nil
+}
 
-	if stayEndAt, ok := updates["stayEndAt"].(time.Time); ok {
-		reservation.StayEndAt = stayEndAt
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
 	}
+	return b
+}
 
-	if reservation.StayStartAt.After(reservation.StayEndAt) || reservation.StayStartAt.Equal(reservation.StayEndAt) {
-		return nil, ErrInvalidDateRange
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
 	}
+	return b
+}
 
-	_, startChanged := updates["stayStartAt"]
-	_, endChanged := updates["stayEndAt"]
-	if (startChanged || endChanged) && s.dateBlockRepo != nil {
-		blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, reservation.StayStartAt, reservation.StayEndAt)
-		if err != nil {
-			return nil, err
-		}
-		if blocked {
-			return nil, ErrDateRangeBlocked
+// linkGuest는 예약자 전화번호로 고객을 찾아 예약에 연결하고, 처음 보는 번호면 예약자 이름으로 새 고객을 만듭니다.
+// 고객을 직접 지정한 예약은 지정한 고객이 있는지만 확인하고, 전화번호가 없는 예약은 연결하지 않습니다.
+func (s *reservationService) linkGuest(ctx context.Context, reservation *models.Reservation) error {
+	if s.guestRepo == nil {
+		return nil
+	}
+	if reservation.GuestID != nil {
+		if _, err := s.guestRepo.FindByID(ctx, *reservation.GuestID); err != nil {
+			return ErrGuestNotFound
 		}
+		return nil
 	}
 
-	if checkInAt, ok := updates["checkInAt"].(*time.Time); ok {
-		reservation.CheckInAt = checkInAt
+	if reservation.Phone == "" {
+		return nil
 	}
 
-	if checkOutAt, ok := updates["checkOutAt"].(*time.Time); ok {
-		reservation.CheckOutAt = checkOutAt
+	guest, err := s.guestRepo.FindByPhone(ctx, reservation.Phone)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		guest, err = s.guestRepo.Create(ctx, &models.Guest{Name: reservation.Name, Phone: reservation.Phone})
 	}
-
-	if price, ok := updates["price"].(int); ok {
-		reservation.Price = price
+	if err != nil {
+		return err
 	}
+	reservation.GuestID = &guest.ID
+	return nil
+}
 
-	if deposit, ok := updates["deposit"].(int); ok {
-		reservation.Deposit = deposit
+// canonicalPhone은 예약자 전화번호를 저장 형식(models.CanonicalPhone)으로 바꿉니다. 전화번호는 비워 둘 수 있습니다.
+func canonicalPhone(phone string) (string, error) {
+	if strings.TrimSpace(phone) == "" {
+		return "", nil
 	}
-
-	if paymentAmount, ok := updates["paymentAmount"].(int); ok {
-		reservation.PaymentAmount = paymentAmount
+	canonical, ok := models.CanonicalPhone(phone)
+	if !ok {
+		return "", ErrInvalidPhone
 	}
+	return canonical, nil
+}
 
-	if refundAmount, ok := updates["refundAmount"].(int); ok {
-		reservation.RefundAmount = refundAmount
+// checkPeopleCount는 예약 인원이 예약한 객실들의 최대 인원 합계를 넘는지 확인합니다.
+// 최대 인원을 정하지 않은 객실이 있으면 확인하지 않으며, 기준 인원 초과는 막지 않습니다.
+func checkPeopleCount(reservation *models.Reservation) error {
+	if _, max := reservation.Occupancy(); max > 0 && reservation.PeopleCount > max {
+		return ErrPeopleCountExceeded
 	}
+	return nil
+}
 
-	if note, ok := updates["note"].(string); ok {
-		reservation.Note = note
+// rescheduleRent는 숙박 기간, 판매 금액, 유형이 바뀐 예약의 달방 청구를 다시 만듭니다.
+// 납부된 청구는 그대로 두고, 납부되지 않은 청구만 새 기간과 남은 금액으로 다시 생성합니다.
+func rescheduleRent(ctx context.Context, reservationRepo repositories.ReservationRepository, reservation *models.Reservation) error {
+	var paid []models.RentCharge
+	for _, charge := range reservation.RentCharges {
+		if charge.IsPaid() {
+			paid = append(paid, charge)
+		}
 	}
 
-	if status, ok := updates["status"].(models.ReservationStatus); ok {
-		reservation.Status = status
-		if status == models.ReservationStatusCancel || status == models.ReservationStatusRefund {
-			now := time.Now()
-			reservation.CanceledAt = &now
+	if len(paid) < len(reservation.RentCharges) {
+		if err := reservationRepo.DeleteUnpaidRentCharges(ctx, reservation.ID); err != nil {
+			return err
 		}
 	}
 
-	if type_, ok := updates["type"].(models.ReservationType); ok {
-		reservation.Type = type_
+	reservation.RentCharges = nil
+	if reservation.Type == models.ReservationTypeMonthlyRent {
+		reservation.RentCharges = models.BuildRentCharges(reservation, paid)
 	}
+	return nil
+}
 
-	if paymentMethodID, ok := updates["paymentMethodId"].(uint); ok {
-		if paymentMethodID != reservation.PaymentMethodID {
-			paymentMethod, err := s.paymentMethodRepo.FindByID(ctx, paymentMethodID)
-			if err != nil {
-				return nil, ErrPaymentMethodNotFound
-			}
-			if !paymentMethod.IsActive() {
-				return nil, ErrPaymentMethodInactive
-			}
-			reservation.PaymentMethod = nil // GORM Save 충돌 방지: Preload된 association을 nil로 설정
-			reservation.PaymentMethodID = paymentMethodID
-			reservation.BrokerFee = int(float64(reservation.Price) * paymentMethod.CommissionRate)
+// changesSettledFields는 수정 요청이 정산에 반영된 금액 필드(판매 금액, 결제 수단, 예약금, 결제 금액, 환불 금액)를 바꾸는지 확인합니다.
+// 기존 값과 같은 값을 다시 보내는 것은 허용합니다.
+func changesSettledFields(reservation *models.Reservation, updates map[string]interface{}) bool {
+	amounts := map[string]int{
+		"price":         reservation.Price,
+		"deposit":       reservation.Deposit,
+		"paymentAmount": reservation.PaymentAmount,
+		"refundAmount":  reservation.RefundAmount,
+	}
+	for key, current := range amounts {
+		if value, ok := updates[key].(int); ok && value != current {
+			return true
 		}
 	}
 
-	if hasRoomsUpdate {
-		for _, roomID := range roomIDs {
-			available, err := s.roomRepo.IsRoomAvailable(ctx, roomID, reservation.StayStartAt, reservation.StayEndAt, &id)
-			if err != nil {
-				return nil, err
-			}
-			if !available {
-				return nil, ErrRoomNotAvailable
-			}
-		}
+	paymentMethodID, ok := updates["paymentMethodId"].(uint)
+	return ok && paymentMethodID != reservation.PaymentMethodID
+}
 
-		if err := s.reservationRepo.DeleteRooms(ctx, id); err != nil {
-			return nil, err
+// applyAmountUpdates는 예약 수정 요청의 예약금, 결제 금액, 환불 금액을 기존 금액과의 차액만큼 결제 내역으로 추가합니다.
+// 결제 내역은 현재 예약의 결제 수단으로 기록하며, 금액을 줄이는 요청은 결제 내역에서 직접 처리해야 합니다.
+func (s *reservationService) applyAmountUpdates(ctx context.Context, reservation *models.Reservation, updates map[string]interface{}) error {
+	amounts := []struct {
+		key         string
+		paymentType models.ReservationPaymentType
+		current     int
+	}{
+		{"deposit", models.ReservationPaymentTypeDeposit, reservation.Deposit},
+		{"paymentAmount", models.ReservationPaymentTypePayment, reservation.PaymentAmount},
+		{"refundAmount", models.ReservationPaymentTypeRefund, reservation.RefundAmount},
+	}
+
+	var paymentMethod *models.PaymentMethod
+	now := time.Now()
+	for _, amount := range amounts {
+		value, ok := updates[amount.key].(int)
+		if !ok || value == amount.current {
+			continue
+		}
+		if value < amount.current {
+			return ErrPaymentAmountDecrease
 		}
 
-		reservation.Rooms = make([]models.ReservationRoom, len(roomIDs))
-		for i, roomID := range roomIDs {
-			room, err := s.roomRepo.FindByID(ctx, roomID)
-			if err != nil {
-				return nil, ErrRoomNotFound
-			}
-			reservation.Rooms[i] = models.ReservationRoom{
-				RoomID: roomID,
-				Room:   room,
+		if paymentMethod == nil {
+			paymentMethod = reservation.PaymentMethod
+			if paymentMethod == nil {
+				found, err := s.paymentMethodRepo.FindByID(ctx, reservation.PaymentMethodID)
+				if err != nil {
+					return ErrPaymentMethodNotFound
+				}
+				paymentMethod = found
 			}
 		}
+
+		payment := appendAdjustmentPayment(reservation, paymentMethod, amount.paymentType, value-amount.current, now)
+		switch amount.paymentType {
+		case models.ReservationPaymentTypeDeposit:
+			reservation.Deposit = value
+		case models.ReservationPaymentTypePayment:
+			reservation.PaymentAmount = value
+		case models.ReservationPaymentTypeRefund:
+			reservation.RefundAmount = value
+		}
+		reservation.BrokerFee += payment.BrokerFee
 	}
+	return nil
+}
 
-	if err := s.reservationRepo.Update(ctx, reservation); err != nil {
-		return nil, err
+// appendAdjustmentPayment는 amount가 양수면 예약에 저장할 결제 내역을 추가하고 추가한 내역을 반환합니다.
+func appendAdjustmentPayment(reservation *models.Reservation, paymentMethod *models.PaymentMethod,
+	paymentType models.ReservationPaymentType, amount int, paidAt time.Time) models.ReservationPayment {
+	if amount <= 0 {
+		return models.ReservationPayment{}
 	}
 
-	return s.reservationRepo.FindByIDWithDetails(ctx, id)
+	payment := models.ReservationPayment{
+		PaymentMethodID: reservation.PaymentMethodID,
+		Type:            paymentType,
+		Amount:          amount,
+		PaidAt:          paidAt,
+	}
+	payment.CalculateBrokerFee(paymentMethod.CommissionRate)
+	reservation.Payments = append(reservation.Payments, payment)
+	return payment
 }
 
 func (s *reservationService) Delete(ctx context.Context, id uint) error {
@@ -274,6 +687,10 @@ func (s *reservationService) Delete(ctx context.Context, id uint) error {
 		return ErrReservationNotFound
 	}
 
+	if reservation.IsSettled() {
+		return ErrReservationSettled
+	}
+
 	// Perform the deletion
 	if err := s.reservationRepo.Delete(ctx, id); err != nil {
 		return err
@@ -290,6 +707,94 @@ func (s *reservationService) Delete(ctx context.Context, id uint) error {
 	return nil
 }
 
+// CheckIn은 예약을 체크인 처리합니다. 숙박 시작일 이전이거나 취소된 예약은 체크인할 수 없으며,
+// 감사 로그에는 일반 수정(UPDATE)이 아닌 CHECK_IN으로 기록됩니다.
+func (s *reservationService) CheckIn(ctx context.Context, id uint) (*models.Reservation, error) {
+	ctx = audit.WithAction(ctx, audit.ActionCheckIn)
+
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, id)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+
+		if !reservation.IsActive() {
+			return ErrCheckInCanceled
+		}
+		if reservation.CheckInAt != nil {
+			return ErrAlreadyCheckedIn
+		}
+
+		now := time.Now()
+		if now.Format("2006-01-02") < reservation.StayStartAt.Format("2006-01-02") {
+			return ErrCheckInBeforeStay
+		}
+
+		// 체크인하면 대기 중이던 예약도 확정된 것으로 본다
+		if err := reservation.TransitionTo(models.ReservationStatusNormal, now); err != nil {
+			return err
+		}
+		reservation.CheckInAt = &now
+		return s.reservationRepo.Update(ctx, reservation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reservationRepo.FindByIDWithDetails(ctx, id)
+}
+
+// CheckOut은 체크인한 예약을 체크아웃하고 이용 완료(COMPLETED) 상태로 변경합니다. 결제 수단에 미수금 확인이
+// 설정되어 있으면 미수금이 남은 예약은 체크아웃할 수 없으며, 감사 로그에는 CHECK_OUT으로 기록됩니다.
+// 예약 객실의 청소 상태는 DIRTY로 바뀝니다.
+func (s *reservationService) CheckOut(ctx context.Context, id uint) (*models.Reservation, error) {
+	ctx = audit.WithAction(ctx, audit.ActionCheckOut)
+
+	err := s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, id)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+
+		if reservation.CheckInAt == nil {
+			return ErrNotCheckedIn
+		}
+		if reservation.CheckOutAt != nil {
+			return ErrAlreadyCheckedOut
+		}
+
+		if reservation.PaymentMethod != nil && bool(reservation.PaymentMethod.RequireUnpaidAmountCheck) &&
+			reservation.UnpaidAmount() > 0 {
+			return ErrUnpaidAmountRemaining
+		}
+
+		if err := reservation.TransitionTo(models.ReservationStatusCompleted, time.Now()); err != nil {
+			return err
+		}
+		if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+			return err
+		}
+
+		// 손님이 나간 객실은 청소가 필요하다. 숙박 중 옮기기 전 객실은 옮긴 날 이미 비었으므로 마지막 날 객실만 바꾼다
+		lastNight := reservation.StayEndAt.AddDate(0, 0, -1)
+		for _, reservationRoom := range reservation.Rooms {
+			if reservationRoom.Room == nil || !reservationRoom.Covers(reservation, lastNight) {
+				continue
+			}
+			if err := s.roomRepo.UpdateHousekeepingStatus(ctx, reservationRoom.RoomID, models.HousekeepingStatusDirty); err != nil {
+				return err
+			}
+			reservationRoom.Room.HousekeepingStatus = models.HousekeepingStatusDirty
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reservationRepo.FindByIDWithDetails(ctx, id)
+}
+
 func (s *reservationService) GetAvailableRooms(ctx context.Context, startDate, endDate time.Time, excludeReservationID *uint) ([]models.Room, error) {
 	if startDate.After(endDate) || startDate.Equal(endDate) {
 		return nil, ErrInvalidDateRange
@@ -298,6 +803,61 @@ func (s *reservationService) GetAvailableRooms(ctx context.Context, startDate, e
 	return s.roomRepo.FindAvailableRooms(ctx, startDate, endDate, excludeReservationID)
 }
 
+// SelectRooms는 객실 그룹에서 기간 동안 비어 있는 객실 count개를 고릅니다. 앞뒤 예약과의 빈 날이 가장 적은 객실부터 골라
+// 팔기 어려운 짧은 공백을 덜 만들며, 빈 객실이 모자라면 ErrNoRoomToAssign을 반환합니다.
+// 고른 객실은 잠그지 않으므로 Create에서 다시 가용성을 검증합니다.
+func (s *reservationService) SelectRooms(ctx context.Context, roomGroupID uint, count int, startDate, endDate time.Time) ([]uint, error) {
+	startDate, endDate = truncateToDate(startDate), truncateToDate(endDate)
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidDateRange
+	}
+	if count <= 0 {
+		count = 1
+	}
+
+	availableRooms, err := s.roomRepo.FindAvailableRooms(ctx, startDate, endDate, nil)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []models.Room
+	for _, room := range availableRooms {
+		if room.RoomGroupID == roomGroupID {
+			candidates = append(candidates, room)
+		}
+	}
+	if len(candidates) < count {
+		return nil, ErrNoRoomToAssign
+	}
+
+	neighborStart := startDate.AddDate(0, 0, -roomAssignmentGapCapDays)
+	neighborEnd := endDate.AddDate(0, 0, roomAssignmentGapCapDays)
+	neighbors, _, err := s.reservationRepo.FindAll(ctx, dto.ReservationRepositoryFilter{StartDate: &neighborStart, EndDate: &neighborEnd}, 0, -1, "")
+	if err != nil {
+		return nil, err
+	}
+	schedule := make(roomSchedule)
+	for _, reservation := range neighbors {
+		if !reservation.CountsAsOccupied() {
+			continue
+		}
+		for _, reservationRoom := range reservation.Rooms {
+			stayStartAt, stayEndAt := reservationRoom.StayPeriod(&reservation)
+			schedule.add(reservationRoom.RoomID, stayStartAt, stayEndAt)
+		}
+	}
+
+	roomIDs := make([]uint, 0, count)
+	for len(roomIDs) < count {
+		roomID := selectBestFitRoom(schedule, candidates, startDate, endDate, 0)
+		if roomID == 0 {
+			return nil, ErrNoRoomToAssign
+		}
+		schedule.add(roomID, startDate, endDate)
+		roomIDs = append(roomIDs, roomID)
+	}
+	return roomIDs, nil
+}
+
 func (s *reservationService) GetLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error) {
 	return s.reservationRepo.FindLastReservationForRoom(ctx, roomID)
 }
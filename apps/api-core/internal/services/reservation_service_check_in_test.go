@@ -0,0 +1,229 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type ReservationCheckInOutTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	service             services.ReservationService
+	mockReservationRepo *MockReservationRepository
+	mockRoomRepo        *MockRoomRepository
+}
+
+func (suite *ReservationCheckInOutTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockReservationRepo = new(MockReservationRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.service = services.NewReservationService(
+		suite.mockReservationRepo,
+		suite.mockRoomRepo,
+		new(MockPaymentMethodRepository),
+		nil,
+		nil,
+	)
+}
+
+func (suite *ReservationCheckInOutTestSuite) newReservation(stayStartAt time.Time) *models.Reservation {
+	reservation := &models.Reservation{
+		Name:        "홍길동",
+		StayStartAt: stayStartAt,
+		StayEndAt:   stayStartAt.AddDate(0, 0, 2),
+		Price:       200000,
+		Status:      models.ReservationStatusNormal,
+		PaymentMethod: &models.PaymentMethod{
+			Name:                     "현금",
+			RequireUnpaidAmountCheck: models.BitBool(true),
+		},
+	}
+	reservation.ID = 1
+	return reservation
+}
+
+// auditActionIs는 리포지토리에 전달된 컨텍스트가 지정한 감사 동작을 담고 있는지 확인한다.
+func auditActionIs(action audit.Action) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		contextAction, ok := audit.GetAction(ctx)
+		return ok && contextAction == action
+	})
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckIn_성공() {
+	// Given - 오늘 시작하는 예약이면
+	reservation := suite.newReservation(today())
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockReservationRepo.On("Update", auditActionIs(audit.ActionCheckIn), reservation).Return(nil)
+
+	// When
+	result, err := suite.service.CheckIn(suite.ctx, 1)
+
+	// Then - 체크인 시각이 기록되고 CHECK_IN 동작으로 저장된다
+	suite.NoError(err)
+	suite.NotNil(result.CheckInAt)
+	suite.mockReservationRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckIn_대기_예약은_확정된다() {
+	// Given - 대기 상태의 예약이면
+	reservation := suite.newReservation(today())
+	reservation.Status = models.ReservationStatusPending
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockReservationRepo.On("Update", auditActionIs(audit.ActionCheckIn), reservation).Return(nil)
+
+	// When
+	result, err := suite.service.CheckIn(suite.ctx, 1)
+
+	// Then - 체크인과 함께 확정 상태로 변경된다
+	suite.NoError(err)
+	suite.Equal(models.ReservationStatusNormal, result.Status)
+	suite.NotNil(result.CheckInAt)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckOut_이용_완료로_변경된다() {
+	reservation := suite.newReservation(today())
+	checkInAt := time.Now()
+	reservation.CheckInAt = &checkInAt
+	reservation.PaymentAmount = reservation.Price
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockReservationRepo.On("Update", mock.Anything, reservation).Return(nil)
+
+	result, err := suite.service.CheckOut(suite.ctx, 1)
+
+	suite.NoError(err)
+	suite.Equal(models.ReservationStatusCompleted, result.Status)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckIn_숙박_시작일_이전() {
+	reservation := suite.newReservation(today().AddDate(0, 0, 3))
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+
+	_, err := suite.service.CheckIn(suite.ctx, 1)
+
+	suite.ErrorIs(err, services.ErrCheckInBeforeStay)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckIn_취소된_예약() {
+	reservation := suite.newReservation(today())
+	reservation.Status = models.ReservationStatusCancel
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+
+	_, err := suite.service.CheckIn(suite.ctx, 1)
+
+	suite.ErrorIs(err, services.ErrCheckInCanceled)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckIn_이미_체크인() {
+	reservation := suite.newReservation(today())
+	checkInAt := time.Now()
+	reservation.CheckInAt = &checkInAt
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+
+	_, err := suite.service.CheckIn(suite.ctx, 1)
+
+	suite.ErrorIs(err, services.ErrAlreadyCheckedIn)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckOut_성공() {
+	// Given - 체크인했고 미수금이 없는 예약이면
+	reservation := suite.newReservation(today())
+	checkInAt := time.Now()
+	reservation.CheckInAt = &checkInAt
+	reservation.PaymentAmount = reservation.Price
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockReservationRepo.On("Update", auditActionIs(audit.ActionCheckOut), reservation).Return(nil)
+
+	// When
+	result, err := suite.service.CheckOut(suite.ctx, 1)
+
+	// Then
+	suite.NoError(err)
+	suite.NotNil(result.CheckOutAt)
+	suite.mockReservationRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckOut_객실이_청소_전_상태가_된다() {
+	// Given - 객실을 배정받아 체크인한 예약이면
+	reservation := suite.newReservation(today())
+	checkInAt := time.Now()
+	reservation.CheckInAt = &checkInAt
+	reservation.PaymentAmount = reservation.Price
+	room := &models.Room{Number: "101", HousekeepingStatus: models.HousekeepingStatusInspected}
+	room.ID = 10
+	reservation.Rooms = []models.ReservationRoom{{RoomID: room.ID, Room: room}}
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockReservationRepo.On("Update", auditActionIs(audit.ActionCheckOut), reservation).Return(nil)
+	suite.mockRoomRepo.On("UpdateHousekeepingStatus", mock.Anything, uint(10), models.HousekeepingStatusDirty).Return(nil)
+
+	// When
+	_, err := suite.service.CheckOut(suite.ctx, 1)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(models.HousekeepingStatusDirty, room.HousekeepingStatus)
+	suite.mockRoomRepo.AssertExpectations(suite.T())
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckOut_체크인_전() {
+	reservation := suite.newReservation(today())
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+
+	_, err := suite.service.CheckOut(suite.ctx, 1)
+
+	suite.ErrorIs(err, services.ErrNotCheckedIn)
+}
+
+func (suite *ReservationCheckInOutTestSuite) TestCheckOut_미수금_확인() {
+	testCases := []struct {
+		name          string
+		requireCheck  bool
+		paymentAmount int
+		expectedErr   error
+	}{
+		{"미수금 확인 결제 수단에 미수금이 있으면 실패", true, 150000, services.ErrUnpaidAmountRemaining},
+		{"미수금 확인 결제 수단이라도 완납이면 성공", true, 200000, nil},
+		{"미수금 확인을 하지 않는 결제 수단은 미수금이 있어도 성공", false, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			reservation := suite.newReservation(today())
+			checkInAt := time.Now()
+			reservation.CheckInAt = &checkInAt
+			reservation.PaymentAmount = tc.paymentAmount
+			reservation.PaymentMethod.RequireUnpaidAmountCheck = models.BitBool(tc.requireCheck)
+			suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+			suite.mockReservationRepo.On("Update", mock.Anything, reservation).Return(nil)
+
+			_, err := suite.service.CheckOut(suite.ctx, 1)
+
+			if tc.expectedErr != nil {
+				suite.ErrorIs(err, tc.expectedErr)
+				suite.Nil(reservation.CheckOutAt)
+			} else {
+				suite.NoError(err)
+				suite.NotNil(reservation.CheckOutAt)
+			}
+		})
+	}
+}
+
+func TestReservationCheckInOutTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationCheckInOutTestSuite))
+}
@@ -0,0 +1,173 @@
+//go:build integration
+
+package services_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/database"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// 행 잠금(SELECT ... FOR UPDATE) 동작은 실제 MySQL에서만 검증할 수 있으므로
+// TEST_MYSQL_DSN 환경 변수로 빈 테스트용 데이터베이스를 지정해야 실행된다.
+// 예: TEST_MYSQL_DSN="root:password@tcp(localhost:3306)/rms_test?parseTime=True&loc=UTC" go test -tags integration ./internal/services/
+type ReservationConcurrencyIntegrationTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	db              *gorm.DB
+	service         services.ReservationService
+	paymentMethodID uint
+	roomIDs         []uint
+}
+
+func (s *ReservationConcurrencyIntegrationTestSuite) SetupSuite() {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		s.T().Skip("TEST_MYSQL_DSN이 설정되지 않아 동시 예약 통합 테스트를 건너뜁니다")
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	s.Require().NoError(err)
+	s.Require().NoError(database.Migrate(db))
+	s.db = db
+	s.ctx = context.Background()
+
+	s.Require().NoError(db.Exec(`INSERT IGNORE INTO user (id, email, password, role, name, status, created_at, updated_at, deleted_at, user_id)
+		VALUES (1, NULL, 'x', 127, 'tester', 1, NOW(), NOW(), '1970-01-01 00:00:00', 'concurrency-tester')`).Error)
+
+	paymentMethod := &models.PaymentMethod{Name: "동시성테스트", Status: models.PaymentMethodStatusActive}
+	s.Require().NoError(db.Create(paymentMethod).Error)
+	s.paymentMethodID = paymentMethod.ID
+
+	roomGroup := &models.RoomGroup{Name: "동시성테스트그룹", PeekPrice: 100000, OffPeekPrice: 80000}
+	s.Require().NoError(db.Create(roomGroup).Error)
+
+	for _, number := range []string{"C901", "C902"} {
+		room := &models.Room{Number: number, RoomGroupID: roomGroup.ID, Status: models.RoomStatusNormal}
+		s.Require().NoError(db.Create(room).Error)
+		s.roomIDs = append(s.roomIDs, room.ID)
+	}
+
+	s.service = services.NewReservationService(
+		repositories.NewReservationRepository(db),
+		repositories.NewRoomRepository(db),
+		repositories.NewPaymentMethodRepository(db),
+		nil,
+		nil,
+		repositories.NewDateBlockRepository(db),
+	)
+}
+
+func (s *ReservationConcurrencyIntegrationTestSuite) TearDownSuite() {
+	if s.db == nil {
+		return
+	}
+	s.db.Exec("DELETE FROM reservation_room WHERE room_id IN ?", s.roomIDs)
+	s.db.Exec("DELETE FROM reservation WHERE payment_method_id = ?", s.paymentMethodID)
+	s.db.Exec("DELETE FROM room WHERE id IN ?", s.roomIDs)
+	s.db.Exec("DELETE FROM room_group WHERE name = ?", "동시성테스트그룹")
+	s.db.Exec("DELETE FROM payment_method WHERE id = ?", s.paymentMethodID)
+}
+
+// bookConcurrently는 같은 객실·기간으로 n개의 예약 생성을 동시에 실행하고 각 결과를 반환한다.
+func (s *ReservationConcurrencyIntegrationTestSuite) bookConcurrently(n int, roomIDs []uint, start, end time.Time) []error {
+	results := make([]error, n)
+	ready := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-ready
+			results[i] = s.service.Create(s.ctx, &models.Reservation{
+				PaymentMethodID: s.paymentMethodID,
+				Name:            "동시예약",
+				StayStartAt:     start,
+				StayEndAt:       end,
+				Status:          models.ReservationStatusNormal,
+			}, roomIDs)
+		}(i)
+	}
+
+	close(ready)
+	wg.Wait()
+	return results
+}
+
+func (s *ReservationConcurrencyIntegrationTestSuite) TestCreate_같은_객실을_동시에_예약하면_하나만_성공한다() {
+	// Given - 같은 객실과 기간으로 10건의 예약 요청이 동시에 들어오면
+	start := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2030, 1, 12, 0, 0, 0, 0, time.UTC)
+
+	// When
+	results := s.bookConcurrently(10, []uint{s.roomIDs[0]}, start, end)
+
+	// Then - 정확히 한 건만 성공하고 나머지는 ErrRoomNotAvailable로 실패한다
+	succeeded := 0
+	for _, err := range results {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		s.True(errors.Is(err, services.ErrRoomNotAvailable), "예상하지 못한 에러: %v", err)
+	}
+	s.Equal(1, succeeded)
+
+	var count int64
+	s.NoError(s.db.Table("reservation_room").
+		Joins("JOIN reservation ON reservation.id = reservation_room.reservation_id").
+		Where("reservation_room.room_id = ? AND reservation.stay_start_at = ?", s.roomIDs[0], start).
+		Count(&count).Error)
+	s.Equal(int64(1), count)
+}
+
+func (s *ReservationConcurrencyIntegrationTestSuite) TestCreate_겹치는_객실_조합을_동시에_예약해도_교착_없이_하나만_성공한다() {
+	// Given - 객실 순서를 뒤집은 두 조합으로 동시에 예약하면 (잠금 순서가 다르면 교착 상태가 날 수 있는 상황)
+	start := time.Date(2030, 2, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2030, 2, 12, 0, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	results := make([]error, 2)
+	for i, roomIDs := range [][]uint{{s.roomIDs[0], s.roomIDs[1]}, {s.roomIDs[1], s.roomIDs[0]}} {
+		wg.Add(1)
+		go func(i int, roomIDs []uint) {
+			defer wg.Done()
+			results[i] = s.service.Create(s.ctx, &models.Reservation{
+				PaymentMethodID: s.paymentMethodID,
+				Name:            "교차예약",
+				StayStartAt:     start,
+				StayEndAt:       end,
+				Status:          models.ReservationStatusNormal,
+			}, roomIDs)
+		}(i, roomIDs)
+	}
+	wg.Wait()
+
+	// Then - 한 건은 성공하고 다른 한 건은 ErrRoomNotAvailable로 실패한다
+	succeeded := 0
+	for _, err := range results {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		s.ErrorIs(err, services.ErrRoomNotAvailable)
+	}
+	s.Equal(1, succeeded)
+}
+
+func TestReservationConcurrencyIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationConcurrencyIntegrationTestSuite))
+}
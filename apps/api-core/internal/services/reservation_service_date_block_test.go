@@ -79,6 +79,7 @@ func (s *ReservationServiceDateBlockTestSuite) SetupTest() {
 		s.mockReservationRepo,
 		s.mockRoomRepo,
 		s.mockPaymentMethodRepo,
+		nil,
 		s.mockAuditService,
 		s.mockDateBlockRepo,
 	)
@@ -96,7 +97,8 @@ func (s *ReservationServiceDateBlockTestSuite) TestCreate_차단된_날짜에_
 	paymentMethod.ID = 1
 
 	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(1)).Return(paymentMethod, nil)
-	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, reservation.StayStartAt, reservation.StayEndAt).Return(true, nil)
+	s.mockRoomRepo.On("LockRooms", s.ctx, []uint{1}).Return(nil)
+	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, reservation.StayStartAt, reservation.StayEndAt, []uint{1}).Return(true, nil)
 
 	// When - 예약 생성을 시도하면
 	err := s.service.Create(s.ctx, reservation, []uint{1})
@@ -117,6 +119,7 @@ func (s *ReservationServiceDateBlockTestSuite) TestCreate_차단되지_않은_
 		StayStartAt:     time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC),
 		StayEndAt:       time.Date(2026, 6, 22, 0, 0, 0, 0, time.UTC),
 		Price:           100000,
+		PaymentAmount:   100000,
 		PaymentMethodID: 2,
 	}
 	paymentMethod := &models.PaymentMethod{Status: models.PaymentMethodStatusActive, CommissionRate: 0.1}
@@ -125,9 +128,11 @@ func (s *ReservationServiceDateBlockTestSuite) TestCreate_차단되지_않은_
 	room.ID = 1
 
 	s.mockPaymentMethodRepo.On("FindByID", s.ctx, uint(2)).Return(paymentMethod, nil)
-	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, reservation.StayStartAt, reservation.StayEndAt).Return(false, nil)
+	s.mockRoomRepo.On("LockRooms", s.ctx, []uint{1}).Return(nil)
+	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, reservation.StayStartAt, reservation.StayEndAt, []uint{1}).Return(false, nil)
 	s.mockRoomRepo.On("IsRoomAvailable", s.ctx, uint(1), reservation.StayStartAt, reservation.StayEndAt, (*uint)(nil)).Return(true, nil)
-	s.mockRoomRepo.On("FindByID", s.ctx, uint(1)).Return(room, nil)
+	s.mockRoomRepo.On("FindByIDWithGroup", s.ctx, uint(1)).Return(room, nil)
+	s.mockReservationRepo.On("FindDuplicateCandidates", s.ctx, mock.Anything).Return([]models.Reservation{}, nil)
 	s.mockReservationRepo.On("Create", s.ctx, reservation).Return(reservation, nil)
 
 	// When - 예약 생성을 시도하면
@@ -158,8 +163,9 @@ func (s *ReservationServiceDateBlockTestSuite) TestUpdate_예약_수정_시_날
 		"stayEndAt":   time.Date(2026, 7, 7, 0, 0, 0, 0, time.UTC),
 	}
 
+	s.mockReservationRepo.On("LockRoomIDs", s.ctx, uint(10)).Return([]uint{}, nil)
 	s.mockReservationRepo.On("FindByIDWithDetails", s.ctx, uint(10)).Return(existingReservation, nil)
-	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, updates["stayStartAt"], updates["stayEndAt"]).Return(true, nil)
+	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, updates["stayStartAt"], updates["stayEndAt"], []uint{}).Return(true, nil)
 
 	// When - 예약 수정을 시도하면
 	result, err := s.service.Update(s.ctx, 10, updates, nil, false)
@@ -173,6 +179,31 @@ func (s *ReservationServiceDateBlockTestSuite) TestUpdate_예약_수정_시_날
 	s.mockReservationRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
 }
 
+func (s *ReservationServiceDateBlockTestSuite) TestUpdate_예약_수정_시_차단된_객실로_변경하면_ErrDateRangeBlocked를_반환한다() {
+	// Given - 날짜는 그대로 두고 차단된 객실로 변경하는 수정 요청이 주어지면
+	existingReservation := &models.Reservation{
+		Name:            "최지우",
+		StayStartAt:     time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2026, 7, 12, 0, 0, 0, 0, time.UTC),
+		PaymentMethodID: 1,
+		Rooms:           []models.ReservationRoom{{RoomID: 1}},
+	}
+	existingReservation.ID = 12
+
+	s.mockRoomRepo.On("LockRooms", s.ctx, []uint{2}).Return(nil)
+	s.mockReservationRepo.On("FindByIDWithDetails", s.ctx, uint(12)).Return(existingReservation, nil)
+	s.mockDateBlockRepo.On("IsDateRangeBlocked", s.ctx, existingReservation.StayStartAt, existingReservation.StayEndAt, []uint{2}).Return(true, nil)
+
+	// When - 예약 수정을 시도하면
+	result, err := s.service.Update(s.ctx, 12, map[string]interface{}{}, []uint{2}, true)
+
+	// Then - 변경할 객실 기준으로 차단을 확인해 ErrDateRangeBlocked를 반환한다
+	assert.ErrorIs(s.T(), err, services.ErrDateRangeBlocked)
+	assert.Nil(s.T(), result)
+	s.mockDateBlockRepo.AssertExpectations(s.T())
+	s.mockReservationRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
 func (s *ReservationServiceDateBlockTestSuite) TestUpdate_예약_수정_시_날짜를_변경하지_않으면_차단_검증을_하지_않는다() {
 	// Given - 날짜 변경 없이 메모만 변경하는 수정 요청이 주어지면
 	existingReservation := &models.Reservation{
@@ -199,7 +230,7 @@ func (s *ReservationServiceDateBlockTestSuite) TestUpdate_예약_수정_시_날
 	assert.NotNil(s.T(), result)
 	assert.Equal(s.T(), "요청사항 수정", result.Note)
 	s.mockReservationRepo.AssertExpectations(s.T())
-	s.mockDateBlockRepo.AssertNotCalled(s.T(), "IsDateRangeBlocked", mock.Anything, mock.Anything, mock.Anything)
+	s.mockDateBlockRepo.AssertNotCalled(s.T(), "IsDateRangeBlocked", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestReservationServiceDateBlockTestSuite(t *testing.T) {
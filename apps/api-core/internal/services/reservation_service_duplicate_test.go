@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type ReservationDuplicateTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	service             services.ReservationService
+	mockReservationRepo *MockReservationRepository
+	mockRoomRepo        *MockRoomRepository
+}
+
+func (suite *ReservationDuplicateTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockReservationRepo = new(MockReservationRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+
+	paymentMethodRepo := new(MockPaymentMethodRepository)
+	paymentMethod := &models.PaymentMethod{Name: "현금", Status: models.PaymentMethodStatusActive}
+	paymentMethod.ID = 1
+	paymentMethodRepo.On("FindByID", mock.Anything, uint(1)).Return(paymentMethod, nil)
+
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{2}).Return(nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, uint(2), mock.Anything, mock.Anything, (*uint)(nil)).Return(true, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", mock.Anything, uint(2)).Return(&models.Room{Number: "102"}, nil)
+
+	suite.service = services.NewReservationService(
+		suite.mockReservationRepo,
+		suite.mockRoomRepo,
+		paymentMethodRepo,
+		nil,
+		nil,
+	)
+}
+
+func (suite *ReservationDuplicateTestSuite) reservation(id uint, name, phone string, startDay, endDay int) *models.Reservation {
+	reservation := &models.Reservation{
+		Name:            name,
+		Phone:           phone,
+		StayStartAt:     time.Date(2024, 3, startDay, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2024, 3, endDay, 0, 0, 0, 0, time.UTC),
+		Status:          models.ReservationStatusNormal,
+		PaymentMethodID: 1,
+	}
+	reservation.ID = id
+	return reservation
+}
+
+func (suite *ReservationDuplicateTestSuite) Test_전화번호가_같고_기간이_겹치는_예약이_있으면_만들지_않는다() {
+	// Given - 전화 예약으로 이미 입력된 예약이 있는 상태에서
+	existing := suite.reservation(10, "홍길동", "010-1234-5678", 20, 22)
+	existing.Rooms = []models.ReservationRoom{{RoomID: 1, Room: &models.Room{Number: "101"}}}
+	suite.mockReservationRepo.On("FindDuplicateCandidates", mock.Anything, dto.DuplicateCandidateFilter{
+		StartDate: time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 3, 23, 0, 0, 0, 0, time.UTC),
+		Name:      "홍길동",
+		Phone:     "01012345678",
+	}).Return([]models.Reservation{*existing}, nil)
+
+	// When - OTA 메일을 보고 같은 고객의 예약을 다시 입력하면
+	err := suite.service.Create(suite.ctx, suite.reservation(0, "홍길동", "01012345678", 21, 23), []uint{2})
+
+	// Then - 중복 의심 예약을 담은 오류로 거부된다
+	suite.ErrorIs(err, services.ErrDuplicateReservation)
+	var duplicateErr *services.DuplicateReservationError
+	suite.Require().ErrorAs(err, &duplicateErr)
+	suite.Require().Len(duplicateErr.Duplicates, 1)
+	suite.Equal(uint(10), duplicateErr.Duplicates[0].ReservationID)
+	suite.Equal([]string{"101"}, duplicateErr.Duplicates[0].RoomNumbers)
+	suite.Equal([]string{"PHONE", "NAME"}, duplicateErr.Duplicates[0].MatchedBy)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationDuplicateTestSuite) Test_중복을_확인하면_겹치는_예약이_있어도_만든다() {
+	// Given - 같은 고객의 겹치는 예약이 있어도
+	newReservation := suite.reservation(0, "홍길동", "010-1234-5678", 21, 23)
+	suite.mockReservationRepo.On("Create", mock.Anything, newReservation).Return(newReservation, nil)
+
+	// When - 중복을 확인했다고 표시하고 예약을 만들면
+	err := suite.service.Create(services.WithDuplicateConfirmed(suite.ctx), newReservation, []uint{2})
+
+	// Then - 중복 확인 없이 저장된다
+	suite.NoError(err)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "FindDuplicateCandidates", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationDuplicateTestSuite) Test_보고서는_서로_중복으로_의심되는_예약_쌍을_보여준다() {
+	// Given - 숙박 시작일 순으로 조회된 예약 중 세 쌍이 중복으로 의심되면
+	candidates := []models.Reservation{
+		*suite.reservation(5, "홍길동", "010-1234-5678", 1, 3),
+		*suite.reservation(3, "홍길동", "01012345678", 2, 4),
+		*suite.reservation(4, "김철수", "010-5555-6666", 2, 3),
+		*suite.reservation(6, "김 철수", "010-7777-8888", 2, 5),
+		*suite.reservation(7, "홍길동", "010-1234-5678", 3, 6),
+	}
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockReservationRepo.On("FindDuplicateCandidates", suite.ctx, dto.DuplicateCandidateFilter{StartDate: start, EndDate: end}).
+		Return(candidates, nil)
+
+	// When - 중복 의심 예약 보고서를 조회하면
+	pairs, err := suite.service.GetDuplicateReport(suite.ctx, start, end)
+
+	// Then - 먼저 등록된 예약을 앞에 두고, 퇴실일에 시작해 겹치지 않는 같은 고객의 예약(5와 7)은 빠진다
+	suite.NoError(err)
+	suite.Require().Len(pairs, 3)
+	suite.Equal(uint(3), pairs[0].Reservation.ReservationID)
+	suite.Equal(uint(5), pairs[0].Duplicate.ReservationID)
+	suite.Equal([]string{"PHONE", "NAME"}, pairs[0].MatchedBy)
+	suite.Equal(uint(3), pairs[1].Reservation.ReservationID)
+	suite.Equal(uint(7), pairs[1].Duplicate.ReservationID)
+	suite.Equal(uint(4), pairs[2].Reservation.ReservationID)
+	suite.Equal(uint(6), pairs[2].Duplicate.ReservationID)
+	suite.Equal([]string{"NAME"}, pairs[2].MatchedBy)
+}
+
+func (suite *ReservationDuplicateTestSuite) Test_보고서_기간이_잘못되면_조회하지_않는다() {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := suite.service.GetDuplicateReport(suite.ctx, day, day)
+
+	suite.ErrorIs(err, services.ErrInvalidDateRange)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "FindDuplicateCandidates", mock.Anything, mock.Anything)
+}
+
+func TestReservationDuplicateTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationDuplicateTestSuite))
+}
@@ -62,6 +62,14 @@ func (m *MockReservationRepository) FindAll(ctx context.Context, filter dto.Rese
 	return args.Get(0).([]models.Reservation), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockReservationRepository) FindReceivables(ctx context.Context, filter dto.ReceivableFilter, offset, limit int, sort string) ([]models.Reservation, int64, error) {
+	args := m.Called(ctx, filter, offset, limit, sort)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.Reservation), args.Get(1).(int64), args.Error(2)
+}
+
 func (m *MockReservationRepository) GetStatistics(ctx context.Context, startDate, endDate time.Time, periodType string) ([]repositories.ReservationStatistics, error) {
 	args := m.Called(ctx, startDate, endDate, periodType)
 	if args.Get(0) == nil {
@@ -75,6 +83,19 @@ func (m *MockReservationRepository) DeleteRooms(ctx context.Context, reservation
 	return args.Error(0)
 }
 
+func (m *MockReservationRepository) LockRoomIDs(ctx context.Context, reservationID uint) ([]uint, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uint), args.Error(1)
+}
+
+func (m *MockReservationRepository) DeleteUnpaidRentCharges(ctx context.Context, reservationID uint) error {
+	args := m.Called(ctx, reservationID)
+	return args.Error(0)
+}
+
 func (m *MockReservationRepository) FindLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error) {
 	args := m.Called(ctx, roomID)
 	if args.Get(0) == nil {
@@ -83,6 +104,19 @@ func (m *MockReservationRepository) FindLastReservationForRoom(ctx context.Conte
 	return args.Get(0).(*models.Reservation), args.Error(1)
 }
 
+func (m *MockReservationRepository) FindDuplicateCandidates(ctx context.Context, filter dto.DuplicateCandidateFilter) ([]models.Reservation, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Reservation), args.Error(1)
+}
+
+// Transaction은 별도 트랜잭션 없이 fn을 바로 실행해 호출 컨텍스트를 그대로 전달한다.
+func (m *MockReservationRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 type ReservationServiceTestSuite struct {
 	suite.Suite
 	ctx                   context.Context
@@ -103,6 +137,7 @@ func (suite *ReservationServiceTestSuite) SetupTest() {
 		suite.mockRoomRepo,
 		suite.mockPaymentMethodRepo,
 		nil,
+		nil,
 	)
 }
 
@@ -222,6 +257,8 @@ func (suite *ReservationServiceTestSuite) TestCreate() {
 		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
 		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
 		Price:           200000,
+		Deposit:         50000,
+		PaymentAmount:   150000,
 		Status:          models.ReservationStatusNormal,
 		Type:            models.ReservationTypeStay,
 		PaymentMethodID: 1,
@@ -234,7 +271,7 @@ func (suite *ReservationServiceTestSuite) TestCreate() {
 		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
 		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
 		Price:           200000,
-		BrokerFee:       5000, // 200000 * 2.5%
+		BrokerFee:       5000, // (50000 + 150000) * 2.5%
 		Status:          models.ReservationStatusNormal,
 		Type:            models.ReservationTypeStay,
 		PaymentMethodID: 1,
@@ -249,12 +286,20 @@ func (suite *ReservationServiceTestSuite) TestCreate() {
 
 	// 결제 수단 확인
 	suite.mockPaymentMethodRepo.On("FindByID", suite.ctx, uint(1)).Return(paymentMethod, nil)
-	// 객실 가용성 확인
+	// 객실 잠금 및 가용성 확인
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, roomIDs).Return(nil)
 	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(1), newReservation.StayStartAt, newReservation.StayEndAt, (*uint)(nil)).Return(true, nil)
 	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(2), newReservation.StayStartAt, newReservation.StayEndAt, (*uint)(nil)).Return(true, nil)
 	// 객실 정보 로드
-	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(1)).Return(&models.Room{Number: "101"}, nil)
-	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(2)).Return(&models.Room{Number: "102"}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(&models.Room{Number: "101"}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(2)).Return(&models.Room{Number: "102"}, nil)
+	// 중복 의심 예약 확인
+	suite.mockReservationRepo.On("FindDuplicateCandidates", suite.ctx, dto.DuplicateCandidateFilter{
+		StartDate: newReservation.StayStartAt,
+		EndDate:   newReservation.StayEndAt,
+		Name:      "홍길동",
+		Phone:     "01012345678",
+	}).Return([]models.Reservation{}, nil)
 	// 생성
 	suite.mockReservationRepo.On("Create", suite.ctx, newReservation).Return(createdReservation, nil)
 
@@ -263,7 +308,11 @@ func (suite *ReservationServiceTestSuite) TestCreate() {
 
 	// Then - 정상적으로 생성된다
 	assert.NoError(suite.T(), err)
-	assert.Equal(suite.T(), 5000, newReservation.BrokerFee) // 수수료가 계산됨
+	assert.Equal(suite.T(), 5000, newReservation.BrokerFee) // 결제 내역별 수수료 합계
+	assert.Len(suite.T(), newReservation.Payments, 2)       // 예약금, 결제 금액이 결제 내역으로 기록됨
+	assert.Equal(suite.T(), models.ReservationPaymentTypeDeposit, newReservation.Payments[0].Type)
+	assert.Equal(suite.T(), 1250, newReservation.Payments[0].BrokerFee)
+	assert.Equal(suite.T(), 3750, newReservation.Payments[1].BrokerFee)
 	assert.Len(suite.T(), newReservation.Rooms, 2)
 	assert.NotNil(suite.T(), newReservation.PaymentMethod)
 	assert.Equal(suite.T(), "신용카드", newReservation.PaymentMethod.Name)
@@ -291,6 +340,25 @@ func (suite *ReservationServiceTestSuite) TestCreate_InvalidDateRange() {
 	assert.Equal(suite.T(), services.ErrInvalidDateRange, err)
 }
 
+func (suite *ReservationServiceTestSuite) TestCreate_전화번호_형식이_맞지_않으면_거부된다() {
+	// Given - 자릿수가 모자란 전화번호로 예약 시도
+	newReservation := &models.Reservation{
+		Name:            "홍길동",
+		Phone:           "010-123-456",
+		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+		PaymentMethodID: 1,
+	}
+
+	// When - 예약을 생성하면
+	err := suite.service.Create(suite.ctx, newReservation, []uint{1})
+
+	// Then - 전화번호 형식 에러가 발생하고 아무것도 조회하지 않는다
+	assert.ErrorIs(suite.T(), err, services.ErrInvalidPhone)
+	suite.mockPaymentMethodRepo.AssertNotCalled(suite.T(), "FindByID", mock.Anything, mock.Anything)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "LockRooms", mock.Anything, mock.Anything)
+}
+
 func (suite *ReservationServiceTestSuite) TestCreate_RoomNotAvailable() {
 	// Given - 이미 예약된 객실로 예약 시도
 	paymentMethod := &models.PaymentMethod{
@@ -311,6 +379,7 @@ func (suite *ReservationServiceTestSuite) TestCreate_RoomNotAvailable() {
 	// 결제 수단 확인
 	suite.mockPaymentMethodRepo.On("FindByID", suite.ctx, uint(1)).Return(paymentMethod, nil)
 	// 객실 가용성 확인 - 사용 불가
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, roomIDs).Return(nil)
 	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(1), newReservation.StayStartAt, newReservation.StayEndAt, (*uint)(nil)).Return(false, nil)
 
 	// When - 예약을 생성하면
@@ -323,6 +392,71 @@ func (suite *ReservationServiceTestSuite) TestCreate_RoomNotAvailable() {
 	suite.mockRoomRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ReservationServiceTestSuite) TestCreate_예약_인원이_최대_인원을_넘으면_거부된다() {
+	// Given - 최대 4명인 객실 그룹에 객실 하나는 최대 2명으로 줄여 둔 상태에서 7명 예약을 시도하면
+	paymentMethod := &models.PaymentMethod{
+		Name:   "신용카드",
+		Status: models.PaymentMethodStatusActive,
+	}
+	paymentMethod.ID = 1
+
+	newReservation := &models.Reservation{
+		Name:            "홍길동",
+		PeopleCount:     7,
+		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+		PaymentMethodID: 1,
+	}
+
+	roomGroup := &models.RoomGroup{Name: "디럭스", StandardOccupancy: 2, MaxOccupancy: 4}
+	smallMax := 2
+	roomIDs := []uint{1, 2}
+
+	suite.mockPaymentMethodRepo.On("FindByID", suite.ctx, uint(1)).Return(paymentMethod, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, roomIDs).Return(nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(1), newReservation.StayStartAt, newReservation.StayEndAt, (*uint)(nil)).Return(true, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(2), newReservation.StayStartAt, newReservation.StayEndAt, (*uint)(nil)).Return(true, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(&models.Room{Number: "101", RoomGroup: roomGroup}, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(2)).Return(&models.Room{Number: "102", RoomGroup: roomGroup, MaxOccupancy: &smallMax}, nil)
+
+	// When - 예약을 생성하면
+	err := suite.service.Create(suite.ctx, newReservation, roomIDs)
+
+	// Then - 최대 인원 합계(4+2=6명)를 넘으므로 저장하지 않고 거부한다
+	assert.ErrorIs(suite.T(), err, services.ErrPeopleCountExceeded)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationServiceTestSuite) TestCreate_객실_잠금_실패() {
+	// Given - 객실 잠금 획득에 실패하는 상황에서 (예: 잠금 대기 시간 초과)
+	paymentMethod := &models.PaymentMethod{
+		Name:   "신용카드",
+		Status: models.PaymentMethodStatusActive,
+	}
+	paymentMethod.ID = 1
+
+	newReservation := &models.Reservation{
+		Name:            "홍길동",
+		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+		PaymentMethodID: 1,
+	}
+
+	roomIDs := []uint{1}
+	lockErr := errors.New("Lock wait timeout exceeded")
+
+	suite.mockPaymentMethodRepo.On("FindByID", suite.ctx, uint(1)).Return(paymentMethod, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, roomIDs).Return(lockErr)
+
+	// When - 예약을 생성하면
+	err := suite.service.Create(suite.ctx, newReservation, roomIDs)
+
+	// Then - 가용성 검증이나 저장 없이 에러가 반환된다
+	assert.ErrorIs(suite.T(), err, lockErr)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "IsRoomAvailable", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
 func (suite *ReservationServiceTestSuite) TestUpdate() {
 	// Given - 예약이 등록된 상황에서
 	existingReservation := &models.Reservation{
@@ -364,6 +498,37 @@ func (suite *ReservationServiceTestSuite) TestUpdate() {
 	suite.mockReservationRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ReservationServiceTestSuite) TestUpdate_전화번호를_저장_형식으로_바꾼다() {
+	// Given - 예약이 등록된 상황에서
+	existingReservation := &models.Reservation{
+		Name:            "홍길동",
+		Phone:           "010-1234-5678",
+		StayStartAt:     time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC),
+		Status:          models.ReservationStatusNormal,
+		PaymentMethodID: 1,
+		Rooms:           []models.ReservationRoom{{RoomID: 1}},
+	}
+	existingReservation.ID = 1
+
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockReservationRepo.On("Update", suite.ctx, existingReservation).Return(nil)
+
+	// When - 국가 번호를 붙인 전화번호로 수정하면
+	result, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"phone": "+82 10 9876 5432"}, nil, false)
+
+	// Then - 국내 번호 형식으로 저장된다
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "010-9876-5432", result.Phone)
+
+	// When - 형식에 맞지 않는 전화번호로 수정하면
+	_, err = suite.service.Update(suite.ctx, 1, map[string]interface{}{"phone": "12345"}, nil, false)
+
+	// Then - 전화번호 형식 에러가 발생한다
+	assert.ErrorIs(suite.T(), err, services.ErrInvalidPhone)
+	suite.mockReservationRepo.AssertNumberOfCalls(suite.T(), "Update", 1)
+}
+
 func (suite *ReservationServiceTestSuite) TestUpdate_NotFound() {
 	// Given - 존재하지 않는 예약에 대해
 	updates := map[string]interface{}{}
@@ -420,6 +585,7 @@ func (suite *ReservationServiceTestSuite) TestUpdate_결제수단변경시_Payme
 			Status:         models.PaymentMethodStatusActive,
 		},
 		Price:       100000,
+		BrokerFee:   10000,
 		StayStartAt: now.Add(24 * time.Hour),
 		StayEndAt:   now.Add(48 * time.Hour),
 	}
@@ -452,7 +618,7 @@ func (suite *ReservationServiceTestSuite) TestUpdate_결제수단변경시_Payme
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), uint(3), existingReservation.PaymentMethodID)
-	assert.Equal(suite.T(), 20000, existingReservation.BrokerFee) // 100000 * 0.2
+	assert.Equal(suite.T(), 10000, existingReservation.BrokerFee) // 중개 수수료는 결제 내역 기준이라 결제 수단을 바꿔도 유지된다
 
 	// 이 부분에서 실패할 것으로 예상됨 (버그: PaymentMethod가 nil이 아니면 GORM이 예전 값을 다시 쓸 수 있음)
 	// 서비스 로직에서 reservation.PaymentMethod = nil 처리가 누락되어 있음
@@ -646,6 +812,7 @@ func (suite *ReservationServiceTestSuite) TestUpdate_기간변경과_객실재
 
 	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
 	excludeID := uint(1)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{1}).Return(nil)
 	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(1), newStayStartAt, newStayEndAt, &excludeID).Return(false, nil)
 
 	// When - 충돌하는 기간으로 수정하고 객실도 재배정(hasRoomsUpdate=true)하면
@@ -677,6 +844,7 @@ func (suite *ReservationServiceTestSuite) TestUpdate_객실변경시_새객실_
 
 	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
 	excludeID := uint(1)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, newRoomIDs).Return(nil)
 	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(2), existingReservation.StayStartAt, existingReservation.StayEndAt, &excludeID).Return(false, nil)
 
 	// When - 충돌하는 객실로 변경을 시도하면
@@ -830,6 +998,326 @@ func (suite *ReservationServiceTestSuite) TestGetLastReservationForRoom_NoReserv
 	suite.mockReservationRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ReservationServiceTestSuite) TestUpdate_금액을_늘리면_차액을_결제_내역으로_기록한다() {
+	// Given - 예약금 50,000원이 입금된 예약에서
+	existingReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		PaymentMethod:   &models.PaymentMethod{CommissionRate: 0.1, Status: models.PaymentMethodStatusActive},
+		Price:           200000,
+		Deposit:         50000,
+		BrokerFee:       5000,
+		StayStartAt:     time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}
+	existingReservation.ID = 1
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockReservationRepo.On("Update", suite.ctx, existingReservation).Return(nil).Once()
+
+	// When - 결제 금액을 150,000원으로 수정하면
+	_, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"deposit": 50000, "paymentAmount": 150000}, nil, false)
+
+	// Then - 결제 금액 내역 한 건만 추가되고 수수료가 더해진다
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), existingReservation.Payments, 1)
+	assert.Equal(suite.T(), models.ReservationPaymentTypePayment, existingReservation.Payments[0].Type)
+	assert.Equal(suite.T(), 150000, existingReservation.Payments[0].Amount)
+	assert.Equal(suite.T(), 150000, existingReservation.PaymentAmount)
+	assert.Equal(suite.T(), 20000, existingReservation.BrokerFee)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_결제수단을_바꾸면_새로_기록하는_결제_내역에만_적용한다() {
+	// Given - 수수료율 10% 결제 수단으로 예약금 50,000원이 입금된 예약에서
+	existingReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		PaymentMethod:   &models.PaymentMethod{CommissionRate: 0.1, Status: models.PaymentMethodStatusActive},
+		Price:           200000,
+		Deposit:         50000,
+		BrokerFee:       5000,
+		StayStartAt:     time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}
+	existingReservation.ID = 1
+	newPaymentMethod := &models.PaymentMethod{CommissionRate: 0.2, Status: models.PaymentMethodStatusActive}
+	newPaymentMethod.ID = 3
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockPaymentMethodRepo.On("FindByID", suite.ctx, uint(3)).Return(newPaymentMethod, nil)
+	suite.mockReservationRepo.On("Update", suite.ctx, existingReservation).Return(nil).Once()
+
+	// When - 수수료율 20% 결제 수단으로 바꾸면서 결제 금액 100,000원을 기록하면
+	_, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"paymentMethodId": uint(3), "paymentAmount": 100000}, nil, false)
+
+	// Then - 기존 예약금의 수수료는 그대로 두고 새 결제 내역만 새 결제 수단의 수수료율로 계산한다
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), existingReservation.Payments, 1)
+	assert.Equal(suite.T(), uint(3), existingReservation.Payments[0].PaymentMethodID)
+	assert.Equal(suite.T(), 20000, existingReservation.Payments[0].BrokerFee)
+	assert.Equal(suite.T(), 5000+20000, existingReservation.BrokerFee)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_금액을_줄이면_에러() {
+	existingReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		Deposit:         50000,
+		StayStartAt:     time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+	}
+	existingReservation.ID = 1
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+
+	_, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"deposit": 30000}, nil, false)
+
+	assert.ErrorIs(suite.T(), err, services.ErrPaymentAmountDecrease)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_정산이_끝난_예약의_금액을_바꾸면_에러() {
+	// Given - 중개 수수료 정산에 포함된 예약에서
+	settlementID := uint(3)
+	existingReservation := &models.Reservation{
+		PaymentMethodID:       1,
+		Price:                 200000,
+		StayStartAt:           time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:             time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+		BrokerFeeSettlementID: &settlementID,
+	}
+	existingReservation.ID = 1
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+
+	// When - 판매 금액을 바꾸면
+	_, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"price": 250000}, nil, false)
+
+	// Then - 수정할 수 없다
+	assert.ErrorIs(suite.T(), err, services.ErrReservationSettled)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_정산이_끝난_예약도_금액_외_필드는_수정한다() {
+	// Given - 중개 수수료 정산에 포함된 예약에서
+	settlementID := uint(3)
+	existingReservation := &models.Reservation{
+		PaymentMethodID:       1,
+		Price:                 200000,
+		StayStartAt:           time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:             time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC),
+		BrokerFeeSettlementID: &settlementID,
+	}
+	existingReservation.ID = 1
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockReservationRepo.On("Update", suite.ctx, existingReservation).Return(nil)
+
+	// When - 같은 판매 금액과 함께 메모만 바꾸면
+	_, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"price": 200000, "note": "늦은 체크인"}, nil, false)
+
+	// Then - 정상적으로 수정된다
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "늦은 체크인", existingReservation.Note)
+}
+
+func (suite *ReservationServiceTestSuite) TestDelete_정산이_끝난_예약이면_에러() {
+	settlementID := uint(3)
+	reservation := &models.Reservation{Name: "홍길동", BrokerFeeSettlementID: &settlementID}
+	reservation.ID = 1
+	suite.mockReservationRepo.On("FindByID", suite.ctx, uint(1)).Return(reservation, nil)
+
+	err := suite.service.Delete(suite.ctx, 1)
+
+	assert.ErrorIs(suite.T(), err, services.ErrReservationSettled)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationServiceTestSuite) TestCreate_달방_예약이면_월별_청구를_만든다() {
+	// Given - 3개월 달방 예약을 생성하면
+	paymentMethod := &models.PaymentMethod{Name: "계좌이체", Status: models.PaymentMethodStatusActive}
+	paymentMethod.ID = 1
+	newReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		Price:           1500000,
+		Type:            models.ReservationTypeMonthlyRent,
+		StayStartAt:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	suite.mockPaymentMethodRepo.On("FindByID", suite.ctx, uint(1)).Return(paymentMethod, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{1}).Return(nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(1), newReservation.StayStartAt, newReservation.StayEndAt, (*uint)(nil)).Return(true, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", suite.ctx, uint(1)).Return(&models.Room{Number: "101"}, nil)
+	suite.mockReservationRepo.On("Create", suite.ctx, newReservation).Return(newReservation, nil)
+
+	// When
+	err := suite.service.Create(suite.ctx, newReservation, []uint{1})
+
+	// Then - 월 500,000원씩 3개월 청구가 함께 저장된다
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), newReservation.RentCharges, 3)
+	assert.Equal(suite.T(), 500000, newReservation.RentCharges[0].Amount)
+	assert.Equal(suite.T(), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), newReservation.RentCharges[2].DueDate)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_달방_기간을_늘리면_납부된_청구는_두고_나머지를_다시_만든다() {
+	// Given - 첫 달을 납부한 3개월 달방 예약에서
+	paidAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	existingReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		Price:           1500000,
+		Type:            models.ReservationTypeMonthlyRent,
+		StayStartAt:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	existingReservation.ID = 1
+	existingReservation.RentCharges = models.BuildRentCharges(existingReservation, nil)
+	existingReservation.RentCharges[0].PaidAt = &paidAt
+	suite.mockReservationRepo.On("LockRoomIDs", suite.ctx, uint(1)).Return([]uint{}, nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockReservationRepo.On("DeleteUnpaidRentCharges", suite.ctx, uint(1)).Return(nil)
+	suite.mockReservationRepo.On("Update", suite.ctx, existingReservation).Return(nil)
+
+	// When - 한 달 늘리고 판매 금액을 2,000,000원으로 바꾸면
+	updates := map[string]interface{}{
+		"stayEndAt": time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		"price":     2000000,
+	}
+	_, err := suite.service.Update(suite.ctx, 1, updates, nil, false)
+
+	// Then - 납부되지 않은 청구는 지우고 남은 1,500,000원을 2~4회차로 다시 만든다
+	assert.NoError(suite.T(), err)
+	suite.mockReservationRepo.AssertCalled(suite.T(), "DeleteUnpaidRentCharges", suite.ctx, uint(1))
+	assert.Len(suite.T(), existingReservation.RentCharges, 3)
+	assert.Equal(suite.T(), 2, existingReservation.RentCharges[0].Sequence)
+	assert.Equal(suite.T(), 500000, existingReservation.RentCharges[0].Amount)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_객실을_보내지_않고_퇴실일만_늘려도_늘어난_숙박일을_확인한다() {
+	// Given - 5/1~5/3 예약의 객실은 그대로 두고 퇴실일만 5/5로 늘리면
+	existingReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		Status:          models.ReservationStatusNormal,
+		StayStartAt:     time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC),
+		Rooms:           []models.ReservationRoom{{RoomID: 1}},
+	}
+	existingReservation.ID = 1
+	newStayEndAt := time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC)
+	excludeID := uint(1)
+
+	suite.mockReservationRepo.On("LockRoomIDs", suite.ctx, uint(1)).Return([]uint{1}, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{1}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(1), existingReservation.StayEndAt, newStayEndAt, &excludeID).Return(false, nil)
+
+	// When
+	result, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"stayEndAt": newStayEndAt}, nil, false)
+
+	// Then - 5/3~5/5만 확인하고, 다른 예약과 겹치면 수정하지 않는다
+	assert.ErrorIs(suite.T(), err, services.ErrRoomNotAvailable)
+	assert.Nil(suite.T(), result)
+	suite.mockRoomRepo.AssertExpectations(suite.T())
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationServiceTestSuite) TestUpdate_숙박_기간만_바꾸면_트랜잭션_안에서_지금_객실을_읽어_잠근다() {
+	// Given - 그사이 101호에서 102호로 옮겨진 예약의 퇴실일만 늘리면
+	existingReservation := &models.Reservation{
+		PaymentMethodID: 1,
+		Status:          models.ReservationStatusNormal,
+		StayStartAt:     time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:       time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC),
+		Rooms:           []models.ReservationRoom{{RoomID: 2}},
+	}
+	existingReservation.ID = 1
+	newStayEndAt := time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC)
+
+	suite.mockReservationRepo.On("LockRoomIDs", suite.ctx, uint(1)).Return([]uint{2}, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", suite.ctx, uint(1)).Return(existingReservation, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(2), mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+
+	// When
+	_, err := suite.service.Update(suite.ctx, 1, map[string]interface{}{"stayEndAt": newStayEndAt}, nil, false)
+
+	// Then - 잠금 조회로 읽은 102호를 잠근 뒤에 예약을 조회한다
+	assert.ErrorIs(suite.T(), err, services.ErrRoomNotAvailable)
+	suite.mockRoomRepo.AssertCalled(suite.T(), "LockRooms", suite.ctx, []uint{2})
+	suite.Equal("LockRoomIDs", suite.mockReservationRepo.Calls[0].Method)
+	suite.mockReservationRepo.AssertNumberOfCalls(suite.T(), "FindByIDWithDetails", 1)
+}
+
+func (suite *ReservationServiceTestSuite) newMoveRoomReservation() *models.Reservation {
+	reservation := &models.Reservation{
+		Name:        "홍길동",
+		StayStartAt: time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC),
+		StayEndAt:   time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC),
+		Status:      models.ReservationStatusNormal,
+		Rooms: []models.ReservationRoom{
+			{RoomID: 1, StayStartAt: time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC), StayEndAt: time.Date(2030, 5, 5, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	reservation.ID = 1
+	return reservation
+}
+
+func (suite *ReservationServiceTestSuite) TestMoveRoom_옮기는_날짜부터_새_객실을_사용한다() {
+	// Given - 5/1~5/5 숙박 중 5/3부터 2번 객실로 옮길 때
+	reservation := suite.newMoveRoomReservation()
+	moveDate := time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC)
+	toRoom := &models.Room{Number: "102"}
+	toRoom.ID = 2
+	excludeID := uint(1)
+
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", mock.Anything, uint(2)).Return(toRoom, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, uint(2), moveDate, reservation.StayEndAt, &excludeID).Return(true, nil)
+	suite.mockReservationRepo.On("Update", mock.Anything, mock.MatchedBy(func(r *models.Reservation) bool {
+		return len(r.Rooms) == 2 && r.Rooms[0].StayEndAt.Equal(moveDate) &&
+			r.Rooms[1].RoomID == 2 && r.Rooms[1].StayStartAt.Equal(moveDate)
+	})).Return(nil)
+
+	// When
+	result, err := suite.service.MoveRoom(suite.ctx, 1, 1, 2, moveDate)
+
+	// Then - 기존 구간은 5/3에 끝나고 5/3~5/5 구간이 추가된다
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.HasRoomMoves())
+	suite.mockReservationRepo.AssertExpectations(suite.T())
+	suite.mockRoomRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReservationServiceTestSuite) TestMoveRoom_옮길_객실이_사용_중이면_실패한다() {
+	// Given
+	reservation := suite.newMoveRoomReservation()
+	moveDate := time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC)
+	toRoom := &models.Room{Number: "102"}
+	toRoom.ID = 2
+	excludeID := uint(1)
+
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+	suite.mockRoomRepo.On("FindByIDWithGroup", mock.Anything, uint(2)).Return(toRoom, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, uint(2), moveDate, reservation.StayEndAt, &excludeID).Return(false, nil)
+
+	// When
+	result, err := suite.service.MoveRoom(suite.ctx, 1, 1, 2, moveDate)
+
+	// Then
+	assert.ErrorIs(suite.T(), err, services.ErrRoomNotAvailable)
+	assert.Nil(suite.T(), result)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *ReservationServiceTestSuite) TestMoveRoom_취소된_예약이면_실패한다() {
+	// Given
+	reservation := suite.newMoveRoomReservation()
+	reservation.Status = models.ReservationStatusCancel
+
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(1)).Return(reservation, nil)
+
+	// When
+	_, err := suite.service.MoveRoom(suite.ctx, 1, 1, 2, time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC))
+
+	// Then
+	assert.ErrorIs(suite.T(), err, services.ErrRoomMoveInactive)
+}
+
 func TestReservationServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(ReservationServiceTestSuite))
 }
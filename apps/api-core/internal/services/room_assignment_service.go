@@ -0,0 +1,541 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrNoRoomToAssign             = errors.New("배정할 수 있는 객실이 없습니다")
+	ErrInvalidRoomAssignment      = errors.New("잘못된 객실 배정 요청")
+	ErrRoomAssignmentStale        = errors.New("제안 이후 예약 객실이 바뀌었습니다")
+	ErrRoomAssignmentRangeTooLong = errors.New("객실 배정은 최대 92일 기간까지 제안할 수 있습니다")
+)
+
+const (
+	// maxRoomAssignmentDays는 객실 배정을 한 번에 제안할 수 있는 최대 기간(일)입니다.
+	maxRoomAssignmentDays = 92
+	// roomAssignmentGapCapDays는 객실 배정 시 앞뒤 예약과의 빈 날을 셀 때 보는 최대 일수입니다.
+	// 이보다 긴 공백은 다른 예약으로 채울 수 있으므로 모두 같은 값으로 봅니다.
+	roomAssignmentGapCapDays = 14
+
+	unassignedReasonNoRoom      = "빈 객실이 없어 배정하지 못했습니다"
+	unassignedReasonNoRoomGroup = "객실 그룹을 알 수 없어 배정하지 못했습니다"
+)
+
+// RoomAssignmentService는 기간 안의 예약 객실 배정을 다시 계산해 제안하고, 검토한 제안을 적용합니다.
+type RoomAssignmentService interface {
+	Propose(ctx context.Context, req dto.RoomAssignmentProposalRequest) (*dto.RoomAssignmentProposalResponse, error)
+	Apply(ctx context.Context, req dto.ApplyRoomAssignmentRequest) error
+}
+
+type roomAssignmentService struct {
+	reservationRepo repositories.ReservationRepository
+	roomRepo        repositories.RoomRepository
+	dateBlockRepo   repositories.DateBlockRepository
+	roomHoldRepo    repositories.RoomHoldRepository
+	scheduleRepo    repositories.RoomStatusScheduleRepository
+}
+
+func NewRoomAssignmentService(reservationRepo repositories.ReservationRepository, roomRepo repositories.RoomRepository,
+	dateBlockRepo repositories.DateBlockRepository, roomHoldRepo repositories.RoomHoldRepository,
+	scheduleRepo repositories.RoomStatusScheduleRepository) RoomAssignmentService {
+	return &roomAssignmentService{
+		reservationRepo: reservationRepo,
+		roomRepo:        roomRepo,
+		dateBlockRepo:   dateBlockRepo,
+		roomHoldRepo:    roomHoldRepo,
+		scheduleRepo:    scheduleRepo,
+	}
+}
+
+// roomSchedule은 객실별로 이미 사용 중인 [start, end) 기간 목록입니다.
+type roomSchedule map[uint][]assignmentInterval
+
+type assignmentInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+func (s roomSchedule) add(roomID uint, start, end time.Time) {
+	s[roomID] = append(s[roomID], assignmentInterval{start: truncateToDate(start), end: truncateToDate(end)})
+}
+
+func (s roomSchedule) isFree(roomID uint, start, end time.Time) bool {
+	for _, interval := range s[roomID] {
+		if interval.start.Before(end) && interval.end.After(start) {
+			return false
+		}
+	}
+	return true
+}
+
+// gapCost는 [start, end)를 객실에 넣었을 때 앞뒤 사용 기간과 남는 빈 날 수의 합입니다.
+// 값이 작을수록 객실 사이에 팔기 어려운 짧은 공백을 덜 만듭니다.
+func (s roomSchedule) gapCost(roomID uint, start, end time.Time) int {
+	before, after := roomAssignmentGapCapDays, roomAssignmentGapCapDays
+	for _, interval := range s[roomID] {
+		if !interval.end.After(start) {
+			if days := int(start.Sub(interval.end).Hours() / 24); days < before {
+				before = days
+			}
+		}
+		if !interval.start.Before(end) {
+			if days := int(interval.start.Sub(end).Hours() / 24); days < after {
+				after = days
+			}
+		}
+	}
+	return before + after
+}
+
+// selectBestFitRoom은 rooms 중 [start, end)에 비어 있고 gapCost가 가장 작은 객실을 고릅니다.
+// 같으면 preferredRoomID, 그다음 rooms 순서를 따르며, 빈 객실이 없으면 0을 반환합니다.
+func selectBestFitRoom(schedule roomSchedule, rooms []models.Room, start, end time.Time, preferredRoomID uint) uint {
+	var selected uint
+	bestCost := 0
+	for _, room := range rooms {
+		if !schedule.isFree(room.ID, start, end) {
+			continue
+		}
+		cost := schedule.gapCost(room.ID, start, end)
+		if selected == 0 || cost < bestCost || (cost == bestCost && room.ID == preferredRoomID) {
+			selected, bestCost = room.ID, cost
+		}
+	}
+	return selected
+}
+
+// assignmentUnit은 배정 대상 예약의 객실 하나입니다. 객실 없이 객실 그룹으로 예약한 경우 currentRoomID가 0입니다.
+type assignmentUnit struct {
+	reservation    *models.Reservation
+	roomGroupID    uint
+	currentRoomID  uint
+	assignedRoomID uint
+}
+
+// planRoomAssignment는 units에 객실을 배정합니다. 숙박 시작일 순(같으면 긴 숙박 먼저)으로 앞뒤 공백이 가장 적은 객실을 고르며,
+// reshuffle이 false면 지금 객실에 그대로 둘 수 있는 예약을 먼저 고정해 객실 변경을 최소화합니다.
+// 배정한 기간은 schedule에 추가되고, 빈 객실이 없는 unit은 assignedRoomID가 0으로 남습니다.
+func planRoomAssignment(units []*assignmentUnit, roomsByGroup map[uint][]models.Room, schedule roomSchedule, reshuffle bool) {
+	sort.SliceStable(units, func(i, j int) bool {
+		a, b := units[i].reservation, units[j].reservation
+		if !a.StayStartAt.Equal(b.StayStartAt) {
+			return a.StayStartAt.Before(b.StayStartAt)
+		}
+		if !a.StayEndAt.Equal(b.StayEndAt) {
+			return a.StayEndAt.After(b.StayEndAt)
+		}
+		return a.ID < b.ID
+	})
+
+	if !reshuffle {
+		for _, unit := range units {
+			if unit.currentRoomID == 0 || !containsRoom(roomsByGroup[unit.roomGroupID], unit.currentRoomID) {
+				continue
+			}
+			if schedule.isFree(unit.currentRoomID, unit.reservation.StayStartAt, unit.reservation.StayEndAt) {
+				unit.assignedRoomID = unit.currentRoomID
+				schedule.add(unit.currentRoomID, unit.reservation.StayStartAt, unit.reservation.StayEndAt)
+			}
+		}
+	}
+
+	for _, unit := range units {
+		if unit.assignedRoomID != 0 {
+			continue
+		}
+		start, end := truncateToDate(unit.reservation.StayStartAt), truncateToDate(unit.reservation.StayEndAt)
+		unit.assignedRoomID = selectBestFitRoom(schedule, roomsByGroup[unit.roomGroupID], start, end, unit.currentRoomID)
+		if unit.assignedRoomID != 0 {
+			schedule.add(unit.assignedRoomID, start, end)
+		}
+	}
+}
+
+func containsRoom(rooms []models.Room, roomID uint) bool {
+	for _, room := range rooms {
+		if room.ID == roomID {
+			return true
+		}
+	}
+	return false
+}
+
+// Propose는 [StartDate, EndDate) 기간에 숙박을 시작하는 체크인 전 정상/대기 예약의 객실 배정을 다시 계산합니다.
+// 그 밖의 예약, 날짜 차단, 다른 사용자의 임시 홀드, 객실 상태 예약을 반영해 정상(NORMAL)이 아닌 날은 옮길 수 없는 사용 기간으로 봅니다.
+// 예약은 지금 객실과 같은 객실 그룹 안에서만 옮기며, 객실 없이 객실 그룹으로 예약한 경우 요청 객실 그룹에서 객실 하나를 배정합니다.
+func (s *roomAssignmentService) Propose(ctx context.Context, req dto.RoomAssignmentProposalRequest) (*dto.RoomAssignmentProposalResponse, error) {
+	startDate := truncateToDate(req.StartDate)
+	endDate := truncateToDate(req.EndDate)
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidDateRange
+	}
+	if endDate.Sub(startDate) > maxRoomAssignmentDays*24*time.Hour {
+		return nil, ErrRoomAssignmentRangeTooLong
+	}
+
+	lookbackStart := startDate.AddDate(0, 0, -roomAssignmentGapCapDays)
+	reservations, err := s.findOccupyingReservations(ctx, lookbackStart, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 옮길 예약이 기간 뒤까지 이어지면 그 기간의 예약과 날짜 차단도 봐야 한다
+	horizon := endDate
+	for i := range reservations {
+		if isMovableReservation(&reservations[i], startDate, endDate) && reservations[i].StayEndAt.After(horizon) {
+			horizon = truncateToDate(reservations[i].StayEndAt)
+		}
+	}
+	horizon = horizon.AddDate(0, 0, roomAssignmentGapCapDays)
+	if reservations, err = s.findOccupyingReservations(ctx, lookbackStart, horizon); err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.roomRepo.FindAllWithDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roomsByGroup := make(map[uint][]models.Room)
+	roomNumbers := make(map[uint]string)
+	for _, room := range rooms {
+		roomsByGroup[room.RoomGroupID] = append(roomsByGroup[room.RoomGroupID], room)
+		roomNumbers[room.ID] = room.Number
+	}
+
+	schedule := make(roomSchedule)
+	var units []*assignmentUnit
+	var unassignable []*models.Reservation
+	for i := range reservations {
+		reservation := &reservations[i]
+		movable := isMovableReservation(reservation, startDate, endDate)
+
+		if len(reservation.Rooms) == 0 && movable {
+			if reservation.RoomGroupID == nil {
+				if req.RoomGroupID == nil {
+					unassignable = append(unassignable, reservation)
+				}
+				continue
+			}
+			if req.RoomGroupID == nil || *req.RoomGroupID == *reservation.RoomGroupID {
+				units = append(units, &assignmentUnit{reservation: reservation, roomGroupID: *reservation.RoomGroupID})
+			}
+			continue
+		}
+
+		for _, reservationRoom := range reservation.Rooms {
+			if reservationRoom.Room != nil {
+				roomNumbers[reservationRoom.RoomID] = reservationRoom.Room.Number
+			}
+			roomGroupID, ok := reservationRoomGroupID(reservation, &reservationRoom)
+			if movable && ok && (req.RoomGroupID == nil || *req.RoomGroupID == roomGroupID) {
+				units = append(units, &assignmentUnit{reservation: reservation, roomGroupID: roomGroupID, currentRoomID: reservationRoom.RoomID})
+				continue
+			}
+			stayStartAt, stayEndAt := reservationRoom.StayPeriod(reservation)
+			schedule.add(reservationRoom.RoomID, stayStartAt, stayEndAt)
+		}
+	}
+
+	if err := s.addUnavailablePeriods(ctx, schedule, rooms, lookbackStart, horizon); err != nil {
+		return nil, err
+	}
+
+	planRoomAssignment(units, roomsByGroup, schedule, req.Reshuffle)
+
+	proposal := &dto.RoomAssignmentProposalResponse{
+		StartDate:   dto.JSONDate{Time: startDate},
+		EndDate:     dto.JSONDate{Time: endDate},
+		Assignments: []dto.RoomAssignmentResponse{},
+		Unassigned:  []dto.UnassignedReservationResponse{},
+	}
+
+	unitsByReservation := make(map[uint][]*assignmentUnit)
+	var order []*models.Reservation
+	for _, unit := range units {
+		if _, exists := unitsByReservation[unit.reservation.ID]; !exists {
+			order = append(order, unit.reservation)
+		}
+		unitsByReservation[unit.reservation.ID] = append(unitsByReservation[unit.reservation.ID], unit)
+	}
+
+	for _, reservation := range order {
+		fromRoomIDs := reservation.RoomIDs()
+		toRoomIDs := make([]uint, 0, len(fromRoomIDs))
+		moved := make(map[uint]uint)
+		failed := false
+		for _, unit := range unitsByReservation[reservation.ID] {
+			if unit.assignedRoomID == 0 {
+				failed = true
+				break
+			}
+			if unit.currentRoomID == 0 {
+				toRoomIDs = append(toRoomIDs, unit.assignedRoomID)
+			} else {
+				moved[unit.currentRoomID] = unit.assignedRoomID
+			}
+		}
+		if failed {
+			proposal.Unassigned = append(proposal.Unassigned, toUnassignedReservationResponse(reservation, unassignedReasonNoRoom))
+			continue
+		}
+
+		changed := len(toRoomIDs) > 0
+		for _, roomID := range fromRoomIDs {
+			toRoomID := roomID
+			if assigned, ok := moved[roomID]; ok {
+				toRoomID = assigned
+			}
+			changed = changed || toRoomID != roomID
+			toRoomIDs = append(toRoomIDs, toRoomID)
+		}
+		if !changed {
+			continue
+		}
+
+		proposal.Assignments = append(proposal.Assignments, dto.RoomAssignmentResponse{
+			ReservationID:   reservation.ID,
+			Name:            reservation.Name,
+			StayStartAt:     dto.JSONDate{Time: reservation.StayStartAt},
+			StayEndAt:       dto.JSONDate{Time: reservation.StayEndAt},
+			FromRoomIDs:     fromRoomIDs,
+			FromRoomNumbers: lookupRoomNumbers(roomNumbers, fromRoomIDs),
+			ToRoomIDs:       toRoomIDs,
+			ToRoomNumbers:   lookupRoomNumbers(roomNumbers, toRoomIDs),
+		})
+	}
+
+	for _, reservation := range unassignable {
+		proposal.Unassigned = append(proposal.Unassigned, toUnassignedReservationResponse(reservation, unassignedReasonNoRoomGroup))
+	}
+
+	return proposal, nil
+}
+
+// Apply는 제안된 객실 배정을 하나의 트랜잭션으로 적용합니다. 예약끼리 객실을 맞바꿀 수 있도록 대상 예약의 객실을
+// 모두 내려놓은 뒤 하나씩 다시 배정하며, 예약의 현재 객실이 FromRoomIDs와 다르면 ErrRoomAssignmentStale을 반환합니다.
+// 감사 로그에는 일반 수정(UPDATE)이 아닌 ROOM_ASSIGNMENT로 기록됩니다.
+func (s *roomAssignmentService) Apply(ctx context.Context, req dto.ApplyRoomAssignmentRequest) error {
+	ctx = audit.WithAction(ctx, audit.ActionRoomAssignment)
+
+	var lockRoomIDs []uint
+	seen := make(map[uint]bool)
+	for _, assignment := range req.Assignments {
+		if seen[assignment.ReservationID] || len(assignment.ToRoomIDs) == 0 || hasDuplicateRoomID(assignment.ToRoomIDs) {
+			return fmt.Errorf("%w: 예약 #%d", ErrInvalidRoomAssignment, assignment.ReservationID)
+		}
+		seen[assignment.ReservationID] = true
+		lockRoomIDs = append(lockRoomIDs, assignment.FromRoomIDs...)
+		lockRoomIDs = append(lockRoomIDs, assignment.ToRoomIDs...)
+	}
+
+	return s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, lockRoomIDs); err != nil {
+			return err
+		}
+
+		reservations := make([]*models.Reservation, len(req.Assignments))
+		for i, assignment := range req.Assignments {
+			reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, assignment.ReservationID)
+			if err != nil {
+				return fmt.Errorf("%w: 예약 #%d", ErrReservationNotFound, assignment.ReservationID)
+			}
+			if !reservation.IsActive() || reservation.CheckInAt != nil {
+				return fmt.Errorf("%w: 예약 #%d는 체크인 전 정상/대기 예약이 아닙니다", ErrInvalidRoomAssignment, reservation.ID)
+			}
+			if reservation.HasRoomMoves() {
+				return fmt.Errorf("%w: 예약 #%d는 숙박 중 객실을 옮기는 예약입니다", ErrInvalidRoomAssignment, reservation.ID)
+			}
+			if !sameRoomIDs(reservation.RoomIDs(), assignment.FromRoomIDs) {
+				return fmt.Errorf("%w: 예약 #%d", ErrRoomAssignmentStale, reservation.ID)
+			}
+			reservations[i] = reservation
+		}
+
+		for _, reservation := range reservations {
+			if err := s.reservationRepo.DeleteRooms(ctx, reservation.ID); err != nil {
+				return err
+			}
+		}
+
+		for i, reservation := range reservations {
+			toRoomIDs := req.Assignments[i].ToRoomIDs
+			if s.dateBlockRepo != nil {
+				blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, reservation.StayStartAt, reservation.StayEndAt, toRoomIDs)
+				if err != nil {
+					return err
+				}
+				if blocked {
+					return fmt.Errorf("%w: 예약 #%d", ErrDateRangeBlocked, reservation.ID)
+				}
+			}
+
+			schedulesByRoom, err := findRoomStatusSchedules(ctx, s.scheduleRepo, truncateToDate(reservation.StayStartAt), truncateToDate(reservation.StayEndAt))
+			if err != nil {
+				return err
+			}
+
+			reservation.Rooms = make([]models.ReservationRoom, len(toRoomIDs))
+			for j, roomID := range toRoomIDs {
+				room, err := s.roomRepo.FindByID(ctx, roomID)
+				if err != nil {
+					return fmt.Errorf("%w: 객실 #%d", ErrRoomNotFound, roomID)
+				}
+				available, err := s.roomRepo.IsRoomAvailable(ctx, roomID, reservation.StayStartAt, reservation.StayEndAt, &reservation.ID)
+				if err != nil {
+					return err
+				}
+				if !available || !isInService(room, schedulesByRoom[roomID], reservation.StayStartAt, reservation.StayEndAt) {
+					return fmt.Errorf("%w: 예약 #%d, 객실 %s", ErrRoomNotAvailable, reservation.ID, room.Number)
+				}
+				reservation.Rooms[j] = models.ReservationRoom{
+					RoomID:      roomID,
+					Room:        room,
+					StayStartAt: reservation.StayStartAt,
+					StayEndAt:   reservation.StayEndAt,
+				}
+			}
+
+			if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// findOccupyingReservations는 [startDate, endDate) 기간과 겹치고 객실을 점유하는 예약을 객실과 함께 조회합니다.
+// 이용 완료 예약은 옮기지 않고 객실 일정에만 넣습니다.
+func (s *roomAssignmentService) findOccupyingReservations(ctx context.Context, startDate, endDate time.Time) ([]models.Reservation, error) {
+	lastNight := endDate.AddDate(0, 0, -1)
+	candidates, _, err := s.reservationRepo.FindAll(ctx, dto.ReservationRepositoryFilter{StartDate: &startDate, EndDate: &lastNight}, 0, -1, "")
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := make([]models.Reservation, 0, len(candidates))
+	for _, reservation := range candidates {
+		if reservation.CountsAsOccupied() && reservation.StayEndAt.After(startDate) && reservation.StayStartAt.Before(endDate) {
+			reservations = append(reservations, reservation)
+		}
+	}
+	return reservations, nil
+}
+
+// addUnavailablePeriods는 객실이 정상 상태가 아닌 날, 날짜 차단 회차, 다른 사용자의 임시 홀드를 객실 사용 기간으로 추가합니다.
+func (s *roomAssignmentService) addUnavailablePeriods(ctx context.Context, schedule roomSchedule, rooms []models.Room, startDate, endDate time.Time) error {
+	schedulesByRoom, err := findRoomStatusSchedules(ctx, s.scheduleRepo, startDate, endDate)
+	if err != nil {
+		return err
+	}
+	for i := range rooms {
+		for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+			if rooms[i].StatusOn(schedulesByRoom[rooms[i].ID], date) != models.RoomStatusNormal {
+				schedule.add(rooms[i].ID, date, date.AddDate(0, 0, 1))
+			}
+		}
+	}
+
+	if s.dateBlockRepo != nil {
+		dateBlocks, err := s.dateBlockRepo.FindOverlapping(ctx, startDate, endDate)
+		if err != nil {
+			return err
+		}
+		for i := range dateBlocks {
+			occurrences := dateBlocks[i].Occurrences(startDate, endDate)
+			for _, room := range rooms {
+				if !dateBlocks[i].AppliesToRoom(room.ID, room.RoomGroupID) {
+					continue
+				}
+				for _, occurrence := range occurrences {
+					schedule.add(room.ID, occurrence.StartDate, occurrence.EndDate.AddDate(0, 0, 1))
+				}
+			}
+		}
+	}
+
+	holds, err := findOthersHolds(ctx, s.roomHoldRepo, startDate, endDate)
+	if err != nil {
+		return err
+	}
+	for _, hold := range holds {
+		for _, roomID := range hold.RoomIDs {
+			schedule.add(roomID, hold.StayStartAt, hold.StayEndAt)
+		}
+	}
+	return nil
+}
+
+// isMovableReservation은 [startDate, endDate) 기간에 숙박을 시작하고 아직 체크인하지 않은 정상/대기 예약인지 확인합니다.
+// 숙박 중 객실을 옮기도록 나눈 예약은 구간을 그대로 두어야 하므로 옮기지 않습니다.
+func isMovableReservation(reservation *models.Reservation, startDate, endDate time.Time) bool {
+	stayStartAt := truncateToDate(reservation.StayStartAt)
+	return reservation.IsActive() && reservation.CheckInAt == nil && !reservation.HasRoomMoves() &&
+		!stayStartAt.Before(startDate) && stayStartAt.Before(endDate)
+}
+
+// reservationRoomGroupID는 예약 객실의 객실 그룹을 반환합니다. 객실이 삭제되어 없으면 예약의 요청 객실 그룹을 씁니다.
+func reservationRoomGroupID(reservation *models.Reservation, reservationRoom *models.ReservationRoom) (uint, bool) {
+	if reservationRoom.Room != nil {
+		return reservationRoom.Room.RoomGroupID, true
+	}
+	if reservation.RoomGroupID != nil {
+		return *reservation.RoomGroupID, true
+	}
+	return 0, false
+}
+
+func toUnassignedReservationResponse(reservation *models.Reservation, reason string) dto.UnassignedReservationResponse {
+	return dto.UnassignedReservationResponse{
+		ReservationID: reservation.ID,
+		Name:          reservation.Name,
+		StayStartAt:   dto.JSONDate{Time: reservation.StayStartAt},
+		StayEndAt:     dto.JSONDate{Time: reservation.StayEndAt},
+		Reason:        reason,
+	}
+}
+
+func lookupRoomNumbers(roomNumbers map[uint]string, roomIDs []uint) []string {
+	numbers := make([]string, len(roomIDs))
+	for i, roomID := range roomIDs {
+		numbers[i] = roomNumbers[roomID]
+	}
+	return numbers
+}
+
+func sameRoomIDs(a, b []uint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[uint]int)
+	for _, id := range a {
+		counts[id]++
+	}
+	for _, id := range b {
+		if counts[id] == 0 {
+			return false
+		}
+		counts[id]--
+	}
+	return true
+}
+
+func hasDuplicateRoomID(roomIDs []uint) bool {
+	seen := make(map[uint]bool)
+	for _, roomID := range roomIDs {
+		if seen[roomID] {
+			return true
+		}
+		seen[roomID] = true
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type RoomAssignmentServiceTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	mockReservationRepo *MockReservationRepository
+	mockRoomRepo        *MockRoomRepository
+	mockDateBlockRepo   *MockDateBlockRepository
+	mockRoomHoldRepo    *MockRoomHoldRepository
+	mockScheduleRepo    *MockRoomStatusScheduleRepository
+	service             services.RoomAssignmentService
+	reservationService  services.ReservationService
+}
+
+func (suite *RoomAssignmentServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockReservationRepo = new(MockReservationRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockDateBlockRepo = new(MockDateBlockRepository)
+	suite.mockRoomHoldRepo = new(MockRoomHoldRepository)
+	suite.mockScheduleRepo = new(MockRoomStatusScheduleRepository)
+	suite.mockScheduleRepo.On("FindEffective", mock.Anything, mock.Anything, mock.Anything).Return([]models.RoomStatusSchedule{}, nil).Maybe()
+	suite.service = services.NewRoomAssignmentService(suite.mockReservationRepo, suite.mockRoomRepo, suite.mockDateBlockRepo, suite.mockRoomHoldRepo, suite.mockScheduleRepo)
+	suite.reservationService = services.NewReservationService(suite.mockReservationRepo, suite.mockRoomRepo, nil, nil, nil, suite.mockDateBlockRepo)
+}
+
+func assignmentDate(day int) time.Time {
+	return time.Date(2026, 7, day, 0, 0, 0, 0, time.UTC)
+}
+
+func assignmentRoom(id, groupID uint, number string) models.Room {
+	room := models.Room{RoomGroupID: groupID, Number: number, Status: models.RoomStatusNormal}
+	room.ID = id
+	return room
+}
+
+func assignmentReservation(id uint, start, end time.Time, rooms ...models.Room) models.Reservation {
+	reservation := models.Reservation{Name: "홍길동", StayStartAt: start, StayEndAt: end, Status: models.ReservationStatusNormal}
+	reservation.ID = id
+	for i := range rooms {
+		reservation.Rooms = append(reservation.Rooms, models.ReservationRoom{RoomID: rooms[i].ID, Room: &rooms[i]})
+	}
+	return reservation
+}
+
+// givenSchedule은 같은 그룹의 정상 객실 101, 102와 사용 중지된 객실 103을 두고 다음 예약을 준비합니다.
+//   - 1번: 102호에 체크인한 6/28 ~ 7/3 예약 (옮길 수 없음)
+//   - 10번: 101호 7/3 ~ 7/5
+//   - 11번: 사용 중지된 103호 7/5 ~ 7/6
+//   - 12번: 객실 없이 그룹으로 예약한 7/6 ~ 7/7
+//   - 13번: 객실도 그룹도 없는 7/6 ~ 7/7
+func (suite *RoomAssignmentServiceTestSuite) givenSchedule() {
+	room101, room102 := assignmentRoom(1, 1, "101"), assignmentRoom(2, 1, "102")
+	room103 := assignmentRoom(3, 1, "103")
+	room103.Status = models.RoomStatusInactive
+
+	checkedIn := assignmentReservation(1, time.Date(2026, 6, 28, 0, 0, 0, 0, time.UTC), assignmentDate(3), room102)
+	checkInAt := time.Date(2026, 6, 28, 15, 0, 0, 0, time.UTC)
+	checkedIn.CheckInAt = &checkInAt
+	groupOnly := assignmentReservation(12, assignmentDate(6), assignmentDate(7))
+	roomGroupID := uint(1)
+	groupOnly.RoomGroupID = &roomGroupID
+
+	suite.mockReservationRepo.On("FindAll", suite.ctx, mock.Anything, 0, -1, "").Return([]models.Reservation{
+		checkedIn,
+		assignmentReservation(10, assignmentDate(3), assignmentDate(5), room101),
+		assignmentReservation(11, assignmentDate(5), assignmentDate(6), room103),
+		groupOnly,
+		assignmentReservation(13, assignmentDate(6), assignmentDate(7)),
+	}, int64(5), nil)
+	suite.mockRoomRepo.On("FindAllWithDetails", suite.ctx).Return([]models.Room{room101, room102, room103}, nil)
+	suite.mockDateBlockRepo.On("FindOverlapping", suite.ctx, mock.Anything, mock.Anything).Return([]models.DateBlock{}, nil)
+	suite.mockRoomHoldRepo.On("FindOverlapping", suite.ctx, mock.Anything, mock.Anything).Return([]models.RoomHold{}, nil)
+}
+
+func assignmentsByReservation(proposal *dto.RoomAssignmentProposalResponse) map[uint][]uint {
+	result := make(map[uint][]uint)
+	for _, assignment := range proposal.Assignments {
+		result[assignment.ReservationID] = assignment.ToRoomIDs
+	}
+	return result
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestPropose_기본은_지금_객실을_유지하고_필요한_예약만_옮긴다() {
+	// Given
+	suite.givenSchedule()
+
+	// When - 7/1 ~ 7/8 배정 제안을 받으면
+	proposal, err := suite.service.Propose(suite.ctx, dto.RoomAssignmentProposalRequest{StartDate: assignmentDate(1), EndDate: assignmentDate(8)})
+
+	// Then - 10번은 그대로 두고, 사용 중지 객실의 11번과 그룹 예약 12번만 빈 날이 없도록 101호에 붙인다
+	suite.NoError(err)
+	suite.Equal(map[uint][]uint{11: {1}, 12: {1}}, assignmentsByReservation(proposal))
+	suite.Equal([]string{"103"}, proposal.Assignments[0].FromRoomNumbers)
+	suite.Equal([]string{"101"}, proposal.Assignments[0].ToRoomNumbers)
+
+	// 그룹을 알 수 없는 13번은 배정하지 못한다
+	suite.Len(proposal.Unassigned, 1)
+	suite.Equal(uint(13), proposal.Unassigned[0].ReservationID)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestPropose_reshuffle이면_빈_날이_적도록_모든_예약을_다시_배정한다() {
+	// Given
+	suite.givenSchedule()
+
+	// When - 전체 재배정 제안을 받으면
+	proposal, err := suite.service.Propose(suite.ctx, dto.RoomAssignmentProposalRequest{StartDate: assignmentDate(1), EndDate: assignmentDate(8), Reshuffle: true})
+
+	// Then - 체크인한 예약이 끝나는 102호에 이어서 10번부터 차례로 붙인다
+	suite.NoError(err)
+	suite.Equal(map[uint][]uint{10: {2}, 11: {2}, 12: {2}}, assignmentsByReservation(proposal))
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestPropose_객실_상태_예약_기간에는_배정하지_않는다() {
+	// Given - 101호에 7/5 ~ 7/6 공사가 예약돼 있으면
+	suite.givenSchedule()
+	suite.mockScheduleRepo.ExpectedCalls = nil
+	suite.mockScheduleRepo.On("FindEffective", suite.ctx, mock.Anything, mock.Anything).Return([]models.RoomStatusSchedule{{
+		RoomID:    1,
+		Status:    models.RoomStatusConstruction,
+		StartDate: assignmentDate(5),
+		EndDate:   assignmentDate(6),
+	}}, nil)
+
+	// When - 7/1 ~ 7/8 배정 제안을 받으면
+	proposal, err := suite.service.Propose(suite.ctx, dto.RoomAssignmentProposalRequest{StartDate: assignmentDate(1), EndDate: assignmentDate(8)})
+
+	// Then - 공사 기간에 묵는 11번, 12번은 101호 대신 102호로 배정한다
+	suite.NoError(err)
+	suite.Equal(map[uint][]uint{11: {2}, 12: {2}}, assignmentsByReservation(proposal))
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestApply_객실_상태_예약_기간에_묵는_객실로는_옮기지_않는다() {
+	// Given - 102호에 7/4 하루 공사가 예약돼 있으면
+	first := assignmentReservation(10, assignmentDate(3), assignmentDate(5), assignmentRoom(1, 1, "101"))
+	room102 := assignmentRoom(2, 1, "102")
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(10)).Return(&first, nil)
+	suite.mockReservationRepo.On("DeleteRooms", mock.Anything, mock.Anything).Return(nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	suite.mockRoomRepo.On("FindByID", mock.Anything, uint(2)).Return(&room102, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	suite.mockScheduleRepo.ExpectedCalls = nil
+	suite.mockScheduleRepo.On("FindEffective", mock.Anything, assignmentDate(3), assignmentDate(4)).Return([]models.RoomStatusSchedule{{
+		RoomID:    2,
+		Status:    models.RoomStatusConstruction,
+		StartDate: assignmentDate(4),
+		EndDate:   assignmentDate(4),
+	}}, nil)
+
+	// When
+	err := suite.service.Apply(suite.ctx, dto.ApplyRoomAssignmentRequest{Assignments: []dto.ApplyRoomAssignmentItem{
+		{ReservationID: 10, FromRoomIDs: []uint{1}, ToRoomIDs: []uint{2}},
+	}})
+
+	// Then
+	suite.ErrorIs(err, services.ErrRoomNotAvailable)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestPropose_기간이_너무_길면_거절한다() {
+	// When
+	_, err := suite.service.Propose(suite.ctx, dto.RoomAssignmentProposalRequest{StartDate: assignmentDate(1), EndDate: assignmentDate(1).AddDate(0, 4, 0)})
+
+	// Then
+	suite.ErrorIs(err, services.ErrRoomAssignmentRangeTooLong)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestApply_예약끼리_객실을_맞바꾼다() {
+	// Given - 101호의 10번과 102호의 11번을 맞바꾸는 배정이 주어지면
+	room101, room102 := assignmentRoom(1, 1, "101"), assignmentRoom(2, 1, "102")
+	first := assignmentReservation(10, assignmentDate(3), assignmentDate(5), room101)
+	second := assignmentReservation(11, assignmentDate(3), assignmentDate(4), room102)
+
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2, 2, 1}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(10)).Return(&first, nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(11)).Return(&second, nil)
+	suite.mockReservationRepo.On("DeleteRooms", mock.Anything, mock.Anything).Return(nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	suite.mockRoomRepo.On("FindByID", mock.Anything, uint(1)).Return(&room101, nil)
+	suite.mockRoomRepo.On("FindByID", mock.Anything, uint(2)).Return(&room102, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	suite.mockReservationRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	// When
+	err := suite.service.Apply(suite.ctx, dto.ApplyRoomAssignmentRequest{Assignments: []dto.ApplyRoomAssignmentItem{
+		{ReservationID: 10, FromRoomIDs: []uint{1}, ToRoomIDs: []uint{2}},
+		{ReservationID: 11, FromRoomIDs: []uint{2}, ToRoomIDs: []uint{1}},
+	}})
+
+	// Then - 두 예약의 객실을 모두 내려놓은 뒤 바뀐 객실로 저장한다
+	suite.NoError(err)
+	suite.mockReservationRepo.AssertNumberOfCalls(suite.T(), "DeleteRooms", 2)
+	suite.Equal([]uint{2}, first.RoomIDs())
+	suite.Equal([]uint{1}, second.RoomIDs())
+	suite.mockReservationRepo.AssertNumberOfCalls(suite.T(), "Update", 2)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestApply_제안_이후_객실이_바뀌었으면_거절한다() {
+	// Given - 제안 때 101호였던 예약이 지금은 103호라면
+	first := assignmentReservation(10, assignmentDate(3), assignmentDate(5), assignmentRoom(3, 1, "103"))
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(10)).Return(&first, nil)
+
+	// When
+	err := suite.service.Apply(suite.ctx, dto.ApplyRoomAssignmentRequest{Assignments: []dto.ApplyRoomAssignmentItem{
+		{ReservationID: 10, FromRoomIDs: []uint{1}, ToRoomIDs: []uint{2}},
+	}})
+
+	// Then - 아무것도 바꾸지 않는다
+	suite.ErrorIs(err, services.ErrRoomAssignmentStale)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "DeleteRooms", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestApply_체크인한_예약은_옮기지_않는다() {
+	// Given
+	first := assignmentReservation(10, assignmentDate(3), assignmentDate(5), assignmentRoom(1, 1, "101"))
+	checkInAt := assignmentDate(3)
+	first.CheckInAt = &checkInAt
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1, 2}).Return(nil)
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(10)).Return(&first, nil)
+
+	// When
+	err := suite.service.Apply(suite.ctx, dto.ApplyRoomAssignmentRequest{Assignments: []dto.ApplyRoomAssignmentItem{
+		{ReservationID: 10, FromRoomIDs: []uint{1}, ToRoomIDs: []uint{2}},
+	}})
+
+	// Then
+	suite.ErrorIs(err, services.ErrInvalidRoomAssignment)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestSelectRooms_앞뒤_예약과_빈_날이_가장_적은_객실을_고른다() {
+	// Given - 102호만 7/3에 끝나는 예약이 있고, 다른 그룹의 201호도 비어 있으면
+	room101, room102, room201 := assignmentRoom(1, 1, "101"), assignmentRoom(2, 1, "102"), assignmentRoom(4, 2, "201")
+	suite.mockRoomRepo.On("FindAvailableRooms", suite.ctx, assignmentDate(3), assignmentDate(5), (*uint)(nil)).
+		Return([]models.Room{room101, room102, room201}, nil)
+	suite.mockReservationRepo.On("FindAll", suite.ctx, mock.Anything, 0, -1, "").Return([]models.Reservation{
+		assignmentReservation(1, assignmentDate(1), assignmentDate(3), room102),
+	}, int64(1), nil)
+
+	// When - 그룹 1에서 객실 하나를 고르면
+	roomIDs, err := suite.reservationService.SelectRooms(suite.ctx, 1, 0, assignmentDate(3), assignmentDate(5))
+
+	// Then - 빈 날 없이 이어지는 102호를 고른다
+	suite.NoError(err)
+	suite.Equal([]uint{2}, roomIDs)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestSelectRooms_숙박_중_객실을_옮긴_예약은_구간별로_본다() {
+	// Given - 1번 예약이 7/1 ~ 7/3은 101호, 7/3 ~ 7/5는 102호에 묵고, 103호는 비어 있으면
+	room101, room102, room103 := assignmentRoom(1, 1, "101"), assignmentRoom(2, 1, "102"), assignmentRoom(3, 1, "103")
+	suite.mockRoomRepo.On("FindAvailableRooms", suite.ctx, assignmentDate(3), assignmentDate(5), (*uint)(nil)).
+		Return([]models.Room{room101, room103}, nil)
+	splitStay := assignmentReservation(1, assignmentDate(1), assignmentDate(5), room101, room102)
+	splitStay.Rooms[0].StayEndAt = assignmentDate(3)
+	splitStay.Rooms[1].StayStartAt = assignmentDate(3)
+	suite.mockReservationRepo.On("FindAll", suite.ctx, mock.Anything, 0, -1, "").Return([]models.Reservation{splitStay}, int64(1), nil)
+
+	// When - 7/3 ~ 7/5에 그룹 1에서 객실 하나를 고르면
+	roomIDs, err := suite.reservationService.SelectRooms(suite.ctx, 1, 0, assignmentDate(3), assignmentDate(5))
+
+	// Then - 옮겨 간 뒤 비는 101호를 빈 날 없이 이어 붙인다
+	suite.NoError(err)
+	suite.Equal([]uint{1}, roomIDs)
+}
+
+func (suite *RoomAssignmentServiceTestSuite) TestSelectRooms_빈_객실이_모자라면_ErrNoRoomToAssign() {
+	// Given
+	suite.mockRoomRepo.On("FindAvailableRooms", suite.ctx, assignmentDate(3), assignmentDate(5), (*uint)(nil)).
+		Return([]models.Room{assignmentRoom(1, 1, "101")}, nil)
+
+	// When - 객실 두 개를 요청하면
+	_, err := suite.reservationService.SelectRooms(suite.ctx, 1, 2, assignmentDate(3), assignmentDate(5))
+
+	// Then
+	suite.True(errors.Is(err, services.ErrNoRoomToAssign))
+}
+
+func TestRoomAssignmentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RoomAssignmentServiceTestSuite))
+}
@@ -12,6 +12,7 @@ var (
 	ErrRoomGroupNotFound   = errors.New("존재하지 않는 객실 그룹")
 	ErrRoomGroupNameExists = errors.New("이미 존재하는 객실 그룹 이름")
 	ErrRoomGroupHasRooms   = errors.New("객실이 존재하는 객실 그룹")
+	ErrInvalidOccupancy    = errors.New("기준 인원은 최대 인원보다 많을 수 없습니다")
 )
 
 type RoomGroupService interface {
@@ -28,11 +29,19 @@ type RoomGroupService interface {
 
 type roomGroupService struct {
 	roomGroupRepo repositories.RoomGroupRepository
+	amenityRepo   repositories.AmenityRepository
 }
 
-func NewRoomGroupService(roomGroupRepo repositories.RoomGroupRepository) RoomGroupService {
+// NewRoomGroupService는 객실 그룹 서비스를 생성합니다. amenityRepo를 넘기지 않으면 편의시설 지정은 무시합니다.
+func NewRoomGroupService(roomGroupRepo repositories.RoomGroupRepository, amenityRepo ...repositories.AmenityRepository) RoomGroupService {
+	var amenityRepository repositories.AmenityRepository
+	if len(amenityRepo) > 0 {
+		amenityRepository = amenityRepo[0]
+	}
+
 	return &roomGroupService{
 		roomGroupRepo: roomGroupRepo,
+		amenityRepo:   amenityRepository,
 	}
 }
 
@@ -74,6 +83,13 @@ func (s *roomGroupService) Create(ctx context.Context, roomGroup *models.RoomGro
 		return ErrRoomGroupNameExists
 	}
 
+	if err := validateOccupancy(roomGroup.StandardOccupancy, roomGroup.MaxOccupancy); err != nil {
+		return err
+	}
+	if err := s.resolveAmenities(ctx, roomGroup); err != nil {
+		return err
+	}
+
 	_, err = s.roomGroupRepo.Create(ctx, roomGroup)
 	return err
 }
@@ -109,6 +125,28 @@ func (s *roomGroupService) Update(ctx context.Context, id uint, updates map[stri
 		roomGroup.Description = description
 	}
 
+	if standardOccupancy, ok := updates["standardOccupancy"].(int); ok {
+		roomGroup.StandardOccupancy = standardOccupancy
+	}
+
+	if maxOccupancy, ok := updates["maxOccupancy"].(int); ok {
+		roomGroup.MaxOccupancy = maxOccupancy
+	}
+
+	if err := validateOccupancy(roomGroup.StandardOccupancy, roomGroup.MaxOccupancy); err != nil {
+		return nil, err
+	}
+
+	if amenityIDs, ok := updates["amenityIds"].([]uint); ok {
+		roomGroup.Amenities = make([]models.Amenity, len(amenityIDs))
+		for i, id := range amenityIDs {
+			roomGroup.Amenities[i].ID = id
+		}
+		if err := s.resolveAmenities(ctx, roomGroup); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.roomGroupRepo.Update(ctx, roomGroup); err != nil {
 		return nil, err
 	}
@@ -129,6 +167,33 @@ func (s *roomGroupService) Delete(ctx context.Context, id uint) error {
 	return s.roomGroupRepo.Delete(ctx, id)
 }
 
+// resolveAmenities는 roomGroup.Amenities에 ID만 채워 넘긴 편의시설을 카탈로그에서 조회해 채웁니다.
+func (s *roomGroupService) resolveAmenities(ctx context.Context, roomGroup *models.RoomGroup) error {
+	if s.amenityRepo == nil {
+		roomGroup.Amenities = nil
+		return nil
+	}
+
+	ids := make([]uint, len(roomGroup.Amenities))
+	for i, amenity := range roomGroup.Amenities {
+		ids[i] = amenity.ID
+	}
+	amenities, err := findAmenities(ctx, s.amenityRepo, ids)
+	if err != nil {
+		return err
+	}
+	roomGroup.Amenities = amenities
+	return nil
+}
+
+// validateOccupancy는 기준 인원이 최대 인원보다 많지 않은지 확인합니다. 0은 정하지 않은 것으로 봅니다.
+func validateOccupancy(standard, max int) error {
+	if standard > 0 && max > 0 && standard > max {
+		return ErrInvalidOccupancy
+	}
+	return nil
+}
+
 func (s *roomGroupService) GetByIDWithUsers(ctx context.Context, id uint) (*models.RoomGroup, error) {
 	roomGroup, err := s.roomGroupRepo.FindByIDWithUsers(ctx, id)
 	if err != nil {
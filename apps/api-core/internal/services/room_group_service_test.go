@@ -370,6 +370,23 @@ func (suite *RoomGroupServiceTestSuite) TestCreate_DuplicateName() {
 	suite.mockRepo.AssertExpectations(suite.T())
 }
 
+func (suite *RoomGroupServiceTestSuite) TestCreate_기준_인원이_최대_인원보다_많으면_거부된다() {
+	// Given - 기준 인원 4명, 최대 인원 2명인 객실 그룹을
+	newRoomGroup := &models.RoomGroup{
+		Name:              "Family",
+		StandardOccupancy: 4,
+		MaxOccupancy:      2,
+	}
+	suite.mockRepo.On("ExistsByName", suite.ctx, "Family", (*uint)(nil)).Return(false, nil)
+
+	// When - 생성하면
+	err := suite.service.Create(suite.ctx, newRoomGroup)
+
+	// Then - 인원 설정 오류로 저장하지 않는다
+	assert.ErrorIs(suite.T(), err, services.ErrInvalidOccupancy)
+	suite.mockRepo.AssertNotCalled(suite.T(), "Create", suite.ctx, newRoomGroup)
+}
+
 func (suite *RoomGroupServiceTestSuite) TestUpdate() {
 	// Given - 객실 그룹이 등록된 상황에서
 	existingRoomGroup := &models.RoomGroup{
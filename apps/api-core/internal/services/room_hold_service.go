@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+const (
+	DefaultRoomHoldTTL = 10 * time.Minute
+	MaxRoomHoldTTL     = 60 * time.Minute
+)
+
+var (
+	ErrRoomHoldNotFound   = errors.New("존재하지 않거나 만료된 객실 홀드")
+	ErrRoomHoldNotOwned   = errors.New("다른 사용자가 잡은 객실 홀드")
+	ErrInvalidRoomHoldTTL = errors.New("잘못된 객실 홀드 유지 시간")
+)
+
+type RoomHoldService interface {
+	Create(ctx context.Context, hold *models.RoomHold, ttl time.Duration) error
+	GetAll(ctx context.Context) ([]models.RoomHold, error)
+	GetByID(ctx context.Context, id uint) (*models.RoomHold, error)
+	Release(ctx context.Context, id uint) error
+	Convert(ctx context.Context, id uint, reservation *models.Reservation) error
+}
+
+type roomHoldService struct {
+	holdRepo           repositories.RoomHoldRepository
+	reservationRepo    repositories.ReservationRepository
+	roomRepo           repositories.RoomRepository
+	dateBlockRepo      repositories.DateBlockRepository
+	reservationService ReservationService
+}
+
+func NewRoomHoldService(holdRepo repositories.RoomHoldRepository, reservationRepo repositories.ReservationRepository,
+	roomRepo repositories.RoomRepository, dateBlockRepo repositories.DateBlockRepository,
+	reservationService ReservationService) RoomHoldService {
+	return &roomHoldService{
+		holdRepo:           holdRepo,
+		reservationRepo:    reservationRepo,
+		roomRepo:           roomRepo,
+		dateBlockRepo:      dateBlockRepo,
+		reservationService: reservationService,
+	}
+}
+
+func (s *roomHoldService) Create(ctx context.Context, hold *models.RoomHold, ttl time.Duration) error {
+	if !hold.StayStartAt.Before(hold.StayEndAt) {
+		return ErrInvalidDateRange
+	}
+
+	if ttl == 0 {
+		ttl = DefaultRoomHoldTTL
+	}
+	if ttl < 0 || ttl > MaxRoomHoldTTL {
+		return ErrInvalidRoomHoldTTL
+	}
+
+	userID, ok := appContext.GetUserID(ctx)
+	if !ok {
+		return ErrRoomHoldNotOwned
+	}
+	hold.HolderID = userID
+
+	// 예약 생성과 같은 객실 행 잠금을 잡은 상태에서 가용성을 확인하고 홀드를 저장해야
+	// 동시에 들어온 예약이나 다른 홀드와 겹치지 않는다.
+	return s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, hold.RoomIDs); err != nil {
+			return err
+		}
+
+		if s.dateBlockRepo != nil {
+			blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, hold.StayStartAt, hold.StayEndAt, hold.RoomIDs)
+			if err != nil {
+				return err
+			}
+			if blocked {
+				return ErrDateRangeBlocked
+			}
+		}
+
+		for _, roomID := range hold.RoomIDs {
+			if _, err := s.roomRepo.FindByID(ctx, roomID); err != nil {
+				return ErrRoomNotFound
+			}
+
+			available, err := s.roomRepo.IsRoomAvailable(ctx, roomID, hold.StayStartAt, hold.StayEndAt, nil)
+			if err != nil {
+				return err
+			}
+			if !available {
+				return ErrRoomNotAvailable
+			}
+		}
+
+		return s.holdRepo.Create(ctx, hold, ttl)
+	})
+}
+
+func (s *roomHoldService) GetAll(ctx context.Context) ([]models.RoomHold, error) {
+	return s.holdRepo.FindAll(ctx)
+}
+
+func (s *roomHoldService) GetByID(ctx context.Context, id uint) (*models.RoomHold, error) {
+	hold, err := s.holdRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, ErrRoomHoldNotFound
+	}
+	return hold, nil
+}
+
+// Release는 홀드를 해제합니다. 통화 중인 다른 직원의 홀드를 풀지 않도록 홀드를 잡은 사용자만 해제할 수 있습니다.
+func (s *roomHoldService) Release(ctx context.Context, id uint) error {
+	hold, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	userID, ok := appContext.GetUserID(ctx)
+	if !ok || hold.HolderID != userID {
+		return ErrRoomHoldNotOwned
+	}
+
+	return s.holdRepo.Delete(ctx, id)
+}
+
+// Convert는 홀드를 예약으로 전환합니다. 예약 기간과 객실은 홀드의 값을 사용합니다.
+// 홀드의 객실을 잠근 뒤 홀드가 아직 남아 있는지 다시 확인하고, 예약 생성이 같은 잠금 아래에서 객실 가용성을
+// 다시 검증합니다. 예약 저장과 홀드 해제를 같은 트랜잭션 안에서 처리하고, 커밋에 실패하면 남은 시간만큼 홀드를 되살려
+// 예약만 생기거나 홀드만 사라지는 상태가 남지 않도록 한다.
+func (s *roomHoldService) Convert(ctx context.Context, id uint, reservation *models.Reservation) error {
+	hold, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	userID, ok := appContext.GetUserID(ctx)
+	if !ok || hold.HolderID != userID {
+		return ErrRoomHoldNotOwned
+	}
+
+	reservation.StayStartAt = hold.StayStartAt
+	reservation.StayEndAt = hold.StayEndAt
+
+	released := false
+	err = s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, hold.RoomIDs); err != nil {
+			return err
+		}
+
+		// 잠금을 기다리는 동안 홀드가 만료되었거나 해제되었으면 그사이 다른 예약이 객실을 가져갔을 수 있다
+		current, err := s.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if current.HolderID != userID {
+			return ErrRoomHoldNotOwned
+		}
+
+		if err := s.reservationService.Create(ctx, reservation, hold.RoomIDs); err != nil {
+			return err
+		}
+
+		if err := s.holdRepo.Delete(ctx, hold.ID); err != nil {
+			return err
+		}
+		released = true
+		return nil
+	})
+
+	if err != nil && released {
+		if ttl := hold.RemainingTTL(time.Now()); ttl > 0 {
+			_ = s.holdRepo.Create(ctx, hold, ttl)
+		}
+	}
+
+	return err
+}
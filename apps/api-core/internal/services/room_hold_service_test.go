@@ -0,0 +1,407 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	appContext "gitlab.bellsoft.net/rms/api-core/internal/context"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockRoomHoldRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoomHoldRepository) Create(ctx context.Context, hold *models.RoomHold, ttl time.Duration) error {
+	args := m.Called(ctx, hold, ttl)
+	return args.Error(0)
+}
+
+func (m *MockRoomHoldRepository) FindByID(ctx context.Context, id uint) (*models.RoomHold, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RoomHold), args.Error(1)
+}
+
+func (m *MockRoomHoldRepository) FindAll(ctx context.Context) ([]models.RoomHold, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomHold), args.Error(1)
+}
+
+func (m *MockRoomHoldRepository) FindOverlapping(ctx context.Context, startDate, endDate time.Time) ([]models.RoomHold, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomHold), args.Error(1)
+}
+
+func (m *MockRoomHoldRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockReservationServiceForHold는 홀드 전환 시 호출되는 예약 생성만 검증하기 위한 mock입니다.
+type MockReservationServiceForHold struct {
+	mock.Mock
+}
+
+func (m *MockReservationServiceForHold) GetByID(ctx context.Context, id uint) (*models.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) GetByIDWithDetails(ctx context.Context, id uint) (*models.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) GetAll(ctx context.Context, filter dto.ReservationRepositoryFilter, page, size int, sort string) ([]models.Reservation, int64, error) {
+	args := m.Called(ctx, filter, page, size, sort)
+	return args.Get(0).([]models.Reservation), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockReservationServiceForHold) GetReceivables(ctx context.Context, filter dto.ReceivableFilter, page, size int, sort string) ([]models.Reservation, int64, error) {
+	args := m.Called(ctx, filter, page, size, sort)
+	return args.Get(0).([]models.Reservation), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockReservationServiceForHold) GetStatistics(ctx context.Context, startDate, endDate time.Time, periodType string) ([]repositories.ReservationStatistics, error) {
+	args := m.Called(ctx, startDate, endDate, periodType)
+	return args.Get(0).([]repositories.ReservationStatistics), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) Create(ctx context.Context, reservation *models.Reservation, roomIDs []uint) error {
+	args := m.Called(ctx, reservation, roomIDs)
+	return args.Error(0)
+}
+
+func (m *MockReservationServiceForHold) Update(ctx context.Context, id uint, updates map[string]interface{}, roomIDs []uint, hasRoomsUpdate bool) (*models.Reservation, error) {
+	args := m.Called(ctx, id, updates, roomIDs, hasRoomsUpdate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockReservationServiceForHold) CheckIn(ctx context.Context, id uint) (*models.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) CheckOut(ctx context.Context, id uint) (*models.Reservation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) MoveRoom(ctx context.Context, id uint, fromRoomID, toRoomID uint, moveDate time.Time) (*models.Reservation, error) {
+	args := m.Called(ctx, id, fromRoomID, toRoomID, moveDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) GetAvailableRooms(ctx context.Context, startDate, endDate time.Time, excludeReservationID *uint) ([]models.Room, error) {
+	args := m.Called(ctx, startDate, endDate, excludeReservationID)
+	return args.Get(0).([]models.Room), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) SelectRooms(ctx context.Context, roomGroupID uint, count int, startDate, endDate time.Time) ([]uint, error) {
+	args := m.Called(ctx, roomGroupID, count, startDate, endDate)
+	return args.Get(0).([]uint), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) GetLastReservationForRoom(ctx context.Context, roomID uint) (*models.Reservation, error) {
+	args := m.Called(ctx, roomID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Reservation), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) FindDuplicates(ctx context.Context, reservation *models.Reservation) ([]dto.DuplicateReservationResponse, error) {
+	args := m.Called(ctx, reservation)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.DuplicateReservationResponse), args.Error(1)
+}
+
+func (m *MockReservationServiceForHold) GetDuplicateReport(ctx context.Context, startDate, endDate time.Time) ([]dto.DuplicateReservationPairResponse, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.DuplicateReservationPairResponse), args.Error(1)
+}
+
+// commitFailingReservationRepository는 트랜잭션 본문은 성공했지만 커밋이 실패하는 상황을 흉내냅니다.
+type commitFailingReservationRepository struct {
+	*MockReservationRepository
+	commitErr error
+}
+
+func (r *commitFailingReservationRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	return r.commitErr
+}
+
+type RoomHoldServiceTestSuite struct {
+	suite.Suite
+	ctx                    context.Context
+	mockHoldRepo           *MockRoomHoldRepository
+	mockReservationRepo    *MockReservationRepository
+	mockRoomRepo           *MockRoomRepository
+	mockDateBlockRepo      *MockDateBlockRepository
+	mockReservationService *MockReservationServiceForHold
+	service                services.RoomHoldService
+	start                  time.Time
+	end                    time.Time
+}
+
+func (suite *RoomHoldServiceTestSuite) SetupTest() {
+	suite.ctx = appContext.WithUserID(context.Background(), 1)
+	suite.mockHoldRepo = new(MockRoomHoldRepository)
+	suite.mockReservationRepo = new(MockReservationRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockDateBlockRepo = new(MockDateBlockRepository)
+	suite.mockReservationService = new(MockReservationServiceForHold)
+	suite.service = services.NewRoomHoldService(suite.mockHoldRepo, suite.mockReservationRepo, suite.mockRoomRepo,
+		suite.mockDateBlockRepo, suite.mockReservationService)
+	suite.start = time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)
+	suite.end = time.Date(2030, 5, 3, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *RoomHoldServiceTestSuite) newHold() *models.RoomHold {
+	return &models.RoomHold{
+		RoomIDs:     []uint{10},
+		StayStartAt: suite.start,
+		StayEndAt:   suite.end,
+	}
+}
+
+func (suite *RoomHoldServiceTestSuite) TestCreate_성공_기본_TTL() {
+	// Given
+	hold := suite.newHold()
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", suite.ctx, suite.start, suite.end, []uint{10}).Return(false, nil)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(&models.Room{}, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(10), suite.start, suite.end, (*uint)(nil)).Return(true, nil)
+	suite.mockHoldRepo.On("Create", suite.ctx, hold, services.DefaultRoomHoldTTL).Return(nil)
+
+	// When - TTL을 지정하지 않으면
+	err := suite.service.Create(suite.ctx, hold, 0)
+
+	// Then - 기본 TTL로 홀드를 잡고 홀드한 사용자가 기록된다
+	suite.NoError(err)
+	suite.Equal(uint(1), hold.HolderID)
+	suite.mockHoldRepo.AssertExpectations(suite.T())
+	suite.mockRoomRepo.AssertExpectations(suite.T())
+}
+
+func (suite *RoomHoldServiceTestSuite) TestCreate_TTL_범위_초과() {
+	err := suite.service.Create(suite.ctx, suite.newHold(), 2*time.Hour)
+
+	suite.ErrorIs(err, services.ErrInvalidRoomHoldTTL)
+	suite.mockHoldRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestCreate_잘못된_날짜_범위() {
+	hold := suite.newHold()
+	hold.StayEndAt = hold.StayStartAt
+
+	err := suite.service.Create(suite.ctx, hold, time.Minute)
+
+	suite.ErrorIs(err, services.ErrInvalidDateRange)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestCreate_이미_예약되었거나_홀드된_객실() {
+	// Given
+	hold := suite.newHold()
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", suite.ctx, suite.start, suite.end, []uint{10}).Return(false, nil)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(&models.Room{}, nil)
+	suite.mockRoomRepo.On("IsRoomAvailable", suite.ctx, uint(10), suite.start, suite.end, (*uint)(nil)).Return(false, nil)
+
+	// When
+	err := suite.service.Create(suite.ctx, hold, time.Minute)
+
+	// Then
+	suite.ErrorIs(err, services.ErrRoomNotAvailable)
+	suite.mockHoldRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestCreate_차단된_날짜() {
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", suite.ctx, suite.start, suite.end, []uint{10}).Return(true, nil)
+
+	err := suite.service.Create(suite.ctx, suite.newHold(), time.Minute)
+
+	suite.ErrorIs(err, services.ErrDateRangeBlocked)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestGetByID_만료된_홀드() {
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(nil, nil)
+
+	_, err := suite.service.GetByID(suite.ctx, 7)
+
+	suite.ErrorIs(err, services.ErrRoomHoldNotFound)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestRelease_성공() {
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 1
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil)
+	suite.mockHoldRepo.On("Delete", suite.ctx, uint(7)).Return(nil)
+
+	err := suite.service.Release(suite.ctx, 7)
+
+	suite.NoError(err)
+	suite.mockHoldRepo.AssertExpectations(suite.T())
+}
+
+func (suite *RoomHoldServiceTestSuite) TestRelease_다른_사용자의_홀드() {
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 2
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil)
+
+	err := suite.service.Release(suite.ctx, 7)
+
+	suite.ErrorIs(err, services.ErrRoomHoldNotOwned)
+	suite.mockHoldRepo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestConvert_성공() {
+	// Given
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 1
+	reservation := &models.Reservation{Name: "홍길동", PaymentMethodID: 1}
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockReservationService.On("Create", suite.ctx, reservation, []uint{10}).Return(nil)
+	suite.mockHoldRepo.On("Delete", suite.ctx, uint(7)).Return(nil)
+
+	// When
+	err := suite.service.Convert(suite.ctx, 7, reservation)
+
+	// Then - 홀드의 기간으로 예약이 생성되고 홀드는 해제된다
+	suite.NoError(err)
+	suite.Equal(suite.start, reservation.StayStartAt)
+	suite.Equal(suite.end, reservation.StayEndAt)
+	suite.mockReservationService.AssertExpectations(suite.T())
+	suite.mockHoldRepo.AssertExpectations(suite.T())
+}
+
+func (suite *RoomHoldServiceTestSuite) TestConvert_다른_사용자의_홀드() {
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 2
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil)
+
+	err := suite.service.Convert(suite.ctx, 7, &models.Reservation{})
+
+	suite.ErrorIs(err, services.ErrRoomHoldNotOwned)
+	suite.mockReservationService.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestConvert_객실을_잠그는_동안_홀드가_만료되면_예약하지_않는다() {
+	// Given - 처음 조회 때는 남아 있던 홀드가 객실을 잠근 뒤에는 만료되었으면
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 1
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil).Once()
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(nil, nil).Once()
+
+	// When
+	err := suite.service.Convert(suite.ctx, 7, &models.Reservation{})
+
+	// Then
+	suite.ErrorIs(err, services.ErrRoomHoldNotFound)
+	suite.mockReservationService.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestConvert_예약_생성_실패시_홀드_유지() {
+	// Given
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 1
+	reservation := &models.Reservation{}
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockReservationService.On("Create", suite.ctx, reservation, []uint{10}).Return(services.ErrPaymentMethodInactive)
+
+	// When
+	err := suite.service.Convert(suite.ctx, 7, reservation)
+
+	// Then
+	suite.ErrorIs(err, services.ErrPaymentMethodInactive)
+	suite.mockHoldRepo.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomHoldServiceTestSuite) TestConvert_커밋_실패시_홀드_복원() {
+	// Given - 예약 저장과 홀드 해제 이후 커밋이 실패하면
+	commitErr := errors.New("commit failed")
+	service := services.NewRoomHoldService(suite.mockHoldRepo,
+		&commitFailingReservationRepository{MockReservationRepository: suite.mockReservationRepo, commitErr: commitErr},
+		suite.mockRoomRepo, suite.mockDateBlockRepo, suite.mockReservationService)
+
+	hold := suite.newHold()
+	hold.ID = 7
+	hold.HolderID = 1
+	hold.ExpiresAt = time.Now().Add(5 * time.Minute)
+	reservation := &models.Reservation{}
+	suite.mockHoldRepo.On("FindByID", suite.ctx, uint(7)).Return(hold, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockReservationService.On("Create", suite.ctx, reservation, []uint{10}).Return(nil)
+	suite.mockHoldRepo.On("Delete", suite.ctx, uint(7)).Return(nil)
+	suite.mockHoldRepo.On("Create", suite.ctx, hold, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 4*time.Minute && ttl <= 5*time.Minute
+	})).Return(nil)
+
+	// When
+	err := service.Convert(suite.ctx, 7, reservation)
+
+	// Then - 남은 시간만큼 같은 홀드를 되살린다
+	suite.ErrorIs(err, commitErr)
+	suite.mockHoldRepo.AssertExpectations(suite.T())
+}
+
+func TestRoomHoldServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RoomHoldServiceTestSuite))
+}
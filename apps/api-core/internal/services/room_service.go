@@ -32,13 +32,22 @@ type roomService struct {
 	roomRepo      repositories.RoomRepository
 	roomGroupRepo repositories.RoomGroupRepository
 	auditService  audit.AuditService
+	amenityRepo   repositories.AmenityRepository
 }
 
-func NewRoomService(roomRepo repositories.RoomRepository, roomGroupRepo repositories.RoomGroupRepository, auditService audit.AuditService) RoomService {
+// NewRoomService는 객실 서비스를 생성합니다. amenityRepo를 넘기지 않으면 편의시설 지정은 무시합니다.
+func NewRoomService(roomRepo repositories.RoomRepository, roomGroupRepo repositories.RoomGroupRepository, auditService audit.AuditService,
+	amenityRepo ...repositories.AmenityRepository) RoomService {
+	var amenityRepository repositories.AmenityRepository
+	if len(amenityRepo) > 0 {
+		amenityRepository = amenityRepo[0]
+	}
+
 	return &roomService{
 		roomRepo:      roomRepo,
 		roomGroupRepo: roomGroupRepo,
 		auditService:  auditService,
+		amenityRepo:   amenityRepository,
 	}
 }
 
@@ -76,11 +85,18 @@ func (s *roomService) Create(ctx context.Context, room *models.Room) error {
 		return ErrRoomNumberExists
 	}
 
-	_, err = s.roomGroupRepo.FindByID(ctx, room.RoomGroupID)
+	roomGroup, err := s.roomGroupRepo.FindByID(ctx, room.RoomGroupID)
 	if err != nil {
 		return ErrRoomGroupNotFound
 	}
 
+	if err := validateRoomOccupancy(room, roomGroup); err != nil {
+		return err
+	}
+	if err := s.resolveAmenities(ctx, room); err != nil {
+		return err
+	}
+
 	_, err = s.roomRepo.Create(ctx, room)
 	return err
 }
@@ -114,6 +130,35 @@ func (s *roomService) Update(ctx context.Context, id uint, updates map[string]in
 		}
 	}
 
+	// 객실별 인원 기준은 0이면 지우고 객실 그룹 기준을 따른다
+	_, standardChanged := updates["standard_occupancy"]
+	_, maxChanged := updates["max_occupancy"]
+	if standardOccupancy, ok := updates["standard_occupancy"].(int); ok {
+		room.StandardOccupancy = occupancyOverride(standardOccupancy)
+	}
+	if maxOccupancy, ok := updates["max_occupancy"].(int); ok {
+		room.MaxOccupancy = occupancyOverride(maxOccupancy)
+	}
+	if standardChanged || maxChanged {
+		roomGroup, err := s.roomGroupRepo.FindByID(ctx, room.RoomGroupID)
+		if err != nil {
+			return nil, ErrRoomGroupNotFound
+		}
+		if err := validateRoomOccupancy(room, roomGroup); err != nil {
+			return nil, err
+		}
+	}
+
+	if amenityIDs, ok := updates["amenity_ids"].([]uint); ok {
+		room.Amenities = make([]models.Amenity, len(amenityIDs))
+		for i, id := range amenityIDs {
+			room.Amenities[i].ID = id
+		}
+		if err := s.resolveAmenities(ctx, room); err != nil {
+			return nil, err
+		}
+	}
+
 	if note, ok := updates["note"].(string); ok {
 		room.Note = note
 	}
@@ -129,6 +174,41 @@ func (s *roomService) Update(ctx context.Context, id uint, updates map[string]in
 	return room, nil
 }
 
+// resolveAmenities는 room.Amenities에 ID만 채워 넘긴 편의시설을 카탈로그에서 조회해 채웁니다.
+func (s *roomService) resolveAmenities(ctx context.Context, room *models.Room) error {
+	if s.amenityRepo == nil {
+		room.Amenities = nil
+		return nil
+	}
+
+	ids := make([]uint, len(room.Amenities))
+	for i, amenity := range room.Amenities {
+		ids[i] = amenity.ID
+	}
+	amenities, err := findAmenities(ctx, s.amenityRepo, ids)
+	if err != nil {
+		return err
+	}
+	room.Amenities = amenities
+	return nil
+}
+
+// validateRoomOccupancy는 객실별 인원 기준을 객실 그룹 기준에 덮어쓴 결과가 올바른지 확인합니다.
+func validateRoomOccupancy(room *models.Room, roomGroup *models.RoomGroup) error {
+	withGroup := *room
+	withGroup.RoomGroup = roomGroup
+	standard, max := withGroup.Occupancy()
+	return validateOccupancy(standard, max)
+}
+
+// occupancyOverride는 객실별 인원 기준 요청 값을 저장할 값으로 바꿉니다. 0이면 객실 그룹 기준을 따르도록 nil을 반환합니다.
+func occupancyOverride(value int) *int {
+	if value <= 0 {
+		return nil
+	}
+	return &value
+}
+
 func (s *roomService) Delete(ctx context.Context, id uint) error {
 	room, err := s.roomRepo.FindByID(ctx, id)
 	if err != nil {
@@ -96,6 +96,24 @@ func (m *MockRoomRepository) FindByStatus(ctx context.Context, status models.Roo
 	return args.Get(0).([]models.Room), args.Error(1)
 }
 
+func (m *MockRoomRepository) FindAllWithDetails(ctx context.Context) ([]models.Room, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Room), args.Error(1)
+}
+
+func (m *MockRoomRepository) LockRooms(ctx context.Context, roomIDs []uint) error {
+	args := m.Called(ctx, roomIDs)
+	return args.Error(0)
+}
+
+func (m *MockRoomRepository) UpdateHousekeepingStatus(ctx context.Context, roomID uint, status models.HousekeepingStatus) error {
+	args := m.Called(ctx, roomID, status)
+	return args.Error(0)
+}
+
 // MockRoomGroupRepository is a mock implementation of RoomGroupRepository
 type MockRoomGroupRepository struct {
 	mock.Mock
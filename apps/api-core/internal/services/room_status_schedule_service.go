@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrRoomStatusScheduleNotFound     = errors.New("객실 상태 예약을 찾을 수 없습니다")
+	ErrRoomStatusScheduleOverlap      = errors.New("기간이 겹치는 객실 상태 예약이 있습니다")
+	ErrRoomStatusSchedulePast         = errors.New("이미 지난 기간입니다")
+	ErrRoomStatusTimelineRangeTooLong = errors.New("조회 기간이 너무 깁니다")
+)
+
+// maxRoomStatusTimelineDays는 객실 상태 타임라인을 한 번에 조회할 수 있는 최대 일수입니다.
+const maxRoomStatusTimelineDays = 366
+
+// RoomStatusScheduleService는 기간을 정해 둔 객실 상태 변경(객실 상태 예약)을 관리하고, 날짜가 되면 객실에 적용합니다.
+type RoomStatusScheduleService interface {
+	GetSchedules(ctx context.Context, roomID uint) ([]models.RoomStatusSchedule, error)
+	Create(ctx context.Context, schedule *models.RoomStatusSchedule) error
+	Delete(ctx context.Context, roomID, id uint) error
+	ApplyDue(ctx context.Context, now time.Time) (applied int, reverted int, err error)
+	GetTimeline(ctx context.Context, roomID uint, startDate, endDate, today time.Time) (*dto.RoomStatusTimelineResponse, error)
+}
+
+type roomStatusScheduleService struct {
+	scheduleRepo repositories.RoomStatusScheduleRepository
+	roomRepo     repositories.RoomRepository
+}
+
+func NewRoomStatusScheduleService(scheduleRepo repositories.RoomStatusScheduleRepository, roomRepo repositories.RoomRepository) RoomStatusScheduleService {
+	return &roomStatusScheduleService{
+		scheduleRepo: scheduleRepo,
+		roomRepo:     roomRepo,
+	}
+}
+
+func (s *roomStatusScheduleService) GetSchedules(ctx context.Context, roomID uint) ([]models.RoomStatusSchedule, error) {
+	if _, err := s.roomRepo.FindByID(ctx, roomID); err != nil {
+		return nil, ErrRoomNotFound
+	}
+	return s.scheduleRepo.FindByRoomID(ctx, roomID)
+}
+
+// Create는 객실 상태 예약을 등록합니다. 같은 객실의 다른 예약과 기간이 겹치면 ErrRoomStatusScheduleOverlap을,
+// 종료일이 오늘 이전이면 ErrRoomStatusSchedulePast를 반환하며, 오늘부터 시작하는 예약은 바로 객실에 적용합니다.
+func (s *roomStatusScheduleService) Create(ctx context.Context, schedule *models.RoomStatusSchedule) error {
+	today := truncateToDate(time.Now())
+	if schedule.EndDate.Before(today) {
+		return ErrRoomStatusSchedulePast
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, schedule.RoomID)
+	if err != nil {
+		return ErrRoomNotFound
+	}
+
+	return s.scheduleRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, []uint{schedule.RoomID}); err != nil {
+			return err
+		}
+
+		overlapping, err := s.scheduleRepo.FindOverlapping(ctx, schedule.RoomID, schedule.StartDate, schedule.EndDate)
+		if err != nil {
+			return err
+		}
+		if len(overlapping) > 0 {
+			return ErrRoomStatusScheduleOverlap
+		}
+
+		if err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+			return err
+		}
+		if schedule.Covers(today) {
+			return s.apply(ctx, schedule, room)
+		}
+		return nil
+	})
+}
+
+// Delete는 객실 상태 예약을 삭제합니다. 적용 중인 예약이면 객실 상태를 바꾸기 전 상태로 되돌립니다.
+func (s *roomStatusScheduleService) Delete(ctx context.Context, roomID, id uint) error {
+	schedule, err := s.scheduleRepo.FindByID(ctx, id)
+	if err != nil || schedule.RoomID != roomID {
+		return ErrRoomStatusScheduleNotFound
+	}
+
+	return s.scheduleRepo.Transaction(ctx, func(ctx context.Context) error {
+		if schedule.IsActive() {
+			if err := s.revert(ctx, schedule); err != nil {
+				return err
+			}
+		}
+		return s.scheduleRepo.Delete(ctx, id)
+	})
+}
+
+// ApplyDue는 now 날짜에 시작한 객실 상태 예약을 객실에 적용하고, 기간이 끝난 예약은 객실 상태를 되돌립니다.
+// 주기적으로 호출되며, 같은 날 여러 번 호출해도 이미 처리한 예약은 다시 처리하지 않습니다.
+// 같은 객실에서 어제 끝난 예약과 오늘 시작하는 예약이 이어지면 먼저 되돌린 뒤 적용합니다.
+func (s *roomStatusScheduleService) ApplyDue(ctx context.Context, now time.Time) (int, int, error) {
+	today := truncateToDate(now)
+	applied, reverted := 0, 0
+
+	err := s.scheduleRepo.Transaction(ctx, func(ctx context.Context) error {
+		toRevert, err := s.scheduleRepo.FindToRevert(ctx, today)
+		if err != nil {
+			return err
+		}
+		for i := range toRevert {
+			if err := s.revert(ctx, &toRevert[i]); err != nil {
+				return err
+			}
+			reverted++
+		}
+
+		toApply, err := s.scheduleRepo.FindToApply(ctx, today)
+		if err != nil {
+			return err
+		}
+		for i := range toApply {
+			room, err := s.roomRepo.FindByID(ctx, toApply[i].RoomID)
+			if err != nil {
+				return ErrRoomNotFound
+			}
+			if err := s.apply(ctx, &toApply[i], room); err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return applied, reverted, nil
+}
+
+// apply는 객실의 현재 상태를 PreviousStatus로 기록하고 객실을 예약한 상태로 바꿉니다.
+func (s *roomStatusScheduleService) apply(ctx context.Context, schedule *models.RoomStatusSchedule, room *models.Room) error {
+	now := time.Now()
+	previousStatus := room.Status
+	schedule.PreviousStatus = &previousStatus
+	schedule.AppliedAt = &now
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return err
+	}
+
+	if room.Status == schedule.Status {
+		return nil
+	}
+	room.Status = schedule.Status
+	return s.roomRepo.Update(ctx, room)
+}
+
+// revert는 객실을 PreviousStatus로 되돌립니다. 적용한 뒤 객실 상태를 직접 바꿨으면 객실은 그대로 둡니다.
+func (s *roomStatusScheduleService) revert(ctx context.Context, schedule *models.RoomStatusSchedule) error {
+	now := time.Now()
+	schedule.RevertedAt = &now
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return err
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, schedule.RoomID)
+	if err != nil {
+		return ErrRoomNotFound
+	}
+	if schedule.PreviousStatus == nil || room.Status != schedule.Status {
+		return nil
+	}
+	room.Status = *schedule.PreviousStatus
+	return s.roomRepo.Update(ctx, room)
+}
+
+// GetTimeline은 [startDate, endDate) 기간의 객실 운영 상태를 같은 상태가 이어지는 구간으로 묶어 반환합니다.
+func (s *roomStatusScheduleService) GetTimeline(ctx context.Context, roomID uint, startDate, endDate, today time.Time) (*dto.RoomStatusTimelineResponse, error) {
+	startDate, endDate, today = truncateToDate(startDate), truncateToDate(endDate), truncateToDate(today)
+	if !startDate.Before(endDate) {
+		return nil, ErrInvalidDateRange
+	}
+	if endDate.Sub(startDate) > maxRoomStatusTimelineDays*24*time.Hour {
+		return nil, ErrRoomStatusTimelineRangeTooLong
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		return nil, ErrRoomNotFound
+	}
+
+	schedules, err := s.scheduleRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 적용 중인 예약이 있으면 그 예약이 끝난 뒤 돌아갈 상태가 평소 상태다
+	baseStatus := room.BaseStatus(schedules)
+
+	periods := []dto.RoomStatusPeriodResponse{}
+	var current *dto.RoomStatusPeriodResponse
+	for date := startDate; date.Before(endDate); date = date.AddDate(0, 0, 1) {
+		status := baseStatus
+		var scheduleID *uint
+		for i := range schedules {
+			if schedules[i].Covers(date) {
+				status = schedules[i].Status
+				id := schedules[i].ID
+				scheduleID = &id
+				break
+			}
+		}
+
+		if current != nil && current.Status == status.String() && sameScheduleID(current.ScheduleID, scheduleID) {
+			current.EndDate = dto.JSONDate{Time: date}
+			continue
+		}
+		periods = append(periods, dto.RoomStatusPeriodResponse{
+			StartDate:  dto.JSONDate{Time: date},
+			EndDate:    dto.JSONDate{Time: date},
+			Status:     status.String(),
+			ScheduleID: scheduleID,
+		})
+		current = &periods[len(periods)-1]
+	}
+
+	visible := make([]models.RoomStatusSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		if schedule.StartDate.Before(endDate) && !schedule.EndDate.Before(startDate) {
+			visible = append(visible, schedule)
+		}
+	}
+
+	return &dto.RoomStatusTimelineResponse{
+		RoomID:        room.ID,
+		RoomNumber:    room.Number,
+		CurrentStatus: room.Status.String(),
+		StartDate:     dto.JSONDate{Time: startDate},
+		EndDate:       dto.JSONDate{Time: endDate},
+		Periods:       periods,
+		Schedules:     mappers.ToRoomStatusScheduleListResponse(visible, today),
+	}, nil
+}
+
+// findRoomStatusSchedules는 [startDate, endDate) 숙박일의 객실 상태에 영향을 주는 객실 상태 예약을 객실별로 묶어 조회합니다.
+// 빈 객실 검색, 객실 배정, 점유 현황은 이 예약과 Room.StatusOn으로 날짜별 객실 상태를 판단합니다.
+func findRoomStatusSchedules(ctx context.Context, scheduleRepo repositories.RoomStatusScheduleRepository, startDate, endDate time.Time) (map[uint][]models.RoomStatusSchedule, error) {
+	if scheduleRepo == nil {
+		return nil, nil
+	}
+
+	schedules, err := scheduleRepo.FindEffective(ctx, startDate, endDate.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	schedulesByRoom := make(map[uint][]models.RoomStatusSchedule)
+	for _, schedule := range schedules {
+		schedulesByRoom[schedule.RoomID] = append(schedulesByRoom[schedule.RoomID], schedule)
+	}
+	return schedulesByRoom, nil
+}
+
+// isInService는 객실 상태 예약을 반영해 [startDate, endDate) 숙박일 내내 객실이 정상(NORMAL) 상태인지 확인합니다.
+func isInService(room *models.Room, schedules []models.RoomStatusSchedule, startDate, endDate time.Time) bool {
+	for date := truncateToDate(startDate); date.Before(truncateToDate(endDate)); date = date.AddDate(0, 0, 1) {
+		if room.StatusOn(schedules, date) != models.RoomStatusNormal {
+			return false
+		}
+	}
+	return true
+}
+
+func sameScheduleID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
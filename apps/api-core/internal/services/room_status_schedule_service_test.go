@@ -0,0 +1,314 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockRoomStatusScheduleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoomStatusScheduleRepository) Create(ctx context.Context, schedule *models.RoomStatusSchedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockRoomStatusScheduleRepository) Update(ctx context.Context, schedule *models.RoomStatusSchedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockRoomStatusScheduleRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRoomStatusScheduleRepository) FindByID(ctx context.Context, id uint) (*models.RoomStatusSchedule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RoomStatusSchedule), args.Error(1)
+}
+
+func (m *MockRoomStatusScheduleRepository) FindByRoomID(ctx context.Context, roomID uint) ([]models.RoomStatusSchedule, error) {
+	args := m.Called(ctx, roomID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomStatusSchedule), args.Error(1)
+}
+
+func (m *MockRoomStatusScheduleRepository) FindOverlapping(ctx context.Context, roomID uint, startDate, endDate time.Time) ([]models.RoomStatusSchedule, error) {
+	args := m.Called(ctx, roomID, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomStatusSchedule), args.Error(1)
+}
+
+func (m *MockRoomStatusScheduleRepository) FindToApply(ctx context.Context, today time.Time) ([]models.RoomStatusSchedule, error) {
+	args := m.Called(ctx, today)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomStatusSchedule), args.Error(1)
+}
+
+func (m *MockRoomStatusScheduleRepository) FindToRevert(ctx context.Context, today time.Time) ([]models.RoomStatusSchedule, error) {
+	args := m.Called(ctx, today)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomStatusSchedule), args.Error(1)
+}
+
+func (m *MockRoomStatusScheduleRepository) FindEffective(ctx context.Context, startDate, endDate time.Time) ([]models.RoomStatusSchedule, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.RoomStatusSchedule), args.Error(1)
+}
+
+func (m *MockRoomStatusScheduleRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type RoomStatusScheduleServiceTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	mockScheduleRepo *MockRoomStatusScheduleRepository
+	mockRoomRepo     *MockRoomRepository
+	service          services.RoomStatusScheduleService
+	today            time.Time
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockScheduleRepo = new(MockRoomStatusScheduleRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.service = services.NewRoomStatusScheduleService(suite.mockScheduleRepo, suite.mockRoomRepo)
+	suite.today = today()
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) day(offset int) time.Time {
+	return suite.today.AddDate(0, 0, offset)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) newRoom(status models.RoomStatus) *models.Room {
+	room := &models.Room{Number: "101", Status: status}
+	room.ID = 10
+	return room
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) newSchedule(id uint, status models.RoomStatus, start, end int) models.RoomStatusSchedule {
+	schedule := models.RoomStatusSchedule{RoomID: 10, Status: status, StartDate: suite.day(start), EndDate: suite.day(end)}
+	schedule.ID = id
+	return schedule
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestCreate_미래_예약은_객실_상태를_바로_바꾸지_않는다() {
+	// Given
+	room := suite.newRoom(models.RoomStatusNormal)
+	schedule := suite.newSchedule(0, models.RoomStatusConstruction, 10, 20)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockScheduleRepo.On("FindOverlapping", suite.ctx, uint(10), suite.day(10), suite.day(20)).Return([]models.RoomStatusSchedule{}, nil)
+	suite.mockScheduleRepo.On("Create", suite.ctx, &schedule).Return(nil)
+
+	// When
+	err := suite.service.Create(suite.ctx, &schedule)
+
+	// Then
+	suite.NoError(err)
+	suite.Nil(schedule.AppliedAt)
+	suite.Equal(models.RoomStatusNormal, room.Status)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestCreate_오늘이_기간에_속하면_바로_적용한다() {
+	// Given
+	room := suite.newRoom(models.RoomStatusNormal)
+	schedule := suite.newSchedule(0, models.RoomStatusConstruction, 0, 5)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockScheduleRepo.On("FindOverlapping", suite.ctx, uint(10), suite.day(0), suite.day(5)).Return([]models.RoomStatusSchedule{}, nil)
+	suite.mockScheduleRepo.On("Create", suite.ctx, &schedule).Return(nil)
+	suite.mockScheduleRepo.On("Update", suite.ctx, &schedule).Return(nil)
+	suite.mockRoomRepo.On("Update", suite.ctx, room).Return(nil)
+
+	// When
+	err := suite.service.Create(suite.ctx, &schedule)
+
+	// Then - 바꾸기 전 상태를 기록하고 객실을 공사 상태로 바꾼다
+	suite.NoError(err)
+	suite.NotNil(schedule.AppliedAt)
+	suite.Equal(models.RoomStatusNormal, *schedule.PreviousStatus)
+	suite.Equal(models.RoomStatusConstruction, room.Status)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestCreate_기간이_겹치면_에러() {
+	schedule := suite.newSchedule(0, models.RoomStatusConstruction, 10, 20)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(suite.newRoom(models.RoomStatusNormal), nil)
+	suite.mockRoomRepo.On("LockRooms", suite.ctx, []uint{10}).Return(nil)
+	suite.mockScheduleRepo.On("FindOverlapping", suite.ctx, uint(10), suite.day(10), suite.day(20)).
+		Return([]models.RoomStatusSchedule{suite.newSchedule(1, models.RoomStatusDamaged, 15, 16)}, nil)
+
+	err := suite.service.Create(suite.ctx, &schedule)
+
+	suite.ErrorIs(err, services.ErrRoomStatusScheduleOverlap)
+	suite.mockScheduleRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestCreate_지난_기간이면_에러() {
+	schedule := suite.newSchedule(0, models.RoomStatusConstruction, -10, -1)
+
+	err := suite.service.Create(suite.ctx, &schedule)
+
+	suite.ErrorIs(err, services.ErrRoomStatusSchedulePast)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestApplyDue_끝난_예약을_되돌리고_시작한_예약을_적용한다() {
+	// Given - 어제 끝난 공사 예약과 오늘 시작하는 고장 예약이 같은 객실에 이어지면
+	room := suite.newRoom(models.RoomStatusConstruction)
+	normal := models.RoomStatusNormal
+	appliedAt := suite.day(-5)
+	ended := suite.newSchedule(1, models.RoomStatusConstruction, -5, -1)
+	ended.PreviousStatus = &normal
+	ended.AppliedAt = &appliedAt
+	starting := suite.newSchedule(2, models.RoomStatusDamaged, 0, 3)
+
+	suite.mockScheduleRepo.On("FindToRevert", suite.ctx, suite.today).Return([]models.RoomStatusSchedule{ended}, nil)
+	suite.mockScheduleRepo.On("FindToApply", suite.ctx, suite.today).Return([]models.RoomStatusSchedule{starting}, nil)
+	suite.mockScheduleRepo.On("Update", suite.ctx, mock.AnythingOfType("*models.RoomStatusSchedule")).Return(nil)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+
+	var roomStatuses []models.RoomStatus
+	suite.mockRoomRepo.On("Update", suite.ctx, room).Return(nil).Run(func(args mock.Arguments) {
+		roomStatuses = append(roomStatuses, args.Get(1).(*models.Room).Status)
+	})
+
+	// When
+	applied, reverted, err := suite.service.ApplyDue(suite.ctx, suite.today.Add(3*time.Hour))
+
+	// Then - 정상으로 되돌린 뒤 고장 상태로 바꾸고, 고장 예약의 이전 상태는 정상으로 기록된다
+	suite.NoError(err)
+	suite.Equal(1, applied)
+	suite.Equal(1, reverted)
+	suite.Equal([]models.RoomStatus{models.RoomStatusNormal, models.RoomStatusDamaged}, roomStatuses)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestApplyDue_직접_바꾼_객실_상태는_되돌리지_않는다() {
+	// Given - 공사 예약을 적용한 뒤 객실을 직접 사용 안 함으로 바꿨으면
+	room := suite.newRoom(models.RoomStatusInactive)
+	normal := models.RoomStatusNormal
+	appliedAt := suite.day(-5)
+	ended := suite.newSchedule(1, models.RoomStatusConstruction, -5, -1)
+	ended.PreviousStatus = &normal
+	ended.AppliedAt = &appliedAt
+
+	suite.mockScheduleRepo.On("FindToRevert", suite.ctx, suite.today).Return([]models.RoomStatusSchedule{ended}, nil)
+	suite.mockScheduleRepo.On("FindToApply", suite.ctx, suite.today).Return([]models.RoomStatusSchedule{}, nil)
+	suite.mockScheduleRepo.On("Update", suite.ctx, mock.AnythingOfType("*models.RoomStatusSchedule")).Return(nil)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+
+	// When
+	_, reverted, err := suite.service.ApplyDue(suite.ctx, suite.today)
+
+	// Then - 예약은 끝난 것으로 기록하지만 객실 상태는 그대로 둔다
+	suite.NoError(err)
+	suite.Equal(1, reverted)
+	suite.Equal(models.RoomStatusInactive, room.Status)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestDelete_적용_중인_예약을_지우면_객실_상태를_되돌린다() {
+	room := suite.newRoom(models.RoomStatusConstruction)
+	normal := models.RoomStatusNormal
+	appliedAt := suite.day(-1)
+	active := suite.newSchedule(1, models.RoomStatusConstruction, -1, 5)
+	active.PreviousStatus = &normal
+	active.AppliedAt = &appliedAt
+
+	suite.mockScheduleRepo.On("FindByID", suite.ctx, uint(1)).Return(&active, nil)
+	suite.mockScheduleRepo.On("Update", suite.ctx, &active).Return(nil)
+	suite.mockScheduleRepo.On("Delete", suite.ctx, uint(1)).Return(nil)
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockRoomRepo.On("Update", suite.ctx, room).Return(nil)
+
+	err := suite.service.Delete(suite.ctx, 10, 1)
+
+	suite.NoError(err)
+	suite.Equal(models.RoomStatusNormal, room.Status)
+	suite.mockScheduleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestDelete_다른_객실의_예약이면_에러() {
+	schedule := suite.newSchedule(1, models.RoomStatusConstruction, 10, 20)
+	suite.mockScheduleRepo.On("FindByID", suite.ctx, uint(1)).Return(&schedule, nil)
+
+	err := suite.service.Delete(suite.ctx, 99, 1)
+
+	suite.ErrorIs(err, services.ErrRoomStatusScheduleNotFound)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestGetTimeline_예약_기간을_구간으로_보여준다() {
+	// Given - 지금 공사 중(어제~내일)이고 5일 뒤부터 이틀 동안 고장 예약이 있으면
+	room := suite.newRoom(models.RoomStatusConstruction)
+	normal := models.RoomStatusNormal
+	appliedAt := suite.day(-1)
+	active := suite.newSchedule(1, models.RoomStatusConstruction, -1, 1)
+	active.PreviousStatus = &normal
+	active.AppliedAt = &appliedAt
+	future := suite.newSchedule(2, models.RoomStatusDamaged, 5, 6)
+
+	suite.mockRoomRepo.On("FindByID", suite.ctx, uint(10)).Return(room, nil)
+	suite.mockScheduleRepo.On("FindByRoomID", suite.ctx, uint(10)).Return([]models.RoomStatusSchedule{active, future}, nil)
+
+	// When - 오늘부터 10일을 조회하면
+	timeline, err := suite.service.GetTimeline(suite.ctx, 10, suite.day(0), suite.day(10), suite.today)
+
+	// Then - 예약이 없는 날은 공사 전 상태(정상)로 표시된다
+	suite.NoError(err)
+	suite.Equal("CONSTRUCTION", timeline.CurrentStatus)
+	suite.Len(timeline.Periods, 4)
+
+	expected := []struct {
+		start, end int
+		status     string
+		scheduleID *uint
+	}{
+		{0, 1, "CONSTRUCTION", &active.ID},
+		{2, 4, "NORMAL", nil},
+		{5, 6, "DAMAGED", &future.ID},
+		{7, 9, "NORMAL", nil},
+	}
+	for i, e := range expected {
+		suite.Equal(suite.day(e.start), timeline.Periods[i].StartDate.Time, "구간 %d 시작일", i)
+		suite.Equal(suite.day(e.end), timeline.Periods[i].EndDate.Time, "구간 %d 종료일", i)
+		suite.Equal(e.status, timeline.Periods[i].Status)
+		suite.Equal(e.scheduleID, timeline.Periods[i].ScheduleID)
+	}
+
+	suite.Len(timeline.Schedules, 2)
+	suite.Equal("ACTIVE", timeline.Schedules[0].State)
+	suite.Equal("SCHEDULED", timeline.Schedules[1].State)
+}
+
+func (suite *RoomStatusScheduleServiceTestSuite) TestGetTimeline_조회_기간이_너무_길면_에러() {
+	_, err := suite.service.GetTimeline(suite.ctx, 10, suite.day(0), suite.day(400), suite.today)
+
+	suite.ErrorIs(err, services.ErrRoomStatusTimelineRangeTooLong)
+}
+
+func TestRoomStatusScheduleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RoomStatusScheduleServiceTestSuite))
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/mappers"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrSeasonNotFound       = errors.New("존재하지 않는 시즌")
+	ErrInvalidSeasonRequest = errors.New("잘못된 시즌 요청")
+)
+
+type SeasonService interface {
+	Create(ctx context.Context, req dto.CreateSeasonRequest) (*dto.SeasonResponse, error)
+	Delete(ctx context.Context, id uint) error
+	GetSeason(ctx context.Context, id uint) (*dto.SeasonResponse, error)
+	GetAll(ctx context.Context, filter dto.SeasonFilter, page, size int) ([]dto.SeasonResponse, int64, error)
+	UpdateSeason(ctx context.Context, id uint, req dto.UpdateSeasonRequest) (*dto.SeasonResponse, error)
+}
+
+type seasonService struct {
+	seasonRepo    repositories.SeasonRepository
+	roomGroupRepo repositories.RoomGroupRepository
+	auditService  audit.AuditService
+}
+
+func NewSeasonService(seasonRepo repositories.SeasonRepository, roomGroupRepo repositories.RoomGroupRepository, auditService audit.AuditService) SeasonService {
+	return &seasonService{seasonRepo: seasonRepo, roomGroupRepo: roomGroupRepo, auditService: auditService}
+}
+
+func (s *seasonService) Create(ctx context.Context, req dto.CreateSeasonRequest) (*dto.SeasonResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSeasonRequest, err.Error())
+	}
+
+	startDate, _ := time.Parse("2006-01-02", req.StartDate)
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+
+	roomGroups, err := s.findRoomGroups(ctx, req.RoomGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	season := &models.Season{
+		Name:            req.Name,
+		StartDate:       startDate,
+		EndDate:         endDate,
+		RecurringYearly: req.RecurringYearly,
+		RoomGroups:      roomGroups,
+	}
+
+	created, err := s.seasonRepo.Create(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToSeasonResponse(created)
+	return &result, nil
+}
+
+func (s *seasonService) Delete(ctx context.Context, id uint) error {
+	season, err := s.seasonRepo.FindByID(ctx, id)
+	if err != nil {
+		return ErrSeasonNotFound
+	}
+
+	if err := s.seasonRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// Log deletion in audit — manual call required because soft delete bypasses GORM delete hooks
+	_ = s.auditService.LogDelete(ctx, season)
+
+	return nil
+}
+
+func (s *seasonService) GetAll(ctx context.Context, filter dto.SeasonFilter, page, size int) ([]dto.SeasonResponse, int64, error) {
+	offset := page * size
+	seasons, total, err := s.seasonRepo.FindAll(ctx, filter, offset, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mappers.ToSeasonListResponse(seasons), total, nil
+}
+
+func (s *seasonService) GetSeason(ctx context.Context, id uint) (*dto.SeasonResponse, error) {
+	season, err := s.seasonRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrSeasonNotFound
+	}
+
+	result := mappers.ToSeasonResponse(season)
+	return &result, nil
+}
+
+// UpdateSeason은 시즌을 수정합니다. 변경 이력은 GORM 감사 훅이 기록합니다.
+func (s *seasonService) UpdateSeason(ctx context.Context, id uint, req dto.UpdateSeasonRequest) (*dto.SeasonResponse, error) {
+	season, err := s.seasonRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, ErrSeasonNotFound
+	}
+
+	if req.Name != nil {
+		season.Name = *req.Name
+	}
+
+	if req.StartDate != nil {
+		startDate, parseErr := time.Parse("2006-01-02", *req.StartDate)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: invalid startDate format, expected YYYY-MM-DD", ErrInvalidSeasonRequest)
+		}
+		season.StartDate = startDate
+	}
+
+	if req.EndDate != nil {
+		endDate, parseErr := time.Parse("2006-01-02", *req.EndDate)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: invalid endDate format, expected YYYY-MM-DD", ErrInvalidSeasonRequest)
+		}
+		season.EndDate = endDate
+	}
+
+	if req.RecurringYearly != nil {
+		season.RecurringYearly = *req.RecurringYearly
+	}
+
+	if err := dto.ValidateSeasonPeriod(season.StartDate, season.EndDate, season.RecurringYearly); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSeasonRequest, err.Error())
+	}
+
+	if req.RoomGroupIDs != nil {
+		roomGroups, err := s.findRoomGroups(ctx, *req.RoomGroupIDs)
+		if err != nil {
+			return nil, err
+		}
+		season.RoomGroups = roomGroups
+	}
+
+	if err := s.seasonRepo.Update(ctx, season); err != nil {
+		return nil, err
+	}
+
+	result := mappers.ToSeasonResponse(season)
+	return &result, nil
+}
+
+// findRoomGroups는 시즌에 연결할 객실 그룹을 조회합니다. 존재하지 않는 그룹이 있으면 요청 오류입니다.
+func (s *seasonService) findRoomGroups(ctx context.Context, roomGroupIDs []uint) ([]models.RoomGroup, error) {
+	roomGroups := make([]models.RoomGroup, 0, len(roomGroupIDs))
+	seen := make(map[uint]bool, len(roomGroupIDs))
+	for _, roomGroupID := range roomGroupIDs {
+		if seen[roomGroupID] {
+			continue
+		}
+		seen[roomGroupID] = true
+
+		roomGroup, err := s.roomGroupRepo.FindByID(ctx, roomGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s (id=%d)", ErrInvalidSeasonRequest, ErrRoomGroupNotFound.Error(), roomGroupID)
+		}
+		roomGroups = append(roomGroups, *roomGroup)
+	}
+	return roomGroups, nil
+}
@@ -0,0 +1,215 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+	"gorm.io/gorm"
+)
+
+type MockSeasonRepository struct {
+	mock.Mock
+}
+
+func (m *MockSeasonRepository) Create(ctx context.Context, season *models.Season) (*models.Season, error) {
+	args := m.Called(ctx, season)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Season), args.Error(1)
+}
+
+func (m *MockSeasonRepository) Update(ctx context.Context, season *models.Season) error {
+	args := m.Called(ctx, season)
+	return args.Error(0)
+}
+
+func (m *MockSeasonRepository) Delete(ctx context.Context, id uint) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSeasonRepository) FindByID(ctx context.Context, id uint) (*models.Season, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Season), args.Error(1)
+}
+
+func (m *MockSeasonRepository) FindAll(ctx context.Context, filter dto.SeasonFilter, offset, limit int) ([]models.Season, int64, error) {
+	args := m.Called(ctx, filter, offset, limit)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.Season), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockSeasonRepository) FindApplicable(ctx context.Context, startDate, endDate time.Time) ([]models.Season, error) {
+	args := m.Called(ctx, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Season), args.Error(1)
+}
+
+type SeasonServiceTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	mockRepo          *MockSeasonRepository
+	mockRoomGroupRepo *MockRoomGroupRepository
+	mockAuditService  *MockAuditService
+	service           services.SeasonService
+}
+
+func (s *SeasonServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.mockRepo = new(MockSeasonRepository)
+	s.mockRoomGroupRepo = new(MockRoomGroupRepository)
+	s.mockAuditService = new(MockAuditService)
+	s.service = services.NewSeasonService(s.mockRepo, s.mockRoomGroupRepo, s.mockAuditService)
+}
+
+func (s *SeasonServiceTestSuite) TestCreate_객실_그룹을_지정한_시즌을_생성한다() {
+	// Given - 객실 그룹 1번에만 적용되는 여름 성수기 요청이면
+	req := dto.CreateSeasonRequest{
+		Name:         "여름 성수기",
+		StartDate:    "2026-07-15",
+		EndDate:      "2026-08-20",
+		RoomGroupIDs: []uint{1, 1},
+	}
+	roomGroup := &models.RoomGroup{Name: "디럭스"}
+	roomGroup.ID = 1
+	s.mockRoomGroupRepo.On("FindByID", s.ctx, uint(1)).Return(roomGroup, nil).Once()
+
+	created := &models.Season{Name: req.Name, RoomGroups: []models.RoomGroup{*roomGroup}}
+	created.ID = 5
+	s.mockRepo.On("Create", s.ctx, mock.MatchedBy(func(season *models.Season) bool {
+		return season.Name == req.Name && len(season.RoomGroups) == 1 && season.RoomGroups[0].ID == 1
+	})).Return(created, nil)
+
+	// When
+	result, err := s.service.Create(s.ctx, req)
+
+	// Then - 중복된 그룹은 한 번만 연결된다
+	s.NoError(err)
+	s.Equal(uint(5), result.ID)
+	s.Equal([]uint{1}, result.RoomGroupIDs)
+	s.mockRoomGroupRepo.AssertExpectations(s.T())
+}
+
+func (s *SeasonServiceTestSuite) TestCreate_존재하지_않는_객실_그룹이면_에러() {
+	req := dto.CreateSeasonRequest{
+		Name:         "여름 성수기",
+		StartDate:    "2026-07-15",
+		EndDate:      "2026-08-20",
+		RoomGroupIDs: []uint{99},
+	}
+	s.mockRoomGroupRepo.On("FindByID", s.ctx, uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := s.service.Create(s.ctx, req)
+
+	s.ErrorIs(err, services.ErrInvalidSeasonRequest)
+	s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *SeasonServiceTestSuite) TestCreate_기간_검증() {
+	testCases := []struct {
+		name      string
+		req       dto.CreateSeasonRequest
+		expectErr bool
+	}{
+		{"시작일이 종료일보다 이후면 실패", dto.CreateSeasonRequest{Name: "시즌", StartDate: "2026-08-01", EndDate: "2026-07-01"}, true},
+		{"날짜 형식이 잘못되면 실패", dto.CreateSeasonRequest{Name: "시즌", StartDate: "2026/07/01", EndDate: "2026-08-01"}, true},
+		{"1년 이상인 반복 시즌은 실패", dto.CreateSeasonRequest{Name: "시즌", StartDate: "2026-01-01", EndDate: "2027-01-01", RecurringYearly: true}, true},
+		{"연말을 넘어가는 반복 시즌은 성공", dto.CreateSeasonRequest{Name: "연말연시", StartDate: "2026-12-20", EndDate: "2027-01-05", RecurringYearly: true}, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockRepo.On("Create", s.ctx, mock.Anything).Return(&models.Season{Name: tc.req.Name}, nil)
+
+			_, err := s.service.Create(s.ctx, tc.req)
+
+			if tc.expectErr {
+				s.ErrorIs(err, services.ErrInvalidSeasonRequest)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *SeasonServiceTestSuite) TestUpdateSeason_객실_그룹을_비우면_전체_그룹에_적용된다() {
+	// Given - 객실 그룹 1번에 적용되던 시즌을
+	roomGroup := models.RoomGroup{}
+	roomGroup.ID = 1
+	season := &models.Season{
+		Name:       "여름 성수기",
+		StartDate:  time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:    time.Date(2026, 8, 20, 0, 0, 0, 0, time.UTC),
+		RoomGroups: []models.RoomGroup{roomGroup},
+	}
+	season.ID = 5
+	s.mockRepo.On("FindByID", s.ctx, uint(5)).Return(season, nil)
+	s.mockRepo.On("Update", s.ctx, season).Return(nil)
+
+	// When - 빈 객실 그룹 목록으로 수정하면
+	emptyGroups := []uint{}
+	result, err := s.service.UpdateSeason(s.ctx, 5, dto.UpdateSeasonRequest{RoomGroupIDs: &emptyGroups})
+
+	// Then
+	s.NoError(err)
+	s.Empty(result.RoomGroupIDs)
+	s.Empty(season.RoomGroups)
+}
+
+func (s *SeasonServiceTestSuite) TestUpdateSeason_잘못된_기간이면_저장하지_않는다() {
+	season := &models.Season{
+		Name:      "여름 성수기",
+		StartDate: time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 8, 20, 0, 0, 0, 0, time.UTC),
+	}
+	season.ID = 5
+	s.mockRepo.On("FindByID", s.ctx, uint(5)).Return(season, nil)
+
+	endDate := "2026-07-01"
+	_, err := s.service.UpdateSeason(s.ctx, 5, dto.UpdateSeasonRequest{EndDate: &endDate})
+
+	s.ErrorIs(err, services.ErrInvalidSeasonRequest)
+	s.mockRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *SeasonServiceTestSuite) TestDelete_삭제_후_감사_로그를_남긴다() {
+	season := &models.Season{Name: "여름 성수기"}
+	season.ID = 5
+	s.mockRepo.On("FindByID", s.ctx, uint(5)).Return(season, nil)
+	s.mockRepo.On("Delete", s.ctx, uint(5)).Return(nil)
+	s.mockAuditService.On("LogDelete", s.ctx, season).Return(nil)
+
+	err := s.service.Delete(s.ctx, 5)
+
+	s.NoError(err)
+	s.mockAuditService.AssertExpectations(s.T())
+}
+
+func (s *SeasonServiceTestSuite) TestDelete_존재하지_않는_시즌() {
+	s.mockRepo.On("FindByID", s.ctx, uint(5)).Return(nil, errors.New("record not found"))
+
+	err := s.service.Delete(s.ctx, 5)
+
+	assert.ErrorIs(s.T(), err, services.ErrSeasonNotFound)
+}
+
+func TestSeasonServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SeasonServiceTestSuite))
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.bellsoft.net/rms/api-core/internal/audit"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/repositories"
+)
+
+var (
+	ErrStayChangeInactive    = errors.New("취소되었거나 종료된 예약은 숙박 기간을 바꿀 수 없습니다")
+	ErrInvalidStayExtension  = errors.New("연장할 퇴실일은 현재 퇴실일 이후여야 합니다")
+	ErrInvalidStayShortening = errors.New("앞당길 퇴실일은 숙박 시작일과 현재 퇴실일 사이여야 합니다")
+	ErrStayChangeOverpaid    = errors.New("받은 금액이 줄어든 판매 금액보다 많습니다. 환불 결제 내역을 먼저 기록해야 합니다")
+)
+
+// StayChangeService는 숙박 연장과 조기 퇴실을 처리합니다. 늘어난 숙박일만 객실 가용성과 날짜 차단을 확인하고,
+// 바뀐 기간의 견적 차액만큼 판매 금액(미수금)을 조정해 감사 로그에 STAY_EXTEND/STAY_SHORTEN 한 건으로 기록합니다.
+// 줄어든 판매 금액보다 이미 받은 금액이 많으면 환불 결제 내역을 먼저 기록해야 합니다.
+type StayChangeService interface {
+	Extend(ctx context.Context, reservationID uint, stayEndAt time.Time) (*dto.StayChangeResponse, error)
+	Shorten(ctx context.Context, reservationID uint, stayEndAt time.Time) (*dto.StayChangeResponse, error)
+}
+
+type stayChangeService struct {
+	reservationRepo repositories.ReservationRepository
+	roomRepo        repositories.RoomRepository
+	dateBlockRepo   repositories.DateBlockRepository
+	quoteService    QuoteService
+}
+
+func NewStayChangeService(reservationRepo repositories.ReservationRepository, roomRepo repositories.RoomRepository,
+	dateBlockRepo repositories.DateBlockRepository, quoteService QuoteService) StayChangeService {
+	return &stayChangeService{
+		reservationRepo: reservationRepo,
+		roomRepo:        roomRepo,
+		dateBlockRepo:   dateBlockRepo,
+		quoteService:    quoteService,
+	}
+}
+
+func (s *stayChangeService) Extend(ctx context.Context, reservationID uint, stayEndAt time.Time) (*dto.StayChangeResponse, error) {
+	return s.change(audit.WithAction(ctx, audit.ActionStayExtend), reservationID, stayEndAt, true)
+}
+
+func (s *stayChangeService) Shorten(ctx context.Context, reservationID uint, stayEndAt time.Time) (*dto.StayChangeResponse, error) {
+	return s.change(audit.WithAction(ctx, audit.ActionStayShorten), reservationID, stayEndAt, false)
+}
+
+func (s *stayChangeService) change(ctx context.Context, reservationID uint, stayEndAt time.Time, extend bool) (*dto.StayChangeResponse, error) {
+	stayEndAt = truncateToDate(stayEndAt)
+
+	// 객실 잠금은 트랜잭션의 첫 조회보다 먼저 걸어야 하므로 잠글 객실은 트랜잭션 밖에서 미리 조회한다
+	current, err := s.reservationRepo.FindByIDWithDetails(ctx, reservationID)
+	if err != nil {
+		return nil, ErrReservationNotFound
+	}
+
+	var result *dto.StayChangeResponse
+	err = s.reservationRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepo.LockRooms(ctx, current.RoomIDs()); err != nil {
+			return err
+		}
+
+		reservation, err := s.reservationRepo.FindByIDWithDetails(ctx, reservationID)
+		if err != nil {
+			return ErrReservationNotFound
+		}
+		if !reservation.IsActive() {
+			return ErrStayChangeInactive
+		}
+
+		stayStartAt, previousEndAt := truncateToDate(reservation.StayStartAt), truncateToDate(reservation.StayEndAt)
+		if extend && !stayEndAt.After(previousEndAt) {
+			return ErrInvalidStayExtension
+		}
+		if !extend && (!stayEndAt.Before(previousEndAt) || !stayEndAt.After(stayStartAt)) {
+			return ErrInvalidStayShortening
+		}
+
+		previousRooms := append([]models.ReservationRoom(nil), reservation.Rooms...)
+		reservation.StayEndAt = stayEndAt
+		if !reservation.RescheduleRooms(stayStartAt, previousEndAt) {
+			return ErrRoomMoveOutOfStay
+		}
+
+		if extend {
+			if err := checkAddedNights(ctx, s.roomRepo, reservation, stayStartAt, previousEndAt, previousRooms); err != nil {
+				return err
+			}
+			if err := s.checkAddedNightsBlocked(ctx, reservation, previousEndAt); err != nil {
+				return err
+			}
+		}
+
+		difference, appliedRules, err := s.priceDifference(ctx, reservation, previousEndAt)
+		if err != nil {
+			return err
+		}
+		if difference != 0 && reservation.IsSettled() {
+			return ErrReservationSettled
+		}
+
+		previousPrice := reservation.Price
+		reservation.Price += difference
+		if difference < 0 && reservation.UnpaidAmount() < 0 {
+			return ErrStayChangeOverpaid
+		}
+		// 적용 규칙은 견적으로 판매 금액을 계산한 예약에만 있으므로 그런 예약만 바뀐 기간의 견적으로 다시 채운다
+		if reservation.AppliedPricingRules != nil {
+			reservation.AppliedPricingRules = appliedRules
+		}
+		if err := rescheduleRent(ctx, s.reservationRepo, reservation); err != nil {
+			return err
+		}
+		if err := s.reservationRepo.Update(ctx, reservation); err != nil {
+			return err
+		}
+
+		result = &dto.StayChangeResponse{
+			ReservationID:     reservation.ID,
+			PreviousStayEndAt: dto.JSONDate{Time: previousEndAt},
+			StayEndAt:         dto.JSONDate{Time: stayEndAt},
+			PreviousPrice:     previousPrice,
+			Price:             reservation.Price,
+			PriceDifference:   difference,
+			UnpaidAmount:      reservation.UnpaidAmount(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkAddedNightsBlocked는 연장한 [previousEndAt, 새 퇴실일) 기간에 마지막 날 객실이 차단되어 있는지 확인합니다.
+func (s *stayChangeService) checkAddedNightsBlocked(ctx context.Context, reservation *models.Reservation, previousEndAt time.Time) error {
+	if s.dateBlockRepo == nil {
+		return nil
+	}
+	blocked, err := s.dateBlockRepo.IsDateRangeBlocked(ctx, previousEndAt, reservation.StayEndAt, lastNightRoomIDs(reservation))
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrDateRangeBlocked
+	}
+	return nil
+}
+
+// priceDifference는 마지막 날 객실로 바뀐 숙박 기간과 이전 숙박 기간의 견적을 각각 계산한 차액입니다.
+// 기간 전체를 다시 계산해야 장기 숙박 할인처럼 박 수에 따라 달라지는 규칙이 차액에 반영되며,
+// 직접 정한 판매 금액은 덮어쓰지 않고 차액만 더하거나 뺍니다. 바뀐 숙박 기간의 견적에 적용된 규칙도 함께 돌려줍니다.
+func (s *stayChangeService) priceDifference(ctx context.Context, reservation *models.Reservation,
+	previousEndAt time.Time) (int, models.AppliedPricingRules, error) {
+	req := dto.QuoteRequest{
+		RoomIDs:     lastNightRoomIDs(reservation),
+		StayStartAt: truncateToDate(reservation.StayStartAt),
+		StayEndAt:   truncateToDate(reservation.StayEndAt),
+		PeopleCount: reservation.PeopleCount,
+		Type:        reservation.Type,
+	}
+	price, appliedRules, err := s.quoteService.Price(ctx, req)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req.StayEndAt = previousEndAt
+	previousPrice, _, err := s.quoteService.Price(ctx, req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return price - previousPrice, appliedRules, nil
+}
+
+// lastNightRoomIDs는 숙박 마지막 날 사용하는 객실입니다. 연장하거나 앞당긴 숙박일은 이 객실들로 숙박합니다.
+func lastNightRoomIDs(reservation *models.Reservation) []uint {
+	lastNight := truncateToDate(reservation.StayEndAt).AddDate(0, 0, -1)
+	var roomIDs []uint
+	for i := range reservation.Rooms {
+		if reservation.Rooms[i].Covers(reservation, lastNight) {
+			roomIDs = append(roomIDs, reservation.Rooms[i].RoomID)
+		}
+	}
+	return roomIDs
+}
@@ -0,0 +1,216 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gitlab.bellsoft.net/rms/api-core/internal/dto"
+	"gitlab.bellsoft.net/rms/api-core/internal/models"
+	"gitlab.bellsoft.net/rms/api-core/internal/services"
+)
+
+type MockQuoteServiceForStayChange struct {
+	mock.Mock
+}
+
+func (m *MockQuoteServiceForStayChange) Quote(ctx context.Context, req dto.QuoteRequest) (*dto.QuoteResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.QuoteResponse), args.Error(1)
+}
+
+func (m *MockQuoteServiceForStayChange) Price(ctx context.Context, req dto.QuoteRequest) (int, models.AppliedPricingRules, error) {
+	args := m.Called(ctx, req)
+	appliedRules, _ := args.Get(1).(models.AppliedPricingRules)
+	return args.Int(0), appliedRules, args.Error(2)
+}
+
+type StayChangeServiceTestSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	mockReservationRepo *MockReservationRepository
+	mockRoomRepo        *MockRoomRepository
+	mockDateBlockRepo   *MockDateBlockRepository
+	mockQuoteService    *MockQuoteServiceForStayChange
+	service             services.StayChangeService
+}
+
+func (suite *StayChangeServiceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.mockReservationRepo = new(MockReservationRepository)
+	suite.mockRoomRepo = new(MockRoomRepository)
+	suite.mockDateBlockRepo = new(MockDateBlockRepository)
+	suite.mockQuoteService = new(MockQuoteServiceForStayChange)
+	suite.service = services.NewStayChangeService(suite.mockReservationRepo, suite.mockRoomRepo,
+		suite.mockDateBlockRepo, suite.mockQuoteService)
+}
+
+func stayDate(day int) time.Time {
+	return time.Date(2030, 5, day, 0, 0, 0, 0, time.UTC)
+}
+
+// newStayChangeReservation은 5/1부터 stayEndDay일까지 1번 객실을 쓰는, 1박 100,000원 중 100,000원을 결제한 예약입니다.
+func (suite *StayChangeServiceTestSuite) newStayChangeReservation(stayEndDay int) *models.Reservation {
+	reservation := &models.Reservation{
+		Status:        models.ReservationStatusNormal,
+		PeopleCount:   2,
+		StayStartAt:   stayDate(1),
+		StayEndAt:     stayDate(stayEndDay),
+		Price:         100000 * (stayEndDay - 1),
+		PaymentAmount: 100000,
+		Rooms: []models.ReservationRoom{
+			{RoomID: 1, StayStartAt: stayDate(1), StayEndAt: stayDate(stayEndDay)},
+		},
+	}
+	reservation.ID = 7
+	suite.mockReservationRepo.On("FindByIDWithDetails", mock.Anything, uint(7)).Return(reservation, nil)
+	suite.mockRoomRepo.On("LockRooms", mock.Anything, []uint{1}).Return(nil)
+	return reservation
+}
+
+func (suite *StayChangeServiceTestSuite) onPrice(stayEndDay, price int, appliedRules ...models.AppliedPricingRule) {
+	suite.mockQuoteService.On("Price", mock.Anything, dto.QuoteRequest{
+		RoomIDs:     []uint{1},
+		StayStartAt: stayDate(1),
+		StayEndAt:   stayDate(stayEndDay),
+		PeopleCount: 2,
+		Type:        models.ReservationTypeStay,
+	}).Return(price, models.AppliedPricingRules(appliedRules), nil)
+}
+
+func (suite *StayChangeServiceTestSuite) TestExtend_늘어난_숙박일만_확인하고_견적_차액을_더한다() {
+	// Given - 5/3 퇴실 예약을 5/5까지 연장하면
+	reservation := suite.newStayChangeReservation(3)
+	excludeID := uint(7)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, uint(1), stayDate(3), stayDate(5), &excludeID).Return(true, nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", mock.Anything, stayDate(3), stayDate(5), []uint{1}).Return(false, nil)
+	suite.onPrice(5, 380000)
+	suite.onPrice(3, 190000)
+	suite.mockReservationRepo.On("Update", mock.Anything, reservation).Return(nil)
+
+	// When
+	result, err := suite.service.Extend(suite.ctx, 7, stayDate(5))
+
+	// Then - 견적 차액 190,000원만큼 판매 금액과 미수금이 늘고 객실 구간도 5/5까지 늘어난다
+	suite.Require().NoError(err)
+	suite.Equal(190000, result.PriceDifference)
+	suite.Equal(390000, result.Price)
+	suite.Equal(290000, result.UnpaidAmount)
+	suite.Equal(stayDate(5), reservation.Rooms[0].StayEndAt)
+	suite.mockReservationRepo.AssertExpectations(suite.T())
+	suite.mockRoomRepo.AssertExpectations(suite.T())
+}
+
+func (suite *StayChangeServiceTestSuite) TestExtend_적용_규칙을_바뀐_기간의_견적으로_다시_채운다() {
+	// Given - 2박 견적의 주말 할증으로 판매 금액을 계산한 예약을 4박으로 연장하면 장기 숙박 할인이 새로 적용된다
+	reservation := suite.newStayChangeReservation(3)
+	reservation.AppliedPricingRules = models.AppliedPricingRules{
+		{RuleID: 1, Type: "WEEKEND_SURCHARGE", Name: "주말 할증", RoomID: 1, Nights: 1, Amount: 10000},
+	}
+	extendedRules := []models.AppliedPricingRule{
+		{RuleID: 1, Type: "WEEKEND_SURCHARGE", Name: "주말 할증", RoomID: 1, Nights: 1, Amount: 10000},
+		{RuleID: 2, Type: "LONG_STAY_DISCOUNT", Name: "장기 숙박 할인", Nights: 4, Amount: -20000},
+	}
+	excludeID := uint(7)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, uint(1), stayDate(3), stayDate(5), &excludeID).Return(true, nil)
+	suite.mockDateBlockRepo.On("IsDateRangeBlocked", mock.Anything, stayDate(3), stayDate(5), []uint{1}).Return(false, nil)
+	suite.onPrice(5, 390000, extendedRules...)
+	suite.onPrice(3, 210000, reservation.AppliedPricingRules...)
+	suite.mockReservationRepo.On("Update", mock.Anything, reservation).Return(nil)
+
+	// When
+	_, err := suite.service.Extend(suite.ctx, 7, stayDate(5))
+
+	// Then
+	suite.Require().NoError(err)
+	suite.Equal(models.AppliedPricingRules(extendedRules), reservation.AppliedPricingRules)
+}
+
+func (suite *StayChangeServiceTestSuite) TestExtend_늘어난_숙박일에_객실이_사용_중이면_실패한다() {
+	// Given
+	suite.newStayChangeReservation(3)
+	excludeID := uint(7)
+	suite.mockRoomRepo.On("IsRoomAvailable", mock.Anything, uint(1), stayDate(3), stayDate(5), &excludeID).Return(false, nil)
+
+	// When
+	_, err := suite.service.Extend(suite.ctx, 7, stayDate(5))
+
+	// Then
+	suite.ErrorIs(err, services.ErrRoomNotAvailable)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *StayChangeServiceTestSuite) TestExtend_현재_퇴실일_이전이면_실패한다() {
+	suite.newStayChangeReservation(3)
+
+	_, err := suite.service.Extend(suite.ctx, 7, stayDate(3))
+
+	suite.ErrorIs(err, services.ErrInvalidStayExtension)
+}
+
+func (suite *StayChangeServiceTestSuite) TestShorten_가용성은_확인하지_않고_견적_차액을_뺀다() {
+	// Given - 5/5 퇴실 예약을 5/3으로 앞당기면
+	reservation := suite.newStayChangeReservation(5)
+	suite.onPrice(3, 190000)
+	suite.onPrice(5, 380000)
+	suite.mockReservationRepo.On("Update", mock.Anything, reservation).Return(nil)
+
+	// When
+	result, err := suite.service.Shorten(suite.ctx, 7, stayDate(3))
+
+	// Then
+	suite.Require().NoError(err)
+	suite.Equal(-190000, result.PriceDifference)
+	suite.Equal(210000, result.Price)
+	suite.Equal(stayDate(3), reservation.StayEndAt)
+	suite.Equal(stayDate(3), reservation.Rooms[0].StayEndAt)
+	suite.mockRoomRepo.AssertNotCalled(suite.T(), "IsRoomAvailable", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *StayChangeServiceTestSuite) TestShorten_받은_금액이_줄어든_판매_금액보다_많으면_실패한다() {
+	// Given - 400,000원 중 300,000원을 받은 예약을 2박으로 앞당기면 판매 금액이 210,000원이 된다
+	reservation := suite.newStayChangeReservation(5)
+	reservation.PaymentAmount = 300000
+	suite.onPrice(3, 190000)
+	suite.onPrice(5, 380000)
+
+	// When
+	_, err := suite.service.Shorten(suite.ctx, 7, stayDate(3))
+
+	// Then - 환불 결제 내역을 먼저 기록해야 한다
+	suite.ErrorIs(err, services.ErrStayChangeOverpaid)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *StayChangeServiceTestSuite) TestShorten_숙박_시작일까지_앞당기면_실패한다() {
+	suite.newStayChangeReservation(5)
+
+	_, err := suite.service.Shorten(suite.ctx, 7, stayDate(1))
+
+	suite.ErrorIs(err, services.ErrInvalidStayShortening)
+}
+
+func (suite *StayChangeServiceTestSuite) TestShorten_정산이_끝난_예약은_금액이_바뀌면_실패한다() {
+	// Given
+	reservation := suite.newStayChangeReservation(5)
+	settlementID := uint(1)
+	reservation.BrokerFeeSettlementID = &settlementID
+	suite.onPrice(3, 190000)
+	suite.onPrice(5, 380000)
+
+	// When
+	_, err := suite.service.Shorten(suite.ctx, 7, stayDate(3))
+
+	// Then
+	suite.ErrorIs(err, services.ErrReservationSettled)
+	suite.mockReservationRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func TestStayChangeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(StayChangeServiceTestSuite))
+}